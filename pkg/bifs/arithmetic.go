@@ -3,6 +3,7 @@ package bifs
 import (
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
@@ -27,6 +28,9 @@ var upos_dispositions = [mlrval.MT_DIM]UnaryFunc{
 	/*ERROR  */ upos_te,
 	/*NULL   */ _null1,
 	/*ABSENT */ _absn1,
+	/*TIME   */ _1u___,
+	/*DURATION*/ _1u___,
+	/*DECIMAL*/ _1u___,
 }
 
 func BIF_plus_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -48,6 +52,14 @@ func uneg_f_f(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(-input1.AcquireFloatValue())
 }
 
+func uneg_d_d(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromDuration(-input1.AcquireDurationValue())
+}
+
+func uneg_decimal(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromDecimal(input1.AcquireDecimalValue().Neg())
+}
+
 var uneg_dispositions = [mlrval.MT_DIM]UnaryFunc{
 	/*INT    */ uneg_i_i,
 	/*FLOAT  */ uneg_f_f,
@@ -60,12 +72,44 @@ var uneg_dispositions = [mlrval.MT_DIM]UnaryFunc{
 	/*ERROR  */ uneg_te,
 	/*NULL   */ _null1,
 	/*ABSENT */ _absn1,
+	/*TIME   */ uneg_te,
+	/*DURATION*/ uneg_d_d,
+	/*DECIMAL*/ uneg_decimal,
 }
 
 func BIF_minus_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return uneg_dispositions[input1.Type()](input1)
 }
 
+// ================================================================
+// Decimals, for mlr --decimal. A decimal with an int or a decimal is exact,
+// as a decimal; a decimal with a float is a float.
+
+func decimalBinary(
+	input1, input2 *mlrval.Mlrval,
+	decimalFunc func(a, b *lib.Decimal) *mlrval.Mlrval,
+	floatFunc func(a, b float64) *mlrval.Mlrval,
+) *mlrval.Mlrval {
+	if input1.IsFloat() || input2.IsFloat() {
+		a, _ := input1.GetNumericToFloatValue()
+		b, _ := input2.GetNumericToFloatValue()
+		return floatFunc(a, b)
+	}
+	a, _ := input1.GetNumericToDecimalValue()
+	b, _ := input2.GetNumericToDecimalValue()
+	return decimalFunc(a, b)
+}
+
+// intOverflow is for int results which don't fit in 64 bits: big-integer
+// decimals with mlr --decimal, else floats.
+func intOverflow(a, b int64, decimalFunc func(a, b *lib.Decimal) *lib.Decimal, floatValue float64) *mlrval.Mlrval {
+	if mlrval.IsDecimalMode() {
+		return mlrval.FromDecimal(decimalFunc(lib.NewDecimalFromInt64(a), lib.NewDecimalFromInt64(b)))
+	} else {
+		return mlrval.FromFloat(floatValue)
+	}
+}
+
 // ================================================================
 // Addition with auto-overflow from int to float when necessary.  See also
 // https://miller.readthedocs.io/en/latest/reference-main-arithmetic
@@ -89,7 +133,7 @@ func plus_n_ii(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	}
 
 	if overflowed {
-		return mlrval.FromFloat(float64(a) + float64(b))
+		return intOverflow(a, b, (*lib.Decimal).Add, float64(a)+float64(b))
 	} else {
 		return mlrval.FromInt(c)
 	}
@@ -104,24 +148,73 @@ func plus_f_fi(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 func plus_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(input1.AcquireFloatValue() + input2.AcquireFloatValue())
 }
+func plus_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return decimalBinary(input1, input2,
+		func(a, b *lib.Decimal) *mlrval.Mlrval { return mlrval.FromDecimal(a.Add(b)) },
+		func(a, b float64) *mlrval.Mlrval { return mlrval.FromFloat(a + b) },
+	)
+}
+
+// Times plus durations are times. Ints and floats added to times are seconds.
+
+func plus_t_td(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromTime(
+		input1.AcquireTimeValue().Add(input2.AcquireDurationValue()),
+		input1.AcquireTimeFormat(),
+	)
+}
+func plus_t_dt(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return plus_t_td(input2, input1)
+}
+func plus_t_tn(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromTime(
+		input1.AcquireTimeValue().Add(secondsToDuration(input2)),
+		input1.AcquireTimeFormat(),
+	)
+}
+func plus_t_nt(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return plus_t_tn(input2, input1)
+}
+func plus_d_dd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromDuration(input1.AcquireDurationValue() + input2.AcquireDurationValue())
+}
+func plus_d_dn(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromDuration(input1.AcquireDurationValue() + secondsToDuration(input2))
+}
+func plus_d_nd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return plus_d_dn(input2, input1)
+}
+
+// secondsToDuration is for int, float, or decimal seconds.
+func secondsToDuration(input *mlrval.Mlrval) time.Duration {
+	if input.IsInt() {
+		return time.Duration(input.AcquireIntValue()) * time.Second
+	} else {
+		seconds, _ := input.GetNumericToFloatValue()
+		return time.Duration(seconds * 1.0e9)
+	}
+}
 
 func plste(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorBinary("+", input1, input2)
 }
 
 var plus_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT         FLOAT      BOOL     VOID     STRING   ARRAY    MAP      FUNC     ERROR    NULL     ABSENT
-	/*INT    */ {plus_n_ii, plus_f_if, plste, _1___, plste, _absn, _absn, plste, plste, _1___, _1___},
-	/*FLOAT  */ {plus_f_fi, plus_f_ff, plste, _1___, plste, _absn, _absn, plste, plste, _1___, _1___},
-	/*BOOL   */ {plste, plste, plste, plste, plste, _absn, _absn, plste, plste, plste, plste},
-	/*VOID   */ {_2___, _2___, plste, _void, plste, _absn, _absn, plste, plste, plste, _absn},
-	/*STRING */ {plste, plste, plste, plste, plste, _absn, _absn, plste, plste, plste, plste},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, plste, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, plste, _absn, _absn, _absn},
-	/*FUNC   */ {plste, plste, plste, plste, plste, plste, plste, plste, plste, plste, plste},
-	/*ERROR  */ {plste, plste, plste, plste, plste, _absn, _absn, plste, plste, plste, plste},
-	/*NULL   */ {_2___, _2___, plste, plste, plste, _absn, _absn, plste, plste, _null, _absn},
-	/*ABSENT */ {_2___, _2___, plste, _absn, plste, _absn, _absn, plste, plste, _absn, _absn},
+	//       .  INT         FLOAT      BOOL     VOID     STRING   ARRAY    MAP      FUNC     ERROR    NULL     ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {plus_n_ii, plus_f_if, plste, _1___, plste, _absn, _absn, plste, plste, _1___, _1___, plus_t_nt, plus_d_nd, plus_decimal},
+	/*FLOAT  */ {plus_f_fi, plus_f_ff, plste, _1___, plste, _absn, _absn, plste, plste, _1___, _1___, plus_t_nt, plus_d_nd, plus_decimal},
+	/*BOOL   */ {plste, plste, plste, plste, plste, _absn, _absn, plste, plste, plste, plste, plste, plste, plste},
+	/*VOID   */ {_2___, _2___, plste, _void, plste, _absn, _absn, plste, plste, plste, _absn, _2___, _2___, _2___},
+	/*STRING */ {plste, plste, plste, plste, plste, _absn, _absn, plste, plste, plste, plste, plste, plste, plste},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, plste, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, plste, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {plste, plste, plste, plste, plste, plste, plste, plste, plste, plste, plste, plste, plste, plste},
+	/*ERROR  */ {plste, plste, plste, plste, plste, _absn, _absn, plste, plste, plste, plste, plste, plste, plste},
+	/*NULL   */ {_2___, _2___, plste, plste, plste, _absn, _absn, plste, plste, _null, _absn, _2___, _2___, _2___},
+	/*ABSENT */ {_2___, _2___, plste, _absn, plste, _absn, _absn, plste, plste, _absn, _absn, _2___, _2___, _2___},
+	/*TIME   */ {plus_t_tn, plus_t_tn, plste, _1___, plste, _absn, _absn, plste, plste, _1___, _1___, plste, plus_t_td, plus_t_tn},
+	/*DURATION*/ {plus_d_dn, plus_d_dn, plste, _1___, plste, _absn, _absn, plste, plste, _1___, _1___, plus_t_dt, plus_d_dd, plus_d_dn},
+	/*DECIMAL*/ {plus_decimal, plus_decimal, plste, _1___, plste, _absn, _absn, plste, plste, _1___, _1___, plus_t_nt, plus_d_nd, plus_decimal},
 }
 
 func BIF_plus_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -151,7 +244,7 @@ func minus_n_ii(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	}
 
 	if overflowed {
-		return mlrval.FromFloat(float64(a) - float64(b))
+		return intOverflow(a, b, (*lib.Decimal).Sub, float64(a)-float64(b))
 	} else {
 		return mlrval.FromInt(c)
 	}
@@ -166,24 +259,61 @@ func minus_f_fi(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 func minus_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(input1.AcquireFloatValue() - input2.AcquireFloatValue())
 }
+func minus_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return decimalBinary(input1, input2,
+		func(a, b *lib.Decimal) *mlrval.Mlrval { return mlrval.FromDecimal(a.Sub(b)) },
+		func(a, b float64) *mlrval.Mlrval { return mlrval.FromFloat(a - b) },
+	)
+}
+
+// Time minus time is a duration; time minus duration is a time. Ints and
+// floats subtracted from times are seconds.
+
+func minus_d_tt(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromDuration(input1.AcquireTimeValue().Sub(input2.AcquireTimeValue()))
+}
+func minus_t_td(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromTime(
+		input1.AcquireTimeValue().Add(-input2.AcquireDurationValue()),
+		input1.AcquireTimeFormat(),
+	)
+}
+func minus_t_tn(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromTime(
+		input1.AcquireTimeValue().Add(-secondsToDuration(input2)),
+		input1.AcquireTimeFormat(),
+	)
+}
+func minus_d_dd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromDuration(input1.AcquireDurationValue() - input2.AcquireDurationValue())
+}
+func minus_d_dn(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromDuration(input1.AcquireDurationValue() - secondsToDuration(input2))
+}
+func minus_d_nd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromDuration(secondsToDuration(input1) - input2.AcquireDurationValue())
+}
 
 func mnste(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorBinary("-", input1, input2)
 }
 
 var minus_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT          FLOAT       BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {minus_n_ii, minus_f_if, mnste, _1___, mnste, _absn, _absn, mnste, mnste, _1___, _1___},
-	/*FLOAT  */ {minus_f_fi, minus_f_ff, mnste, _1___, mnste, _absn, _absn, mnste, mnste, _1___, _1___},
-	/*BOOL   */ {mnste, mnste, mnste, mnste, mnste, _absn, _absn, mnste, mnste, mnste, mnste},
-	/*VOID   */ {_n2__, _n2__, mnste, _void, mnste, _absn, _absn, mnste, mnste, mnste, _absn},
-	/*STRING */ {mnste, mnste, mnste, mnste, mnste, _absn, _absn, mnste, mnste, mnste, mnste},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, mnste, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, mnste, _absn, _absn, _absn},
-	/*FUNC   */ {mnste, mnste, mnste, mnste, mnste, mnste, mnste, mnste, mnste, mnste, mnste},
-	/*ERROR  */ {mnste, mnste, mnste, mnste, mnste, _absn, _absn, mnste, mnste, mnste, mnste},
-	/*NULL   */ {_2___, _2___, mnste, mnste, mnste, _absn, _absn, mnste, mnste, _null, _absn},
-	/*ABSENT */ {_2___, _2___, mnste, _absn, mnste, _absn, _absn, mnste, mnste, _absn, _absn},
+	//       .  INT          FLOAT       BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {minus_n_ii, minus_f_if, mnste, _1___, mnste, _absn, _absn, mnste, mnste, _1___, _1___, mnste, minus_d_nd, minus_decimal},
+	/*FLOAT  */ {minus_f_fi, minus_f_ff, mnste, _1___, mnste, _absn, _absn, mnste, mnste, _1___, _1___, mnste, minus_d_nd, minus_decimal},
+	/*BOOL   */ {mnste, mnste, mnste, mnste, mnste, _absn, _absn, mnste, mnste, mnste, mnste, mnste, mnste, mnste},
+	/*VOID   */ {_n2__, _n2__, mnste, _void, mnste, _absn, _absn, mnste, mnste, mnste, _absn, mnste, _n2__, _n2__},
+	/*STRING */ {mnste, mnste, mnste, mnste, mnste, _absn, _absn, mnste, mnste, mnste, mnste, mnste, mnste, mnste},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, mnste, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, mnste, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {mnste, mnste, mnste, mnste, mnste, mnste, mnste, mnste, mnste, mnste, mnste, mnste, mnste, mnste},
+	/*ERROR  */ {mnste, mnste, mnste, mnste, mnste, _absn, _absn, mnste, mnste, mnste, mnste, mnste, mnste, mnste},
+	/*NULL   */ {_2___, _2___, mnste, mnste, mnste, _absn, _absn, mnste, mnste, _null, _absn, mnste, _n2__, _2___},
+	/*ABSENT */ {_2___, _2___, mnste, _absn, mnste, _absn, _absn, mnste, mnste, _absn, _absn, mnste, _n2__, _2___},
+	/*TIME   */ {minus_t_tn, minus_t_tn, mnste, _1___, mnste, _absn, _absn, mnste, mnste, _1___, _1___, minus_d_tt, minus_t_td, minus_t_tn},
+	/*DURATION*/ {minus_d_dn, minus_d_dn, mnste, _1___, mnste, _absn, _absn, mnste, mnste, _1___, _1___, mnste, minus_d_dd, minus_d_dn},
+	/*DECIMAL*/ {minus_decimal, minus_decimal, mnste, _1___, mnste, _absn, _absn, mnste, mnste, _1___, _1___, mnste, minus_d_nd, minus_decimal},
 }
 
 func BIF_minus_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -229,7 +359,7 @@ func times_n_ii(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	c := float64(a) * float64(b)
 
 	if math.Abs(c) > 9223372036854774784.0 {
-		return mlrval.FromFloat(c)
+		return intOverflow(a, b, (*lib.Decimal).Mul, c)
 	} else {
 		return mlrval.FromInt(a * b)
 	}
@@ -244,24 +374,45 @@ func times_f_fi(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 func times_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(input1.AcquireFloatValue() * input2.AcquireFloatValue())
 }
+func times_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return decimalBinary(input1, input2,
+		func(a, b *lib.Decimal) *mlrval.Mlrval { return mlrval.FromDecimal(a.Mul(b)) },
+		func(a, b float64) *mlrval.Mlrval { return mlrval.FromFloat(a * b) },
+	)
+}
+
+func times_d_dn(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input2.IsInt() {
+		return mlrval.FromDuration(input1.AcquireDurationValue() * time.Duration(input2.AcquireIntValue()))
+	} else {
+		factor, _ := input2.GetNumericToFloatValue()
+		return mlrval.FromDuration(time.Duration(float64(input1.AcquireDurationValue()) * factor))
+	}
+}
+func times_d_nd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return times_d_dn(input2, input1)
+}
 
 func tmste(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorBinary("*", input1, input2)
 }
 
 var times_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT          FLOAT       BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {times_n_ii, times_f_if, tmste, _1___, tmste, _absn, _absn, tmste, tmste, _1___, _1___},
-	/*FLOAT  */ {times_f_fi, times_f_ff, tmste, _1___, tmste, _absn, _absn, tmste, tmste, _1___, _1___},
-	/*BOOL   */ {tmste, tmste, tmste, tmste, tmste, _absn, _absn, tmste, tmste, tmste, tmste},
-	/*VOID   */ {_2___, _2___, tmste, _void, tmste, _absn, _absn, tmste, tmste, tmste, _absn},
-	/*STRING */ {tmste, tmste, tmste, tmste, tmste, _absn, _absn, tmste, tmste, tmste, tmste},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, tmste, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, tmste, _absn, _absn, _absn},
-	/*FUNC   */ {tmste, tmste, tmste, tmste, tmste, tmste, tmste, tmste, tmste, tmste, tmste},
-	/*ERROR  */ {tmste, tmste, tmste, tmste, tmste, _absn, _absn, tmste, tmste, tmste, tmste},
-	/*NULL   */ {_2___, _2___, tmste, tmste, tmste, _absn, _absn, tmste, tmste, _null, _absn},
-	/*ABSENT */ {_2___, _2___, tmste, _absn, tmste, _absn, _absn, tmste, tmste, _absn, _absn},
+	//       .  INT          FLOAT       BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {times_n_ii, times_f_if, tmste, _1___, tmste, _absn, _absn, tmste, tmste, _1___, _1___, tmste, times_d_nd, times_decimal},
+	/*FLOAT  */ {times_f_fi, times_f_ff, tmste, _1___, tmste, _absn, _absn, tmste, tmste, _1___, _1___, tmste, times_d_nd, times_decimal},
+	/*BOOL   */ {tmste, tmste, tmste, tmste, tmste, _absn, _absn, tmste, tmste, tmste, tmste, tmste, tmste, tmste},
+	/*VOID   */ {_2___, _2___, tmste, _void, tmste, _absn, _absn, tmste, tmste, tmste, _absn, tmste, _2___, _2___},
+	/*STRING */ {tmste, tmste, tmste, tmste, tmste, _absn, _absn, tmste, tmste, tmste, tmste, tmste, tmste, tmste},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, tmste, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, tmste, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {tmste, tmste, tmste, tmste, tmste, tmste, tmste, tmste, tmste, tmste, tmste, tmste, tmste, tmste},
+	/*ERROR  */ {tmste, tmste, tmste, tmste, tmste, _absn, _absn, tmste, tmste, tmste, tmste, tmste, tmste, tmste},
+	/*NULL   */ {_2___, _2___, tmste, tmste, tmste, _absn, _absn, tmste, tmste, _null, _absn, tmste, _2___, _2___},
+	/*ABSENT */ {_2___, _2___, tmste, _absn, tmste, _absn, _absn, tmste, tmste, _absn, _absn, tmste, _2___, _2___},
+	/*TIME   */ {tmste, tmste, tmste, tmste, tmste, _absn, _absn, tmste, tmste, tmste, tmste, tmste, tmste, tmste},
+	/*DURATION*/ {times_d_dn, times_d_dn, tmste, _1___, tmste, _absn, _absn, tmste, tmste, _1___, _1___, tmste, tmste, times_d_dn},
+	/*DECIMAL*/ {times_decimal, times_decimal, tmste, _1___, tmste, _absn, _absn, tmste, tmste, _1___, _1___, tmste, times_d_nd, times_decimal},
 }
 
 func BIF_times(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -297,6 +448,8 @@ func divide_n_ii(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	// Pythonic division, not C division.
 	if a%b == 0 {
 		return mlrval.FromInt(a / b)
+	} else if mlrval.IsDecimalMode() {
+		return divide_decimal(input1, input2)
 	} else {
 		return mlrval.FromFloat(float64(a) / float64(b))
 	}
@@ -312,23 +465,47 @@ func divide_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(input1.AcquireFloatValue() / input2.AcquireFloatValue())
 }
 
+// Division by zero gives inf/nan as with floats.
+func divide_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return decimalBinary(input1, input2,
+		func(a, b *lib.Decimal) *mlrval.Mlrval {
+			if q, ok := a.Quo(b); ok {
+				return mlrval.FromDecimal(q)
+			}
+			return mlrval.FromFloat(a.Float64() / 0.0)
+		},
+		func(a, b float64) *mlrval.Mlrval { return mlrval.FromFloat(a / b) },
+	)
+}
+
+// Duration over number is a duration; duration over duration is a ratio.
+func divide_d_dn(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromDuration(time.Duration(float64(input1.AcquireDurationValue()) / input2.GetNumericToFloatValueOrDie()))
+}
+func divide_f_dd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromFloat(float64(input1.AcquireDurationValue()) / float64(input2.AcquireDurationValue()))
+}
+
 func dvdte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorBinary("/", input1, input2)
 }
 
 var divide_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT          FLOAT        BOOL   VOID   STRING ARRAY  MAP    FUNC    ERROR   NULL   ABSENT
-	/*INT    */ {divide_n_ii, divide_f_if, dvdte, _void, dvdte, _absn, _absn, dvdte, dvdte, _1___, _1___},
-	/*FLOAT  */ {divide_f_fi, divide_f_ff, dvdte, _void, dvdte, _absn, _absn, dvdte, dvdte, _1___, _1___},
-	/*BOOL   */ {dvdte, dvdte, dvdte, dvdte, dvdte, _absn, _absn, dvdte, dvdte, dvdte, dvdte},
-	/*VOID   */ {_void, _void, dvdte, _void, dvdte, _absn, _absn, dvdte, dvdte, dvdte, _absn},
-	/*STRING */ {dvdte, dvdte, dvdte, dvdte, dvdte, _absn, _absn, dvdte, dvdte, dvdte, dvdte},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dvdte, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dvdte, _absn, _absn, _absn},
-	/*FUNC   */ {dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte},
-	/*ERROR  */ {dvdte, dvdte, dvdte, dvdte, dvdte, _absn, _absn, dvdte, dvdte, dvdte, dvdte},
-	/*NULL   */ {_i0__, _f0__, dvdte, dvdte, dvdte, _absn, _absn, dvdte, dvdte, dvdte, _absn},
-	/*ABSENT */ {_i0__, _f0__, dvdte, _absn, dvdte, _absn, _absn, dvdte, dvdte, _absn, _absn},
+	//       .  INT          FLOAT        BOOL   VOID   STRING ARRAY  MAP    FUNC    ERROR   NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {divide_n_ii, divide_f_if, dvdte, _void, dvdte, _absn, _absn, dvdte, dvdte, _1___, _1___, dvdte, dvdte, divide_decimal},
+	/*FLOAT  */ {divide_f_fi, divide_f_ff, dvdte, _void, dvdte, _absn, _absn, dvdte, dvdte, _1___, _1___, dvdte, dvdte, divide_decimal},
+	/*BOOL   */ {dvdte, dvdte, dvdte, dvdte, dvdte, _absn, _absn, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte},
+	/*VOID   */ {_void, _void, dvdte, _void, dvdte, _absn, _absn, dvdte, dvdte, dvdte, _absn, dvdte, _void, _void},
+	/*STRING */ {dvdte, dvdte, dvdte, dvdte, dvdte, _absn, _absn, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dvdte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dvdte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte},
+	/*ERROR  */ {dvdte, dvdte, dvdte, dvdte, dvdte, _absn, _absn, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte},
+	/*NULL   */ {_i0__, _f0__, dvdte, dvdte, dvdte, _absn, _absn, dvdte, dvdte, dvdte, _absn, dvdte, dvdte, _f0__},
+	/*ABSENT */ {_i0__, _f0__, dvdte, _absn, dvdte, _absn, _absn, dvdte, dvdte, _absn, _absn, dvdte, _2___, _f0__},
+	/*TIME   */ {dvdte, dvdte, dvdte, dvdte, dvdte, _absn, _absn, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte, dvdte},
+	/*DURATION*/ {divide_d_dn, divide_d_dn, dvdte, _void, dvdte, _absn, _absn, dvdte, dvdte, _1___, _1___, dvdte, divide_f_dd, divide_d_dn},
+	/*DECIMAL*/ {divide_decimal, divide_decimal, dvdte, _void, dvdte, _absn, _absn, dvdte, dvdte, _1___, _1___, dvdte, dvdte, divide_decimal},
 }
 
 func BIF_divide(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -376,24 +553,38 @@ func int_divide_f_fi(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 func int_divide_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(math.Floor(input1.AcquireFloatValue() / input2.AcquireFloatValue()))
 }
+func int_divide_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return decimalBinary(input1, input2,
+		func(a, b *lib.Decimal) *mlrval.Mlrval {
+			if q, ok := a.QuoFloor(b); ok {
+				return mlrval.FromDecimal(q)
+			}
+			return mlrval.FromFloat(a.Float64() / 0.0)
+		},
+		func(a, b float64) *mlrval.Mlrval { return mlrval.FromFloat(math.Floor(a / b)) },
+	)
+}
 
 func idvte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorBinary("//", input1, input2)
 }
 
 var int_divide_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT              FLOAT            BOOL   VOID   STRING ARRAY  MAP    FUNC    ERROR   NULL   ABSENT
-	/*INT    */ {int_divide_n_ii, int_divide_f_if, idvte, _void, idvte, _absn, _absn, idvte, idvte, idvte, _1___},
-	/*FLOAT  */ {int_divide_f_fi, int_divide_f_ff, idvte, _void, idvte, _absn, _absn, idvte, idvte, idvte, _1___},
-	/*BOOL   */ {idvte, idvte, idvte, idvte, idvte, _absn, _absn, idvte, idvte, idvte, idvte},
-	/*VOID   */ {_void, _void, idvte, _void, idvte, _absn, _absn, idvte, idvte, idvte, _absn},
-	/*STRING */ {idvte, idvte, idvte, idvte, idvte, _absn, _absn, idvte, idvte, idvte, idvte},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, idvte, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, idvte, _absn, _absn, _absn},
-	/*FUNC   */ {idvte, idvte, idvte, idvte, idvte, idvte, idvte, idvte, idvte, idvte, idvte},
-	/*ERROR  */ {idvte, idvte, idvte, idvte, idvte, _absn, _absn, idvte, idvte, idvte, idvte},
-	/*NULL   */ {idvte, idvte, idvte, idvte, idvte, _absn, _absn, idvte, idvte, idvte, _absn},
-	/*ABSENT */ {_i0__, _f0__, idvte, _absn, idvte, _absn, _absn, idvte, idvte, _absn, _absn},
+	//       .  INT              FLOAT            BOOL   VOID   STRING ARRAY  MAP    FUNC    ERROR   NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {int_divide_n_ii, int_divide_f_if, idvte, _void, idvte, _absn, _absn, idvte, idvte, idvte, _1___, idvte, idvte, int_divide_decimal},
+	/*FLOAT  */ {int_divide_f_fi, int_divide_f_ff, idvte, _void, idvte, _absn, _absn, idvte, idvte, idvte, _1___, idvte, idvte, int_divide_decimal},
+	/*BOOL   */ {idvte, idvte, idvte, idvte, idvte, _absn, _absn, idvte, idvte, idvte, idvte, idvte, idvte, idvte},
+	/*VOID   */ {_void, _void, idvte, _void, idvte, _absn, _absn, idvte, idvte, idvte, _absn, idvte, idvte, _void},
+	/*STRING */ {idvte, idvte, idvte, idvte, idvte, _absn, _absn, idvte, idvte, idvte, idvte, idvte, idvte, idvte},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, idvte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, idvte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {idvte, idvte, idvte, idvte, idvte, idvte, idvte, idvte, idvte, idvte, idvte, idvte, idvte, idvte},
+	/*ERROR  */ {idvte, idvte, idvte, idvte, idvte, _absn, _absn, idvte, idvte, idvte, idvte, idvte, idvte, idvte},
+	/*NULL   */ {idvte, idvte, idvte, idvte, idvte, _absn, _absn, idvte, idvte, idvte, _absn, idvte, idvte, idvte},
+	/*ABSENT */ {_i0__, _f0__, idvte, _absn, idvte, _absn, _absn, idvte, idvte, _absn, _absn, idvte, idvte, _f0__},
+	/*TIME   */ {idvte, idvte, idvte, idvte, idvte, _absn, _absn, idvte, idvte, idvte, idvte, idvte, idvte, idvte},
+	/*DURATION*/ {idvte, idvte, idvte, idvte, idvte, _absn, _absn, idvte, idvte, idvte, idvte, idvte, idvte, idvte},
+	/*DECIMAL*/ {int_divide_decimal, int_divide_decimal, idvte, _void, idvte, _absn, _absn, idvte, idvte, idvte, _1___, idvte, idvte, int_divide_decimal},
 }
 
 func BIF_int_divide(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -422,18 +613,21 @@ func dplte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 var dot_plus_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT            FLOAT         BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {dotplus_i_ii, dotplus_f_if, dplte, _1___, dplte, _absn, _absn, dplte, dplte, _1___, _1___},
-	/*FLOAT  */ {dotplus_f_fi, dotplus_f_ff, dplte, _1___, dplte, _absn, _absn, dplte, dplte, _1___, _1___},
-	/*BOOL   */ {dplte, dplte, dplte, dplte, dplte, _absn, _absn, dplte, dplte, dplte, dplte},
-	/*VOID   */ {_2___, _2___, dplte, _void, dplte, _absn, _absn, dplte, dplte, dplte, _absn},
-	/*STRING */ {dplte, dplte, dplte, dplte, dplte, _absn, _absn, dplte, dplte, dplte, dplte},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dplte, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dplte, _absn, _absn, _absn},
-	/*FUNC   */ {dplte, dplte, dplte, dplte, dplte, dplte, dplte, dplte, dplte, dplte, dplte},
-	/*ERROR  */ {dplte, dplte, dplte, dplte, dplte, _absn, _absn, dplte, dplte, dplte, dplte},
-	/*NULL   */ {_2___, _2___, dplte, dplte, dplte, _absn, _absn, dplte, dplte, _null, _absn},
-	/*ABSENT */ {_2___, _2___, dplte, _absn, dplte, _absn, _absn, dplte, dplte, _absn, _absn},
+	//       .  INT            FLOAT         BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {dotplus_i_ii, dotplus_f_if, dplte, _1___, dplte, _absn, _absn, dplte, dplte, _1___, _1___, dplte, dplte, plus_decimal},
+	/*FLOAT  */ {dotplus_f_fi, dotplus_f_ff, dplte, _1___, dplte, _absn, _absn, dplte, dplte, _1___, _1___, dplte, dplte, plus_decimal},
+	/*BOOL   */ {dplte, dplte, dplte, dplte, dplte, _absn, _absn, dplte, dplte, dplte, dplte, dplte, dplte, dplte},
+	/*VOID   */ {_2___, _2___, dplte, _void, dplte, _absn, _absn, dplte, dplte, dplte, _absn, dplte, dplte, _2___},
+	/*STRING */ {dplte, dplte, dplte, dplte, dplte, _absn, _absn, dplte, dplte, dplte, dplte, dplte, dplte, dplte},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dplte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dplte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {dplte, dplte, dplte, dplte, dplte, dplte, dplte, dplte, dplte, dplte, dplte, dplte, dplte, dplte},
+	/*ERROR  */ {dplte, dplte, dplte, dplte, dplte, _absn, _absn, dplte, dplte, dplte, dplte, dplte, dplte, dplte},
+	/*NULL   */ {_2___, _2___, dplte, dplte, dplte, _absn, _absn, dplte, dplte, _null, _absn, dplte, dplte, _2___},
+	/*ABSENT */ {_2___, _2___, dplte, _absn, dplte, _absn, _absn, dplte, dplte, _absn, _absn, dplte, dplte, _2___},
+	/*TIME   */ {dplte, dplte, dplte, dplte, dplte, _absn, _absn, dplte, dplte, dplte, dplte, dplte, dplte, dplte},
+	/*DURATION*/ {dplte, dplte, dplte, dplte, dplte, _absn, _absn, dplte, dplte, dplte, dplte, dplte, dplte, dplte},
+	/*DECIMAL*/ {plus_decimal, plus_decimal, dplte, _1___, dplte, _absn, _absn, dplte, dplte, _1___, _1___, dplte, dplte, plus_decimal},
 }
 
 func BIF_dot_plus(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -462,18 +656,21 @@ func dmnte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 var dotminus_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT             FLOAT          BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {dotminus_i_ii, dotminus_f_if, dmnte, _1___, dmnte, _absn, _absn, dmnte, dmnte, _1___, _1___},
-	/*FLOAT  */ {dotminus_f_fi, dotminus_f_ff, dmnte, _1___, dmnte, _absn, _absn, dmnte, dmnte, _1___, _1___},
-	/*BOOL   */ {dmnte, dmnte, dmnte, dmnte, dmnte, _absn, _absn, dmnte, dmnte, dmnte, dmnte},
-	/*VOID   */ {_n2__, _n2__, dmnte, _void, dmnte, _absn, _absn, dmnte, dmnte, dmnte, _absn},
-	/*STRING */ {dmnte, dmnte, dmnte, dmnte, dmnte, _absn, _absn, dmnte, dmnte, dmnte, dmnte},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dmnte, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dmnte, _absn, _absn, _absn},
-	/*FUNC   */ {dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte},
-	/*ERROR  */ {dmnte, dmnte, dmnte, dmnte, dmnte, _absn, _absn, dmnte, dmnte, dmnte, dmnte},
-	/*NULL   */ {_n2__, _n2__, dmnte, dmnte, dmnte, _absn, _absn, dmnte, dmnte, _null, _absn},
-	/*ABSENT */ {_n2__, _n2__, dmnte, _absn, dmnte, _absn, _absn, dmnte, dmnte, _absn, _absn},
+	//       .  INT             FLOAT          BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {dotminus_i_ii, dotminus_f_if, dmnte, _1___, dmnte, _absn, _absn, dmnte, dmnte, _1___, _1___, dmnte, dmnte, minus_decimal},
+	/*FLOAT  */ {dotminus_f_fi, dotminus_f_ff, dmnte, _1___, dmnte, _absn, _absn, dmnte, dmnte, _1___, _1___, dmnte, dmnte, minus_decimal},
+	/*BOOL   */ {dmnte, dmnte, dmnte, dmnte, dmnte, _absn, _absn, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte},
+	/*VOID   */ {_n2__, _n2__, dmnte, _void, dmnte, _absn, _absn, dmnte, dmnte, dmnte, _absn, dmnte, dmnte, _n2__},
+	/*STRING */ {dmnte, dmnte, dmnte, dmnte, dmnte, _absn, _absn, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dmnte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dmnte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte},
+	/*ERROR  */ {dmnte, dmnte, dmnte, dmnte, dmnte, _absn, _absn, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte},
+	/*NULL   */ {_n2__, _n2__, dmnte, dmnte, dmnte, _absn, _absn, dmnte, dmnte, _null, _absn, dmnte, dmnte, _n2__},
+	/*ABSENT */ {_n2__, _n2__, dmnte, _absn, dmnte, _absn, _absn, dmnte, dmnte, _absn, _absn, dmnte, dmnte, _n2__},
+	/*TIME   */ {dmnte, dmnte, dmnte, dmnte, dmnte, _absn, _absn, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte},
+	/*DURATION*/ {dmnte, dmnte, dmnte, dmnte, dmnte, _absn, _absn, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte, dmnte},
+	/*DECIMAL*/ {minus_decimal, minus_decimal, dmnte, _1___, dmnte, _absn, _absn, dmnte, dmnte, _1___, _1___, dmnte, dmnte, minus_decimal},
 }
 
 func BIF_dot_minus(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -502,18 +699,21 @@ func dttte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 var dottimes_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT             FLOAT          BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {dottimes_i_ii, dottimes_f_if, dttte, _1___, dttte, _absn, _absn, dttte, dttte, _1___, _1___},
-	/*FLOAT  */ {dottimes_f_fi, dottimes_f_ff, dttte, _1___, dttte, _absn, _absn, dttte, dttte, _1___, _1___},
-	/*BOOL   */ {dttte, dttte, dttte, dttte, dttte, _absn, _absn, dttte, dttte, dttte, dttte},
-	/*VOID   */ {_n2__, _n2__, dttte, _void, dttte, _absn, _absn, dttte, dttte, dttte, _absn},
-	/*STRING */ {dttte, dttte, dttte, dttte, dttte, _absn, _absn, dttte, dttte, dttte, dttte},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dttte, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dttte, _absn, _absn, _absn},
-	/*FUNC   */ {dttte, dttte, dttte, dttte, dttte, dttte, dttte, dttte, dttte, dttte, dttte},
-	/*ERROR  */ {dttte, dttte, dttte, dttte, dttte, _absn, _absn, dttte, dttte, dttte, dttte},
-	/*NULL   */ {_2___, _2___, dttte, dttte, dttte, _absn, _absn, dttte, dttte, dttte, _absn},
-	/*ABSENT */ {_2___, _2___, dttte, _absn, dttte, _absn, _absn, dttte, dttte, _absn, _absn},
+	//       .  INT             FLOAT          BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {dottimes_i_ii, dottimes_f_if, dttte, _1___, dttte, _absn, _absn, dttte, dttte, _1___, _1___, dttte, dttte, times_decimal},
+	/*FLOAT  */ {dottimes_f_fi, dottimes_f_ff, dttte, _1___, dttte, _absn, _absn, dttte, dttte, _1___, _1___, dttte, dttte, times_decimal},
+	/*BOOL   */ {dttte, dttte, dttte, dttte, dttte, _absn, _absn, dttte, dttte, dttte, dttte, dttte, dttte, dttte},
+	/*VOID   */ {_n2__, _n2__, dttte, _void, dttte, _absn, _absn, dttte, dttte, dttte, _absn, dttte, dttte, _n2__},
+	/*STRING */ {dttte, dttte, dttte, dttte, dttte, _absn, _absn, dttte, dttte, dttte, dttte, dttte, dttte, dttte},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dttte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, dttte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {dttte, dttte, dttte, dttte, dttte, dttte, dttte, dttte, dttte, dttte, dttte, dttte, dttte, dttte},
+	/*ERROR  */ {dttte, dttte, dttte, dttte, dttte, _absn, _absn, dttte, dttte, dttte, dttte, dttte, dttte, dttte},
+	/*NULL   */ {_2___, _2___, dttte, dttte, dttte, _absn, _absn, dttte, dttte, dttte, _absn, dttte, dttte, _2___},
+	/*ABSENT */ {_2___, _2___, dttte, _absn, dttte, _absn, _absn, dttte, dttte, _absn, _absn, dttte, dttte, _2___},
+	/*TIME   */ {dttte, dttte, dttte, dttte, dttte, _absn, _absn, dttte, dttte, dttte, dttte, dttte, dttte, dttte},
+	/*DURATION*/ {dttte, dttte, dttte, dttte, dttte, _absn, _absn, dttte, dttte, dttte, dttte, dttte, dttte, dttte},
+	/*DECIMAL*/ {times_decimal, times_decimal, dttte, _1___, dttte, _absn, _absn, dttte, dttte, _1___, _1___, dttte, dttte, times_decimal},
 }
 
 func BIF_dot_times(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -542,18 +742,21 @@ func ddvte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 var dotdivide_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT             FLOAT           BOOL   VOID   STRING ARRAY  MAP    FUNC    ERROR   NULL   ABSENT
-	/*INT    */ {dotdivide_i_ii, dotdivide_f_if, ddvte, _void, ddvte, _absn, _absn, ddvte, ddvte, ddvte, _1___},
-	/*FLOAT  */ {dotdivide_f_fi, dotdivide_f_ff, ddvte, _void, ddvte, _absn, _absn, ddvte, ddvte, ddvte, _1___},
-	/*BOOL   */ {ddvte, ddvte, ddvte, ddvte, ddvte, _absn, _absn, ddvte, ddvte, ddvte, ddvte},
-	/*VOID   */ {_void, _void, ddvte, _void, ddvte, _absn, _absn, ddvte, ddvte, ddvte, _absn},
-	/*STRING */ {ddvte, ddvte, ddvte, ddvte, ddvte, _absn, _absn, ddvte, ddvte, ddvte, ddvte},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, ddvte, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, ddvte, _absn, _absn, _absn},
-	/*FUNC   */ {ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte},
-	/*ERROR  */ {ddvte, ddvte, ddvte, ddvte, ddvte, _absn, _absn, ddvte, ddvte, ddvte, ddvte},
-	/*NULL   */ {ddvte, ddvte, ddvte, ddvte, ddvte, _absn, _absn, ddvte, ddvte, ddvte, _absn},
-	/*ABSENT */ {_2___, _2___, ddvte, _absn, ddvte, _absn, _absn, ddvte, ddvte, _absn, _absn},
+	//       .  INT             FLOAT           BOOL   VOID   STRING ARRAY  MAP    FUNC    ERROR   NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {dotdivide_i_ii, dotdivide_f_if, ddvte, _void, ddvte, _absn, _absn, ddvte, ddvte, ddvte, _1___, ddvte, ddvte, divide_decimal},
+	/*FLOAT  */ {dotdivide_f_fi, dotdivide_f_ff, ddvte, _void, ddvte, _absn, _absn, ddvte, ddvte, ddvte, _1___, ddvte, ddvte, divide_decimal},
+	/*BOOL   */ {ddvte, ddvte, ddvte, ddvte, ddvte, _absn, _absn, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte},
+	/*VOID   */ {_void, _void, ddvte, _void, ddvte, _absn, _absn, ddvte, ddvte, ddvte, _absn, ddvte, ddvte, _void},
+	/*STRING */ {ddvte, ddvte, ddvte, ddvte, ddvte, _absn, _absn, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, ddvte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, ddvte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte},
+	/*ERROR  */ {ddvte, ddvte, ddvte, ddvte, ddvte, _absn, _absn, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte},
+	/*NULL   */ {ddvte, ddvte, ddvte, ddvte, ddvte, _absn, _absn, ddvte, ddvte, ddvte, _absn, ddvte, ddvte, ddvte},
+	/*ABSENT */ {_2___, _2___, ddvte, _absn, ddvte, _absn, _absn, ddvte, ddvte, _absn, _absn, ddvte, ddvte, _2___},
+	/*TIME   */ {ddvte, ddvte, ddvte, ddvte, ddvte, _absn, _absn, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte},
+	/*DURATION*/ {ddvte, ddvte, ddvte, ddvte, ddvte, _absn, _absn, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte, ddvte},
+	/*DECIMAL*/ {divide_decimal, divide_decimal, ddvte, _void, ddvte, _absn, _absn, ddvte, ddvte, ddvte, _1___, ddvte, ddvte, divide_decimal},
 }
 
 func BIF_dot_divide(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -607,18 +810,21 @@ func didte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 var dotidivide_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT              FLOAT            BOOL   VOID   STRING ARRAY  MAP    FUNC    ERROR   NULL   ABSENT
-	/*INT    */ {dotidivide_i_ii, dotidivide_f_if, didte, _void, didte, _absn, _absn, didte, didte, didte, _1___},
-	/*FLOAT  */ {dotidivide_f_fi, dotidivide_f_ff, didte, _void, didte, _absn, _absn, didte, didte, didte, _1___},
-	/*BOOL   */ {didte, didte, didte, didte, didte, _absn, _absn, didte, didte, didte, didte},
-	/*VOID   */ {_void, _void, didte, _void, didte, _absn, _absn, didte, didte, didte, _absn},
-	/*STRING */ {didte, didte, didte, didte, didte, _absn, _absn, didte, didte, didte, didte},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, didte, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, didte, _absn, _absn, _absn},
-	/*FUNC   */ {didte, didte, didte, didte, didte, didte, didte, didte, didte, didte, didte},
-	/*ERROR  */ {didte, didte, didte, didte, didte, _absn, _absn, didte, didte, didte, didte},
-	/*NULL   */ {didte, didte, didte, didte, didte, _absn, _absn, didte, didte, didte, _absn},
-	/*ABSENT */ {_2___, _2___, didte, _absn, didte, _absn, _absn, didte, didte, didte, _absn},
+	//       .  INT              FLOAT            BOOL   VOID   STRING ARRAY  MAP    FUNC    ERROR   NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {dotidivide_i_ii, dotidivide_f_if, didte, _void, didte, _absn, _absn, didte, didte, didte, _1___, didte, didte, int_divide_decimal},
+	/*FLOAT  */ {dotidivide_f_fi, dotidivide_f_ff, didte, _void, didte, _absn, _absn, didte, didte, didte, _1___, didte, didte, int_divide_decimal},
+	/*BOOL   */ {didte, didte, didte, didte, didte, _absn, _absn, didte, didte, didte, didte, didte, didte, didte},
+	/*VOID   */ {_void, _void, didte, _void, didte, _absn, _absn, didte, didte, didte, _absn, didte, didte, _void},
+	/*STRING */ {didte, didte, didte, didte, didte, _absn, _absn, didte, didte, didte, didte, didte, didte, didte},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, didte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, didte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {didte, didte, didte, didte, didte, didte, didte, didte, didte, didte, didte, didte, didte, didte},
+	/*ERROR  */ {didte, didte, didte, didte, didte, _absn, _absn, didte, didte, didte, didte, didte, didte, didte},
+	/*NULL   */ {didte, didte, didte, didte, didte, _absn, _absn, didte, didte, didte, _absn, didte, didte, didte},
+	/*ABSENT */ {_2___, _2___, didte, _absn, didte, _absn, _absn, didte, didte, didte, _absn, didte, didte, _2___},
+	/*TIME   */ {didte, didte, didte, didte, didte, _absn, _absn, didte, didte, didte, didte, didte, didte, didte},
+	/*DURATION*/ {didte, didte, didte, didte, didte, _absn, _absn, didte, didte, didte, didte, didte, didte, didte},
+	/*DECIMAL*/ {int_divide_decimal, int_divide_decimal, didte, _void, didte, _absn, _absn, didte, didte, didte, _1___, didte, didte, int_divide_decimal},
 }
 
 func BIF_dot_int_divide(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -670,23 +876,38 @@ func modulus_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(a - b*math.Floor(a/b))
 }
 
+func modulus_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return decimalBinary(input1, input2,
+		func(a, b *lib.Decimal) *mlrval.Mlrval {
+			if m, ok := a.Mod(b); ok {
+				return mlrval.FromDecimal(m)
+			}
+			return mlrval.FromFloat(a.Float64() / 0.0)
+		},
+		func(a, b float64) *mlrval.Mlrval { return mlrval.FromFloat(a - b*math.Floor(a/b)) },
+	)
+}
+
 func modte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorBinary("%", input1, input2)
 }
 
 var modulus_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT           FLOAT         BOOL   VOID   STRING ARRAY  MAP    FUNC    ERROR   NULL   ABSENT
-	/*INT    */ {modulus_i_ii, modulus_f_if, modte, _void, modte, _absn, _absn, modte, modte, modte, _1___},
-	/*FLOAT  */ {modulus_f_fi, modulus_f_ff, modte, _void, modte, _absn, _absn, modte, modte, modte, _1___},
-	/*BOOL   */ {modte, modte, modte, modte, modte, _absn, _absn, modte, modte, modte, modte},
-	/*VOID   */ {_void, _void, modte, _void, modte, _absn, _absn, modte, modte, modte, _absn},
-	/*STRING */ {modte, modte, modte, modte, modte, _absn, _absn, modte, modte, modte, modte},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, modte, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, modte, _absn, _absn, _absn},
-	/*FUNC   */ {modte, modte, modte, modte, modte, modte, modte, modte, modte, modte, modte},
-	/*ERROR  */ {modte, modte, modte, modte, modte, _absn, _absn, modte, modte, modte, modte},
-	/*NULL   */ {modte, modte, modte, modte, modte, _absn, _absn, modte, modte, modte, _absn},
-	/*ABSENT */ {_i0__, _f0__, modte, _absn, modte, _absn, _absn, modte, modte, _absn, _absn},
+	//       .  INT           FLOAT         BOOL   VOID   STRING ARRAY  MAP    FUNC    ERROR   NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {modulus_i_ii, modulus_f_if, modte, _void, modte, _absn, _absn, modte, modte, modte, _1___, modte, modte, modulus_decimal},
+	/*FLOAT  */ {modulus_f_fi, modulus_f_ff, modte, _void, modte, _absn, _absn, modte, modte, modte, _1___, modte, modte, modulus_decimal},
+	/*BOOL   */ {modte, modte, modte, modte, modte, _absn, _absn, modte, modte, modte, modte, modte, modte, modte},
+	/*VOID   */ {_void, _void, modte, _void, modte, _absn, _absn, modte, modte, modte, _absn, modte, modte, _void},
+	/*STRING */ {modte, modte, modte, modte, modte, _absn, _absn, modte, modte, modte, modte, modte, modte, modte},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, modte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, modte, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {modte, modte, modte, modte, modte, modte, modte, modte, modte, modte, modte, modte, modte, modte},
+	/*ERROR  */ {modte, modte, modte, modte, modte, _absn, _absn, modte, modte, modte, modte, modte, modte, modte},
+	/*NULL   */ {modte, modte, modte, modte, modte, _absn, _absn, modte, modte, modte, _absn, modte, modte, modte},
+	/*ABSENT */ {_i0__, _f0__, modte, _absn, modte, _absn, _absn, modte, modte, _absn, _absn, modte, modte, _f0__},
+	/*TIME   */ {modte, modte, modte, modte, modte, _absn, _absn, modte, modte, modte, modte, modte, modte, modte},
+	/*DURATION*/ {modte, modte, modte, modte, modte, _absn, _absn, modte, modte, modte, modte, modte, modte, modte},
+	/*DECIMAL*/ {modulus_decimal, modulus_decimal, modte, _void, modte, _absn, _absn, modte, modte, modte, _1___, modte, modte, modulus_decimal},
 }
 
 func BIF_modulus(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -790,7 +1011,7 @@ func BIF_mod_exp(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
 // MIN AND MAX
 
 // Sort rules (same for min, max, and comparator):
-// * NUMERICS < BOOL < STRINGS < ERROR < ABSENT
+// * NUMERICS < BOOL < TIMES < DURATIONS < STRINGS < ERROR < ABSENT
 // * error == error (singleton type)
 // * absent == absent (singleton type)
 // * string compares on strings
@@ -851,23 +1072,50 @@ func min_s_ss(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	}
 }
 
+func min_t_tt(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.AcquireTimeValue().Before(input2.AcquireTimeValue()) {
+		return input1
+	} else {
+		return input2
+	}
+}
+
+func min_d_dd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.AcquireDurationValue() < input2.AcquireDurationValue() {
+		return input1
+	} else {
+		return input2
+	}
+}
+
+func min_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if mlrval.LessThan(input2, input1) {
+		return input2
+	} else {
+		return input1
+	}
+}
+
 func min_te(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorBinary("min", input1, input2)
 }
 
 var min_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT        FLOAT     BOOL      VOID   STRING    ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {min_i_ii, min_f_if, _1___, _1___, _1___, _absn, _absn, min_te, min_te, _1___, _1___},
-	/*FLOAT  */ {min_f_fi, min_f_ff, _1___, _1___, _1___, _absn, _absn, min_te, min_te, _1___, _1___},
-	/*BOOL   */ {_2___, _2___, min_b_bb, _1___, _1___, _absn, _absn, min_te, min_te, _1___, _1___},
-	/*VOID   */ {_2___, _2___, _2___, _void, _void, _absn, _absn, min_te, min_te, _1___, _1___},
-	/*STRING */ {_2___, _2___, _2___, _void, min_s_ss, _absn, _absn, min_te, min_te, _1___, _1___},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, min_te, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, min_te, _absn, _absn, _absn},
-	/*FUNC   */ {min_te, min_te, min_te, min_te, min_te, min_te, min_te, min_te, min_te, min_te, min_te},
-	/*ERROR  */ {min_te, min_te, min_te, min_te, min_te, _absn, _absn, min_te, min_te, min_te, min_te},
-	/*NULL   */ {_2___, _2___, _2___, _2___, _2___, _absn, _absn, min_te, min_te, _null, _null},
-	/*ABSENT */ {_2___, _2___, _2___, _2___, _2___, _absn, _absn, min_te, min_te, _null, _absn},
+	//       .  INT        FLOAT     BOOL      VOID   STRING    ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {min_i_ii, min_f_if, _1___, _1___, _1___, _absn, _absn, min_te, min_te, _1___, _1___, _1___, _1___, min_decimal},
+	/*FLOAT  */ {min_f_fi, min_f_ff, _1___, _1___, _1___, _absn, _absn, min_te, min_te, _1___, _1___, _1___, _1___, min_decimal},
+	/*BOOL   */ {_2___, _2___, min_b_bb, _1___, _1___, _absn, _absn, min_te, min_te, _1___, _1___, _1___, _1___, _2___},
+	/*VOID   */ {_2___, _2___, _2___, _void, _void, _absn, _absn, min_te, min_te, _1___, _1___, _2___, _2___, _2___},
+	/*STRING */ {_2___, _2___, _2___, _void, min_s_ss, _absn, _absn, min_te, min_te, _1___, _1___, _2___, _2___, _2___},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, min_te, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, min_te, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {min_te, min_te, min_te, min_te, min_te, min_te, min_te, min_te, min_te, min_te, min_te, min_te, min_te, min_te},
+	/*ERROR  */ {min_te, min_te, min_te, min_te, min_te, _absn, _absn, min_te, min_te, min_te, min_te, min_te, min_te, min_te},
+	/*NULL   */ {_2___, _2___, _2___, _2___, _2___, _absn, _absn, min_te, min_te, _null, _null, _2___, _2___, _2___},
+	/*ABSENT */ {_2___, _2___, _2___, _2___, _2___, _absn, _absn, min_te, min_te, _null, _absn, _2___, _2___, _2___},
+	/*TIME   */ {_2___, _2___, _2___, _1___, _1___, _absn, _absn, min_te, min_te, _1___, _1___, min_t_tt, _1___, _2___},
+	/*DURATION*/ {_2___, _2___, _2___, _1___, _1___, _absn, _absn, min_te, min_te, _1___, _1___, _2___, min_d_dd, _2___},
+	/*DECIMAL*/ {min_decimal, min_decimal, _1___, _1___, _1___, _absn, _absn, min_te, min_te, _1___, _1___, _1___, _1___, min_decimal},
 }
 
 // BIF_min_binary is not a direct DSL function. It's a helper here,
@@ -932,6 +1180,9 @@ func init() {
 		/*ERROR  */ min_unary_te,
 		/*NULL   */ _null1,
 		/*ABSENT */ _absn1,
+		/*TIME   */ _1u___,
+		/*DURATION*/ _1u___,
+		/*DECIMAL*/ _1u___,
 	}
 }
 
@@ -1021,23 +1272,50 @@ func max_s_ss(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	}
 }
 
+func max_t_tt(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.AcquireTimeValue().After(input2.AcquireTimeValue()) {
+		return input1
+	} else {
+		return input2
+	}
+}
+
+func max_d_dd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.AcquireDurationValue() > input2.AcquireDurationValue() {
+		return input1
+	} else {
+		return input2
+	}
+}
+
+func max_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if mlrval.GreaterThan(input2, input1) {
+		return input2
+	} else {
+		return input1
+	}
+}
+
 func max_te(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorBinary("max", input1, input2)
 }
 
 var max_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT       FLOAT     BOOL      VOID   STRING    ARRAY  MAP    FUNC    ERROR   NULL   ABSENT
-	/*INT    */ {max_i_ii, max_f_if, _2___, _2___, _2___, _absn, _absn, max_te, max_te, _null, _1___},
-	/*FLOAT  */ {max_f_fi, max_f_ff, _2___, _2___, _2___, _absn, _absn, max_te, max_te, _null, _1___},
-	/*BOOL   */ {_1___, _1___, max_b_bb, _2___, _2___, _absn, _absn, max_te, max_te, _null, _1___},
-	/*VOID   */ {_1___, _1___, _1___, _void, _2___, _absn, _absn, max_te, max_te, _null, _1___},
-	/*STRING */ {_1___, _1___, _1___, _1___, max_s_ss, _absn, _absn, max_te, max_te, _null, _1___},
-	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, max_te, _absn, _absn, _absn},
-	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, max_te, _absn, _absn, _absn},
-	/*FUNC   */ {max_te, max_te, max_te, max_te, max_te, max_te, max_te, max_te, max_te, max_te, max_te},
-	/*ERROR  */ {max_te, max_te, max_te, max_te, max_te, _absn, _absn, max_te, max_te, _null, max_te},
-	/*NULL   */ {_null, _null, _null, _null, _null, _absn, _absn, max_te, _null, _null, _absn},
-	/*ABSENT */ {_2___, _2___, _2___, _2___, _2___, _absn, _absn, max_te, max_te, _absn, _absn},
+	//       .  INT       FLOAT     BOOL      VOID   STRING    ARRAY  MAP    FUNC    ERROR   NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {max_i_ii, max_f_if, _2___, _2___, _2___, _absn, _absn, max_te, max_te, _null, _1___, _2___, _2___, max_decimal},
+	/*FLOAT  */ {max_f_fi, max_f_ff, _2___, _2___, _2___, _absn, _absn, max_te, max_te, _null, _1___, _2___, _2___, max_decimal},
+	/*BOOL   */ {_1___, _1___, max_b_bb, _2___, _2___, _absn, _absn, max_te, max_te, _null, _1___, _2___, _2___, _1___},
+	/*VOID   */ {_1___, _1___, _1___, _void, _2___, _absn, _absn, max_te, max_te, _null, _1___, _1___, _1___, _1___},
+	/*STRING */ {_1___, _1___, _1___, _1___, max_s_ss, _absn, _absn, max_te, max_te, _null, _1___, _1___, _1___, _1___},
+	/*ARRAY  */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, max_te, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*MAP    */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, max_te, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*FUNC   */ {max_te, max_te, max_te, max_te, max_te, max_te, max_te, max_te, max_te, max_te, max_te, max_te, max_te, max_te},
+	/*ERROR  */ {max_te, max_te, max_te, max_te, max_te, _absn, _absn, max_te, max_te, _null, max_te, max_te, max_te, max_te},
+	/*NULL   */ {_null, _null, _null, _null, _null, _absn, _absn, max_te, _null, _null, _absn, _null, _null, _null},
+	/*ABSENT */ {_2___, _2___, _2___, _2___, _2___, _absn, _absn, max_te, max_te, _absn, _absn, _2___, _2___, _2___},
+	/*TIME   */ {_1___, _1___, _1___, _2___, _2___, _absn, _absn, max_te, max_te, _null, _1___, max_t_tt, _2___, _1___},
+	/*DURATION*/ {_1___, _1___, _1___, _2___, _2___, _absn, _absn, max_te, max_te, _null, _1___, _1___, max_d_dd, _1___},
+	/*DECIMAL*/ {max_decimal, max_decimal, _2___, _2___, _2___, _absn, _absn, max_te, max_te, _null, _1___, _2___, _2___, max_decimal},
 }
 
 // BIF_max_binary is not a direct DSL function. It's a helper here,
@@ -1102,6 +1380,9 @@ func init() {
 		/*ERROR  */ max_unary_te,
 		/*NULL   */ _null1,
 		/*ABSENT */ _absn1,
+		/*TIME   */ _1u___,
+		/*DURATION*/ _1u___,
+		/*DECIMAL*/ _1u___,
 	}
 }
 
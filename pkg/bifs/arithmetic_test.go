@@ -2,6 +2,7 @@ package bifs
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -68,6 +69,75 @@ func TestBIF_plus_binary_overflow(t *testing.T) {
 	assert.Equal(t, 18446744073709552000.0, floatval)
 }
 
+func TestBIF_time_and_duration_arithmetic(t *testing.T) {
+	t1 := mlrval.FromTime(time.Date(2023, 1, 2, 3, 4, 5, 6, time.UTC), "%Y-%m-%d %H:%M:%9S")
+	t2 := mlrval.FromTime(time.Date(2023, 1, 1, 3, 4, 5, 0, time.UTC), "")
+	d := mlrval.FromDuration(90 * time.Minute)
+
+	output := BIF_minus_binary(t1, t2)
+	assert.True(t, output.IsDuration())
+	assert.Equal(t, 24*time.Hour+6*time.Nanosecond, output.AcquireDurationValue())
+
+	output = BIF_plus_binary(t1, d)
+	assert.True(t, output.IsTime())
+	assert.Equal(t, "2023-01-02 04:34:05.000000006", output.String())
+
+	output = BIF_plus_binary(d, t2)
+	assert.Equal(t, "2023-01-01T04:34:05Z", output.String())
+
+	output = BIF_minus_binary(t2, mlrval.FromInt(5))
+	assert.Equal(t, "2023-01-01T03:04:00Z", output.String())
+
+	output = BIF_plus_binary(d, d)
+	assert.Equal(t, "3h0m0s", output.String())
+
+	output = BIF_plus_binary(mlrval.FromInt(30), d)
+	assert.True(t, output.IsDuration())
+	assert.Equal(t, "1h30m30s", output.String())
+
+	output = BIF_minus_binary(d, mlrval.FromFloat(0.5))
+	assert.Equal(t, "1h29m59.5s", output.String())
+
+	output = BIF_times(d, mlrval.FromFloat(0.5))
+	assert.Equal(t, "45m0s", output.String())
+
+	output = BIF_divide(d, mlrval.FromDuration(time.Hour))
+	floatval, ok := output.GetFloatValue()
+	assert.True(t, ok)
+	assert.Equal(t, 1.5, floatval)
+
+	assert.True(t, BIF_plus_binary(t1, t2).IsError())
+	assert.True(t, BIF_minus_binary(d, t1).IsError())
+	assert.True(t, BIF_times(t1, mlrval.FromInt(2)).IsError())
+}
+
+func TestBIF_decimal_arithmetic(t *testing.T) {
+	a := mlrval.TryFromDecimalString("0.1")
+	b := mlrval.TryFromDecimalString("0.2")
+	assert.True(t, a.IsDecimal())
+
+	output := BIF_plus_binary(a, b)
+	assert.True(t, output.IsDecimal())
+	assert.Equal(t, "0.3", output.String())
+	assert.True(t, BIF_equals(output, mlrval.TryFromDecimalString("0.30")).AcquireBoolValue())
+
+	output = BIF_times(mlrval.TryFromDecimalString("1.50"), mlrval.FromInt(3))
+	assert.Equal(t, "4.50", output.String())
+
+	output = BIF_divide(mlrval.TryFromDecimalString("10.00"), mlrval.FromInt(4))
+	assert.Equal(t, "2.50", output.String())
+
+	output = BIF_int_divide(mlrval.TryFromDecimalString("-7.5"), mlrval.FromInt(2))
+	assert.Equal(t, "-4", output.String())
+
+	output = BIF_modulus(mlrval.TryFromDecimalString("-7.5"), mlrval.FromInt(2))
+	assert.Equal(t, "0.5", output.String())
+
+	// Decimal with float is float
+	output = BIF_plus_binary(a, mlrval.FromFloat(0.5))
+	assert.True(t, output.IsFloat())
+}
+
 // TODO: copy in more unit-test cases from existing regression-test data
 
 //func BIF_minus_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
@@ -5,6 +5,8 @@
 package bifs
 
 import (
+	"time"
+
 	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
 )
@@ -205,6 +207,42 @@ func cmp_b_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromInt(float_cmp(input1.AcquireFloatValue(), input2.AcquireFloatValue()))
 }
 
+// - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+// Decimals against ints and decimals compare exactly; against floats, as
+// floats.
+func decimal_cmp(input1, input2 *mlrval.Mlrval) int64 {
+	if input1.IsFloat() || input2.IsFloat() {
+		a, _ := input1.GetNumericToFloatValue()
+		b, _ := input2.GetNumericToFloatValue()
+		return float_cmp(a, b)
+	}
+	a, _ := input1.GetNumericToDecimalValue()
+	b, _ := input2.GetNumericToDecimalValue()
+	return int64(a.Cmp(b))
+}
+
+func eq_b_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(decimal_cmp(input1, input2) == 0)
+}
+func ne_b_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(decimal_cmp(input1, input2) != 0)
+}
+func gt_b_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(decimal_cmp(input1, input2) > 0)
+}
+func ge_b_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(decimal_cmp(input1, input2) >= 0)
+}
+func lt_b_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(decimal_cmp(input1, input2) < 0)
+}
+func le_b_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(decimal_cmp(input1, input2) <= 0)
+}
+func cmp_b_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromInt(decimal_cmp(input1, input2))
+}
+
 // - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
 func eq_b_bb(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromBool(input1.AcquireBoolValue() == input2.AcquireBoolValue())
@@ -232,6 +270,114 @@ func cmp_b_bb(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromInt(int_cmp(lib.BoolToInt(input1.AcquireBoolValue()), lib.BoolToInt(input2.AcquireBoolValue())))
 }
 
+// - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+// For times and durations against strings and each other: compare as strings,
+// as for numbers against strings.
+func eq_b_xx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.String() == input2.String())
+}
+func ne_b_xx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.String() != input2.String())
+}
+func gt_b_xx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.String() > input2.String())
+}
+func ge_b_xx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.String() >= input2.String())
+}
+func lt_b_xx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.String() < input2.String())
+}
+func le_b_xx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.String() <= input2.String())
+}
+func cmp_b_xx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromInt(string_cmp(input1.String(), input2.String()))
+}
+
+// - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+// Times and durations against ints, floats, and decimals compare as seconds,
+// as for addition and subtraction: times as seconds since the epoch.
+func seconds_cmp(input1, input2 *mlrval.Mlrval) int64 {
+	if input1.IsNumeric() {
+		return -seconds_cmp(input2, input1)
+	}
+	if input1.IsTime() {
+		epochPlusSeconds := time.Unix(0, 0).Add(secondsToDuration(input2))
+		return int64(input1.AcquireTimeValue().Compare(epochPlusSeconds))
+	}
+	return int_cmp(int64(input1.AcquireDurationValue()), int64(secondsToDuration(input2)))
+}
+
+func eq_b_sec(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(seconds_cmp(input1, input2) == 0)
+}
+func ne_b_sec(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(seconds_cmp(input1, input2) != 0)
+}
+func gt_b_sec(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(seconds_cmp(input1, input2) > 0)
+}
+func ge_b_sec(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(seconds_cmp(input1, input2) >= 0)
+}
+func lt_b_sec(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(seconds_cmp(input1, input2) < 0)
+}
+func le_b_sec(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(seconds_cmp(input1, input2) <= 0)
+}
+func cmp_b_sec(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromInt(seconds_cmp(input1, input2))
+}
+
+// - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+// Times compare by instant, regardless of location.
+func eq_b_tt(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireTimeValue().Compare(input2.AcquireTimeValue()) == 0)
+}
+func ne_b_tt(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireTimeValue().Compare(input2.AcquireTimeValue()) != 0)
+}
+func gt_b_tt(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireTimeValue().Compare(input2.AcquireTimeValue()) > 0)
+}
+func ge_b_tt(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireTimeValue().Compare(input2.AcquireTimeValue()) >= 0)
+}
+func lt_b_tt(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireTimeValue().Compare(input2.AcquireTimeValue()) < 0)
+}
+func le_b_tt(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireTimeValue().Compare(input2.AcquireTimeValue()) <= 0)
+}
+func cmp_b_tt(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromInt(int64(input1.AcquireTimeValue().Compare(input2.AcquireTimeValue())))
+}
+
+// - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+func eq_b_dd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireDurationValue() == input2.AcquireDurationValue())
+}
+func ne_b_dd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireDurationValue() != input2.AcquireDurationValue())
+}
+func gt_b_dd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireDurationValue() > input2.AcquireDurationValue())
+}
+func ge_b_dd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireDurationValue() >= input2.AcquireDurationValue())
+}
+func lt_b_dd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireDurationValue() < input2.AcquireDurationValue())
+}
+func le_b_dd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireDurationValue() <= input2.AcquireDurationValue())
+}
+func cmp_b_dd(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromInt(int_cmp(int64(input1.AcquireDurationValue()), int64(input2.AcquireDurationValue())))
+}
+
 // - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
 func eq_b_aa(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	a := input1.AcquireArrayValue()
@@ -276,18 +422,21 @@ func eqte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 
 func init() {
 	eq_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-		//       .  INT       FLOAT    BOOL     VOID     STRING   ARRAY    MAP      FUNC   ERROR  NULL   ABSENT
-		/*INT    */ {eq_b_ii, eq_b_if, _fals, eq_b_xs, eq_b_xs, _fals, _fals, eqte, eqte, _fals, _absn},
-		/*FLOAT  */ {eq_b_fi, eq_b_ff, _fals, eq_b_xs, eq_b_xs, _fals, _fals, eqte, eqte, _fals, _absn},
-		/*BOOL   */ {_fals, _fals, eq_b_bb, _fals, _fals, _fals, _fals, eqte, eqte, _fals, _absn},
-		/*VOID   */ {eq_b_sx, eq_b_sx, _fals, eq_b_ss, eq_b_ss, _fals, _fals, eqte, eqte, _fals, _absn},
-		/*STRING */ {eq_b_sx, eq_b_sx, _fals, eq_b_ss, eq_b_ss, _fals, _fals, eqte, eqte, _fals, _absn},
-		/*ARRAY  */ {_fals, _fals, _fals, _fals, _fals, eq_b_aa, _fals, eqte, eqte, _fals, _absn},
-		/*MAP    */ {_fals, _fals, _fals, _fals, _fals, _fals, eq_b_mm, eqte, eqte, _fals, _absn},
-		/*FUNC   */ {eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte},
-		/*ERROR  */ {eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte},
-		/*NULL   */ {_fals, _fals, _fals, _fals, _fals, _fals, _fals, eqte, eqte, _true, _absn},
-		/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, eqte, eqte, _absn, _absn},
+		//       .  INT       FLOAT    BOOL     VOID     STRING   ARRAY    MAP      FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+		/*INT    */ {eq_b_ii, eq_b_if, _fals, eq_b_xs, eq_b_xs, _fals, _fals, eqte, eqte, _fals, _absn, eq_b_sec, eq_b_sec, eq_b_decimal},
+		/*FLOAT  */ {eq_b_fi, eq_b_ff, _fals, eq_b_xs, eq_b_xs, _fals, _fals, eqte, eqte, _fals, _absn, eq_b_sec, eq_b_sec, eq_b_decimal},
+		/*BOOL   */ {_fals, _fals, eq_b_bb, _fals, _fals, _fals, _fals, eqte, eqte, _fals, _absn, _fals, _fals, _fals},
+		/*VOID   */ {eq_b_sx, eq_b_sx, _fals, eq_b_ss, eq_b_ss, _fals, _fals, eqte, eqte, _fals, _absn, eq_b_sx, eq_b_sx, eq_b_sx},
+		/*STRING */ {eq_b_sx, eq_b_sx, _fals, eq_b_ss, eq_b_ss, _fals, _fals, eqte, eqte, _fals, _absn, eq_b_sx, eq_b_sx, eq_b_sx},
+		/*ARRAY  */ {_fals, _fals, _fals, _fals, _fals, eq_b_aa, _fals, eqte, eqte, _fals, _absn, _fals, _fals, _fals},
+		/*MAP    */ {_fals, _fals, _fals, _fals, _fals, _fals, eq_b_mm, eqte, eqte, _fals, _absn, _fals, _fals, _fals},
+		/*FUNC   */ {eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte},
+		/*ERROR  */ {eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte, eqte},
+		/*NULL   */ {_fals, _fals, _fals, _fals, _fals, _fals, _fals, eqte, eqte, _true, _absn, _fals, _fals, _fals},
+		/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, eqte, eqte, _absn, _absn, _absn, _absn, _absn},
+		/*TIME   */ {eq_b_sec, eq_b_sec, _fals, eq_b_xs, eq_b_xs, _fals, _fals, eqte, eqte, _fals, _absn, eq_b_tt, eq_b_xx, eq_b_sec},
+		/*DURATION*/ {eq_b_sec, eq_b_sec, _fals, eq_b_xs, eq_b_xs, _fals, _fals, eqte, eqte, _fals, _absn, eq_b_xx, eq_b_dd, eq_b_sec},
+		/*DECIMAL*/ {eq_b_decimal, eq_b_decimal, _fals, eq_b_xs, eq_b_xs, _fals, _fals, eqte, eqte, _fals, _absn, eq_b_sec, eq_b_sec, eq_b_decimal},
 	}
 }
 
@@ -296,18 +445,21 @@ func nete(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 var ne_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT       FLOAT    BOOL     VOID     STRING   ARRAY    MAP      FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {ne_b_ii, ne_b_if, _true, ne_b_xs, ne_b_xs, _true, _true, nete, nete, _true, _absn},
-	/*FLOAT  */ {ne_b_fi, ne_b_ff, _true, ne_b_xs, ne_b_xs, _true, _true, nete, nete, _true, _absn},
-	/*BOOL   */ {_true, _true, ne_b_bb, _true, _true, _true, _true, nete, nete, _true, _absn},
-	/*VOID   */ {ne_b_sx, ne_b_sx, _true, ne_b_ss, ne_b_ss, _true, _true, nete, nete, _true, _absn},
-	/*STRING */ {ne_b_sx, ne_b_sx, _true, ne_b_ss, ne_b_ss, _true, _true, nete, nete, _true, _absn},
-	/*ARRAY  */ {_true, _true, _true, _true, _true, ne_b_aa, _true, nete, nete, _true, _absn},
-	/*MAP    */ {_true, _true, _true, _true, _true, _true, ne_b_mm, nete, nete, _true, _absn},
-	/*FUNC   */ {nete, nete, nete, nete, nete, nete, nete, nete, nete, nete, nete},
-	/*ERROR  */ {nete, nete, nete, nete, nete, nete, nete, nete, nete, nete, nete},
-	/*NULL   */ {_true, _true, _true, _true, _true, _true, _true, nete, nete, _fals, _absn},
-	/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, nete, nete, _absn, _absn},
+	//       .  INT       FLOAT    BOOL     VOID     STRING   ARRAY    MAP      FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {ne_b_ii, ne_b_if, _true, ne_b_xs, ne_b_xs, _true, _true, nete, nete, _true, _absn, ne_b_sec, ne_b_sec, ne_b_decimal},
+	/*FLOAT  */ {ne_b_fi, ne_b_ff, _true, ne_b_xs, ne_b_xs, _true, _true, nete, nete, _true, _absn, ne_b_sec, ne_b_sec, ne_b_decimal},
+	/*BOOL   */ {_true, _true, ne_b_bb, _true, _true, _true, _true, nete, nete, _true, _absn, _true, _true, _true},
+	/*VOID   */ {ne_b_sx, ne_b_sx, _true, ne_b_ss, ne_b_ss, _true, _true, nete, nete, _true, _absn, ne_b_sx, ne_b_sx, ne_b_sx},
+	/*STRING */ {ne_b_sx, ne_b_sx, _true, ne_b_ss, ne_b_ss, _true, _true, nete, nete, _true, _absn, ne_b_sx, ne_b_sx, ne_b_sx},
+	/*ARRAY  */ {_true, _true, _true, _true, _true, ne_b_aa, _true, nete, nete, _true, _absn, _true, _true, _true},
+	/*MAP    */ {_true, _true, _true, _true, _true, _true, ne_b_mm, nete, nete, _true, _absn, _true, _true, _true},
+	/*FUNC   */ {nete, nete, nete, nete, nete, nete, nete, nete, nete, nete, nete, nete, nete, nete},
+	/*ERROR  */ {nete, nete, nete, nete, nete, nete, nete, nete, nete, nete, nete, nete, nete, nete},
+	/*NULL   */ {_true, _true, _true, _true, _true, _true, _true, nete, nete, _fals, _absn, _true, _true, _true},
+	/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, nete, nete, _absn, _absn, _absn, _absn, _absn},
+	/*TIME   */ {ne_b_sec, ne_b_sec, _true, ne_b_xs, ne_b_xs, _true, _true, nete, nete, _true, _absn, ne_b_tt, ne_b_xx, ne_b_sec},
+	/*DURATION*/ {ne_b_sec, ne_b_sec, _true, ne_b_xs, ne_b_xs, _true, _true, nete, nete, _true, _absn, ne_b_xx, ne_b_dd, ne_b_sec},
+	/*DECIMAL*/ {ne_b_decimal, ne_b_decimal, _true, ne_b_xs, ne_b_xs, _true, _true, nete, nete, _true, _absn, ne_b_sec, ne_b_sec, ne_b_decimal},
 }
 
 func gtte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -315,18 +467,21 @@ func gtte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 var gt_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT       FLOAT    BOOL     VOID     STRING   ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {gt_b_ii, gt_b_if, _fals, gt_b_xs, gt_b_xs, _fals, _fals, gtte, gtte, _fals, _absn},
-	/*FLOAT  */ {gt_b_fi, gt_b_ff, _fals, gt_b_xs, gt_b_xs, _fals, _fals, gtte, gtte, _fals, _absn},
-	/*BOOL   */ {_fals, _fals, gt_b_bb, _fals, _fals, _fals, _fals, gtte, gtte, _fals, _absn},
-	/*VOID   */ {gt_b_sx, gt_b_sx, _fals, gt_b_ss, gt_b_ss, _fals, _fals, gtte, gtte, _fals, _absn},
-	/*STRING */ {gt_b_sx, gt_b_sx, _fals, gt_b_ss, gt_b_ss, _fals, _fals, gtte, gtte, _fals, _absn},
-	/*ARRAY  */ {_fals, _fals, _fals, _fals, _fals, gtte, _fals, gtte, gtte, _fals, _absn},
-	/*MAP    */ {_fals, _fals, _fals, _fals, _fals, _fals, gtte, gtte, gtte, _fals, _absn},
-	/*FUNC   */ {gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte},
-	/*ERROR  */ {gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, _fals, gtte},
-	/*NULL   */ {_true, _true, _true, _true, _true, _absn, _absn, gtte, _true, _fals, _fals},
-	/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, gtte, gtte, _true, _absn},
+	//       .  INT       FLOAT    BOOL     VOID     STRING   ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {gt_b_ii, gt_b_if, _fals, gt_b_xs, gt_b_xs, _fals, _fals, gtte, gtte, _fals, _absn, gt_b_sec, gt_b_sec, gt_b_decimal},
+	/*FLOAT  */ {gt_b_fi, gt_b_ff, _fals, gt_b_xs, gt_b_xs, _fals, _fals, gtte, gtte, _fals, _absn, gt_b_sec, gt_b_sec, gt_b_decimal},
+	/*BOOL   */ {_fals, _fals, gt_b_bb, _fals, _fals, _fals, _fals, gtte, gtte, _fals, _absn, _fals, _fals, _fals},
+	/*VOID   */ {gt_b_sx, gt_b_sx, _fals, gt_b_ss, gt_b_ss, _fals, _fals, gtte, gtte, _fals, _absn, gt_b_sx, gt_b_sx, gt_b_sx},
+	/*STRING */ {gt_b_sx, gt_b_sx, _fals, gt_b_ss, gt_b_ss, _fals, _fals, gtte, gtte, _fals, _absn, gt_b_sx, gt_b_sx, gt_b_sx},
+	/*ARRAY  */ {_fals, _fals, _fals, _fals, _fals, gtte, _fals, gtte, gtte, _fals, _absn, _fals, _fals, _fals},
+	/*MAP    */ {_fals, _fals, _fals, _fals, _fals, _fals, gtte, gtte, gtte, _fals, _absn, _fals, _fals, _fals},
+	/*FUNC   */ {gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte},
+	/*ERROR  */ {gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, gtte, _fals, gtte, gtte, gtte, gtte},
+	/*NULL   */ {_true, _true, _true, _true, _true, _absn, _absn, gtte, _true, _fals, _fals, _true, _true, _true},
+	/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, gtte, gtte, _true, _absn, _absn, _absn, _absn},
+	/*TIME   */ {gt_b_sec, gt_b_sec, _fals, gt_b_xs, gt_b_xs, _fals, _fals, gtte, gtte, _fals, _absn, gt_b_tt, gt_b_xx, gt_b_sec},
+	/*DURATION*/ {gt_b_sec, gt_b_sec, _fals, gt_b_xs, gt_b_xs, _fals, _fals, gtte, gtte, _fals, _absn, gt_b_xx, gt_b_dd, gt_b_sec},
+	/*DECIMAL*/ {gt_b_decimal, gt_b_decimal, _fals, gt_b_xs, gt_b_xs, _fals, _fals, gtte, gtte, _fals, _absn, gt_b_sec, gt_b_sec, gt_b_decimal},
 }
 
 func gete(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -334,18 +489,21 @@ func gete(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 var ge_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT       FLOAT    BOOL     VOID     STRING   ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {ge_b_ii, ge_b_if, _fals, ge_b_xs, ge_b_xs, _fals, _fals, gete, gete, _fals, _absn},
-	/*FLOAT  */ {ge_b_fi, ge_b_ff, _fals, ge_b_xs, ge_b_xs, _fals, _fals, gete, gete, _fals, _absn},
-	/*BOOL   */ {_fals, _fals, ge_b_bb, _fals, _fals, _fals, _fals, gete, gete, _fals, _absn},
-	/*VOID   */ {ge_b_sx, ge_b_sx, _fals, ge_b_ss, ge_b_ss, _fals, _fals, gete, gete, _fals, _absn},
-	/*STRING */ {ge_b_sx, ge_b_sx, _fals, ge_b_ss, ge_b_ss, _fals, _fals, gete, gete, _fals, _absn},
-	/*ARRAY  */ {_fals, _fals, _fals, _fals, _fals, gete, _fals, gete, gete, _fals, _absn},
-	/*MAP    */ {_fals, _fals, _fals, _fals, _fals, _fals, gete, gete, gete, _fals, _absn},
-	/*FUNC   */ {gete, gete, gete, gete, gete, gete, gete, gete, gete, gete, gete},
-	/*ERROR  */ {gete, gete, gete, gete, gete, gete, gete, gete, gete, _fals, gete},
-	/*NULL   */ {_true, _true, _true, _true, _true, _absn, _absn, gete, _true, _true, _fals},
-	/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, gete, gete, _true, _absn},
+	//       .  INT       FLOAT    BOOL     VOID     STRING   ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {ge_b_ii, ge_b_if, _fals, ge_b_xs, ge_b_xs, _fals, _fals, gete, gete, _fals, _absn, ge_b_sec, ge_b_sec, ge_b_decimal},
+	/*FLOAT  */ {ge_b_fi, ge_b_ff, _fals, ge_b_xs, ge_b_xs, _fals, _fals, gete, gete, _fals, _absn, ge_b_sec, ge_b_sec, ge_b_decimal},
+	/*BOOL   */ {_fals, _fals, ge_b_bb, _fals, _fals, _fals, _fals, gete, gete, _fals, _absn, _fals, _fals, _fals},
+	/*VOID   */ {ge_b_sx, ge_b_sx, _fals, ge_b_ss, ge_b_ss, _fals, _fals, gete, gete, _fals, _absn, ge_b_sx, ge_b_sx, ge_b_sx},
+	/*STRING */ {ge_b_sx, ge_b_sx, _fals, ge_b_ss, ge_b_ss, _fals, _fals, gete, gete, _fals, _absn, ge_b_sx, ge_b_sx, ge_b_sx},
+	/*ARRAY  */ {_fals, _fals, _fals, _fals, _fals, gete, _fals, gete, gete, _fals, _absn, _fals, _fals, _fals},
+	/*MAP    */ {_fals, _fals, _fals, _fals, _fals, _fals, gete, gete, gete, _fals, _absn, _fals, _fals, _fals},
+	/*FUNC   */ {gete, gete, gete, gete, gete, gete, gete, gete, gete, gete, gete, gete, gete, gete},
+	/*ERROR  */ {gete, gete, gete, gete, gete, gete, gete, gete, gete, _fals, gete, gete, gete, gete},
+	/*NULL   */ {_true, _true, _true, _true, _true, _absn, _absn, gete, _true, _true, _fals, _true, _true, _true},
+	/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, gete, gete, _true, _absn, _absn, _absn, _absn},
+	/*TIME   */ {ge_b_sec, ge_b_sec, _fals, ge_b_xs, ge_b_xs, _fals, _fals, gete, gete, _fals, _absn, ge_b_tt, ge_b_xx, ge_b_sec},
+	/*DURATION*/ {ge_b_sec, ge_b_sec, _fals, ge_b_xs, ge_b_xs, _fals, _fals, gete, gete, _fals, _absn, ge_b_xx, ge_b_dd, ge_b_sec},
+	/*DECIMAL*/ {ge_b_decimal, ge_b_decimal, _fals, ge_b_xs, ge_b_xs, _fals, _fals, gete, gete, _fals, _absn, ge_b_sec, ge_b_sec, ge_b_decimal},
 }
 
 func ltte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -353,18 +511,21 @@ func ltte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 var lt_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT       FLOAT    BOOL     VOID     STRING   ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {lt_b_ii, lt_b_if, _fals, lt_b_xs, lt_b_xs, _fals, _fals, ltte, ltte, _true, _absn},
-	/*FLOAT  */ {lt_b_fi, lt_b_ff, _fals, lt_b_xs, lt_b_xs, _fals, _fals, ltte, ltte, _true, _absn},
-	/*BOOL   */ {_fals, _fals, lt_b_bb, _fals, _fals, _fals, _fals, ltte, ltte, _true, _absn},
-	/*VOID   */ {lt_b_sx, lt_b_sx, _fals, lt_b_ss, lt_b_ss, _fals, _fals, ltte, ltte, _true, _absn},
-	/*STRING */ {lt_b_sx, lt_b_sx, _fals, lt_b_ss, lt_b_ss, _fals, _fals, ltte, ltte, _true, _absn},
-	/*ARRAY  */ {_fals, _fals, _fals, _fals, _fals, ltte, _fals, ltte, ltte, _absn, _absn},
-	/*MAP    */ {_fals, _fals, _fals, _fals, _fals, _fals, ltte, ltte, ltte, _absn, _absn},
-	/*FUNC   */ {ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte},
-	/*ERROR  */ {ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, _true, ltte},
-	/*NULL   */ {_fals, _fals, _fals, _fals, _fals, _absn, _absn, ltte, _fals, _fals, _true},
-	/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, ltte, ltte, _fals, _absn},
+	//       .  INT       FLOAT    BOOL     VOID     STRING   ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {lt_b_ii, lt_b_if, _fals, lt_b_xs, lt_b_xs, _fals, _fals, ltte, ltte, _true, _absn, lt_b_sec, lt_b_sec, lt_b_decimal},
+	/*FLOAT  */ {lt_b_fi, lt_b_ff, _fals, lt_b_xs, lt_b_xs, _fals, _fals, ltte, ltte, _true, _absn, lt_b_sec, lt_b_sec, lt_b_decimal},
+	/*BOOL   */ {_fals, _fals, lt_b_bb, _fals, _fals, _fals, _fals, ltte, ltte, _true, _absn, _fals, _fals, _fals},
+	/*VOID   */ {lt_b_sx, lt_b_sx, _fals, lt_b_ss, lt_b_ss, _fals, _fals, ltte, ltte, _true, _absn, lt_b_sx, lt_b_sx, lt_b_sx},
+	/*STRING */ {lt_b_sx, lt_b_sx, _fals, lt_b_ss, lt_b_ss, _fals, _fals, ltte, ltte, _true, _absn, lt_b_sx, lt_b_sx, lt_b_sx},
+	/*ARRAY  */ {_fals, _fals, _fals, _fals, _fals, ltte, _fals, ltte, ltte, _absn, _absn, _fals, _fals, _fals},
+	/*MAP    */ {_fals, _fals, _fals, _fals, _fals, _fals, ltte, ltte, ltte, _absn, _absn, _fals, _fals, _fals},
+	/*FUNC   */ {ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte},
+	/*ERROR  */ {ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, ltte, _true, ltte, ltte, ltte, ltte},
+	/*NULL   */ {_fals, _fals, _fals, _fals, _fals, _absn, _absn, ltte, _fals, _fals, _true, _fals, _fals, _fals},
+	/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, ltte, ltte, _fals, _absn, _absn, _absn, _absn},
+	/*TIME   */ {lt_b_sec, lt_b_sec, _fals, lt_b_xs, lt_b_xs, _fals, _fals, ltte, ltte, _true, _absn, lt_b_tt, lt_b_xx, lt_b_sec},
+	/*DURATION*/ {lt_b_sec, lt_b_sec, _fals, lt_b_xs, lt_b_xs, _fals, _fals, ltte, ltte, _true, _absn, lt_b_xx, lt_b_dd, lt_b_sec},
+	/*DECIMAL*/ {lt_b_decimal, lt_b_decimal, _fals, lt_b_xs, lt_b_xs, _fals, _fals, ltte, ltte, _true, _absn, lt_b_sec, lt_b_sec, lt_b_decimal},
 }
 
 func lete(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -372,18 +533,21 @@ func lete(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 var le_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT       FLOAT    BOOL     VOID     STRING   ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {le_b_ii, le_b_if, _fals, le_b_xs, le_b_xs, _fals, _fals, lete, lete, _true, _absn},
-	/*FLOAT  */ {le_b_fi, le_b_ff, _fals, le_b_xs, le_b_xs, _fals, _fals, lete, lete, _true, _absn},
-	/*BOOL   */ {_fals, _fals, le_b_bb, _fals, _fals, _fals, _fals, lete, lete, _true, _absn},
-	/*VOID   */ {le_b_sx, le_b_sx, _fals, le_b_ss, le_b_ss, _fals, _fals, lete, lete, _true, _absn},
-	/*STRING */ {le_b_sx, le_b_sx, _fals, le_b_ss, le_b_ss, _fals, _fals, lete, lete, _true, _absn},
-	/*ARRAY  */ {_fals, _fals, _fals, _fals, _fals, lete, _fals, lete, lete, _absn, _absn},
-	/*MAP    */ {_fals, _fals, _fals, _fals, _fals, _fals, lete, lete, lete, _absn, _absn},
-	/*FUNC   */ {lete, lete, lete, lete, lete, lete, lete, lete, lete, lete, lete},
-	/*ERROR  */ {lete, lete, lete, lete, lete, lete, lete, lete, lete, _true, lete},
-	/*NULL   */ {_fals, _fals, _fals, _fals, _fals, _absn, _absn, lete, _fals, _true, _true},
-	/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, lete, lete, _fals, _absn},
+	//       .  INT       FLOAT    BOOL     VOID     STRING   ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {le_b_ii, le_b_if, _fals, le_b_xs, le_b_xs, _fals, _fals, lete, lete, _true, _absn, le_b_sec, le_b_sec, le_b_decimal},
+	/*FLOAT  */ {le_b_fi, le_b_ff, _fals, le_b_xs, le_b_xs, _fals, _fals, lete, lete, _true, _absn, le_b_sec, le_b_sec, le_b_decimal},
+	/*BOOL   */ {_fals, _fals, le_b_bb, _fals, _fals, _fals, _fals, lete, lete, _true, _absn, _fals, _fals, _fals},
+	/*VOID   */ {le_b_sx, le_b_sx, _fals, le_b_ss, le_b_ss, _fals, _fals, lete, lete, _true, _absn, le_b_sx, le_b_sx, le_b_sx},
+	/*STRING */ {le_b_sx, le_b_sx, _fals, le_b_ss, le_b_ss, _fals, _fals, lete, lete, _true, _absn, le_b_sx, le_b_sx, le_b_sx},
+	/*ARRAY  */ {_fals, _fals, _fals, _fals, _fals, lete, _fals, lete, lete, _absn, _absn, _fals, _fals, _fals},
+	/*MAP    */ {_fals, _fals, _fals, _fals, _fals, _fals, lete, lete, lete, _absn, _absn, _fals, _fals, _fals},
+	/*FUNC   */ {lete, lete, lete, lete, lete, lete, lete, lete, lete, lete, lete, lete, lete, lete},
+	/*ERROR  */ {lete, lete, lete, lete, lete, lete, lete, lete, lete, _true, lete, lete, lete, lete},
+	/*NULL   */ {_fals, _fals, _fals, _fals, _fals, _absn, _absn, lete, _fals, _true, _true, _fals, _fals, _fals},
+	/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, lete, lete, _fals, _absn, _absn, _absn, _absn},
+	/*TIME   */ {le_b_sec, le_b_sec, _fals, le_b_xs, le_b_xs, _fals, _fals, lete, lete, _true, _absn, le_b_tt, le_b_xx, le_b_sec},
+	/*DURATION*/ {le_b_sec, le_b_sec, _fals, le_b_xs, le_b_xs, _fals, _fals, lete, lete, _true, _absn, le_b_xx, le_b_dd, le_b_sec},
+	/*DECIMAL*/ {le_b_decimal, le_b_decimal, _fals, le_b_xs, le_b_xs, _fals, _fals, lete, lete, _true, _absn, le_b_sec, le_b_sec, le_b_decimal},
 }
 
 func cmpte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -391,18 +555,21 @@ func cmpte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 var cmp_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT        FLOAT     BOOL      VOID      STRING    ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {cmp_b_ii, cmp_b_if, _less, cmp_b_xs, cmp_b_xs, _less, _less, cmpte, cmpte, _true, _absn},
-	/*FLOAT  */ {cmp_b_fi, cmp_b_ff, _less, cmp_b_xs, cmp_b_xs, _less, _less, cmpte, cmpte, _true, _absn},
-	/*BOOL   */ {_more, _more, cmp_b_bb, _less, _less, _less, _less, cmpte, cmpte, _true, _absn},
-	/*VOID   */ {cmp_b_sx, cmp_b_sx, _more, cmp_b_ss, cmp_b_ss, _less, _less, cmpte, cmpte, _true, _absn},
-	/*STRING */ {cmp_b_sx, cmp_b_sx, _more, cmp_b_ss, cmp_b_ss, _less, _less, cmpte, cmpte, _true, _absn},
-	/*ARRAY  */ {_more, _more, _more, _more, _more, cmpte, _less, cmpte, cmpte, _absn, _absn},
-	/*MAP    */ {_more, _more, _more, _more, _more, _more, cmpte, cmpte, cmpte, _absn, _absn},
-	/*FUNC   */ {cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte},
-	/*ERROR  */ {cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, _true, cmpte},
-	/*NULL   */ {_more, _more, _more, _more, _more, _absn, _absn, cmpte, _more, _same, _true},
-	/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, cmpte, cmpte, _more, _absn},
+	//       .  INT        FLOAT     BOOL      VOID      STRING    ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {cmp_b_ii, cmp_b_if, _less, cmp_b_xs, cmp_b_xs, _less, _less, cmpte, cmpte, _true, _absn, cmp_b_sec, cmp_b_sec, cmp_b_decimal},
+	/*FLOAT  */ {cmp_b_fi, cmp_b_ff, _less, cmp_b_xs, cmp_b_xs, _less, _less, cmpte, cmpte, _true, _absn, cmp_b_sec, cmp_b_sec, cmp_b_decimal},
+	/*BOOL   */ {_more, _more, cmp_b_bb, _less, _less, _less, _less, cmpte, cmpte, _true, _absn, _less, _less, _more},
+	/*VOID   */ {cmp_b_sx, cmp_b_sx, _more, cmp_b_ss, cmp_b_ss, _less, _less, cmpte, cmpte, _true, _absn, cmp_b_sx, cmp_b_sx, cmp_b_sx},
+	/*STRING */ {cmp_b_sx, cmp_b_sx, _more, cmp_b_ss, cmp_b_ss, _less, _less, cmpte, cmpte, _true, _absn, cmp_b_sx, cmp_b_sx, cmp_b_sx},
+	/*ARRAY  */ {_more, _more, _more, _more, _more, cmpte, _less, cmpte, cmpte, _absn, _absn, _more, _more, _more},
+	/*MAP    */ {_more, _more, _more, _more, _more, _more, cmpte, cmpte, cmpte, _absn, _absn, _more, _more, _more},
+	/*FUNC   */ {cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte},
+	/*ERROR  */ {cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, cmpte, _true, cmpte, cmpte, cmpte, cmpte},
+	/*NULL   */ {_more, _more, _more, _more, _more, _absn, _absn, cmpte, _more, _same, _true, _more, _more, _more},
+	/*ABSENT */ {_absn, _absn, _absn, _absn, _absn, _absn, _absn, cmpte, cmpte, _more, _absn, _absn, _absn, _absn},
+	/*TIME   */ {cmp_b_sec, cmp_b_sec, _more, cmp_b_xs, cmp_b_xs, _less, _less, cmpte, cmpte, _true, _absn, cmp_b_tt, cmp_b_xx, cmp_b_sec},
+	/*DURATION*/ {cmp_b_sec, cmp_b_sec, _more, cmp_b_xs, cmp_b_xs, _less, _less, cmpte, cmpte, _true, _absn, cmp_b_xx, cmp_b_dd, cmp_b_sec},
+	/*DECIMAL*/ {cmp_b_decimal, cmp_b_decimal, _less, cmp_b_xs, cmp_b_xs, _less, _less, cmpte, cmpte, _true, _absn, cmp_b_sec, cmp_b_sec, cmp_b_decimal},
 }
 
 func BIF_equals(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -82,6 +82,9 @@ func init() {
 		/*ERROR  */ depth_te,
 		/*NULL   */ _zero1,
 		/*ABSENT */ _absn1,
+		/*TIME   */ depth_from_scalar,
+		/*DURATION*/ depth_from_scalar,
+		/*DECIMAL*/ depth_from_scalar,
 	}
 }
 
@@ -154,6 +157,9 @@ var leafcount_dispositions = [mlrval.MT_DIM]UnaryFunc{
 	/*ERROR  */ leafcount_te,
 	/*NULL   */ _zero1,
 	/*ABSENT */ _absn1,
+	/*TIME   */ leafcount_from_scalar,
+	/*DURATION*/ leafcount_from_scalar,
+	/*DECIMAL*/ leafcount_from_scalar,
 }
 
 func BIF_leafcount(input1 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -1,12 +1,9 @@
 package bifs
 
 import (
-	"fmt"
-	"regexp"
 	"time"
 
 	strptime "github.com/johnkerl/miller/v6/pkg/pbnjay-strptime"
-	"github.com/lestrrat-go/strftime"
 
 	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
@@ -51,8 +48,16 @@ func BIF_upntime() *mlrval.Mlrval {
 }
 
 // ================================================================
+// The sec2* and nsec2* functions also format times, as the instants they are.
+
+func epochNanoseconds(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromInt(input1.AcquireTimeValue().UnixNano())
+}
 
 func BIF_sec2gmt_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		return BIF_nsec2gmt_unary(epochNanoseconds(input1))
+	}
 	floatValue, isNumeric := input1.GetNumericToFloatValue()
 	if !isNumeric {
 		return input1
@@ -62,6 +67,9 @@ func BIF_sec2gmt_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_nsec2gmt_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		input1 = epochNanoseconds(input1)
+	}
 	intValue, errValue := input1.GetIntValueOrError("nsec2gmt")
 	if errValue != nil {
 		return errValue
@@ -71,6 +79,9 @@ func BIF_nsec2gmt_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_sec2gmt_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		return BIF_nsec2gmt_binary(epochNanoseconds(input1), input2)
+	}
 	floatValue, errValue := input1.GetNumericToFloatValueOrError("sec2gmt")
 	if errValue != nil {
 		return errValue
@@ -83,6 +94,9 @@ func BIF_sec2gmt_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_nsec2gmt_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		input1 = epochNanoseconds(input1)
+	}
 	intValue, ok := input1.GetIntValue()
 	if !ok {
 		return input1
@@ -95,6 +109,9 @@ func BIF_nsec2gmt_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_sec2localtime_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		return BIF_nsec2localtime_unary(epochNanoseconds(input1))
+	}
 	floatValue, isNumeric := input1.GetNumericToFloatValue()
 	if !isNumeric {
 		return input1
@@ -104,6 +121,9 @@ func BIF_sec2localtime_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_nsec2localtime_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		input1 = epochNanoseconds(input1)
+	}
 	intValue, ok := input1.GetIntValue()
 	if !ok {
 		return input1
@@ -113,6 +133,9 @@ func BIF_nsec2localtime_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_sec2localtime_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		return BIF_nsec2localtime_binary(epochNanoseconds(input1), input2)
+	}
 	floatValue, isNumeric := input1.GetNumericToFloatValue()
 	if !isNumeric {
 		return input1
@@ -125,6 +148,9 @@ func BIF_sec2localtime_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_nsec2localtime_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		input1 = epochNanoseconds(input1)
+	}
 	intValue, ok := input1.GetIntValue()
 	if !ok {
 		return input1
@@ -137,6 +163,9 @@ func BIF_nsec2localtime_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_sec2localtime_ternary(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		return BIF_nsec2localtime_ternary(epochNanoseconds(input1), input2, input3)
+	}
 	floatValue, isNumeric := input1.GetNumericToFloatValue()
 	if !isNumeric {
 		return input1
@@ -157,6 +186,9 @@ func BIF_sec2localtime_ternary(input1, input2, input3 *mlrval.Mlrval) *mlrval.Ml
 }
 
 func BIF_nsec2localtime_ternary(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		input1 = epochNanoseconds(input1)
+	}
 	intValue, isNumeric := input1.GetIntValue()
 	if !isNumeric {
 		return input1
@@ -177,6 +209,9 @@ func BIF_nsec2localtime_ternary(input1, input2, input3 *mlrval.Mlrval) *mlrval.M
 }
 
 func BIF_sec2gmtdate(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		return BIF_nsec2gmtdate(epochNanoseconds(input1))
+	}
 	if !input1.IsNumeric() {
 		return input1
 	}
@@ -184,6 +219,9 @@ func BIF_sec2gmtdate(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_nsec2gmtdate(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		input1 = epochNanoseconds(input1)
+	}
 	if !input1.IsNumeric() {
 		return input1
 	}
@@ -191,6 +229,9 @@ func BIF_nsec2gmtdate(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_sec2localdate_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		return BIF_nsec2localdate_unary(epochNanoseconds(input1))
+	}
 	if !input1.IsNumeric() {
 		return input1
 	}
@@ -198,6 +239,9 @@ func BIF_sec2localdate_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_nsec2localdate_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		input1 = epochNanoseconds(input1)
+	}
 	if !input1.IsNumeric() {
 		return input1
 	}
@@ -205,6 +249,9 @@ func BIF_nsec2localdate_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_sec2localdate_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		return BIF_nsec2localdate_binary(epochNanoseconds(input1), input2)
+	}
 	if !input1.IsNumeric() {
 		return input1
 	}
@@ -212,6 +259,9 @@ func BIF_sec2localdate_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func BIF_nsec2localdate_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsTime() {
+		input1 = epochNanoseconds(input1)
+	}
 	if !input1.IsNumeric() {
 		return input1
 	}
@@ -251,8 +301,6 @@ func BIF_gmt2localtime_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 // Argument 1 is int/float seconds since the epoch.
 // Argument 2 is format string like "%Y-%m-%d %H:%M:%S".
 
-var extensionRegex = regexp.MustCompile("([1-9])S")
-
 func BIF_strftime(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return strftimeHelper(input1, input2, false, nil, "strftime")
 }
@@ -304,6 +352,9 @@ func strftimeHelper(
 	if input1.IsVoid() {
 		return input1
 	}
+	if input1.IsTime() {
+		return strftimeTimeHelper(input1.AcquireTimeValue(), input2, doLocal, location, funcname)
+	}
 	epochSeconds, errValue := input1.GetNumericToFloatValueOrError(funcname)
 	if errValue != nil {
 		return errValue
@@ -324,21 +375,11 @@ func strftimeHelper(
 		inputTime = lib.EpochSecondsToGMT(epochSeconds)
 	}
 
-	// Convert argument 2 to a strftime format string.
-	//
-	// Miller fractional-second formats are like "%6S", and were so in the C
-	// implementation. However, in the strftime package we're using in the Go
-	// port, extension-formats are only a single byte so we need to rewrite
-	// them to "%6".
-	formatString := extensionRegex.ReplaceAllString(input2.AcquireStringValue(), "$1")
-
-	formatter, err := strftime.New(formatString, strftimeExtensions)
+	outputString, err := lib.Strftime(inputTime, input2.AcquireStringValue())
 	if err != nil {
 		return mlrval.FromError(err)
 	}
 
-	outputString := formatter.FormatString(inputTime)
-
 	return mlrval.FromString(outputString)
 }
 
@@ -351,6 +392,9 @@ func strfntimeHelper(
 	if input1.IsVoid() {
 		return input1
 	}
+	if input1.IsTime() {
+		return strftimeTimeHelper(input1.AcquireTimeValue(), input2, doLocal, location, funcname)
+	}
 	epochNanoseconds, errValue := input1.GetIntValueOrError(funcname)
 	if errValue != nil {
 		return errValue
@@ -371,103 +415,42 @@ func strfntimeHelper(
 		inputTime = lib.EpochNanosecondsToGMT(epochNanoseconds)
 	}
 
-	// Convert argument 2 to a strfntime format string.
-	//
-	// Miller fractional-second formats are like "%6S", and were so in the C
-	// implementation. However, in the strfntime package we're using in the Go
-	// port, extension-formats are only a single byte so we need to rewrite
-	// them to "%6".
-	formatString := extensionRegex.ReplaceAllString(input2.AcquireStringValue(), "$1")
-
-	formatter, err := strftime.New(formatString, strftimeExtensions)
+	outputString, err := lib.Strftime(inputTime, input2.AcquireStringValue())
 	if err != nil {
 		return mlrval.FromError(err)
 	}
 
-	outputString := formatter.FormatString(inputTime)
-
 	return mlrval.FromString(outputString)
 }
 
-// ----------------------------------------------------------------
-// This is support for %1S .. %9S in format strings, using github.com/lestrrat-go/strftime.
-
-var strftimeExtensions strftime.Option
+// strftimeTimeHelper is for time-typed input, rather than seconds since the
+// epoch. The time is converted to GMT or local time as for numeric input.
+func strftimeTimeHelper(
+	inputTime time.Time,
+	input2 *mlrval.Mlrval,
+	doLocal bool,
+	location *time.Location,
+	funcname string,
+) *mlrval.Mlrval {
+	if !input2.IsString() {
+		return mlrval.FromNotStringError(funcname, input2)
+	}
+	if doLocal {
+		if location != nil {
+			inputTime = inputTime.In(location)
+		} else {
+			inputTime = inputTime.Local()
+		}
+	} else {
+		inputTime = inputTime.UTC()
+	}
 
-// This is a helper function for the appenders below, which let people get
-// 1..9 decimal places in the seconds of their strftime format strings.
-func specificationHelper(b []byte, t time.Time, sprintfFormat string, quotient int) []byte {
-	seconds := int(t.Second())
-	fractional := int(t.Nanosecond() / quotient)
-	secondsString := fmt.Sprintf("%02d", seconds)
-	b = append(b, secondsString...)
-	b = append(b, '.')
-	fractionalString := fmt.Sprintf(sprintfFormat, fractional)
-	b = append(b, fractionalString...)
-	return b
-}
+	outputString, err := lib.Strftime(inputTime, input2.AcquireStringValue())
+	if err != nil {
+		return mlrval.FromError(err)
+	}
 
-func init() {
-	appender1 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		return specificationHelper(b, t, "%01d", 100000000)
-	})
-	appender2 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		return specificationHelper(b, t, "%02d", 10000000)
-	})
-	appender3 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		return specificationHelper(b, t, "%03d", 1000000)
-	})
-	appender4 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		return specificationHelper(b, t, "%04d", 100000)
-	})
-	appender5 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		return specificationHelper(b, t, "%05d", 10000)
-	})
-	appender6 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		return specificationHelper(b, t, "%06d", 1000)
-	})
-	appender7 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		return specificationHelper(b, t, "%07d", 100)
-	})
-	appender8 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		return specificationHelper(b, t, "%09d", 10)
-	})
-	appender9 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		return specificationHelper(b, t, "%09d", 1)
-	})
-	appenderN := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		nanos := int(t.Nanosecond())
-		s := fmt.Sprintf("%09d", nanos)
-		//return append(b, []byte(s))
-		return append(b, s...)
-	})
-	appenderO := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		nanos := int(t.Nanosecond())
-		s := fmt.Sprintf("%d", nanos)
-		//return append(b, []byte(s))
-		return append(b, s...)
-	})
-	appenderS := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		epochSeconds := t.Unix()
-		s := fmt.Sprintf("%d", epochSeconds)
-		return append(b, s...)
-	})
-
-	ss := strftime.NewSpecificationSet()
-	ss.Set('1', appender1)
-	ss.Set('2', appender2)
-	ss.Set('3', appender3)
-	ss.Set('4', appender4)
-	ss.Set('5', appender5)
-	ss.Set('6', appender6)
-	ss.Set('7', appender7)
-	ss.Set('8', appender8)
-	ss.Set('9', appender9)
-	ss.Set('N', appenderN)
-	ss.Set('O', appenderO)
-	ss.Set('s', appenderS)
-
-	strftimeExtensions = strftime.WithSpecificationSet(ss)
+	return mlrval.FromString(outputString)
 }
 
 // ================================================================
@@ -614,3 +597,208 @@ func bif_strptime_local_ternary_aux(input1, input2, input3 *mlrval.Mlrval, produ
 		return mlrval.FromFloat(float64(t.UnixNano()) / 1.0e9)
 	}
 }
+
+// ================================================================
+// Time and duration values
+
+// BIF_time_unary makes a time from int/float seconds since the epoch, in GMT,
+// or from an RFC 3339 timestamp like "2021-03-04T02:59:50Z".
+func BIF_time_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsVoid() || input1.IsTime() {
+		return input1
+	}
+	if input1.IsInt() {
+		return mlrval.FromTime(time.Unix(input1.AcquireIntValue(), 0).UTC(), "")
+	}
+	if input1.IsFloat() || input1.IsDecimal() {
+		seconds, _ := input1.GetNumericToFloatValue()
+		return mlrval.FromTime(lib.EpochSecondsToGMT(seconds), "")
+	}
+	if !input1.IsString() {
+		return mlrval.FromNotStringError("time", input1)
+	}
+	timeString := input1.AcquireStringValue()
+	t, err := time.Parse(time.RFC3339Nano, timeString)
+	if err != nil {
+		return mlrval.FromError(err)
+	}
+	return mlrval.FromParsedTime(timeString, t, "")
+}
+
+// BIF_time_binary parses a timestamp using a strptime format. The time prints
+// back using the same format.
+func BIF_time_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return bif_time_aux(input1, input2, nil)
+}
+
+// BIF_time_ternary is as BIF_time_binary but with a location such as
+// "Asia/Istanbul" for timestamps without a time-zone offset.
+func BIF_time_ternary(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
+	locationString, errValue := input3.GetStringValueOrError("time")
+	if errValue != nil {
+		return errValue
+	}
+	location, err := time.LoadLocation(locationString)
+	if err != nil {
+		return mlrval.FromError(err)
+	}
+	return bif_time_aux(input1, input2, location)
+}
+
+func bif_time_aux(input1, input2 *mlrval.Mlrval, location *time.Location) *mlrval.Mlrval {
+	if input1.IsVoid() {
+		return input1
+	}
+	if !input1.IsStringOrVoid() && !input1.IsNumeric() {
+		return mlrval.FromNotStringError("time", input1)
+	}
+	if !input2.IsString() {
+		return mlrval.FromNotStringError("time", input2)
+	}
+	// Use the original string so that, e.g., "20230102" with format "%Y%m%d"
+	// isn't seen as an int.
+	timeString := input1.OriginalString()
+	formatString := input2.AcquireStringValue()
+
+	var t time.Time
+	var err error
+	if location != nil {
+		t, err = strptime.ParseLocation(timeString, formatString, location)
+	} else {
+		t, err = strptime.Parse(timeString, formatString)
+	}
+	if err != nil {
+		return mlrval.FromError(err)
+	}
+	return mlrval.FromParsedTime(timeString, t, formatString)
+}
+
+// BIF_duration makes a duration from int/float seconds, or from a string like
+// "1h30m" or "1.5s" as at https://pkg.go.dev/time#ParseDuration.
+func BIF_duration(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsVoid() || input1.IsDuration() {
+		return input1
+	}
+	if input1.IsInt() {
+		return mlrval.FromDuration(time.Duration(input1.AcquireIntValue()) * time.Second)
+	}
+	if input1.IsFloat() || input1.IsDecimal() {
+		seconds, _ := input1.GetNumericToFloatValue()
+		return mlrval.FromDuration(time.Duration(seconds * 1.0e9))
+	}
+	if !input1.IsString() {
+		return mlrval.FromNotStringError("duration", input1)
+	}
+	d, err := time.ParseDuration(input1.AcquireStringValue())
+	if err != nil {
+		return mlrval.FromError(err)
+	}
+	return mlrval.FromDuration(d)
+}
+
+// ================================================================
+// Calendar buckets
+
+// BIF_timefloor_binary rounds a time down to the start of its calendar bucket,
+// such as "hour", "15minute", "week", or "quarter". Seconds since the epoch
+// are bucketed in UTC, and time values and timestamps in their own time zone.
+func BIF_timefloor_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return bif_timebucket_aux(input1, input2, nil, false, "timefloor")
+}
+
+// BIF_timefloor_ternary is as BIF_timefloor_binary but buckets in a location
+// such as "America/New_York".
+func BIF_timefloor_ternary(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
+	locationString, errValue := input3.GetStringValueOrError("timefloor")
+	if errValue != nil {
+		return errValue
+	}
+	location, err := time.LoadLocation(locationString)
+	if err != nil {
+		return mlrval.FromError(err)
+	}
+	return bif_timebucket_aux(input1, input2, location, false, "timefloor")
+}
+
+// BIF_timeceil_binary rounds a time up to the start of the next calendar
+// bucket, unless it's already at the start of one.
+func BIF_timeceil_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return bif_timebucket_aux(input1, input2, nil, true, "timeceil")
+}
+
+func BIF_timeceil_ternary(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
+	locationString, errValue := input3.GetStringValueOrError("timeceil")
+	if errValue != nil {
+		return errValue
+	}
+	location, err := time.LoadLocation(locationString)
+	if err != nil {
+		return mlrval.FromError(err)
+	}
+	return bif_timebucket_aux(input1, input2, location, true, "timeceil")
+}
+
+func bif_timebucket_aux(
+	input1, input2 *mlrval.Mlrval,
+	location *time.Location,
+	doCeil bool,
+	funcname string,
+) *mlrval.Mlrval {
+	if input1.IsVoid() || input1.IsAbsent() {
+		return input1
+	}
+	unitString, errValue := input2.GetStringValueOrError(funcname)
+	if errValue != nil {
+		return errValue
+	}
+	unit, err := lib.ParseTimeBucketUnit(unitString)
+	if err != nil {
+		return mlrval.FromError(err)
+	}
+	t, ok := TimeFromMlrval(input1)
+	if !ok {
+		return mlrval.FromNotNamedTypeError(funcname, input1, "time, number, or RFC 3339 timestamp")
+	}
+	if location != nil {
+		t = t.In(location)
+	}
+	if doCeil {
+		t = unit.Ceil(t)
+	} else {
+		t = unit.Floor(t)
+	}
+	return TimeToMlrvalLike(t, input1)
+}
+
+// TimeFromMlrval is for the timefloor and timeceil functions and the
+// timebucket verb, which take a time value, seconds since the epoch in UTC, or
+// an RFC 3339 timestamp such as "2023-01-02T03:04:05Z".
+func TimeFromMlrval(input *mlrval.Mlrval) (time.Time, bool) {
+	if input.IsTime() {
+		return input.AcquireTimeValue(), true
+	}
+	if input.IsInt() {
+		return time.Unix(input.AcquireIntValue(), 0).UTC(), true
+	}
+	if seconds, isNumeric := input.GetNumericToFloatValue(); isNumeric {
+		return lib.EpochSecondsToGMT(seconds), true
+	}
+	if input.IsString() {
+		t, err := time.Parse(time.RFC3339Nano, input.AcquireStringValue())
+		return t, err == nil
+	}
+	return time.Time{}, false
+}
+
+// TimeToMlrvalLike is the inverse of TimeFromMlrval: the output is of the same
+// kind as the given input. Bucket boundaries are whole seconds, so numbers come
+// back as ints.
+func TimeToMlrvalLike(t time.Time, like *mlrval.Mlrval) *mlrval.Mlrval {
+	if like.IsTime() {
+		return mlrval.FromTime(t, like.AcquireTimeFormat())
+	}
+	if like.IsNumeric() {
+		return mlrval.FromInt(t.Unix())
+	}
+	return mlrval.FromString(t.Format(time.RFC3339))
+}
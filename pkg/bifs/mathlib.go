@@ -42,6 +42,28 @@ func math_unary_i_i(input1 *mlrval.Mlrval, f mathLibUnaryFunc, fname string) *ml
 func math_unary_f_f(input1 *mlrval.Mlrval, f mathLibUnaryFunc, fname string) *mlrval.Mlrval {
 	return mlrval.FromFloat(f(input1.AcquireFloatValue()))
 }
+func math_unary_f_x(input1 *mlrval.Mlrval, f mathLibUnaryFunc, fname string) *mlrval.Mlrval {
+	return mlrval.FromFloat(f(input1.AcquireDecimalValue().Float64()))
+}
+
+// Decimal counterparts of the int-preserving math-library functions, so that
+// e.g. round on a decimal is exact.
+var decimalUnaryFuncs = map[string]func(*lib.Decimal) *lib.Decimal{
+	"abs":   (*lib.Decimal).Abs,
+	"ceil":  (*lib.Decimal).Ceil,
+	"floor": (*lib.Decimal).Floor,
+	"round": func(d *lib.Decimal) *lib.Decimal { return d.Round(0) },
+	"sgn":   func(d *lib.Decimal) *lib.Decimal { return lib.NewDecimalFromInt64(int64(d.Sign())) },
+}
+
+func math_unary_x_x(input1 *mlrval.Mlrval, f mathLibUnaryFunc, fname string) *mlrval.Mlrval {
+	decimalFunc, ok := decimalUnaryFuncs[fname]
+	if ok {
+		return mlrval.FromDecimal(decimalFunc(input1.AcquireDecimalValue()))
+	} else {
+		return math_unary_f_x(input1, f, fname)
+	}
+}
 
 // Disposition vector for unary mathlib functions
 var mudispo = [mlrval.MT_DIM]mathLibUnaryFuncWrapper{
@@ -56,6 +78,9 @@ var mudispo = [mlrval.MT_DIM]mathLibUnaryFuncWrapper{
 	/*ERROR  */ _math_unary_erro1,
 	/*NULL   */ _math_unary_null1,
 	/*ABSENT */ _math_unary_absn1,
+	/*TIME   */ _math_unary_erro1,
+	/*DURATION*/ _math_unary_erro1,
+	/*DECIMAL*/ math_unary_f_x,
 }
 
 func BIF_acos(input1 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -141,6 +166,9 @@ var imudispo = [mlrval.MT_DIM]mathLibUnaryFuncWrapper{
 	/*ERROR  */ _math_unary_erro1,
 	/*NULL   */ _math_unary_null1,
 	/*ABSENT */ _math_unary_absn1,
+	/*TIME   */ _math_unary_erro1,
+	/*DURATION*/ _math_unary_erro1,
+	/*DECIMAL*/ math_unary_x_x,
 }
 
 // Int-preserving
@@ -170,6 +198,8 @@ func pow_f_ii(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	// Int raised to int power should be float if it can be (i.e. unless overflow)
 	if float64(ioutput) == foutput {
 		return mlrval.FromInt(ioutput)
+	} else if mlrval.IsDecimalMode() {
+		return pow_decimal(input1, input2)
 	} else {
 		return mlrval.FromFloat(foutput)
 	}
@@ -184,23 +214,72 @@ func pow_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(math.Pow(input1.AcquireFloatValue(), input2.AcquireFloatValue()))
 }
 
+// Decimals raised to integer powers are exact, within reason; otherwise, as
+// with floats.
+func pow_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if !input1.IsFloat() && !input2.IsFloat() {
+		base, _ := input1.GetNumericToDecimalValue()
+		exponent, _ := input2.GetNumericToDecimalValue()
+		if exponent.IsInteger() {
+			n, ok := exponent.Int64()
+			if ok && n >= -maxExactDecimalExponent && n <= maxExactDecimalExponent {
+				output, ok := decimalIntPower(base, n)
+				if ok {
+					return mlrval.FromDecimal(output)
+				}
+			}
+		}
+	}
+	a, _ := input1.GetNumericToFloatValue()
+	b, _ := input2.GetNumericToFloatValue()
+	return mlrval.FromFloat(math.Pow(a, b))
+}
+
+const maxExactDecimalExponent = 1 << 16
+
+// decimalIntPower uses repeated squaring. It returns false for zero to a
+// negative power.
+func decimalIntPower(base *lib.Decimal, n int64) (*lib.Decimal, bool) {
+	negative := n < 0
+	if negative {
+		n = -n
+	}
+	output := lib.NewDecimalFromInt64(1)
+	for n != 0 {
+		if n&1 == 1 {
+			output = output.Mul(base)
+		}
+		n >>= 1
+		if n != 0 {
+			base = base.Mul(base)
+		}
+	}
+	if negative {
+		return lib.NewDecimalFromInt64(1).Quo(output)
+	}
+	return output, true
+}
+
 func powte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorBinary("**", input1, input2)
 }
 
 var pow_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT        FLOAT     BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {pow_f_ii, pow_f_if, powte, _void, powte, powte, powte, powte, powte, powte, _1___},
-	/*FLOAT  */ {pow_f_fi, pow_f_ff, powte, _void, powte, powte, powte, powte, powte, powte, _1___},
-	/*BOOL   */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn},
-	/*VOID   */ {_void, _void, powte, _void, powte, powte, powte, powte, powte, powte, _absn},
-	/*STRING */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn},
-	/*ARRAY  */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn},
-	/*MAP    */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn},
-	/*FUNC   */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn},
-	/*ERROR  */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn},
-	/*NULL   */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn},
-	/*ABSENT */ {_i0__, _f0__, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn},
+	//       .  INT        FLOAT     BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {pow_f_ii, pow_f_if, powte, _void, powte, powte, powte, powte, powte, powte, _1___, powte, powte, pow_decimal},
+	/*FLOAT  */ {pow_f_fi, pow_f_ff, powte, _void, powte, powte, powte, powte, powte, powte, _1___, powte, powte, pow_decimal},
+	/*BOOL   */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn, powte, powte, powte},
+	/*VOID   */ {_void, _void, powte, _void, powte, powte, powte, powte, powte, powte, _absn, powte, powte, _void},
+	/*STRING */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn, powte, powte, powte},
+	/*ARRAY  */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn, powte, powte, powte},
+	/*MAP    */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn, powte, powte, powte},
+	/*FUNC   */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn, powte, powte, powte},
+	/*ERROR  */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn, powte, powte, powte},
+	/*NULL   */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn, powte, powte, powte},
+	/*ABSENT */ {_i0__, _f0__, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _f0__},
+	/*TIME   */ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn, powte, powte, powte},
+	/*DURATION*/ {powte, powte, powte, powte, powte, powte, powte, powte, powte, powte, _absn, powte, powte, powte},
+	/*DECIMAL*/ {pow_decimal, pow_decimal, powte, _void, powte, powte, powte, powte, powte, powte, _1___, powte, powte, pow_decimal},
 }
 
 func BIF_pow(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -221,23 +300,32 @@ func atan2_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(math.Atan2(input1.AcquireFloatValue(), input2.AcquireFloatValue()))
 }
 
+func atan2_f_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	a, _ := input1.GetNumericToFloatValue()
+	b, _ := input2.GetNumericToFloatValue()
+	return mlrval.FromFloat(math.Atan2(a, b))
+}
+
 func atan2te(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorBinary("atan2", input1, input2)
 }
 
 var atan2_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT          FLOAT       BOOL     VOID     STRING   ARRAY    MAP      FUNC     ERROR    NULL     ABSENT
-	/*INT    */ {atan2_f_ii, atan2_f_if, atan2te, _void, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _1___},
-	/*FLOAT  */ {atan2_f_fi, atan2_f_ff, atan2te, _void, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _1___},
-	/*BOOL   */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn},
-	/*VOID   */ {_void, _void, atan2te, _void, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn},
-	/*STRING */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn},
-	/*ARRAY  */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn},
-	/*MAP    */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn},
-	/*FUNC   */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn},
-	/*ERROR  */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn},
-	/*NULL   */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn},
-	/*ABSENT */ {_i0__, _f0__, atan2te, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn},
+	//       .  INT          FLOAT       BOOL     VOID     STRING   ARRAY    MAP      FUNC     ERROR    NULL     ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {atan2_f_ii, atan2_f_if, atan2te, _void, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _1___, atan2te, atan2te, atan2_f_decimal},
+	/*FLOAT  */ {atan2_f_fi, atan2_f_ff, atan2te, _void, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _1___, atan2te, atan2te, atan2_f_decimal},
+	/*BOOL   */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn, atan2te, atan2te, atan2te},
+	/*VOID   */ {_void, _void, atan2te, _void, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn, atan2te, atan2te, _void},
+	/*STRING */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn, atan2te, atan2te, atan2te},
+	/*ARRAY  */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn, atan2te, atan2te, atan2te},
+	/*MAP    */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn, atan2te, atan2te, atan2te},
+	/*FUNC   */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn, atan2te, atan2te, atan2te},
+	/*ERROR  */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn, atan2te, atan2te, atan2te},
+	/*NULL   */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn, atan2te, atan2te, atan2te},
+	/*ABSENT */ {_i0__, _f0__, atan2te, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _f0__},
+	/*TIME   */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn, atan2te, atan2te, atan2te},
+	/*DURATION*/ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn, atan2te, atan2te, atan2te},
+	/*DECIMAL*/ {atan2_f_decimal, atan2_f_decimal, atan2te, _void, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _1___, atan2te, atan2te, atan2_f_decimal},
 }
 
 func BIF_atan2(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -262,23 +350,39 @@ func roundm_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(mlr_roundm(input1.AcquireFloatValue(), input2.AcquireFloatValue()))
 }
 
+func roundm_decimal(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return decimalBinary(input1, input2,
+		func(x, m *lib.Decimal) *mlrval.Mlrval {
+			q, ok := x.Quo(m)
+			if !ok {
+				return mlrval.FromFloat(mlr_roundm(x.Float64(), 0.0))
+			}
+			return mlrval.FromDecimal(q.Round(0).Mul(m))
+		},
+		func(x, m float64) *mlrval.Mlrval { return mlrval.FromFloat(mlr_roundm(x, m)) },
+	)
+}
+
 func rdmte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorBinary("roundm", input1, input2)
 }
 
 var roundm_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
-	//       .  INT           FLOAT        BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {roundm_f_ii, roundm_f_if, rdmte, _void, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _1___},
-	/*FLOAT  */ {roundm_f_fi, roundm_f_ff, rdmte, _void, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _1___},
-	/*BOOL   */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn},
-	/*VOID   */ {_void, _void, rdmte, _void, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn},
-	/*STRING */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn},
-	/*ARRAY  */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn},
-	/*MAP    */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn},
-	/*FUNC   */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn},
-	/*ERROR  */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn},
-	/*NULL   */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn},
-	/*ABSENT */ {_i0__, _f0__, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn},
+	//       .  INT           FLOAT        BOOL   VOID   STRING ARRAY  MAP    FUNC   ERROR  NULL   ABSENT  TIME   DURATION DECIMAL
+	/*INT    */ {roundm_f_ii, roundm_f_if, rdmte, _void, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _1___, rdmte, rdmte, roundm_decimal},
+	/*FLOAT  */ {roundm_f_fi, roundm_f_ff, rdmte, _void, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _1___, rdmte, rdmte, roundm_decimal},
+	/*BOOL   */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn, rdmte, rdmte, rdmte},
+	/*VOID   */ {_void, _void, rdmte, _void, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn, rdmte, rdmte, _void},
+	/*STRING */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn, rdmte, rdmte, rdmte},
+	/*ARRAY  */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn, rdmte, rdmte, rdmte},
+	/*MAP    */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn, rdmte, rdmte, rdmte},
+	/*FUNC   */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn, rdmte, rdmte, rdmte},
+	/*ERROR  */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn, rdmte, rdmte, rdmte},
+	/*NULL   */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn, rdmte, rdmte, rdmte},
+	/*ABSENT */ {_i0__, _f0__, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _f0__},
+	/*TIME   */ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn, rdmte, rdmte, rdmte},
+	/*DURATION*/ {rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _absn, rdmte, rdmte, rdmte},
+	/*DECIMAL*/ {roundm_decimal, roundm_decimal, rdmte, _void, rdmte, rdmte, rdmte, rdmte, rdmte, rdmte, _1___, rdmte, rdmte, roundm_decimal},
 }
 
 func BIF_roundm(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
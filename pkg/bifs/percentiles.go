@@ -3,6 +3,7 @@ package bifs
 import (
 	"math"
 
+	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
 )
 
@@ -215,3 +216,199 @@ func GetPercentileNonInterpolated(
 	}
 	return array[index].Copy()
 }
+
+// ================================================================
+// Approximate percentiles and distinct counts, using the same sketches as the
+// stats1 verb's approx_p50, approx_distinct_count, etc. These use bounded
+// memory, regardless of the number of values ingested.
+
+func BIF_approx_median(
+	collection *mlrval.Mlrval,
+) *mlrval.Mlrval {
+	return bif_approx_percentile_with_options_aux(collection, mlrval.FromFloat(50.0), nil, "approx_median")
+}
+
+func BIF_approx_median_with_options(
+	collection *mlrval.Mlrval,
+	options *mlrval.Mlrval,
+) *mlrval.Mlrval {
+	return bif_approx_percentile_with_options_aux(collection, mlrval.FromFloat(50.0), options, "approx_median")
+}
+
+func BIF_approx_percentile(
+	collection *mlrval.Mlrval,
+	percentile *mlrval.Mlrval,
+) *mlrval.Mlrval {
+	return bif_approx_percentile_with_options_aux(collection, percentile, nil, "approx_percentile")
+}
+
+func BIF_approx_percentile_with_options(
+	collection *mlrval.Mlrval,
+	percentile *mlrval.Mlrval,
+	options *mlrval.Mlrval,
+) *mlrval.Mlrval {
+	return bif_approx_percentile_with_options_aux(collection, percentile, options, "approx_percentile")
+}
+
+func BIF_approx_percentiles(
+	collection *mlrval.Mlrval,
+	percentiles *mlrval.Mlrval,
+) *mlrval.Mlrval {
+	return bif_approx_percentiles_with_options_aux(collection, percentiles, nil, "approx_percentiles")
+}
+
+func BIF_approx_percentiles_with_options(
+	collection *mlrval.Mlrval,
+	percentiles *mlrval.Mlrval,
+	options *mlrval.Mlrval,
+) *mlrval.Mlrval {
+	return bif_approx_percentiles_with_options_aux(collection, percentiles, options, "approx_percentiles")
+}
+
+func bif_approx_percentile_with_options_aux(
+	collection *mlrval.Mlrval,
+	percentile *mlrval.Mlrval,
+	options *mlrval.Mlrval,
+	funcname string,
+) *mlrval.Mlrval {
+	percentiles := mlrval.FromSingletonArray(percentile)
+	outputs := bif_approx_percentiles_with_options_aux(collection, percentiles, options, funcname)
+
+	// Check for error/absent returns from the main impl body
+	ok, value_if_not := check_collection(outputs, funcname)
+	if !ok {
+		return value_if_not
+	}
+
+	return outputs.AcquireMapValue().Head.Value
+}
+
+func bif_approx_percentiles_with_options_aux(
+	collection *mlrval.Mlrval,
+	percentiles *mlrval.Mlrval,
+	options *mlrval.Mlrval,
+	funcname string,
+) *mlrval.Mlrval {
+	ok, value_if_not := check_collection(collection, funcname)
+	if !ok {
+		return value_if_not
+	}
+
+	compression := lib.DefaultTDigestCompression
+	output_array_not_map := false
+
+	if options != nil {
+		om := options.GetMap()
+		if om == nil { // not a map
+			return type_error_named_argument(funcname, "map", "options", options)
+		}
+		for pe := om.Head; pe != nil; pe = pe.Next {
+			if pe.Key == "compression" {
+				c, ok := pe.Value.GetNumericToFloatValue()
+				if !ok || c < 1 {
+					return type_error_named_argument(funcname, "number at least 1", pe.Key, pe.Value)
+				}
+				compression = c
+			} else if pe.Key == "output_array_not_map" || pe.Key == "oa" {
+				if mlrval.Equals(pe.Value, mlrval.TRUE) {
+					output_array_not_map = true
+				} else if mlrval.Equals(pe.Value, mlrval.FALSE) {
+					output_array_not_map = false
+				} else {
+					return type_error_named_argument(funcname, "boolean", pe.Key, pe.Value)
+				}
+			}
+		}
+	}
+
+	ps := percentiles.GetArray()
+	if ps == nil { // not an array
+		return mlrval.FromNotArrayError(funcname+" percentiles", percentiles)
+	}
+
+	tdigest := lib.NewTDigest(compression)
+	collection_for_each(collection, func(element *mlrval.Mlrval) {
+		value, ok := element.GetNumericToFloatValue()
+		if ok {
+			tdigest.Add(value)
+		}
+	})
+
+	outputs := make([]*mlrval.Mlrval, len(ps))
+	for i := range ps {
+		p, ok := ps[i].GetNumericToFloatValue()
+		if !ok {
+			outputs[i] = type_error_named_argument(funcname, "numeric", "percentile", ps[i])
+		} else if q, ok := tdigest.Quantile(p / 100.0); ok {
+			outputs[i] = mlrval.FromFloat(q)
+		} else {
+			outputs[i] = mlrval.VOID
+		}
+	}
+
+	if output_array_not_map {
+		return mlrval.FromArray(outputs)
+	} else {
+		m := mlrval.NewMlrmap()
+		for i := range ps {
+			m.PutCopy(ps[i].String(), outputs[i])
+		}
+		return mlrval.FromMap(m)
+	}
+}
+
+func BIF_approx_distinct_count(
+	collection *mlrval.Mlrval,
+) *mlrval.Mlrval {
+	return BIF_approx_distinct_count_with_options(collection, nil)
+}
+
+func BIF_approx_distinct_count_with_options(
+	collection *mlrval.Mlrval,
+	options *mlrval.Mlrval,
+) *mlrval.Mlrval {
+	funcname := "approx_distinct_count"
+	ok, value_if_not := check_collection(collection, funcname)
+	if !ok {
+		return value_if_not
+	}
+
+	precision := lib.DefaultHyperLogLogPrecision
+	if options != nil {
+		om := options.GetMap()
+		if om == nil { // not a map
+			return type_error_named_argument(funcname, "map", "options", options)
+		}
+		for pe := om.Head; pe != nil; pe = pe.Next {
+			if pe.Key == "precision" {
+				p, ok := pe.Value.GetIntValue()
+				if !ok || p < lib.MinHyperLogLogPrecision || p > lib.MaxHyperLogLogPrecision {
+					return type_error_named_argument(funcname, "int from 4 to 18", pe.Key, pe.Value)
+				}
+				precision = int(p)
+			}
+		}
+	}
+
+	hll := lib.NewHyperLogLog(precision)
+	collection_for_each(collection, func(element *mlrval.Mlrval) {
+		hll.Add(element.OriginalString())
+	})
+	return mlrval.FromInt(hll.Count())
+}
+
+// collection_for_each calls f on each value of the array or map.
+func collection_for_each(
+	collection *mlrval.Mlrval,
+	f func(element *mlrval.Mlrval),
+) {
+	if collection.IsArray() {
+		for _, e := range collection.AcquireArrayValue() {
+			f(e)
+		}
+	} else {
+		for pe := collection.AcquireMapValue().Head; pe != nil; pe = pe.Next {
+			f(pe.Value)
+		}
+	}
+}
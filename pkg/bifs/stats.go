@@ -1,6 +1,7 @@
 package bifs
 
 import (
+	"fmt"
 	"math"
 	"sort"
 
@@ -662,3 +663,128 @@ func bif_percentiles_impl(
 		return mlrval.FromMap(m)
 	}
 }
+
+// ----------------------------------------------------------------
+// MERGEABLE STATE
+// See stats_state.go.
+
+func BIF_stats_state(collection, accumulatorName *mlrval.Mlrval) *mlrval.Mlrval {
+	return BIF_stats_state_with_options(collection, accumulatorName, nil)
+}
+
+func BIF_stats_state_with_options(
+	collection *mlrval.Mlrval,
+	accumulatorName *mlrval.Mlrval,
+	options *mlrval.Mlrval,
+) *mlrval.Mlrval {
+	funcname := "stats_state"
+	ok, value_if_not := check_collection(collection, funcname)
+	if !ok {
+		return value_if_not
+	}
+
+	state, errValue := bif_new_stats_state(funcname, accumulatorName)
+	if errValue != nil {
+		return errValue
+	}
+
+	if options != nil {
+		om := options.GetMap()
+		if om == nil { // not a map
+			return type_error_named_argument(funcname, "map", "options", options)
+		}
+		for pe := om.Head; pe != nil; pe = pe.Next {
+			if pe.Key == "compression" {
+				c, ok := pe.Value.GetNumericToFloatValue()
+				if !ok || c < 1 {
+					return type_error_named_argument(funcname, "number at least 1", pe.Key, pe.Value)
+				}
+				state.tdigestCompression = c
+			} else if pe.Key == "precision" {
+				p, ok := pe.Value.GetIntValue()
+				if !ok || p < lib.MinHyperLogLogPrecision || p > lib.MaxHyperLogLogPrecision {
+					return type_error_named_argument(funcname, "int from 4 to 18", pe.Key, pe.Value)
+				}
+				state.hllPrecision = int(p)
+			}
+		}
+	}
+
+	collection_for_each(collection, func(element *mlrval.Mlrval) {
+		state.Ingest(element)
+	})
+	return mlrval.FromString(state.String())
+}
+
+func BIF_stats_merge(states, accumulatorName *mlrval.Mlrval) *mlrval.Mlrval {
+	return BIF_stats_merge_with_options(states, accumulatorName, nil)
+}
+
+func BIF_stats_merge_with_options(
+	states *mlrval.Mlrval,
+	accumulatorName *mlrval.Mlrval,
+	options *mlrval.Mlrval,
+) *mlrval.Mlrval {
+	funcname := "stats_merge"
+	ok, value_if_not := check_collection(states, funcname)
+	if !ok {
+		return value_if_not
+	}
+
+	state, errValue := bif_new_stats_state(funcname, accumulatorName)
+	if errValue != nil {
+		return errValue
+	}
+
+	output_state := false
+	if options != nil {
+		om := options.GetMap()
+		if om == nil { // not a map
+			return type_error_named_argument(funcname, "map", "options", options)
+		}
+		for pe := om.Head; pe != nil; pe = pe.Next {
+			if pe.Key == "output_state" {
+				if mlrval.Equals(pe.Value, mlrval.TRUE) {
+					output_state = true
+				} else if mlrval.Equals(pe.Value, mlrval.FALSE) {
+					output_state = false
+				} else {
+					return type_error_named_argument(funcname, "boolean", pe.Key, pe.Value)
+				}
+			}
+		}
+	}
+
+	var err error = nil
+	collection_for_each(states, func(element *mlrval.Mlrval) {
+		if err == nil && !element.IsVoid() {
+			err = state.Merge(element.String())
+		}
+	})
+	if err != nil {
+		return mlrval.FromError(fmt.Errorf("%s: %v", funcname, err))
+	}
+
+	if output_state {
+		return mlrval.FromString(state.String())
+	}
+	return state.Finalize()
+}
+
+func bif_new_stats_state(
+	funcname string,
+	accumulatorName *mlrval.Mlrval,
+) (*StatsState, *mlrval.Mlrval) {
+	if !accumulatorName.IsStringOrVoid() {
+		return nil, mlrval.FromNotStringError(funcname, accumulatorName)
+	}
+	state, err := NewStatsState(
+		accumulatorName.String(),
+		lib.DefaultTDigestCompression,
+		lib.DefaultHyperLogLogPrecision,
+	)
+	if err != nil {
+		return nil, mlrval.FromError(fmt.Errorf("%s: %v", funcname, err))
+	}
+	return state, nil
+}
@@ -0,0 +1,448 @@
+// ================================================================
+// Mergeable state for the stats accumulators, for map-reduce-style rollups:
+// e.g. stats1 on hourly files emits states rather than final values, and
+// those are merged into daily statistics later on. This is used by the stats1
+// verb as well as the stats_state and stats_merge DSL functions.
+//
+// States are strings with a kind prefix, safe for all the file formats:
+//
+//   count:17
+//   moments:17;250.5;4100.25      count, then sum, sum of squares, etc.
+//   min:0.031
+//   max:pan
+//   counts:eyJ...                 base64 JSON of distinct values and counts
+//   tdigest:AAA...                base64 t-digest centroids
+//   hll:DggA...                   base64 deflated HyperLogLog registers
+//
+// Exact percentiles, median, and mad aren't mergeable without keeping all the
+// values, so approx_p50 etc. are to be used for those.
+// ================================================================
+
+package bifs
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+)
+
+const (
+	statsStateKindCount   = "count"
+	statsStateKindMoments = "moments"
+	statsStateKindMin     = "min"
+	statsStateKindMax     = "max"
+	statsStateKindCounts  = "counts"
+	statsStateKindTDigest = "tdigest"
+	statsStateKindHLL     = "hll"
+)
+
+type StatsState struct {
+	accumulatorName string
+	kind            string
+	numSums         int     // for moments
+	percentile      float64 // for approx percentiles
+
+	tdigestCompression float64
+	hllPrecision       int
+
+	count   int64
+	sums    []*mlrval.Mlrval // sum, sum of squares, etc.
+	extreme *mlrval.Mlrval   // for min, max, minlen, and maxlen
+	counts  *lib.OrderedMap  // from value string to int64 count
+	// These are created on first use, so that merging adopts the incoming
+	// compression and precision.
+	tdigest *lib.TDigest
+	hll     *lib.HyperLogLog
+}
+
+// statsStateKindForName returns the state kind for the accumulator name, and
+// for the moments kind, how many sums are needed.
+func statsStateKindForName(accumulatorName string) (kind string, numSums int, ok bool) {
+	switch accumulatorName {
+	case "count", "null_count":
+		return statsStateKindCount, 0, true
+	case "sum", "mean":
+		return statsStateKindMoments, 1, true
+	case "var", "stddev", "meaneb":
+		return statsStateKindMoments, 2, true
+	case "skewness":
+		return statsStateKindMoments, 3, true
+	case "kurtosis":
+		return statsStateKindMoments, 4, true
+	case "min", "minlen":
+		return statsStateKindMin, 0, true
+	case "max", "maxlen":
+		return statsStateKindMax, 0, true
+	case "distinct_count", "mode", "antimode":
+		return statsStateKindCounts, 0, true
+	case "approx_distinct_count":
+		return statsStateKindHLL, 0, true
+	}
+	if _, ok := approxPercentileFromName(accumulatorName); ok {
+		return statsStateKindTDigest, 0, true
+	}
+	return "", 0, false
+}
+
+// E.g. "approx_median" -> 50, "approx_p99.9" -> 99.9.
+func approxPercentileFromName(accumulatorName string) (float64, bool) {
+	if accumulatorName == "approx_median" {
+		return 50.0, true
+	}
+	if !strings.HasPrefix(accumulatorName, "approx_p") {
+		return 0.0, false
+	}
+	percentile, ok := lib.TryFloatFromString(strings.TrimPrefix(accumulatorName, "approx_p"))
+	if !ok || percentile < 0.0 || percentile > 100.0 {
+		return 0.0, false
+	}
+	return percentile, true
+}
+
+// IsMergeableStatsAccumulatorName tells whether NewStatsState accepts the
+// accumulator name.
+func IsMergeableStatsAccumulatorName(accumulatorName string) bool {
+	_, _, ok := statsStateKindForName(accumulatorName)
+	return ok
+}
+
+func NewStatsState(
+	accumulatorName string,
+	tdigestCompression float64,
+	hllPrecision int,
+) (*StatsState, error) {
+	kind, numSums, ok := statsStateKindForName(accumulatorName)
+	if !ok {
+		return nil, fmt.Errorf("accumulator \"%s\" does not have mergeable state", accumulatorName)
+	}
+	percentile, _ := approxPercentileFromName(accumulatorName)
+
+	state := &StatsState{
+		accumulatorName:    accumulatorName,
+		kind:               kind,
+		numSums:            numSums,
+		percentile:         percentile,
+		tdigestCompression: tdigestCompression,
+		hllPrecision:       hllPrecision,
+		count:              0,
+		sums:               make([]*mlrval.Mlrval, numSums),
+		extreme:            mlrval.ABSENT,
+		counts:             lib.NewOrderedMap(),
+	}
+	for i := range state.sums {
+		state.sums[i] = mlrval.FromInt(0)
+	}
+	return state, nil
+}
+
+// Ingest takes a value as the corresponding stats1 accumulator does.
+func (state *StatsState) Ingest(value *mlrval.Mlrval) {
+	switch state.kind {
+
+	case statsStateKindCount:
+		if state.accumulatorName == "count" || value.IsVoid() || value.IsNull() {
+			state.count++
+		}
+
+	case statsStateKindMoments:
+		if value.IsNumeric() {
+			state.count++
+			power := value
+			for i := range state.sums {
+				if i > 0 {
+					power = BIF_times(power, value)
+				}
+				state.sums[i] = BIF_plus_binary(state.sums[i], power)
+			}
+		}
+
+	case statsStateKindMin, statsStateKindMax:
+		if state.accumulatorName == "minlen" || state.accumulatorName == "maxlen" {
+			value = mlrval.FromInt(lib.UTF8Strlen(value.OriginalString()))
+		}
+		state.mergeExtreme(value)
+
+	case statsStateKindCounts:
+		state.addCount(value.OriginalString(), 1)
+
+	case statsStateKindTDigest:
+		floatValue, ok := value.GetNumericToFloatValue()
+		if ok {
+			if state.tdigest == nil {
+				state.tdigest = lib.NewTDigest(state.tdigestCompression)
+			}
+			state.tdigest.Add(floatValue)
+		}
+
+	case statsStateKindHLL:
+		if state.hll == nil {
+			state.hll = lib.NewHyperLogLog(state.hllPrecision)
+		}
+		state.hll.Add(value.OriginalString())
+	}
+}
+
+func (state *StatsState) mergeExtreme(value *mlrval.Mlrval) {
+	if state.kind == statsStateKindMin {
+		state.extreme = BIF_min_binary(state.extreme, value)
+	} else {
+		state.extreme = BIF_max_binary(state.extreme, value)
+	}
+}
+
+func (state *StatsState) addCount(key string, count int64) {
+	iPrevious, ok := state.counts.GetWithCheck(key)
+	if ok {
+		state.counts.Put(key, iPrevious.(int64)+count)
+	} else {
+		state.counts.Put(key, count)
+	}
+}
+
+// Finalize computes the accumulator's value, as the corresponding stats1
+// accumulator would have from all the values ingested into all the merged
+// states.
+func (state *StatsState) Finalize() *mlrval.Mlrval {
+	mcount := mlrval.FromInt(state.count)
+
+	switch state.accumulatorName {
+	case "count", "null_count":
+		return mcount
+	case "sum":
+		return state.sums[0].Copy()
+	case "mean":
+		if state.count == 0 {
+			return mlrval.VOID
+		}
+		return BIF_divide(state.sums[0], mcount)
+	case "var":
+		return BIF_finalize_variance(mcount, state.sums[0], state.sums[1])
+	case "stddev":
+		return BIF_finalize_stddev(mcount, state.sums[0], state.sums[1])
+	case "meaneb":
+		return BIF_finalize_mean_eb(mcount, state.sums[0], state.sums[1])
+	case "skewness":
+		return BIF_finalize_skewness(mcount, state.sums[0], state.sums[1], state.sums[2])
+	case "kurtosis":
+		return BIF_finalize_kurtosis(mcount, state.sums[0], state.sums[1], state.sums[2], state.sums[3])
+	case "min", "max", "minlen", "maxlen":
+		if state.extreme.IsAbsent() {
+			return mlrval.VOID
+		}
+		return state.extreme.Copy()
+	case "distinct_count":
+		return mlrval.FromInt(state.counts.FieldCount)
+	case "mode", "antimode":
+		return state.finalizeModeOrAntimode(state.accumulatorName == "mode")
+	case "approx_distinct_count":
+		if state.hll == nil {
+			return mlrval.FromInt(0)
+		}
+		return mlrval.FromInt(state.hll.Count())
+	}
+
+	// Approximate percentiles
+	if state.tdigest == nil {
+		return mlrval.VOID
+	}
+	quantile, ok := state.tdigest.Quantile(state.percentile / 100.0)
+	if !ok {
+		return mlrval.VOID
+	}
+	return mlrval.FromFloat(quantile)
+}
+
+// First-found wins ties.
+func (state *StatsState) finalizeModeOrAntimode(isMode bool) *mlrval.Mlrval {
+	if state.counts.IsEmpty() {
+		return mlrval.VOID
+	}
+	bestValue := state.counts.Head.Key
+	bestCount := state.counts.Head.Value.(int64)
+	for pe := state.counts.Head.Next; pe != nil; pe = pe.Next {
+		count := pe.Value.(int64)
+		if (isMode && count > bestCount) || (!isMode && count < bestCount) {
+			bestValue = pe.Key
+			bestCount = count
+		}
+	}
+	return mlrval.FromInferredType(bestValue)
+}
+
+// ----------------------------------------------------------------
+// SERIALIZATION
+
+type statsStateCount struct {
+	Value string `json:"v"`
+	Count int64  `json:"n"`
+}
+
+func (state *StatsState) String() string {
+	var payload string
+
+	switch state.kind {
+
+	case statsStateKindCount:
+		payload = strconv.FormatInt(state.count, 10)
+
+	case statsStateKindMoments:
+		fields := make([]string, 1+len(state.sums))
+		fields[0] = strconv.FormatInt(state.count, 10)
+		for i, sum := range state.sums {
+			fields[i+1] = formatStatsStateValue(sum)
+		}
+		payload = strings.Join(fields, ";")
+
+	case statsStateKindMin, statsStateKindMax:
+		if !state.extreme.IsAbsent() {
+			payload = formatStatsStateValue(state.extreme)
+		}
+
+	case statsStateKindCounts:
+		counts := make([]statsStateCount, 0, state.counts.FieldCount)
+		for pe := state.counts.Head; pe != nil; pe = pe.Next {
+			counts = append(counts, statsStateCount{pe.Key, pe.Value.(int64)})
+		}
+		bytes, err := json.Marshal(counts)
+		lib.InternalCodingErrorIf(err != nil)
+		payload = base64.RawURLEncoding.EncodeToString(bytes)
+
+	case statsStateKindTDigest:
+		if state.tdigest != nil {
+			bytes, err := state.tdigest.MarshalBinary()
+			lib.InternalCodingErrorIf(err != nil)
+			payload = base64.RawURLEncoding.EncodeToString(bytes)
+		}
+
+	case statsStateKindHLL:
+		if state.hll != nil {
+			bytes, err := state.hll.MarshalBinary()
+			lib.InternalCodingErrorIf(err != nil)
+			payload = base64.RawURLEncoding.EncodeToString(bytes)
+		}
+	}
+
+	return state.kind + ":" + payload
+}
+
+// Floats are formatted in full, regardless of --ofmt, so as not to lose
+// precision through a rollup.
+func formatStatsStateValue(value *mlrval.Mlrval) string {
+	floatValue, isFloat := value.GetFloatValue()
+	if isFloat {
+		return strconv.FormatFloat(floatValue, 'f', -1, 64)
+	}
+	return value.OriginalString()
+}
+
+// Merge combines a serialized state, as from String, into this one.
+func (state *StatsState) Merge(serialized string) error {
+	kind, payload, found := strings.Cut(serialized, ":")
+	if !found || kind != state.kind {
+		return fmt.Errorf(
+			"%s: expected a %s state; got \"%s\"", state.accumulatorName, state.kind, serialized,
+		)
+	}
+	err := state.mergePayload(payload)
+	if err != nil {
+		return fmt.Errorf("%s: invalid %s state \"%s\": %v", state.accumulatorName, kind, serialized, err)
+	}
+	return nil
+}
+
+func (state *StatsState) mergePayload(payload string) error {
+	switch state.kind {
+
+	case statsStateKindCount:
+		count, err := strconv.ParseInt(payload, 10, 64)
+		if err != nil {
+			return err
+		}
+		state.count += count
+
+	case statsStateKindMoments:
+		fields := strings.Split(payload, ";")
+		if len(fields) < 1+len(state.sums) {
+			return fmt.Errorf("need %d sums; got %d", len(state.sums), len(fields)-1)
+		}
+		count, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return err
+		}
+		sums := make([]*mlrval.Mlrval, len(state.sums))
+		for i := range sums {
+			sums[i] = mlrval.FromInferredType(fields[i+1])
+			if !sums[i].IsNumeric() {
+				return fmt.Errorf("sum \"%s\" is not a number", fields[i+1])
+			}
+		}
+		state.count += count
+		for i := range sums {
+			state.sums[i] = BIF_plus_binary(state.sums[i], sums[i])
+		}
+
+	case statsStateKindMin, statsStateKindMax:
+		if payload != "" {
+			state.mergeExtreme(mlrval.FromInferredType(payload))
+		}
+
+	case statsStateKindCounts:
+		bytes, err := base64.RawURLEncoding.DecodeString(payload)
+		if err != nil {
+			return err
+		}
+		var counts []statsStateCount
+		err = json.Unmarshal(bytes, &counts)
+		if err != nil {
+			return err
+		}
+		for _, count := range counts {
+			state.addCount(count.Value, count.Count)
+		}
+
+	case statsStateKindTDigest:
+		if payload == "" {
+			return nil
+		}
+		bytes, err := base64.RawURLEncoding.DecodeString(payload)
+		if err != nil {
+			return err
+		}
+		tdigest := &lib.TDigest{}
+		err = tdigest.UnmarshalBinary(bytes)
+		if err != nil {
+			return err
+		}
+		if state.tdigest == nil {
+			state.tdigest = tdigest
+		} else {
+			state.tdigest.Merge(tdigest)
+		}
+
+	case statsStateKindHLL:
+		if payload == "" {
+			return nil
+		}
+		bytes, err := base64.RawURLEncoding.DecodeString(payload)
+		if err != nil {
+			return err
+		}
+		hll := &lib.HyperLogLog{}
+		err = hll.UnmarshalBinary(bytes)
+		if err != nil {
+			return err
+		}
+		if state.hll == nil {
+			state.hll = hll
+		} else {
+			return state.hll.Merge(hll)
+		}
+	}
+
+	return nil
+}
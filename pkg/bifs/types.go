@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"time"
 
 	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
@@ -36,6 +37,18 @@ func float_to_int(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromInt(int64(input1.AcquireFloatValue()))
 }
 
+// Decimals truncate toward zero, as floats do. Integer parts too large for 64
+// bits stay as decimals.
+func decimal_to_int(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	d := input1.AcquireDecimalValue()
+	i, ok := d.Int64()
+	if ok {
+		return mlrval.FromInt(i)
+	} else {
+		return mlrval.FromDecimal(d.Trunc())
+	}
+}
+
 func bool_to_int(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	if input1.AcquireBoolValue() == true {
 		return mlrval.FromInt(1)
@@ -44,6 +57,15 @@ func bool_to_int(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	}
 }
 
+// Times are seconds since the epoch, and durations are seconds.
+func time_to_int(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromInt(input1.AcquireTimeValue().Unix())
+}
+
+func duration_to_int(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromInt(int64(input1.AcquireDurationValue() / time.Second))
+}
+
 func to_int_te(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorUnary("int", input1)
 }
@@ -60,6 +82,9 @@ var to_int_dispositions = [mlrval.MT_DIM]UnaryFunc{
 	/*ERROR  */ to_int_te,
 	/*NULL   */ _null1,
 	/*ABSENT */ _absn1,
+	/*TIME   */ time_to_int,
+	/*DURATION*/ duration_to_int,
+	/*DECIMAL*/ decimal_to_int,
 }
 
 func BIF_int(input1 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -91,6 +116,10 @@ func float_to_int_with_base(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromInt(int64(input1.AcquireFloatValue()))
 }
 
+func decimal_to_int_with_base(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return decimal_to_int(input1)
+}
+
 func bool_to_int_with_base(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	if input1.AcquireBoolValue() == true {
 		return mlrval.FromInt(1)
@@ -115,6 +144,9 @@ var to_int_with_base_dispositions = [mlrval.MT_DIM]BinaryFunc{
 	/*ERROR  */ to_int_with_base_te,
 	/*NULL   */ _null,
 	/*ABSENT */ _absn,
+	/*TIME   */ to_int_with_base_te,
+	/*DURATION*/ to_int_with_base_te,
+	/*DECIMAL*/ decimal_to_int_with_base,
 }
 
 func BIF_int_with_base(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -145,6 +177,10 @@ func int_to_float(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(float64(input1.AcquireIntValue()))
 }
 
+func decimal_to_float(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromFloat(input1.AcquireDecimalValue().Float64())
+}
+
 func bool_to_float(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	if input1.AcquireBoolValue() == true {
 		return mlrval.FromFloat(1.0)
@@ -153,6 +189,14 @@ func bool_to_float(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	}
 }
 
+func time_to_float(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromFloat(float64(input1.AcquireTimeValue().UnixNano()) / 1.0e9)
+}
+
+func duration_to_float(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromFloat(input1.AcquireDurationValue().Seconds())
+}
+
 func to_float_te(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorUnary("float", input1)
 }
@@ -169,12 +213,80 @@ var to_float_dispositions = [mlrval.MT_DIM]UnaryFunc{
 	/*ERROR  */ to_float_te,
 	/*NULL   */ _null1,
 	/*ABSENT */ _absn1,
+	/*TIME   */ time_to_float,
+	/*DURATION*/ duration_to_float,
+	/*DECIMAL*/ decimal_to_float,
 }
 
 func BIF_float(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return to_float_dispositions[input1.Type()](input1)
 }
 
+// ----------------------------------------------------------------
+func string_to_decimal(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	d, ok := lib.TryDecimalFromString(input1.AcquireStringValue())
+	if ok {
+		return mlrval.FromDecimal(d)
+	} else {
+		return mlrval.FromError(
+			fmt.Errorf(
+				"%s: unacceptable value %s with type %s",
+				"decimal",
+				input1.StringMaybeQuoted(),
+				input1.GetTypeName(),
+			),
+		)
+	}
+}
+
+func int_to_decimal(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromDecimal(lib.NewDecimalFromInt64(input1.AcquireIntValue()))
+}
+
+// Floats become their shortest decimal representation, e.g. 0.1 rather than
+// 0.1000000000000000055511151231257827.
+func float_to_decimal(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	d, ok := lib.NewDecimalFromFloat64(input1.AcquireFloatValue())
+	if ok {
+		return mlrval.FromDecimal(d)
+	} else {
+		return to_decimal_te(input1)
+	}
+}
+
+func bool_to_decimal(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.AcquireBoolValue() == true {
+		return mlrval.FromDecimal(lib.NewDecimalFromInt64(1))
+	} else {
+		return mlrval.FromDecimal(lib.NewDecimalFromInt64(0))
+	}
+}
+
+func to_decimal_te(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromTypeErrorUnary("decimal", input1)
+}
+
+var to_decimal_dispositions = [mlrval.MT_DIM]UnaryFunc{
+	/*INT    */ int_to_decimal,
+	/*FLOAT  */ float_to_decimal,
+	/*BOOL   */ bool_to_decimal,
+	/*VOID   */ _void1,
+	/*STRING */ string_to_decimal,
+	/*ARRAY  */ to_decimal_te,
+	/*MAP    */ to_decimal_te,
+	/*FUNC   */ to_decimal_te,
+	/*ERROR  */ to_decimal_te,
+	/*NULL   */ _null1,
+	/*ABSENT */ _absn1,
+	/*TIME   */ to_decimal_te,
+	/*DURATION*/ to_decimal_te,
+	/*DECIMAL*/ _1u___,
+}
+
+func BIF_decimal(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return to_decimal_dispositions[input1.Type()](input1)
+}
+
 // ----------------------------------------------------------------
 func string_to_boolean(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	b, ok := lib.TryBoolFromBoolString(input1.AcquireStringValue())
@@ -200,6 +312,10 @@ func float_to_bool(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromBool(input1.AcquireFloatValue() != 0.0)
 }
 
+func decimal_to_bool(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.AcquireDecimalValue().Sign() != 0)
+}
+
 func to_boolean_te(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorUnary("boolean", input1)
 }
@@ -216,6 +332,9 @@ var to_boolean_dispositions = [mlrval.MT_DIM]UnaryFunc{
 	/*ERROR  */ to_boolean_te,
 	/*NULL   */ _null1,
 	/*ABSENT */ _absn1,
+	/*TIME   */ to_boolean_te,
+	/*DURATION*/ to_boolean_te,
+	/*DECIMAL*/ decimal_to_bool,
 }
 
 func BIF_boolean(input1 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -251,8 +370,14 @@ func BIF_is_empty(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 func BIF_is_emptymap(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromBool(input1.IsMap() && input1.AcquireMapValue().IsEmpty())
 }
+
+// Decimals are floats here, as they are for typedecls, so that is_float(0.5)
+// is true with mlr --decimal.
 func BIF_is_float(input1 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromBool(input1.IsFloat())
+	return mlrval.FromBool(input1.IsFloat() || input1.IsDecimal())
+}
+func BIF_is_decimal(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.IsDecimal())
 }
 func BIF_is_int(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromBool(input1.IsInt())
@@ -294,7 +419,7 @@ func BIF_is_null(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromBool(input1.IsAbsent() || input1.IsVoid() || input1.IsNull())
 }
 func BIF_is_numeric(input1 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromBool(input1.IsInt() || input1.IsFloat())
+	return mlrval.FromBool(input1.IsNumeric())
 }
 func BIF_is_present(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromBool(!input1.IsAbsent())
@@ -302,6 +427,12 @@ func BIF_is_present(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 func BIF_is_string(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromBool(input1.IsStringOrVoid())
 }
+func BIF_is_time(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.IsTime())
+}
+func BIF_is_duration(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromBool(input1.IsDuration())
+}
 func BIF_is_nan(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	fval, ok := input1.GetFloatValue()
 	if ok {
@@ -318,19 +449,24 @@ func assertingCommon(input1, check *mlrval.Mlrval, description string, context *
 		//fprintf(stderr, "%s: %s type-assertion failed at NR=%lld FNR=%lld FILENAME=%s\n",
 		//MLR_GLOBALS.bargv0, pstate->desc, pvars->pctx->nr, pvars->pctx->fnr, pvars->pctx->filename);
 		//exit(1);
-		fmt.Fprintf(
-			os.Stderr,
-			"mlr: %s type-assertion failed at NR=%d FNR=%d FILENAME=%s\n",
-			description,
-			context.NR,
-			context.FNR,
-			context.FILENAME,
-		)
+		fmt.Fprintf(os.Stderr, "mlr: %s\n", TypeAssertionFailureMessage(description, context))
 		os.Exit(1)
 	}
 	return input1
 }
 
+// TypeAssertionFailureMessage is also for type-assertions within try-blocks,
+// which raise an error rather than exiting.
+func TypeAssertionFailureMessage(description string, context *types.Context) string {
+	return fmt.Sprintf(
+		"%s type-assertion failed at NR=%d FNR=%d FILENAME=%s",
+		description,
+		context.NR,
+		context.FNR,
+		context.FILENAME,
+	)
+}
+
 func BIF_asserting_absent(input1 *mlrval.Mlrval, context *types.Context) *mlrval.Mlrval {
 	return assertingCommon(input1, BIF_is_absent(input1), "is_absent", context)
 }
@@ -352,6 +488,9 @@ func BIF_asserting_emptyMap(input1 *mlrval.Mlrval, context *types.Context) *mlrv
 func BIF_asserting_float(input1 *mlrval.Mlrval, context *types.Context) *mlrval.Mlrval {
 	return assertingCommon(input1, BIF_is_float(input1), "is_float", context)
 }
+func BIF_asserting_decimal(input1 *mlrval.Mlrval, context *types.Context) *mlrval.Mlrval {
+	return assertingCommon(input1, BIF_is_decimal(input1), "is_decimal", context)
+}
 func BIF_asserting_int(input1 *mlrval.Mlrval, context *types.Context) *mlrval.Mlrval {
 	return assertingCommon(input1, BIF_is_int(input1), "is_int", context)
 }
@@ -388,3 +527,9 @@ func BIF_asserting_present(input1 *mlrval.Mlrval, context *types.Context) *mlrva
 func BIF_asserting_string(input1 *mlrval.Mlrval, context *types.Context) *mlrval.Mlrval {
 	return assertingCommon(input1, BIF_is_string(input1), "is_string", context)
 }
+func BIF_asserting_time(input1 *mlrval.Mlrval, context *types.Context) *mlrval.Mlrval {
+	return assertingCommon(input1, BIF_is_time(input1), "is_time", context)
+}
+func BIF_asserting_duration(input1 *mlrval.Mlrval, context *types.Context) *mlrval.Mlrval {
+	return assertingCommon(input1, BIF_is_duration(input1), "is_duration", context)
+}
@@ -0,0 +1,138 @@
+package cli
+
+// ================================================================
+// Column specifications for fixed-width input and output, such as
+//
+//   --fixed-spec id:1-6,name:7-26,amount:27-38:right
+//
+// Columns are numbered from 1, and ranges are inclusive. Fields must be given
+// left to right and must not overlap, but there may be gaps between them. On
+// output, values are left-aligned unless ":right" is given, and gaps are
+// filled with spaces.
+// ================================================================
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type TFixedWidthField struct {
+	Name         string
+	Start        int // 1-up
+	End          int // 1-up, inclusive
+	RightAligned bool
+}
+
+func (field *TFixedWidthField) Width() int {
+	return field.End - field.Start + 1
+}
+
+// ParseFixedWidthSpec parses a comma-separated list of column specifications.
+func ParseFixedWidthSpec(spec string) ([]TFixedWidthField, error) {
+	fields := make([]TFixedWidthField, 0)
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		field, err := parseFixedWidthField(item)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	return checkFixedWidthFields(fields)
+}
+
+// ReadFixedWidthSpecFile reads column specifications from a file, one or more
+// per line as for ParseFixedWidthSpec. Blank lines and lines starting with #
+// are ignored.
+func ReadFixedWidthSpecFile(filename string) ([]TFixedWidthField, error) {
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]TFixedWidthField, 0)
+	for _, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, item := range strings.Split(line, ",") {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+			field, err := parseFixedWidthField(item)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", filename, err)
+			}
+			fields = append(fields, field)
+		}
+	}
+	fields, err = checkFixedWidthFields(fields)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return fields, nil
+}
+
+// parseFixedWidthField parses "name:start-end", "name:column", or either of
+// those followed by ":left" or ":right".
+func parseFixedWidthField(item string) (TFixedWidthField, error) {
+	field := TFixedWidthField{}
+
+	pieces := strings.Split(item, ":")
+	if len(pieces) < 2 || len(pieces) > 3 || pieces[0] == "" {
+		return field, fmt.Errorf("fixed-width field \"%s\" is not of the form name:start-end", item)
+	}
+	field.Name = pieces[0]
+
+	if len(pieces) == 3 {
+		switch pieces[2] {
+		case "left", "l":
+			field.RightAligned = false
+		case "right", "r":
+			field.RightAligned = true
+		default:
+			return field, fmt.Errorf(
+				"fixed-width field \"%s\": alignment must be left or right; got \"%s\"", item, pieces[2],
+			)
+		}
+	}
+
+	startString, endString, isRange := strings.Cut(pieces[1], "-")
+	start, err := strconv.Atoi(startString)
+	if err != nil || start < 1 {
+		return field, fmt.Errorf("fixed-width field \"%s\": start column must be a positive integer", item)
+	}
+	end := start
+	if isRange {
+		end, err = strconv.Atoi(endString)
+		if err != nil || end < start {
+			return field, fmt.Errorf(
+				"fixed-width field \"%s\": end column must be an integer at least the start column", item,
+			)
+		}
+	}
+	field.Start = start
+	field.End = end
+
+	return field, nil
+}
+
+func checkFixedWidthFields(fields []TFixedWidthField) ([]TFixedWidthField, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("fixed-width specification has no fields")
+	}
+	for i := 1; i < len(fields); i++ {
+		if fields[i].Start <= fields[i-1].End {
+			return nil, fmt.Errorf(
+				"fixed-width field \"%s\" overlaps or precedes field \"%s\"", fields[i].Name, fields[i-1].Name,
+			)
+		}
+	}
+	return fields, nil
+}
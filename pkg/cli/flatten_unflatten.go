@@ -33,6 +33,8 @@ package cli
 //   o They are nested on record-write
 //   o No action needs to be taken
 //
+// * YAML is handled the same as JSON throughout.
+//
 // * If input is JSON and output is non-JSON:
 //   o Records can be nested from record-read
 //   o They remain that way through the Miller record-processing stream
@@ -61,7 +63,7 @@ package cli
 func DecideFinalFlatten(writerOptions *TWriterOptions) bool {
 	ofmt := writerOptions.OutputFileFormat
 	if writerOptions.AutoFlatten {
-		if ofmt != "json" {
+		if !formatIsNestable(ofmt) {
 			return true
 		}
 	}
@@ -88,11 +90,17 @@ func DecideFinalUnflatten(
 	ofmt := options.WriterOptions.OutputFileFormat
 
 	if options.WriterOptions.AutoUnflatten {
-		if ifmt != "json" {
-			if ofmt == "json" {
+		if !formatIsNestable(ifmt) {
+			if formatIsNestable(ofmt) {
 				return true
 			}
 		}
 	}
 	return false
 }
+
+// formatIsNestable tells whether a file format can hold nested data. YAML is
+// handled the same as JSON here.
+func formatIsNestable(format string) bool {
+	return format == "json" || format == "yaml"
+}
@@ -104,7 +104,11 @@ var FLAG_TABLE = FlagTable{
 		&CSVTSVOnlyFlagSection,
 		&JSONOnlyFlagSection,
 		&PPRINTOnlyFlagSection,
+		&SQLiteOnlyFlagSection,
+		&FixedWidthOnlyFlagSection,
+		&XLSXOnlyFlagSection,
 		&CompressedDataFlagSection,
+		&CharacterEncodingFlagSection,
 		&CommentsInDataFlagSection,
 		&OutputColorizationFlagSection,
 		&FlattenUnflattenFlagSection,
@@ -420,7 +424,7 @@ var SeparatorFlagSection = FlagSection{
 // JSON-ONLY FLAGS
 
 func JSONOnlyPrintInfo() {
-	fmt.Println("These are flags which are applicable to JSON output format.")
+	fmt.Println("These are flags which are applicable to JSON format.")
 }
 
 func init() { JSONOnlyFlagSection.Sort() }
@@ -467,6 +471,17 @@ var JSONOnlyFlagSection = FlagSection{
 			},
 		},
 
+		{
+			name: "--json-records-path",
+			arg:  "{path}",
+			help: "For JSON input, read records from within a larger document, such as `.data.items[*]` for `{\"meta\": ..., \"data\": {\"items\": [...]}}`. Path elements are `.name` or `[\"name\"]` for an object key, `.*` for every value of an object, `[n]` for an array index (from 0), and `[*]` for every element of an array. A path ending in an array means its elements. The input is streamed, without reading the enclosing document into memory.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				options.ReaderOptions.JSONRecordsPath = args[*pargi+1]
+				*pargi += 2
+			},
+		},
+
 		{
 			name: "--jvquoteall",
 			help: "Force all JSON values -- recursively into lists and object -- to string.",
@@ -523,6 +538,202 @@ var PPRINTOnlyFlagSection = FlagSection{
 	},
 }
 
+// ================================================================
+// SQLITE-ONLY FLAGS
+
+func SQLiteOnlyPrintInfo() {
+	fmt.Println("These are flags which are applicable to SQLite format.")
+}
+
+func init() { SQLiteOnlyFlagSection.Sort() }
+
+var SQLiteOnlyFlagSection = FlagSection{
+	name:        "SQLite-only flags",
+	infoPrinter: SQLiteOnlyPrintInfo,
+	flags: []Flag{
+
+		{
+			name: "--table",
+			arg:  "{name}",
+			help: "Table to read for SQLite input, or to write for SQLite output. For input, this may be omitted if the database has only one table. For output, this defaults to `records`.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				options.ReaderOptions.SQLiteTableName = args[*pargi+1]
+				options.WriterOptions.SQLiteTableName = args[*pargi+1]
+				*pargi += 2
+			},
+		},
+
+		{
+			name: "--sqlite-output-file",
+			arg:  "{filename}",
+			help: "For SQLite output, write to the table in this database file -- creating the file and/or table as needed, else appending to the table -- rather than writing a new database to standard output.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				options.WriterOptions.SQLiteOutputFileName = args[*pargi+1]
+				*pargi += 2
+			},
+		},
+	},
+}
+
+// ================================================================
+// FIXED-WIDTH-ONLY FLAGS
+
+func FixedWidthOnlyPrintInfo() {
+	fmt.Print(`These are flags which are applicable to fixed-width format, where each field
+is in a given range of columns, such as in mainframe and bank extracts.
+
+A column specification is a comma-separated list of ` + "`name:start-end`" + `, where
+columns are numbered from 1 and the ranges are inclusive; ` + "`name:column`" + ` is for a
+single column. Fields must be given left to right and must not overlap, but there
+may be gaps between them. Append ` + "`:right`" + ` to right-align a field's values on
+output; the default is ` + "`:left`" + `.
+
+On input, leading and trailing spaces are removed from values, and fields past
+the end of a line are empty. On output, values are padded with spaces, or
+truncated, to the widths of their fields, and gaps are filled with spaces; fields
+not in the specification are not written. There is no header line.
+
+Example:
+
+    mlr --ifixed --ojson --fixed-spec id:1-6,name:7-26,amount:27-38 cat extract.txt
+    mlr --icsv --ofixed --fixed-spec id:1-6,name:7-26,amount:27-38:right cat data.csv
+`)
+}
+
+func init() { FixedWidthOnlyFlagSection.Sort() }
+
+var FixedWidthOnlyFlagSection = FlagSection{
+	name:        "Fixed-width-only flags",
+	infoPrinter: FixedWidthOnlyPrintInfo,
+	flags: []Flag{
+
+		{
+			name: "--fixed-spec",
+			arg:  "{spec}",
+			help: "Column specification for fixed-width input and output, such as `id:1-6,name:7-26,amount:27-38:right`.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				fields, err := ParseFixedWidthSpec(args[*pargi+1])
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "mlr: %s: %v.\n", args[*pargi], err)
+					os.Exit(1)
+				}
+				options.ReaderOptions.FixedWidthFields = fields
+				options.WriterOptions.FixedWidthFields = fields
+				*pargi += 2
+			},
+		},
+
+		{
+			name: "--fixed-spec-file",
+			arg:  "{filename}",
+			help: "Read the column specification for fixed-width input and output from a file, with one or more fields per line as for `--fixed-spec`. Blank lines and lines starting with `#` are ignored.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				fields, err := ReadFixedWidthSpecFile(args[*pargi+1])
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "mlr: %s: %v.\n", args[*pargi], err)
+					os.Exit(1)
+				}
+				options.ReaderOptions.FixedWidthFields = fields
+				options.WriterOptions.FixedWidthFields = fields
+				*pargi += 2
+			},
+		},
+	},
+}
+
+// ================================================================
+// XLSX-ONLY FLAGS
+
+func XLSXOnlyPrintInfo() {
+	fmt.Print(`These are flags which are applicable to XLSX (Excel workbook) format.
+
+On input, one sheet is read, with its header row giving the field names. Cells
+which are empty, or past the end of the header row, are as for CSV with
+` + "`--allow-ragged-csv-input`" + `. Numbers are ints or floats; text is type-inferred
+as for CSV, so numbers stored as text are numbers; booleans are booleans; and
+formulas are their values as of when the workbook was last saved. Date cells
+are read as Miller times, such as ` + "`2024-03-15`" + ` or ` + "`2024-03-15 09:30:00`" + `, which
+print the same way; see ` + "`--xlsx-dates`" + `.
+
+On output, there is one sheet per run of records with the same field names:
+whenever the field names change, as with CSV output, a new sheet is started.
+With ` + "`split`" + `, ` + "`--sheets`" + ` writes one sheet per group into a single workbook.
+Ints and floats are written as numbers, booleans as booleans, times as dates,
+and everything else as text. ` + "`--implicit-csv-header`" + ` and
+` + "`--headerless-csv-output`" + ` apply to XLSX as well.
+
+Examples:
+
+    mlr --ixlsx --ocsv --sheet Orders --header-row 3 cat report.xlsx
+    mlr --icsv --oxlsx sort -f region then put '$total = $price * $qty' data.csv > report.xlsx
+    mlr --icsv --from data.csv split --oxlsx --sheets -g region --prefix by-region
+`)
+}
+
+func init() { XLSXOnlyFlagSection.Sort() }
+
+var XLSXOnlyFlagSection = FlagSection{
+	name:        "XLSX-only flags",
+	infoPrinter: XLSXOnlyPrintInfo,
+	flags: []Flag{
+
+		{
+			name: "--sheet",
+			arg:  "{name or number}",
+			help: "For XLSX input, the sheet to read, by name, or by number counting from 1; the default is the first sheet. For XLSX output, the name of the first sheet; the default is `Sheet1`.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				options.ReaderOptions.XLSXSheet = args[*pargi+1]
+				options.WriterOptions.XLSXSheet = args[*pargi+1]
+				*pargi += 2
+			},
+		},
+
+		{
+			name: "--header-row",
+			arg:  "{n}",
+			help: "For XLSX input, the row number, counting from 1, of the header row. Rows above it are skipped. The default is the first non-empty row.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				headerRow, ok := lib.TryIntFromString(args[*pargi+1])
+				if !ok || headerRow < 1 {
+					fmt.Fprintf(os.Stderr, "mlr: %s: row number must be a positive integer; got \"%s\".\n",
+						args[*pargi], args[*pargi+1])
+					os.Exit(1)
+				}
+				options.ReaderOptions.XLSXHeaderRow = headerRow
+				*pargi += 2
+			},
+		},
+
+		{
+			name: "--xlsx-dates",
+			arg:  "{time, string, or number}",
+			help: "For XLSX input, how date cells are read: as Miller times (the default), as strings in the same format, or as the numbers of days since 1900 which Excel stores them as.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				switch args[*pargi+1] {
+				case "time":
+					options.ReaderOptions.XLSXDates = XLSXDatesAsTimes
+				case "string":
+					options.ReaderOptions.XLSXDates = XLSXDatesAsStrings
+				case "number":
+					options.ReaderOptions.XLSXDates = XLSXDatesAsNumbers
+				default:
+					fmt.Fprintf(os.Stderr, "mlr: %s: expected time, string, or number; got \"%s\".\n",
+						args[*pargi], args[*pargi+1])
+					os.Exit(1)
+				}
+				*pargi += 2
+			},
+		},
+	},
+}
+
 // ================================================================
 // LEGACY FLAGS
 
@@ -642,6 +853,15 @@ var FileFormatFlagSection = FlagSection{
 	infoPrinter: FileFormatPrintInfo,
 	flags: []Flag{
 
+		{
+			name: "--iarrow",
+			help: "Use Arrow IPC format for input data. Both the streaming format and the file (Feather V2) format are accepted.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "arrow"
+				*pargi += 1
+			},
+		},
+
 		{
 			name: "--icsv",
 			help: "Use CSV format for input data.",
@@ -716,6 +936,15 @@ var FileFormatFlagSection = FlagSection{
 			},
 		},
 
+		{
+			name: "--ifixed",
+			help: "Use fixed-width format for input data. Requires `--fixed-spec` or `--fixed-spec-file`.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "fixed"
+				*pargi += 1
+			},
+		},
+
 		{
 			name: "--ijson",
 			help: "Use JSON format for input data.",
@@ -752,6 +981,42 @@ var FileFormatFlagSection = FlagSection{
 			},
 		},
 
+		{
+			name: "--ixlsx",
+			help: "Use XLSX (Excel workbook) format for input data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "xlsx"
+				*pargi += 1
+			},
+		},
+
+		{
+			name: "--iyaml",
+			help: "Use YAML format for input data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "yaml"
+				*pargi += 1
+			},
+		},
+
+		{
+			name: "--iparquet",
+			help: "Use Parquet format for input data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "parquet"
+				*pargi += 1
+			},
+		},
+
+		{
+			name: "--isqlite",
+			help: "Use SQLite format for input data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "sqlite"
+				*pargi += 1
+			},
+		},
+
 		{
 			name: "--ipprint",
 			help: "Use PPRINT format for input data.",
@@ -841,6 +1106,15 @@ var FileFormatFlagSection = FlagSection{
 			},
 		},
 
+		{
+			name: "--oarrow",
+			help: "Use Arrow IPC streaming format for output data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.WriterOptions.OutputFileFormat = "arrow"
+				*pargi += 1
+			},
+		},
+
 		{
 			name: "--ocsv",
 			help: "Use CSV format for output data.",
@@ -938,6 +1212,15 @@ var FileFormatFlagSection = FlagSection{
 			},
 		},
 
+		{
+			name: "--ofixed",
+			help: "Use fixed-width format for output data. Requires `--fixed-spec` or `--fixed-spec-file`.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.WriterOptions.OutputFileFormat = "fixed"
+				*pargi += 1
+			},
+		},
+
 		{
 			name: "--ojson",
 			help: "Use JSON format for output data.",
@@ -980,6 +1263,42 @@ var FileFormatFlagSection = FlagSection{
 			},
 		},
 
+		{
+			name: "--oxlsx",
+			help: "Use XLSX (Excel workbook) format for output data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.WriterOptions.OutputFileFormat = "xlsx"
+				*pargi += 1
+			},
+		},
+
+		{
+			name: "--oyaml",
+			help: "Use YAML format for output data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.WriterOptions.OutputFileFormat = "yaml"
+				*pargi += 1
+			},
+		},
+
+		{
+			name: "--oparquet",
+			help: "Use Parquet format for output data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.WriterOptions.OutputFileFormat = "parquet"
+				*pargi += 1
+			},
+		},
+
+		{
+			name: "--osqlite",
+			help: "Use SQLite format for output data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.WriterOptions.OutputFileFormat = "sqlite"
+				*pargi += 1
+			},
+		},
+
 		{
 			name: "--opprint",
 			help: "Use PPRINT format for output data.",
@@ -1006,6 +1325,16 @@ var FileFormatFlagSection = FlagSection{
 			},
 		},
 
+		{
+			name: "--arrow",
+			help: "Use Arrow IPC format for input and output data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "arrow"
+				options.WriterOptions.OutputFileFormat = "arrow"
+				*pargi += 1
+			},
+		},
+
 		{
 			name:     "--csv",
 			help:     "Use CSV format for input and output data.",
@@ -1127,6 +1456,16 @@ var FileFormatFlagSection = FlagSection{
 			},
 		},
 
+		{
+			name: "--fixed",
+			help: "Use fixed-width format for input and output data. Requires `--fixed-spec` or `--fixed-spec-file`.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "fixed"
+				options.WriterOptions.OutputFileFormat = "fixed"
+				*pargi += 1
+			},
+		},
+
 		{
 			name: "--nidx",
 			help: "Use NIDX format for input and output data.",
@@ -1147,6 +1486,26 @@ var FileFormatFlagSection = FlagSection{
 			},
 		},
 
+		{
+			name: "--xlsx",
+			help: "Use XLSX (Excel workbook) format for input and output data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "xlsx"
+				options.WriterOptions.OutputFileFormat = "xlsx"
+				*pargi += 1
+			},
+		},
+
+		{
+			name: "--yaml",
+			help: "Use YAML format for input and output data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "yaml"
+				options.WriterOptions.OutputFileFormat = "yaml"
+				*pargi += 1
+			},
+		},
+
 		{
 			name: "--xvright",
 			help: "Right-justify values for XTAB format.",
@@ -1156,6 +1515,26 @@ var FileFormatFlagSection = FlagSection{
 			},
 		},
 
+		{
+			name: "--parquet",
+			help: "Use Parquet format for input and output data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "parquet"
+				options.WriterOptions.OutputFileFormat = "parquet"
+				*pargi += 1
+			},
+		},
+
+		{
+			name: "--sqlite",
+			help: "Use SQLite format for input and output data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "sqlite"
+				options.WriterOptions.OutputFileFormat = "sqlite"
+				*pargi += 1
+			},
+		},
+
 		{
 			name: "--pprint",
 			help: "Use PPRINT format for input and output data.",
@@ -1175,20 +1554,21 @@ var FileFormatFlagSection = FlagSection{
 
 func FormatConversionKeystrokeSaverPrintInfo() {
 	fmt.Println(`As keystroke-savers for format-conversion you may use the following.
-The letters c, t, j, l, d, n, x, p, and m refer to formats CSV, TSV, DKVP, NIDX,
-JSON, JSON Lines, XTAB, PPRINT, and markdown, respectively.
-
-| In\out   | CSV   | TSV   | JSON   | JSONL  | DKVP   | NIDX   | XTAB   | PPRINT | Markdown |
-+----------+-------+-------+--------+--------+--------+--------+--------+--------+----------|
-| CSV      |       | --c2t | --c2j  | --c2l  | --c2d  | --c2n  | --c2x  | --c2p  | --c2m    |
-| TSV      | --t2c |       | --t2j  | --t2l  | --t2d  | --t2n  | --t2x  | --t2p  | --t2m    |
-| JSON     | --j2c | --j2t |        | --j2l  | --j2d  | --j2n  | --j2x  | --j2p  | --j2m    |
-| JSONL    | --l2c | --l2t |        |        | --l2d  | --l2n  | --l2x  | --l2p  | --l2m    |
-| DKVP     | --d2c | --d2t | --d2j  | --d2l  |        | --d2n  | --d2x  | --d2p  | --d2m    |
-| NIDX     | --n2c | --n2t | --n2j  | --n2l  | --n2d  |        | --n2x  | --n2p  | --n2m    |
-| XTAB     | --x2c | --x2t | --x2j  | --x2l  | --x2d  | --x2n  |        | --x2p  | --x2m    |
-| PPRINT   | --p2c | --p2t | --p2j  | --p2l  | --p2d  | --p2n  | --p2x  |        | --p2m    |
-| Markdown | --m2c | --m2t | --m2j  | --m2l  | --m2d  | --m2n  | --m2x  | --m2p  |          |`)
+The letters c, t, j, l, d, n, x, p, m, and q refer to formats CSV, TSV, DKVP, NIDX,
+JSON, JSON Lines, XTAB, PPRINT, markdown, and Parquet, respectively.
+
+| In\out   | CSV   | TSV   | JSON   | JSONL  | DKVP   | NIDX   | XTAB   | PPRINT | Markdown | Parquet |
++----------+-------+-------+--------+--------+--------+--------+--------+--------+----------+---------|
+| CSV      |       | --c2t | --c2j  | --c2l  | --c2d  | --c2n  | --c2x  | --c2p  | --c2m    | --c2q   |
+| TSV      | --t2c |       | --t2j  | --t2l  | --t2d  | --t2n  | --t2x  | --t2p  | --t2m    | --t2q   |
+| JSON     | --j2c | --j2t |        | --j2l  | --j2d  | --j2n  | --j2x  | --j2p  | --j2m    | --j2q   |
+| JSONL    | --l2c | --l2t |        |        | --l2d  | --l2n  | --l2x  | --l2p  | --l2m    | --l2q   |
+| DKVP     | --d2c | --d2t | --d2j  | --d2l  |        | --d2n  | --d2x  | --d2p  | --d2m    | --d2q   |
+| NIDX     | --n2c | --n2t | --n2j  | --n2l  | --n2d  |        | --n2x  | --n2p  | --n2m    | --n2q   |
+| XTAB     | --x2c | --x2t | --x2j  | --x2l  | --x2d  | --x2n  |        | --x2p  | --x2m    | --x2q   |
+| PPRINT   | --p2c | --p2t | --p2j  | --p2l  | --p2d  | --p2n  | --p2x  |        | --p2m    | --p2q   |
+| Markdown | --m2c | --m2t | --m2j  | --m2l  | --m2d  | --m2n  | --m2x  | --m2p  |          | --m2q   |
+| Parquet  | --q2c | --q2t | --q2j  | --q2l  | --q2d  | --q2n  | --q2x  | --q2p  | --q2m    |         |`)
 }
 
 func init() { FormatConversionKeystrokeSaverFlagSection.Sort() }
@@ -2355,6 +2735,243 @@ var FormatConversionKeystrokeSaverFlagSection = FlagSection{
 				*pargi += 1
 			},
 		},
+		{
+			name: "--c2q",
+			help: "Use CSV for input, Parquet for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "csv"
+				options.WriterOptions.OutputFileFormat = "parquet"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--t2q",
+			help: "Use TSV for input, Parquet for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "tsv"
+				options.WriterOptions.OutputFileFormat = "parquet"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--j2q",
+			help: "Use JSON for input, Parquet for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "json"
+				options.WriterOptions.OutputFileFormat = "parquet"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--l2q",
+			help: "Use JSON Lines for input, Parquet for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "json"
+				options.WriterOptions.OutputFileFormat = "parquet"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--d2q",
+			help: "Use DKVP for input, Parquet for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "dkvp"
+				options.WriterOptions.OutputFileFormat = "parquet"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--n2q",
+			help: "Use NIDX for input, Parquet for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "nidx"
+				options.WriterOptions.OutputFileFormat = "parquet"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--x2q",
+			help: "Use XTAB for input, Parquet for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "xtab"
+				options.WriterOptions.OutputFileFormat = "parquet"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--p2q",
+			help: "Use PPRINT for input, Parquet for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "pprint"
+				options.ReaderOptions.IFS = " "
+				options.ReaderOptions.ifsWasSpecified = true
+				options.WriterOptions.OutputFileFormat = "parquet"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--m2q",
+			help: "Use markdown-tabular for input, Parquet for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "markdown"
+				options.WriterOptions.OutputFileFormat = "parquet"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--q2c",
+			help: "Use Parquet for input, CSV for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "parquet"
+				options.WriterOptions.OutputFileFormat = "csv"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--q2t",
+			help: "Use Parquet for input, TSV for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "parquet"
+				options.WriterOptions.OutputFileFormat = "tsv"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--q2j",
+			help: "Use Parquet for input, JSON for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "parquet"
+				options.WriterOptions.OutputFileFormat = "json"
+				options.WriterOptions.WrapJSONOutputInOuterList = true
+				options.WriterOptions.JSONOutputMultiline = true
+				*pargi += 1
+			},
+		},
+		{
+			name: "--q2l",
+			help: "Use Parquet for input, JSON Lines for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "parquet"
+				options.WriterOptions.OutputFileFormat = "json"
+				options.WriterOptions.WrapJSONOutputInOuterList = false
+				options.WriterOptions.JSONOutputMultiline = false
+				*pargi += 1
+			},
+		},
+		{
+			name: "--q2d",
+			help: "Use Parquet for input, DKVP for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "parquet"
+				options.WriterOptions.OutputFileFormat = "dkvp"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--q2n",
+			help: "Use Parquet for input, NIDX for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "parquet"
+				options.WriterOptions.OutputFileFormat = "nidx"
+				options.WriterOptions.OFS = " "
+				options.WriterOptions.ofsWasSpecified = true
+				*pargi += 1
+			},
+		},
+		{
+			name: "--q2x",
+			help: "Use Parquet for input, XTAB for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "parquet"
+				options.WriterOptions.OutputFileFormat = "xtab"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--q2p",
+			help: "Use Parquet for input, PPRINT for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "parquet"
+				options.WriterOptions.OutputFileFormat = "pprint"
+				*pargi += 1
+			},
+		},
+		{
+			name: "--q2b",
+			help: "Use Parquet for input, PPRINT with `--barred` for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "parquet"
+				options.WriterOptions.OutputFileFormat = "pprint"
+				options.WriterOptions.BarredPprintOutput = true
+				*pargi += 1
+			},
+		},
+		{
+			name: "--q2m",
+			help: "Use Parquet for input, markdown-tabular for output.",
+			// For format-conversion keystroke-savers, a matrix is plenty -- we don't
+			// need to print a tedious 60-line list.
+			suppressFlagEnumeration: true,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = "parquet"
+				options.WriterOptions.OutputFileFormat = "markdown"
+				*pargi += 1
+			},
+		},
 	},
 }
 
@@ -2604,6 +3221,107 @@ var CompressedDataFlagSection = FlagSection{
 	},
 }
 
+// ================================================================
+// CHARACTER-ENCODING FLAGS
+
+func CharacterEncodingPrintInfo() {
+	fmt.Print(`Miller works in UTF-8 internally. Using these flags you can read data in other
+character sets, such as UTF-16 files exported from Windows programs, or Shift-JIS
+or Windows-1252 files, and write data in other character sets.
+
+Character-set names are as at https://encoding.spec.whatwg.org/#names-and-labels,
+such as ` + "`utf-16le`" + `, ` + "`shift_jis`" + `, ` + "`windows-1252`" + ` (also ` + "`cp1252`" + `), and ` + "`latin1`" + `, along
+with ` + "`utf-32`" + `, ` + "`utf-32le`" + `, and ` + "`utf-32be`" + `.
+
+Input which starts with a UTF-16 or UTF-32 byte-order mark is decoded accordingly
+even without ` + "`--iencoding`" + `. These flags apply to text formats, not to Arrow,
+Parquet, or SQLite.
+
+Examples:
+
+    mlr --icsv --ojson --iencoding utf-16 cat windows-export.csv
+    mlr --csv --iencoding shift_jis --oencoding utf-8 cat partner-data.csv
+    mlr --csv --oencoding cp1252 --encoding-errors replace cat myfile.csv
+`)
+}
+
+func init() { CharacterEncodingFlagSection.Sort() }
+
+var CharacterEncodingFlagSection = FlagSection{
+	name:        "Character-encoding flags",
+	infoPrinter: CharacterEncodingPrintInfo,
+	flags: []Flag{
+
+		{
+			name: "--iencoding",
+			arg:  "{name}",
+			help: "Character set of input data, such as `utf-16le` or `shift_jis`.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				options.ReaderOptions.InputCharset = checkCharsetName(args[*pargi], args[*pargi+1])
+				*pargi += 2
+			},
+		},
+
+		{
+			name: "--oencoding",
+			arg:  "{name}",
+			help: "Character set of output data, such as `utf-16le` or `windows-1252`.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				options.WriterOptions.OutputCharset = checkCharsetName(args[*pargi], args[*pargi+1])
+				*pargi += 2
+			},
+		},
+
+		{
+			name: "--encoding",
+			arg:  "{name}",
+			help: "Character set of both input and output data.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				charsetName := checkCharsetName(args[*pargi], args[*pargi+1])
+				options.ReaderOptions.InputCharset = charsetName
+				options.WriterOptions.OutputCharset = charsetName
+				*pargi += 2
+			},
+		},
+
+		{
+			name: "--encoding-errors",
+			arg:  "{strict or replace}",
+			help: "What to do with input which isn't valid in the input character set, and output characters which aren't in the output character set. With `strict`, the default, these are fatal errors. With `replace`, invalid input becomes U+FFFD, and unsupported output characters become the output character set's substitute character, usually ASCII SUB (0x1a).",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				var policy lib.TCharsetErrorPolicy
+				switch args[*pargi+1] {
+				case "strict":
+					policy = lib.CharsetErrorsStrict
+				case "replace":
+					policy = lib.CharsetErrorsReplace
+				default:
+					fmt.Fprintf(os.Stderr,
+						"mlr: --encoding-errors argument must be strict or replace; got \"%s\".\n",
+						args[*pargi+1])
+					os.Exit(1)
+				}
+				options.ReaderOptions.CharsetErrorPolicy = policy
+				options.WriterOptions.CharsetErrorPolicy = policy
+				*pargi += 2
+			},
+		},
+	},
+}
+
+func checkCharsetName(flagName string, charsetName string) string {
+	_, err := lib.LookupCharset(charsetName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "mlr: %s: %v.\n", flagName, err)
+		os.Exit(1)
+	}
+	return charsetName
+}
+
 // ================================================================
 // COMMENTS-IN-DATA FLAGS
 
@@ -3237,6 +3955,27 @@ on JSON input records, where duplicate keys always result in the last one's valu
 			},
 		},
 
+		{
+			name: "--workers",
+			arg:  "{n}",
+			help: "Run verbs which keep no state from one record to the next -- such as `cut`, `rename`, `sub`,\n" +
+				"and `put`/`filter` without begin/end blocks, out-of-stream variables, emit, tee, dump, or\n" +
+				"redirected output -- on `n` batches of records at a time, using multiple CPUs. Output order\n" +
+				"is the same as without this flag. Other verbs run as usual. Default 1.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				numWorkers, ok := lib.TryIntFromString(args[*pargi+1])
+				if !ok || numWorkers <= 0 {
+					fmt.Fprintf(os.Stderr,
+						"%s: --workers argument must be a positive integer; got \"%s\".\n",
+						"mlr", args[*pargi+1])
+					os.Exit(1)
+				}
+				options.NumWorkers = numWorkers
+				*pargi += 2
+			},
+		},
+
 		{
 			name: "--hash-records",
 			help: `This is an internal parameter which normally does not need to be modified.
@@ -3290,6 +4029,35 @@ Note that 00--07 etc scan as int; 08-09 scan as float.`,
 			},
 		},
 
+		{
+			name: "--decimal",
+			help: `Treat numbers with decimal points, like 0.1 or 12.50, in data files and in DSL expressions as
+arbitrary-precision decimals rather than floats. Sums, differences, and products of decimals are exact
+and keep scale, so 0.1 + 0.2 is 0.3 and 0.10 + 0.2 is 0.30; quotients are exact to 18 digits after the
+decimal point. Decimals with floats, e.g. from math-library functions, are floats. Also, integers which
+overflow 64 bits become decimals rather than floats. For these, typeof gives "decimal" and is_decimal
+is true; is_float and asserting_float accept them as floats, as do float type declarations.`,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				mlrval.SetDecimalMode()
+				*pargi += 1
+			},
+		},
+
+		{
+			name: "--types-file",
+			arg:  "{filename}",
+			help: `Set the types of the given fields as records are read, regardless of type inference -- for
+example, to keep zip codes like 01234 as strings. The file is JSON: either a map from field name to type,
+such as ` + "`" + `{"zip": "string", "amount": "float", "ts": "time(%Y-%m-%d)"}` + "`" + `, or a schema as for the
+` + "`validate`" + ` verb. Values which don't convert are an error. Please see ` + "`mlr cast --help`" + ` for more
+information.`,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				CheckArgCount(args, *pargi, argc, 2)
+				options.TypesFileName = args[*pargi+1]
+				*pargi += 2
+			},
+		},
+
 		{
 			name: "--fflush",
 			help: `Force buffered output to be written after every output record.
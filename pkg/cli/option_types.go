@@ -21,6 +21,16 @@ const (
 )
 const DEFAULT_COMMENT_STRING = "#"
 
+// How date cells in XLSX input are read: as Miller times, as strings, or as
+// the numbers Excel stores them as.
+type TXLSXDates int
+
+const (
+	XLSXDatesAsTimes TXLSXDates = iota
+	XLSXDatesAsStrings
+	XLSXDatesAsNumbers
+)
+
 const DEFAULT_GEN_FIELD_NAME = "i"
 const DEFAULT_GEN_START_AS_STRING = "1"
 const DEFAULT_GEN_STEP_AS_STRING = "1"
@@ -75,6 +85,31 @@ type TReaderOptions struct {
 	// For in-process gunzip/bunzip2/zcat (distinct from prepipe)
 	FileInputEncoding lib.TFileInputEncoding
 
+	// For --iencoding: the character set to decode input from, such as
+	// utf-16le or shift_jis. If empty, input is UTF-8 unless it starts with a
+	// UTF-16 or UTF-32 byte-order mark.
+	InputCharset       string
+	CharsetErrorPolicy lib.TCharsetErrorPolicy
+
+	// For SQLite input: the table to read. If empty, the database must have
+	// only one table.
+	SQLiteTableName string
+
+	// For JSON input: where the records are, such as .data.items[*], for
+	// records nested inside a larger document. If empty, the records are the
+	// top-level objects, or the elements of top-level arrays.
+	JSONRecordsPath string
+
+	// For fixed-width input: the column ranges of the fields.
+	FixedWidthFields []TFixedWidthField
+
+	// For XLSX input: the sheet to read, by name or one-up index, else the
+	// first; the one-up row number of the header row, else the first
+	// non-empty row; and how date cells are read.
+	XLSXSheet     string
+	XLSXHeaderRow int64
+	XLSXDates     TXLSXDates
+
 	// TODO: comment
 	RecordsPerBatch int64
 }
@@ -173,6 +208,22 @@ type TWriterOptions struct {
 
 	// Fatal the process when error data in a given record is about to be output.
 	FailOnDataError bool
+
+	// For SQLite output: the table to write, and the database file to append
+	// it to rather than writing a new database to stdout.
+	SQLiteTableName      string
+	SQLiteOutputFileName string
+
+	// For fixed-width output: the column ranges of the fields.
+	FixedWidthFields []TFixedWidthField
+
+	// For XLSX output: the name of the first sheet. If empty, it's Sheet1.
+	XLSXSheet string
+
+	// For --oencoding: the character set to encode output to. If empty,
+	// output is UTF-8.
+	OutputCharset      string
+	CharsetErrorPolicy lib.TCharsetErrorPolicy
 }
 
 // ----------------------------------------------------------------
@@ -197,6 +248,14 @@ type TOptions struct {
 	DoInPlace     bool // mlr -I
 	NoInput       bool // mlr -n
 
+	// For mlr --workers: how many batches of records at a time verbs without
+	// cross-record state are run on. 1 means one at a time, as usual.
+	NumWorkers int64
+
+	// For mlr --types-file: field types to cast to at the start of the
+	// then-chain, bypassing type inference.
+	TypesFileName string
+
 	HaveRandSeed bool
 	RandSeed     int64
 
@@ -212,6 +271,7 @@ func DefaultOptions() *TOptions {
 		FileNames:           make([]string, 0),
 		DSLPreloadFileNames: make([]string, 0),
 		NoInput:             false,
+		NumWorkers:          1,
 	}
 }
 
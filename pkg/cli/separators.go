@@ -82,53 +82,77 @@ var SEPARATOR_REGEX_NAMES_TO_VALUES = map[string]string{
 // E.g. if IFS isn't specified, it's space for NIDX and comma for DKVP, etc.
 
 var defaultFSes = map[string]string{
+	"arrow":    "N/A", // binary format
 	"gen":      ",",
 	"csv":      ",",
 	"csvlite":  ",",
 	"dkvp":     ",",
+	"fixed":    "N/A",
 	"json":     "N/A", // not alterable; not parameterizable in JSON format
 	"nidx":     " ",
 	"markdown": " ",
+	"parquet":  "N/A", // binary format
 	"pprint":   " ",
+	"sqlite":   "N/A",
 	"tsv":      "\t",
+	"xlsx":     "N/A",
 	"xtab":     "\n", // todo: windows-dependent ...
+	"yaml":     "N/A",
 }
 
 var defaultPSes = map[string]string{
+	"arrow":    "N/A",
 	"gen":      "N/A",
 	"csv":      "N/A",
 	"csvlite":  "N/A",
 	"dkvp":     "=",
+	"fixed":    "N/A",
 	"json":     "N/A", // not alterable; not parameterizable in JSON format
 	"markdown": "N/A",
 	"nidx":     "N/A",
+	"parquet":  "N/A",
 	"pprint":   "N/A",
+	"sqlite":   "N/A",
 	"tsv":      "N/A",
+	"xlsx":     "N/A",
 	"xtab":     " ",
+	"yaml":     "N/A",
 }
 
 var defaultRSes = map[string]string{
+	"arrow":    "N/A",
 	"gen":      "\n",
 	"csv":      "\n",
 	"csvlite":  "\n",
 	"dkvp":     "\n",
+	"fixed":    "\n",
 	"json":     "N/A", // not alterable; not parameterizable in JSON format
 	"markdown": "\n",
 	"nidx":     "\n",
+	"parquet":  "N/A",
 	"pprint":   "\n",
+	"sqlite":   "N/A",
 	"tsv":      "\n",
+	"xlsx":     "N/A",
 	"xtab":     "\n\n", // todo: maybe jettison the idea of this being alterable
+	"yaml":     "N/A",
 }
 
 var defaultAllowRepeatIFSes = map[string]bool{
+	"arrow":    false,
 	"gen":      false,
 	"csv":      false,
 	"csvlite":  false,
 	"dkvp":     false,
+	"fixed":    false,
 	"json":     false,
 	"markdown": false,
 	"nidx":     false,
+	"parquet":  false,
 	"pprint":   true,
+	"sqlite":   false,
 	"tsv":      false,
+	"xlsx":     false,
 	"xtab":     false,
+	"yaml":     false,
 }
@@ -329,6 +329,15 @@ func parseCommandLinePassTwo(
 		panic("mlr: internal coding error: terminal did not exit the process")
 	}
 
+	// E.g. mlr --types-file types.json: cast before any verbs see the records.
+	if options.TypesFileName != "" {
+		transformer, err := transformers.NewTransformerCastFromTypesFile(options.TypesFileName)
+		if err != nil {
+			return options, recordTransformers, err
+		}
+		recordTransformers = append(recordTransformers, transformer)
+	}
+
 	// Now process the verb-sequences from pass one, with options-struct set up
 	// and finalized.
 	for i, verbSequence := range verbSequences {
@@ -360,9 +369,21 @@ func parseCommandLinePassTwo(
 			ignoresInput = true
 		}
 
+		if options.NumWorkers > 1 && isParallelizable(transformerSetup, transformer) {
+			transformer = makeParallelTransformer(transformerSetup, transformer, verbSequence, options)
+		}
+
 		recordTransformers = append(recordTransformers, transformer)
 	}
 
+	// E.g. 'branch -n foo { ... } then merge -n foo'
+	err = transformers.LinkBranchNamedStreams(recordTransformers)
+	if err != nil {
+		// As with errors constructing the verbs themselves
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
 	if ignoresInput {
 		options.NoInput = true // e.g. then-chain begins with seqgen
 	}
@@ -405,3 +426,35 @@ func parseCommandLinePassTwo(
 
 	return options, recordTransformers, nil
 }
+
+// isParallelizable is for mlr --workers: see also aaa_parallel_transformer.go.
+func isParallelizable(
+	transformerSetup *transformers.TransformerSetup,
+	transformer transformers.IRecordTransformer,
+) bool {
+	if !transformerSetup.Parallelizable {
+		return false
+	}
+	if parallelizableTransformer, ok := transformer.(transformers.IParallelizableTransformer); ok {
+		return parallelizableTransformer.IsParallelizable()
+	}
+	return true
+}
+
+// makeParallelTransformer constructs the other instances of the verb from its
+// command-line flags, as was done for the first.
+func makeParallelTransformer(
+	transformerSetup *transformers.TransformerSetup,
+	transformer transformers.IRecordTransformer,
+	verbSequence []string,
+	options *cli.TOptions,
+) transformers.IRecordTransformer {
+	instances := make([]transformers.IRecordTransformer, options.NumWorkers)
+	instances[0] = transformer
+	for j := 1; j < len(instances); j++ {
+		argi := 0
+		instances[j] = transformerSetup.ParseCLIFunc(&argi, len(verbSequence), verbSequence, options, true)
+		lib.InternalCodingErrorIf(instances[j] == nil)
+	}
+	return transformers.NewParallelTransformer(instances)
+}
@@ -276,3 +276,16 @@ func NewASTToken(iliteral interface{}, iclonee interface{}) *token.Token {
 		Pos:  clonee.Pos,
 	}
 }
+
+// NewNamespacedNameToken joins the tokens for "geo" and "distance" into a
+// single "geo::distance" token, for callsites of functions and subroutines in
+// imported modules.
+func NewNamespacedNameToken(inamespace interface{}, iname interface{}) (*token.Token, error) {
+	namespaceToken := inamespace.(*token.Token)
+	nameToken := iname.(*token.Token)
+	return &token.Token{
+		Type: nameToken.Type,
+		Lit:  []byte(string(namespaceToken.Lit) + "::" + string(nameToken.Lit)),
+		Pos:  namespaceToken.Pos,
+	}, nil
+}
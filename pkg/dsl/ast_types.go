@@ -106,6 +106,12 @@ const (
 	NodeTypeIfChain              TNodeType = "if-chain"
 	NodeTypeIfItem               TNodeType = "if-item"
 	NodeTypeCondBlock            TNodeType = "cond block"
+	NodeTypeSwitchStatement      TNodeType = "switch statement"
+	NodeTypeSwitchCases          TNodeType = "switch cases"
+	NodeTypeSwitchCase           TNodeType = "switch case"
+	NodeTypeSwitchCaseValues     TNodeType = "switch case values"
+	NodeTypeTryCatchStatement    TNodeType = "try/catch statement"
+	NodeTypeImportStatement      TNodeType = "import statement"
 	NodeTypeWhileLoop            TNodeType = "while loop"
 	NodeTypeDoWhileLoop          TNodeType = "do-while`loop"
 	NodeTypeForLoopOneVariable   TNodeType = "single-variable for-loop"
@@ -8,19 +8,44 @@ package cst
 import (
 	"github.com/johnkerl/miller/v6/pkg/dsl"
 	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/parsing/token"
 	"github.com/johnkerl/miller/v6/pkg/runtime"
 )
 
 // ----------------------------------------------------------------
 func NewStatementBlockNode() *StatementBlockNode {
 	return &StatementBlockNode{
-		executables: make([]IExecutable, 0),
+		executables:     make([]IExecutable, 0),
+		statementTokens: make([]*token.Token, 0),
 	}
 }
 
 // ----------------------------------------------------------------
-func (node *StatementBlockNode) AppendStatementNode(executable IExecutable) {
+// The statement token is for runtime.State's StatementObserver, and may be
+// nil if the statement isn't to be observed.
+func (node *StatementBlockNode) AppendStatementNode(
+	executable IExecutable,
+	statementToken *token.Token,
+) {
 	node.executables = append(node.executables, executable)
+	node.statementTokens = append(node.statementTokens, statementToken)
+}
+
+// StatementToken finds the first token of a statement, for its location in
+// the DSL expression. For example, in '$y = f($x)' this is the '$y' rather
+// than the '=' at the root of the statement's AST.
+func StatementToken(astNode *dsl.ASTNode) *token.Token {
+	firstToken := astNode.Token
+	for _, astChild := range astNode.Children {
+		childToken := StatementToken(astChild)
+		if childToken == nil {
+			continue
+		}
+		if firstToken == nil || childToken.Pos.Offset < firstToken.Pos.Offset {
+			firstToken = childToken
+		}
+	}
+	return firstToken
 }
 
 // ----------------------------------------------------------------
@@ -88,7 +113,7 @@ func (root *RootNode) BuildStatementBlockNode(
 		if err != nil {
 			return nil, err
 		}
-		statementBlockNode.AppendStatementNode(statement)
+		statementBlockNode.AppendStatementNode(statement, StatementToken(astChild))
 	}
 	return statementBlockNode, nil
 }
@@ -97,11 +122,17 @@ func (root *RootNode) BuildStatementBlockNode(
 func (node *StatementBlockNode) Execute(state *runtime.State) (*BlockExitPayload, error) {
 	state.Stack.PushStackFrame()
 	defer state.Stack.PopStackFrame()
-	for _, statement := range node.executables {
+	for i, statement := range node.executables {
+		if state.StatementObserver != nil && node.statementTokens[i] != nil {
+			state.StatementObserver(node.statementTokens[i], state)
+		}
 		blockExitPayload, err := statement.Execute(state)
 		if err != nil {
 			return nil, err
 		}
+		if state.RaisedError != nil { // see trycatch.go
+			return nil, state.RaisedError
+		}
 		if blockExitPayload != nil {
 			return blockExitPayload, nil
 		}
@@ -121,11 +152,17 @@ func (node *StatementBlockNode) Execute(state *runtime.State) (*BlockExitPayload
 // own stack frame then the 'i=0' would be in an evanescent, isolated frame.
 
 func (node *StatementBlockNode) ExecuteFrameless(state *runtime.State) (*BlockExitPayload, error) {
-	for _, statement := range node.executables {
+	for i, statement := range node.executables {
+		if state.StatementObserver != nil && node.statementTokens[i] != nil {
+			state.StatementObserver(node.statementTokens[i], state)
+		}
 		blockExitPayload, err := statement.Execute(state)
 		if err != nil {
 			return nil, err
 		}
+		if state.RaisedError != nil { // see trycatch.go
+			return nil, state.RaisedError
+		}
 		if blockExitPayload != nil {
 			return blockExitPayload, nil
 		}
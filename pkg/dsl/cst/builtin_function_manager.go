@@ -1041,6 +1041,19 @@ is normally distributed.`,
 			},
 		},
 
+		{
+			name:               "approx_distinct_count",
+			class:              FUNC_CLASS_STATS,
+			help:               `Returns the approximate number of distinct values in an array or map, using bounded memory. Returns error for non-array/non-map types. Values are stringified for comparison, as with distinct_count. This uses HyperLogLog, the same as the stats1 verb's approx_distinct_count, with the "precision" option from 4 to 18: the relative error is about 1.04/sqrt(2^precision), using 2^precision bytes. The default is 14.`,
+			unaryFunc:          bifs.BIF_approx_distinct_count,
+			binaryFunc:         bifs.BIF_approx_distinct_count_with_options,
+			hasMultipleArities: true,
+			examples: []string{
+				`approx_distinct_count([7,8,9,7])  is 3`,
+				`approx_distinct_count(x, {"precision":16}) uses more memory than the default, for more accuracy`,
+			},
+		},
+
 		{
 			name:      "null_count",
 			class:     FUNC_CLASS_STATS,
@@ -1281,6 +1294,71 @@ is normally distributed.`,
 			},
 		},
 
+		{
+			name:               "approx_median",
+			class:              FUNC_CLASS_STATS,
+			help:               `Returns the approximate median of numeric values in an array or map, using bounded memory. Returns empty string AKA void for empty array/map; returns error for non-array/non-map types. Please see the approx_percentiles function for information on optional flags.`,
+			unaryFunc:          bifs.BIF_approx_median,
+			binaryFunc:         bifs.BIF_approx_median_with_options,
+			hasMultipleArities: true,
+			examples: []string{
+				`approx_median([3,4,5,6,9,10]) is 5.5`,
+			},
+		},
+
+		{
+			name:               "approx_percentile",
+			class:              FUNC_CLASS_STATS,
+			help:               `Returns the given approximate percentile of numeric values in an array or map, using bounded memory. Returns empty string AKA void for empty array/map; returns error for non-array/non-map types. Please see the approx_percentiles function for information on optional flags.`,
+			binaryFunc:         bifs.BIF_approx_percentile,
+			ternaryFunc:        bifs.BIF_approx_percentile_with_options,
+			hasMultipleArities: true,
+			examples: []string{
+				`approx_percentile([3,4,5,6,9,10], 25) is 4`,
+			},
+		},
+
+		{
+			name:               "approx_percentiles",
+			class:              FUNC_CLASS_STATS,
+			help:               `Returns the given approximate percentiles of numeric values in an array or map. Non-numeric values are ignored. Returns empty string AKA void for empty array/map; returns error for non-array/non-map types. This uses a t-digest, the same as the stats1 verb's approx_p50 etc., whose size is bounded by the "compression" option: higher is more accurate, using more memory. The default is 100. With fewer values than that, results are exact up to interpolation between adjacent values.`,
+			binaryFunc:         bifs.BIF_approx_percentiles,
+			ternaryFunc:        bifs.BIF_approx_percentiles_with_options,
+			hasMultipleArities: true,
+			examples: []string{
+				`approx_percentiles([3,4,5,6,9,10], [25,75]) is { "25": 4, "75": 9 }`,
+				`approx_percentiles([3,4,5,6,9,10], [25,75], {"output_array_not_map":true}) is [4, 9]`,
+				`approx_percentiles(x, [1,99], {"compression":200}) uses more memory than the default, for more accuracy`,
+			},
+		},
+
+		{
+			name:               "stats_state",
+			class:              FUNC_CLASS_STATS,
+			help:               `Returns the mergeable state of the given stats1 accumulator over the values in an array or map, as a string, for later use with stats_merge or stats1 --merge. This lets statistics computed over separate parts of the data be combined afterward. The accumulator names are as for stats1, except that median, percentiles, and mad are not mergeable; please use approx_median, approx_p25, etc. for those. Returns error for non-array/non-map types. Options are "compression" as for approx_percentiles and "precision" as for approx_distinct_count.`,
+			binaryFunc:         bifs.BIF_stats_state,
+			ternaryFunc:        bifs.BIF_stats_state_with_options,
+			hasMultipleArities: true,
+			examples: []string{
+				`stats_state([3,4,5], "mean") is "moments:3;12"`,
+				`stats_state([3,4,5], "var") is "moments:3;12;50"`,
+				`stats_state(x, "approx_p99", {"compression":200}) is a t-digest state`,
+			},
+		},
+
+		{
+			name:               "stats_merge",
+			class:              FUNC_CLASS_STATS,
+			help:               `Merges an array or map of states from stats_state or stats1 --emit-state, and returns the given accumulator's value over all the data they were computed from. Empty-string values are skipped. With the "output_state" option, returns the merged state instead, for further merging.`,
+			binaryFunc:         bifs.BIF_stats_merge,
+			ternaryFunc:        bifs.BIF_stats_merge_with_options,
+			hasMultipleArities: true,
+			examples: []string{
+				`stats_merge(["moments:3;12", "moments:2;20"], "mean") is 6.4`,
+				`stats_merge(["moments:3;12", "moments:2;20"], "sum", {"output_state":true}) is "moments:5;32"`,
+			},
+		},
+
 		{
 			name:      "sort_collection",
 			class:     FUNC_CLASS_STATS,
@@ -1343,7 +1421,7 @@ unless second argument is supplied.`,
 		{
 			name:  "sec2gmt",
 			class: FUNC_CLASS_TIME,
-			help: `Formats seconds since epoch as GMT timestamp. Leaves non-numbers as-is. With second integer
+			help: `Formats seconds since epoch as GMT timestamp. Formats time values as the instant they hold, and leaves other non-numbers as-is. With second integer
 argument n, includes n decimal places for the seconds part.`,
 			examples: []string{
 				`sec2gmt(1234567890)           = "2009-02-13T23:31:30Z"`,
@@ -1358,7 +1436,7 @@ argument n, includes n decimal places for the seconds part.`,
 		{
 			name:  "nsec2gmt",
 			class: FUNC_CLASS_TIME,
-			help: `Formats integer nanoseconds since epoch as GMT timestamp. Leaves non-numbers as-is. With second integer
+			help: `Formats integer nanoseconds since epoch as GMT timestamp. Formats time values as the instant they hold, and leaves other non-numbers as-is. With second integer
 argument n, includes n decimal places for the seconds part.`,
 			examples: []string{
 				`nsec2gmt(1234567890000000000)    = "2009-02-13T23:31:30Z"`,
@@ -1374,7 +1452,7 @@ argument n, includes n decimal places for the seconds part.`,
 			name:  "sec2localtime",
 			class: FUNC_CLASS_TIME,
 			help: `Formats seconds since epoch (integer part) as local timestamp.  Consults $TZ
-environment variable unless third argument is supplied. Leaves non-numbers as-is. With second integer argument n,
+environment variable unless third argument is supplied. Formats time values as the instant they hold, and leaves other non-numbers as-is. With second integer argument n,
 includes n decimal places for the seconds part`,
 			examples: []string{
 				`sec2localtime(1234567890)           = "2009-02-14 01:31:30"        with TZ="Asia/Istanbul"`,
@@ -1392,7 +1470,7 @@ includes n decimal places for the seconds part`,
 			name:  "nsec2localtime",
 			class: FUNC_CLASS_TIME,
 			help: `Formats integer nanoseconds since epoch as local timestamp.  Consults $TZ
-environment variable unless third argument is supplied. Leaves non-numbers as-is. With second integer argument n,
+environment variable unless third argument is supplied. Formats time values as the instant they hold, and leaves other non-numbers as-is. With second integer argument n,
 includes n decimal places for the seconds part`,
 			examples: []string{
 				`nsec2localtime(1234567890000000000)    = "2009-02-14 01:31:30"        with TZ="Asia/Istanbul"`,
@@ -1410,7 +1488,7 @@ includes n decimal places for the seconds part`,
 			name:  "sec2gmtdate",
 			class: FUNC_CLASS_TIME,
 			help: `Formats seconds since epoch (integer part) as GMT timestamp with year-month-date.
-Leaves non-numbers as-is.`,
+Formats time values as the instant they hold, and leaves other non-numbers as-is.`,
 			examples: []string{
 				`sec2gmtdate(1440768801.7) = "2015-08-28".`,
 			},
@@ -1421,7 +1499,7 @@ Leaves non-numbers as-is.`,
 			name:  "nsec2gmtdate",
 			class: FUNC_CLASS_TIME,
 			help: `Formats integer nanoseconds since epoch as GMT timestamp with year-month-date.
-Leaves non-numbers as-is.`,
+Formats time values as the instant they hold, and leaves other non-numbers as-is.`,
 			examples: []string{
 				`sec2gmtdate(1440768801700000000) = "2015-08-28".`,
 			},
@@ -1432,7 +1510,7 @@ Leaves non-numbers as-is.`,
 			name:  "sec2localdate",
 			class: FUNC_CLASS_TIME,
 			help: `Formats seconds since epoch (integer part) as local timestamp with year-month-date.
-Leaves non-numbers as-is. Consults $TZ environment variable unless second argument is supplied.`,
+Formats time values as the instant they hold, and leaves other non-numbers as-is. Consults $TZ environment variable unless second argument is supplied.`,
 			examples: []string{
 				`sec2localdate(1440768801.7) = "2015-08-28" with TZ="Asia/Istanbul"`,
 				`sec2localdate(1440768801.7, "Asia/Istanbul") = "2015-08-28"`,
@@ -1446,7 +1524,7 @@ Leaves non-numbers as-is. Consults $TZ environment variable unless second argume
 			name:  "nsec2localdate",
 			class: FUNC_CLASS_TIME,
 			help: `Formats integer nanoseconds since epoch as local timestamp with year-month-date.
-Leaves non-numbers as-is. Consults $TZ environment variable unless second argument is supplied.`,
+Formats time values as the instant they hold, and leaves other non-numbers as-is. Consults $TZ environment variable unless second argument is supplied.`,
 			examples: []string{
 				`nsec2localdate(1440768801700000000) = "2015-08-28" with TZ="Asia/Istanbul"`,
 				`nsec2localdate(1440768801700000000, "Asia/Istanbul") = "2015-08-28"`,
@@ -1604,6 +1682,80 @@ See also strftime_local.`,
 			hasMultipleArities: true,
 		},
 
+		{
+			name:  "time",
+			class: FUNC_CLASS_TIME,
+			help: `Makes a time value, with nanosecond precision and a time zone. With one argument, this is
+from int/float seconds since the epoch, or from an RFC 3339 timestamp. With two, the timestamp is
+parsed using a strptime format, and the time prints back in that format. The optional third
+argument is a time zone for timestamps without an offset. Subtracting times gives durations, and
+times plus or minus durations, or int/float seconds, are times. Times compare and sort
+chronologically. See also strftime, which also accepts times.`,
+			examples: []string{
+				`time(0) is 1970-01-01T00:00:00Z`,
+				`time("2023-01-02 03:04:05", "%Y-%m-%d %H:%M:%S") is 2023-01-02 03:04:05`,
+				`time("2023-01-02 03:04:05", "%Y-%m-%d %H:%M:%S") + 3600 is 2023-01-02 04:04:05`,
+				`time("2023-01-02", "%Y-%m-%d") - time("2023-01-01", "%Y-%m-%d") is 24h0m0s`,
+				`time("2023-01-02 03:04:05", "%Y-%m-%d %H:%M:%S", "Asia/Istanbul") is 2023-01-02 03:04:05`,
+			},
+			unaryFunc:          bifs.BIF_time_unary,
+			binaryFunc:         bifs.BIF_time_binary,
+			ternaryFunc:        bifs.BIF_time_ternary,
+			hasMultipleArities: true,
+		},
+
+		{
+			name:  "duration",
+			class: FUNC_CLASS_TIME,
+			help: `Makes a duration value, with nanosecond precision, from int/float seconds or from a string
+such as "1h30m" or "250ms". Durations print like the latter. They can be added and subtracted, with
+each other or with int/float seconds, and multiplied or divided by numbers; int and float give their
+seconds.`,
+			examples: []string{
+				`duration(90) is 1m30s`,
+				`duration("1h30m") * 2 is 3h0m0s`,
+				`duration("1h") + 90 is 1h1m30s`,
+				`float(duration("250ms")) is 0.25`,
+			},
+			unaryFunc: bifs.BIF_duration,
+		},
+
+		{
+			name:  "timefloor",
+			class: FUNC_CLASS_TIME,
+			help: `Rounds a time down to the start of its calendar bucket. The first argument is a time value,
+int/float seconds since the epoch, or an RFC 3339 timestamp, and the output is of the same kind.
+The second is the unit: second, minute, hour, day, week (ISO, starting on Monday), month, quarter,
+or year, optionally with a multiple such as "15minute" or "6hour". Seconds since the epoch are
+bucketed in UTC, and times and timestamps in their own time zone, unless the optional third
+argument gives a time zone. Days start at local midnight, and across daylight-saving changes the
+hours are those of the wall clock. See also timeceil and the timebucket verb.`,
+			examples: []string{
+				`timefloor(1500000000, "hour") is 1499997600`,
+				`timefloor("2023-05-17T13:47:12Z", "15minute") is "2023-05-17T13:45:00Z"`,
+				`timefloor("2023-05-17T13:45:00Z", "week") is "2023-05-15T00:00:00Z"`,
+				`timefloor("2023-05-17T13:45:00Z", "quarter", "America/New_York") is "2023-04-01T00:00:00-04:00"`,
+			},
+			binaryFunc:         bifs.BIF_timefloor_binary,
+			ternaryFunc:        bifs.BIF_timefloor_ternary,
+			hasMultipleArities: true,
+		},
+
+		{
+			name:  "timeceil",
+			class: FUNC_CLASS_TIME,
+			help: `As timefloor, but rounds a time up to the start of the next calendar bucket, unless it is
+already at the start of one.`,
+			examples: []string{
+				`timeceil(1500000000, "hour") is 1500001200`,
+				`timeceil("2023-05-17T13:45:00Z", "day") is "2023-05-18T00:00:00Z"`,
+				`timeceil("2023-05-17T00:00:00Z", "day") is "2023-05-17T00:00:00Z"`,
+			},
+			binaryFunc:         bifs.BIF_timeceil_binary,
+			ternaryFunc:        bifs.BIF_timeceil_ternary,
+			hasMultipleArities: true,
+		},
+
 		{
 			name:      "dhms2fsec",
 			class:     FUNC_CLASS_TIME,
@@ -1747,10 +1899,17 @@ See also strftime_local.`,
 			unaryFunc: bifs.BIF_is_error,
 		},
 
+		{
+			name:      "is_decimal",
+			class:     FUNC_CLASS_TYPING,
+			help:      "True if argument is a decimal, as with mlr --decimal or from the decimal function.",
+			unaryFunc: bifs.BIF_is_decimal,
+		},
+
 		{
 			name:      "is_float",
 			class:     FUNC_CLASS_TYPING,
-			help:      "True if field is present with value inferred to be float",
+			help:      "True if field is present with value inferred to be float, or decimal as with mlr --decimal",
 			unaryFunc: bifs.BIF_is_float,
 		},
 
@@ -1813,7 +1972,7 @@ See also strftime_local.`,
 		{
 			name:      "is_numeric",
 			class:     FUNC_CLASS_TYPING,
-			help:      "True if field is present with value inferred to be int or float",
+			help:      "True if field is present with value inferred to be int, float, or decimal",
 			unaryFunc: bifs.BIF_is_numeric,
 		},
 
@@ -1831,6 +1990,20 @@ See also strftime_local.`,
 			unaryFunc: bifs.BIF_is_string,
 		},
 
+		{
+			name:      "is_time",
+			class:     FUNC_CLASS_TYPING,
+			help:      "True if argument is a time, as from the time function.",
+			unaryFunc: bifs.BIF_is_time,
+		},
+
+		{
+			name:      "is_duration",
+			class:     FUNC_CLASS_TYPING,
+			help:      "True if argument is a duration, as from the duration function or from subtracting times.",
+			unaryFunc: bifs.BIF_is_duration,
+		},
+
 		{
 			name:  "is_nan",
 			class: FUNC_CLASS_TYPING,
@@ -1888,6 +2061,13 @@ Note that NaN has the property that NaN != NaN, so you need 'is_nan(x)' rather t
 			unaryFuncWithContext: bifs.BIF_asserting_emptyMap,
 		},
 
+		{
+			name:                 "asserting_decimal",
+			class:                FUNC_CLASS_TYPING,
+			help:                 `Aborts with an error if is_decimal on the argument returns false, else returns its argument.`,
+			unaryFuncWithContext: bifs.BIF_asserting_decimal,
+		},
+
 		{
 			name:                 "asserting_float",
 			class:                FUNC_CLASS_TYPING,
@@ -1972,6 +2152,20 @@ Note that NaN has the property that NaN != NaN, so you need 'is_nan(x)' rather t
 			unaryFuncWithContext: bifs.BIF_asserting_string,
 		},
 
+		{
+			name:                 "asserting_time",
+			class:                FUNC_CLASS_TYPING,
+			help:                 `Aborts with an error if is_time on the argument returns false, else returns its argument.`,
+			unaryFuncWithContext: bifs.BIF_asserting_time,
+		},
+
+		{
+			name:                 "asserting_duration",
+			class:                FUNC_CLASS_TYPING,
+			help:                 `Aborts with an error if is_duration on the argument returns false, else returns its argument.`,
+			unaryFuncWithContext: bifs.BIF_asserting_duration,
+		},
+
 		{
 			name:      "typeof",
 			class:     FUNC_CLASS_TYPING,
@@ -1989,6 +2183,18 @@ Note that NaN has the property that NaN != NaN, so you need 'is_nan(x)' rather t
 			unaryFunc: bifs.BIF_boolean,
 		},
 
+		{
+			name:  "decimal",
+			class: FUNC_CLASS_CONVERSION,
+			help: `Convert int/float/bool/string to arbitrary-precision decimal. Floats become their shortest decimal
+representation. With mlr --decimal, numbers with decimal points are decimals without needing this function.`,
+			unaryFunc: bifs.BIF_decimal,
+			examples: []string{
+				`decimal("0.1") + decimal("0.2") gives 0.3`,
+				`decimal(1.5) * 3 gives 4.5`,
+			},
+		},
+
 		{
 			name:      "float",
 			class:     FUNC_CLASS_CONVERSION,
@@ -6,11 +6,13 @@ package cst
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/johnkerl/miller/v6/pkg/bifs"
 	"github.com/johnkerl/miller/v6/pkg/dsl"
 	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/parsing/token"
 	"github.com/johnkerl/miller/v6/pkg/runtime"
 )
 
@@ -156,9 +158,19 @@ func (node *UnaryFunctionCallsiteNode) Evaluate(
 }
 
 // ----------------------------------------------------------------
+// UnaryFunctionWithContextCallsiteNode is for the asserting_* functions.
+// Within try-blocks, a failed type-assertion raises an error rather than
+// exiting; the check is done using the corresponding is_* function.
 type UnaryFunctionWithContextCallsiteNode struct {
 	unaryFuncWithContext bifs.UnaryFuncWithContext
 	evaluable1           IEvaluable
+	functionName         string
+	checkName            string
+	checkFunc            bifs.UnaryFunc
+	sourceToken          *token.Token
+	// As for ErrorRaisingCallsiteNode: asserting_error is passed error values
+	// on purpose.
+	handlesErrors bool
 }
 
 func (root *RootNode) BuildUnaryFunctionWithContextCallsiteNode(
@@ -182,16 +194,51 @@ func (root *RootNode) BuildUnaryFunctionWithContextCallsiteNode(
 		return nil, err
 	}
 
+	var checkFunc bifs.UnaryFunc = nil
+	checkName := "is_" + strings.TrimPrefix(builtinFunctionInfo.name, "asserting_")
+	checkInfo := BuiltinFunctionManagerInstance.LookUp(checkName)
+	if checkInfo != nil {
+		checkFunc = checkInfo.unaryFunc
+	}
+
 	return &UnaryFunctionWithContextCallsiteNode{
 		unaryFuncWithContext: builtinFunctionInfo.unaryFuncWithContext,
 		evaluable1:           evaluable1,
+		functionName:         builtinFunctionInfo.name,
+		checkName:            checkName,
+		checkFunc:            checkFunc,
+		sourceToken:          astNode.Token,
+		handlesErrors:        errorHandlingFunctionNames[builtinFunctionInfo.name],
 	}, nil
 }
 
 func (node *UnaryFunctionWithContextCallsiteNode) Evaluate(
 	state *runtime.State,
 ) *mlrval.Mlrval {
-	return node.unaryFuncWithContext(node.evaluable1.Evaluate(state), state.Context)
+	if state.TryDepth == 0 || node.checkFunc == nil {
+		return node.unaryFuncWithContext(node.evaluable1.Evaluate(state), state.Context)
+	}
+
+	var input1 *mlrval.Mlrval
+	if node.handlesErrors {
+		tryDepth := state.TryDepth
+		state.TryDepth = 0
+		input1 = node.evaluable1.Evaluate(state)
+		state.TryDepth = tryDepth
+	} else {
+		input1 = node.evaluable1.Evaluate(state)
+	}
+
+	if node.checkFunc(input1).IsFalse() {
+		message := bifs.TypeAssertionFailureMessage(node.checkName, state.Context)
+		raiseIfInTry(state, &RaisedError{
+			message:      message,
+			functionName: node.functionName,
+			sourceToken:  node.sourceToken,
+		})
+		return mlrval.FromErrorString(message)
+	}
+	return input1
 }
 
 // ----------------------------------------------------------------
@@ -0,0 +1,51 @@
+// ================================================================
+// This is for mlr --workers, which runs put/filter as several instances, each
+// on its own batches of records. That's only correct if the DSL expression
+// keeps no state from one record to the next, and if the instances don't share
+// anything else which would need coordinating, such as output files.
+// ================================================================
+
+package cst
+
+import (
+	"github.com/johnkerl/miller/v6/pkg/dsl"
+)
+
+// AST node types which mean the DSL expression keeps state across records, or
+// has side effects which can't be done independently by separate instances.
+var crossRecordStateNodeTypes = map[dsl.TNodeType]bool{
+	dsl.NodeTypeBeginBlock:          true,
+	dsl.NodeTypeEndBlock:            true,
+	dsl.NodeTypeDirectOosvarValue:   true,
+	dsl.NodeTypeIndirectOosvarValue: true,
+	dsl.NodeTypeFullOosvar:          true,
+	dsl.NodeTypeTeeStatement:        true,
+	dsl.NodeTypeEmit1Statement:      true,
+	dsl.NodeTypeEmitStatement:       true,
+	dsl.NodeTypeEmitPStatement:      true,
+	dsl.NodeTypeEmitFStatement:      true,
+	dsl.NodeTypeDumpStatement:       true,
+	dsl.NodeTypeEdumpStatement:      true,
+	dsl.NodeTypeRedirectWrite:       true,
+	dsl.NodeTypeRedirectAppend:      true,
+	dsl.NodeTypeRedirectPipe:        true,
+}
+
+// HasCrossRecordState is true if any of the DSL expressions ingested, or the
+// modules they import, have begin/end blocks, oosvars, emit/tee/dump
+// statements, or redirected output.
+func (root *RootNode) HasCrossRecordState() bool {
+	return root.hasCrossRecordState
+}
+
+func astHasCrossRecordState(astNode *dsl.ASTNode) bool {
+	if crossRecordStateNodeTypes[astNode.Type] {
+		return true
+	}
+	for _, astChild := range astNode.Children {
+		if astHasCrossRecordState(astChild) {
+			return true
+		}
+	}
+	return false
+}
@@ -172,8 +172,8 @@ func (node *DumpStatementNode) Execute(state *runtime.State) (*BlockExitPayload,
 		}
 	}
 	outputString := buffer.String()
-	node.dumpToRedirectFunc(outputString, state)
-	return nil, nil
+	err := node.dumpToRedirectFunc(outputString, state)
+	return nil, err
 }
 
 // ----------------------------------------------------------------
@@ -217,6 +217,5 @@ func (node *DumpStatementNode) dumpToFileOrPipe(
 	}
 	outputFileName := redirectorTarget.String()
 
-	node.outputHandlerManager.WriteString(outputString, outputFileName)
-	return nil
+	return node.outputHandlerManager.WriteString(outputString, outputFileName)
 }
@@ -34,6 +34,17 @@ func (root *RootNode) BuildFunctionCallsiteNode(astNode *dsl.ASTNode) (IEvaluabl
 
 	functionName := string(astNode.Token.Lit)
 
+	//  - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+	// Functions in imported modules, like 'geo::distance(...)'.
+
+	module, moduleFunctionName, err := root.splitModuleCallsiteName(astNode)
+	if err != nil {
+		return nil, err
+	}
+	if module != nil {
+		return root.BuildModuleFunctionCallsiteNode(astNode, module, moduleFunctionName)
+	}
+
 	//  - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
 	// Special-case the dot operator, which is:
 	// * string + string, with coercion to string if either side is int/float/bool/etc.;
@@ -44,7 +55,7 @@ func (root *RootNode) BuildFunctionCallsiteNode(astNode *dsl.ASTNode) (IEvaluabl
 		if err != nil {
 			return nil, err
 		}
-		return dotCallsiteNode, nil
+		return NewErrorRaisingCallsiteNode(dotCallsiteNode, astNode), nil
 	}
 
 	//  - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
@@ -55,7 +66,13 @@ func (root *RootNode) BuildFunctionCallsiteNode(astNode *dsl.ASTNode) (IEvaluabl
 		return nil, err
 	}
 	if builtinFunctionCallsiteNode != nil {
-		return builtinFunctionCallsiteNode, nil
+		// Type-assertions raise their own errors within try-blocks; for
+		// asserting_error, an error value is the success case.
+		if _, ok := builtinFunctionCallsiteNode.(*UnaryFunctionWithContextCallsiteNode); ok {
+			return builtinFunctionCallsiteNode, nil
+		}
+		// Error values returned within try-blocks are raised as errors.
+		return NewErrorRaisingCallsiteNode(builtinFunctionCallsiteNode, astNode), nil
 	}
 
 	//  - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
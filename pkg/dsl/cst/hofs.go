@@ -51,12 +51,10 @@ type tHOFSpace struct {
 	argsArray   []*mlrval.Mlrval
 }
 
-// hofCache is persistent data for the getHOFSpace cache-manager.
-var hofCache map[string]*tHOFSpace = make(map[string]*tHOFSpace)
-
 // getHOFSpace manages a cache for the data needed by higher-order functions.
 // Those functions may be invoked on every record of a big data file, so we try
-// to cache data they need for UDF-callsite setup.
+// to cache data they need for UDF-callsite setup. The cache is kept on the UDF
+// itself, so each copy of the CST has its own.
 func getHOFSpace(
 	funcVal *mlrval.Mlrval,
 	arity int,
@@ -77,38 +75,17 @@ func getHOFSpace(
 	// "function-literal-000052".
 	udfName := funcVal.String()
 
-	cacheKey := udfName + ":" + hofName + ":" + arrayOrMap
-
-	// Cache hit, but check arity. Example: someone makes a correct-arity
-	// callback for arrays, then re-uses it for maps where the arity needs to
-	// be different. E.g.  apply([...], f(e) {...}) vs apply({...}, f(k,v) {...})
-	entry := hofCache[cacheKey]
-	if entry != nil {
-		if entry.udfCallsite.arity != arity {
-			fmt.Fprintf(
-				os.Stderr,
-				"mlr: %s: argument function \"%s\" has arity %d; needed %d for %s.\n",
-				hofName,
-				udfName,
-				entry.udfCallsite.arity,
-				arity,
-				arrayOrMap,
-			)
-			os.Exit(1)
-		}
-		return entry
-	}
-
-	// Cache miss
-	var udf *UDF = nil
 	iUDF := funcVal.GetFunction()
 	if iUDF == nil { // E.g. does not exist at all
 		fmt.Fprintf(os.Stderr, "mlr: %s: argument function \"%s\" not found.\n", hofName, udfName)
 		os.Exit(1)
 	}
-	udf = iUDF.(*UDF)
+	udf := iUDF.(*UDF)
 
-	if udf.signature.arity != arity { // Present, but with the wrong arity.
+	// Check arity. Example: someone makes a correct-arity callback for arrays,
+	// then re-uses it for maps where the arity needs to be different. E.g.
+	// apply([...], f(e) {...}) vs apply({...}, f(k,v) {...})
+	if udf.signature.arity != arity {
 		fmt.Fprintf(
 			os.Stderr,
 			"mlr: %s: argument function \"%s\" has arity %d; needed %d for %s.\n",
@@ -121,6 +98,15 @@ func getHOFSpace(
 		os.Exit(1)
 	}
 
+	cacheKey := hofName + ":" + arrayOrMap
+
+	// Cache hit
+	entry := udf.hofSpaces[cacheKey]
+	if entry != nil {
+		return entry
+	}
+
+	// Cache miss
 	udfCallsite := NewUDFCallsiteForHigherOrderFunction(udf, arity)
 	argsArray := make([]*mlrval.Mlrval, arity)
 	entry = &tHOFSpace{
@@ -128,7 +114,10 @@ func getHOFSpace(
 		argsArray:   argsArray,
 	}
 	// Remember for subsequent cache hit.
-	hofCache[cacheKey] = entry
+	if udf.hofSpaces == nil {
+		udf.hofSpaces = make(map[string]*tHOFSpace)
+	}
+	udf.hofSpaces[cacheKey] = entry
 	return entry
 }
 
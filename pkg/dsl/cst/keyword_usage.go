@@ -18,11 +18,15 @@ type tKeywordUsageEntry struct {
 
 var KEYWORD_USAGE_TABLE = []tKeywordUsageEntry{
 	{"all", allKeywordUsage},
+	{"as", asKeywordUsage},
 	{"begin", beginKeywordUsage},
 	{"bool", boolKeywordUsage},
 	{"break", breakKeywordUsage},
 	{"call", callKeywordUsage},
+	{"case", caseKeywordUsage},
+	{"catch", catchKeywordUsage},
 	{"continue", continueKeywordUsage},
+	{"default", defaultKeywordUsage},
 	{"do", doKeywordUsage},
 	{"dump", dumpKeywordUsage},
 	{"edump", edumpKeywordUsage},
@@ -42,6 +46,7 @@ var KEYWORD_USAGE_TABLE = []tKeywordUsageEntry{
 	{"func", funcKeywordUsage},
 	{"funct", functKeywordUsage},
 	{"if", ifKeywordUsage},
+	{"import", importKeywordUsage},
 	{"in", inKeywordUsage},
 	{"int", intKeywordUsage},
 	{"map", mapKeywordUsage},
@@ -53,8 +58,10 @@ var KEYWORD_USAGE_TABLE = []tKeywordUsageEntry{
 	{"stdout", stdoutKeywordUsage},
 	{"str", strKeywordUsage},
 	{"subr", subrKeywordUsage},
+	{"switch", switchKeywordUsage},
 	{"tee", teeKeywordUsage},
 	{"true", trueKeywordUsage},
+	{"try", tryKeywordUsage},
 	{"unset", unsetKeywordUsage},
 	{"var", varKeywordUsage},
 	{"while", whileKeywordUsage},
@@ -139,6 +146,10 @@ func allKeywordUsage() {
 	)
 }
 
+func asKeywordUsage() {
+	fmt.Println(`used with "import": see "import".`)
+}
+
 func beginKeywordUsage() {
 	fmt.Println(
 		`defines a block of statements to be executed before input records
@@ -165,12 +176,35 @@ func callKeywordUsage() {
   Example: 'subr s(k,v) { print k . " is " . v} call s("a", $a)'`)
 }
 
+func caseKeywordUsage() {
+	fmt.Println(
+		`used within "switch" statements. A case matches if any of its comma-separated
+values equals the switch value, as with "==". With "case =~", the values are
+regexes, and captures are set as for the "=~" operator. The body statements
+must be wrapped in curly braces.
+
+  Example: 'switch ($x) { case 1, 2: { $y = "low" } case =~ "^a(.)": { $y = "\1" } }'`)
+}
+
+func catchKeywordUsage() {
+	fmt.Println(
+		`used with "try": see "try".`)
+}
+
 func continueKeywordUsage() {
 	fmt.Println(
 		`causes execution to skip the remaining statements in the body of
 the current for/while/do-while loop. For-loop increments are still applied.`)
 }
 
+func defaultKeywordUsage() {
+	fmt.Println(
+		`used within "switch" statements for the statements to run when no case
+matches. There may be at most one default, anywhere among the cases.
+
+  Example: 'switch ($x) { case 1: { $y = "one" } default: { $y = "other" } }'`)
+}
+
 func doKeywordUsage() {
 	fmt.Println(
 		`with "while", introduces a do-while loop. The body statements must be wrapped
@@ -416,6 +450,20 @@ func ifKeywordUsage() {
 in curly braces.`)
 }
 
+func importKeywordUsage() {
+	fmt.Println(
+		`imports the functions and subroutines defined in another DSL file, under a
+namespace. They're then called with the namespace as prefix, as in
+"geo::distance(...)" or "call geo::report(...)". Relative paths are looked for
+in the directory of the importing file -- for the main DSL expression, those of
+put/filter -f and --load files, then the current directory -- then in the
+directories listed in the MLR_PATH environment variable. Imported files may
+contain only func, subr, and import statements. Import statements must be at
+top level.
+
+  Example: 'import "lib/geo.mlr" as geo; $d = geo::distance($lat1, $lon1, $lat2, $lon2)'`)
+}
+
 func inKeywordUsage() {
 	fmt.Println(`used in for-loops over stream records or out-of-stream variables.`)
 }
@@ -487,6 +535,16 @@ func subrKeywordUsage() {
   Example: 'subr s(k,v) { print k . " is " . v} call s("a", $a)'`)
 }
 
+func switchKeywordUsage() {
+	fmt.Println(
+		`introduces a switch statement: the body of the first "case" matching the
+switch value is run, else the "default" body if any. There is no fall-through
+from one case to the next, and so no "break" is needed; "break" and "continue"
+within a switch apply to the enclosing for/while/do-while loop, if any.
+
+  Example: 'switch ($shape) { case "square", "circle": { $kind = "simple" } default: { $kind = "other" } }'`)
+}
+
 func teeKeywordUsage() {
 	fmt.Println(
 		`prints the current record to specified file.
@@ -519,6 +577,19 @@ func trueKeywordUsage() {
 	fmt.Println(`the boolean literal value.`)
 }
 
+func tryKeywordUsage() {
+	fmt.Println(
+		`introduces a try/catch statement. Runtime errors within the try-block,
+including builtin functions returning error values, are caught rather than
+ending processing: the statement raising the error runs to completion, the
+rest of the try-block is skipped, and the catch-block is run. The catch
+variable is a map with the error "message", the "function" which raised the
+error (empty if not raised by a function), and the "line" and "column" where it
+was raised. Both blocks must be wrapped in curly braces.
+
+  Example: 'try { $data = json_parse($payload) } catch (e) { $data_error = e["message"] }'`)
+}
+
 func unsetKeywordUsage() {
 	fmt.Println(
 		`clears field(s) from the current record, or an out-of-stream or local variable.
@@ -314,9 +314,25 @@ type IntLiteralNode struct {
 
 func (root *RootNode) BuildIntLiteralNode(literal string) *IntLiteralNode {
 	ival, ok := lib.TryIntFromString(literal)
+	if ok {
+		return &IntLiteralNode{
+			literal: mlrval.FromPrevalidatedIntString(literal, ival),
+		}
+	}
+	// Too large for 64 bits: a decimal with mlr --decimal, else a float as
+	// for int overflow in arithmetic.
+	if mlrval.IsDecimalMode() {
+		dval, ok := lib.TryDecimalFromString(literal)
+		if ok {
+			return &IntLiteralNode{
+				literal: mlrval.FromPrevalidatedDecimalString(literal, dval),
+			}
+		}
+	}
+	fval, ok := lib.TryFloatFromString(literal)
 	lib.InternalCodingErrorIf(!ok)
 	return &IntLiteralNode{
-		literal: mlrval.FromPrevalidatedIntString(literal, ival),
+		literal: mlrval.FromPrevalidatedFloatString(literal, fval),
 	}
 }
 func (node *IntLiteralNode) Evaluate(
@@ -331,6 +347,14 @@ type FloatLiteralNode struct {
 }
 
 func (root *RootNode) BuildFloatLiteralNode(literal string) *FloatLiteralNode {
+	if mlrval.IsDecimalMode() {
+		dval, ok := lib.TryDecimalFromString(literal)
+		if ok {
+			return &FloatLiteralNode{
+				literal: mlrval.FromPrevalidatedDecimalString(literal, dval),
+			}
+		}
+	}
 	fval, ok := lib.TryFloatFromString(literal)
 	lib.InternalCodingErrorIf(!ok)
 	return &FloatLiteralNode{
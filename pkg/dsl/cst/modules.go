@@ -0,0 +1,309 @@
+// ================================================================
+// This is for DSL modules: 'import "lib/geo.mlr" as geo;' and then callsites
+// like 'geo::distance(...)' or 'call geo::report(...)'.
+//
+// Each module is built into its own RootNode, with its own UDF and UDS
+// managers, so that function and subroutine names in different modules, and
+// in the main DSL expression, don't collide. Modules may contain only
+// function and subroutine definitions, and imports of other modules. Since
+// their functions are called with the namespace, they may have the same names
+// as built-in functions -- though within the module, the unqualified name is
+// still the built-in.
+//
+// Relative import paths are looked for in the directory of the importing
+// file, then in the directories listed in the MLR_PATH environment variable.
+// For the main DSL expression, the importing files are those given by put/filter
+// -f and by --load/--mload, or the current directory for put/filter -e. After
+// the importing file's own directory, the directories of all those files are
+// looked in, followed by the current directory.
+// ================================================================
+
+package cst
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/dsl"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+)
+
+const ENV_MLR_PATH = "MLR_PATH"
+
+const moduleNamespaceSeparator = "::"
+
+// tModuleCache is shared by a RootNode and all the modules it imports,
+// directly or indirectly, so that a module imported more than once -- perhaps
+// under different namespaces -- is built only once. It's keyed by absolute
+// path. A nil value means the module is still being built, which is how
+// import cycles are detected.
+type tModuleCache map[string]*RootNode
+
+// WithImportDirectories sets the directories in which relative import paths
+// are looked for, before those in MLR_PATH. The default is the current
+// directory.
+func (root *RootNode) WithImportDirectories(importDirectories []string) *RootNode {
+	root.importDirectories = importDirectories
+	return root
+}
+
+// ImportDirectoryOf is for files given to put/filter -f and --load/--mload:
+// modules imported from them are looked for in the file's directory. These
+// may also be directories of .mlr files.
+func ImportDirectoryOf(path string) string {
+	fileInfo, err := os.Stat(path)
+	if err == nil && fileInfo.IsDir() {
+		return path
+	}
+	return filepath.Dir(path)
+}
+
+// ----------------------------------------------------------------
+// Sample AST:
+
+// DSL EXPRESSION:
+// import "lib/geo.mlr" as geo; $d = geo::distance($a, $b)
+// AST:
+// * statement block
+//     * import statement "import"
+//         * string literal "lib/geo.mlr"
+//         * local variable "geo"
+//     * assignment "="
+//         * direct field value "d"
+//         * function callsite "geo::distance"
+//             * direct field value "a"
+//             * direct field value "b"
+
+// BuildAndInstallModule loads, builds, and installs the module for an import
+// statement.
+func (root *RootNode) BuildAndInstallModule(astNode *dsl.ASTNode) error {
+	lib.InternalCodingErrorIf(astNode.Type != dsl.NodeTypeImportStatement)
+	lib.InternalCodingErrorIf(len(astNode.Children) != 2)
+
+	importPath := string(astNode.Children[0].Token.Lit)
+	namespace := string(astNode.Children[1].Token.Lit)
+
+	if !root.allowUDFUDSRedefinitions {
+		if _, present := root.modules[namespace]; present {
+			return fmt.Errorf(
+				"mlr: module namespace \"%s\" has already been imported%s.",
+				namespace, dsl.TokenToLocationInfo(astNode.Token),
+			)
+		}
+	}
+
+	modulePath, err := root.findModule(importPath)
+	if err != nil {
+		return fmt.Errorf("mlr: %v%s.", err, dsl.TokenToLocationInfo(astNode.Token))
+	}
+
+	module, err := root.buildModule(modulePath)
+	if err != nil {
+		return err
+	}
+
+	root.modules[namespace] = module
+	if module.hasCrossRecordState {
+		root.hasCrossRecordState = true
+	}
+	return nil
+}
+
+// findModule returns the path of the module file for the import path.
+func (root *RootNode) findModule(importPath string) (string, error) {
+	if filepath.IsAbs(importPath) {
+		if isModuleFile(importPath) {
+			return importPath, nil
+		}
+		return "", fmt.Errorf("cannot find module \"%s\"", importPath)
+	}
+
+	searchDirectories := make([]string, 0, len(root.importDirectories)+1)
+	if root.importingDirectory != "" {
+		searchDirectories = append(searchDirectories, root.importingDirectory)
+	}
+	for _, importDirectory := range root.importDirectories {
+		if importDirectory != root.importingDirectory {
+			searchDirectories = append(searchDirectories, importDirectory)
+		}
+	}
+	if len(searchDirectories) == 0 {
+		searchDirectories = append(searchDirectories, ".")
+	}
+	mlrPath := os.Getenv(ENV_MLR_PATH)
+	if mlrPath != "" {
+		searchDirectories = append(searchDirectories, filepath.SplitList(mlrPath)...)
+	}
+
+	for _, searchDirectory := range searchDirectories {
+		candidatePath := filepath.Join(searchDirectory, importPath)
+		if isModuleFile(candidatePath) {
+			return candidatePath, nil
+		}
+	}
+
+	return "", fmt.Errorf(
+		"cannot find module \"%s\" in %s",
+		importPath, strings.Join(searchDirectories, ", "),
+	)
+}
+
+func isModuleFile(path string) bool {
+	fileInfo, err := os.Stat(path)
+	return err == nil && !fileInfo.IsDir()
+}
+
+// buildModule parses and builds the module file at the given path, or returns
+// it if it's already been built.
+func (root *RootNode) buildModule(modulePath string) (*RootNode, error) {
+	absolutePath, err := filepath.Abs(modulePath)
+	if err != nil {
+		return nil, fmt.Errorf("mlr: module %s: %v", modulePath, err)
+	}
+	module, present := root.moduleCache[absolutePath]
+	if present {
+		if module == nil {
+			return nil, fmt.Errorf("mlr: module %s imports itself, directly or indirectly.", modulePath)
+		}
+		return module, nil
+	}
+
+	root.moduleCache[absolutePath] = nil
+	module, err = root.loadModule(modulePath)
+	if err != nil {
+		delete(root.moduleCache, absolutePath)
+		return nil, err
+	}
+	root.moduleCache[absolutePath] = module
+	return module, nil
+}
+
+func (root *RootNode) loadModule(modulePath string) (*RootNode, error) {
+	dslString, err := lib.LoadStringFromFile(modulePath)
+	if err != nil {
+		return nil, fmt.Errorf("mlr: cannot load module %s: %v", modulePath, err)
+	}
+	ast, err := buildASTFromString(dslString)
+	if err != nil {
+		return nil, fmt.Errorf("mlr: cannot parse module %s:\n%v", modulePath, err)
+	}
+
+	for _, astChild := range ast.RootNode.Children {
+		if astChild.Type != dsl.NodeTypeNamedFunctionDefinition &&
+			astChild.Type != dsl.NodeTypeSubroutineDefinition &&
+			astChild.Type != dsl.NodeTypeImportStatement {
+			return nil, fmt.Errorf(
+				"mlr: module %s: only func, subr, and import statements are allowed at top level%s.",
+				modulePath, dsl.TokenToLocationInfo(astChild.Token),
+			)
+		}
+	}
+
+	module := NewEmptyRoot(root.recordWriterOptions, root.dslInstanceType).
+		WithStrictMode(root.strictMode).
+		WithImportDirectories([]string{filepath.Dir(modulePath)})
+	module.moduleCache = root.moduleCache
+	module.isModule = true
+	// Any tee/emit/print redirects within the module's functions and
+	// subroutines are closed at end of stream along with the importer's.
+	module.outputHandlerManagers = root.outputHandlerManagers
+
+	_, err = module.IngestAST(ast, false, false)
+	if err == nil {
+		err = module.Resolve()
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"mlr: module %s: %s", modulePath, strings.TrimPrefix(err.Error(), "mlr: "),
+		)
+	}
+	return module, nil
+}
+
+// ----------------------------------------------------------------
+// splitModuleCallsiteName splits callsite names like "geo::distance" into the
+// imported module and the function or subroutine name within it. The name is
+// returned empty if it isn't namespaced.
+func (root *RootNode) splitModuleCallsiteName(astNode *dsl.ASTNode) (*RootNode, string, error) {
+	callsiteName := string(astNode.Token.Lit)
+	namespace, name, isNamespaced := strings.Cut(callsiteName, moduleNamespaceSeparator)
+	if !isNamespaced {
+		return nil, "", nil
+	}
+	module := root.modules[namespace]
+	if module == nil {
+		return nil, "", fmt.Errorf(
+			"mlr: module namespace \"%s\" has not been imported, for %s%s.",
+			namespace, callsiteName, dsl.TokenToLocationInfo(astNode.Token),
+		)
+	}
+	return module, name, nil
+}
+
+// BuildModuleFunctionCallsiteNode is for callsites like 'geo::distance(...)'.
+// Since modules are fully built when imported, the function is resolved here
+// rather than in an after-pass.
+func (root *RootNode) BuildModuleFunctionCallsiteNode(
+	astNode *dsl.ASTNode,
+	module *RootNode,
+	functionName string,
+) (IEvaluable, error) {
+	callsiteArity := len(astNode.Children)
+	udf, err := module.udfManager.LookUp(functionName, callsiteArity)
+	if err != nil {
+		return nil, err
+	}
+	if udf == nil {
+		return nil, fmt.Errorf(
+			"mlr: function name not found: %s%s.",
+			string(astNode.Token.Lit), dsl.TokenToLocationInfo(astNode.Token),
+		)
+	}
+
+	argumentNodes, err := root.buildCallsiteArgumentNodes(astNode)
+	if err != nil {
+		return nil, err
+	}
+	return NewUDFCallsite(argumentNodes, udf), nil
+}
+
+// BuildModuleSubroutineCallsiteNode is for callsites like 'call geo::report(...)'.
+func (root *RootNode) BuildModuleSubroutineCallsiteNode(
+	astNode *dsl.ASTNode,
+	module *RootNode,
+	subroutineName string,
+) (IExecutable, error) {
+	callsiteArity := len(astNode.Children)
+	uds, err := module.udsManager.LookUp(subroutineName, callsiteArity)
+	if err != nil {
+		return nil, err
+	}
+	if uds == nil {
+		return nil, fmt.Errorf(
+			"mlr: subroutine name not found: %s%s.",
+			string(astNode.Token.Lit), dsl.TokenToLocationInfo(astNode.Token),
+		)
+	}
+
+	argumentNodes, err := root.buildCallsiteArgumentNodes(astNode)
+	if err != nil {
+		return nil, err
+	}
+	return NewUDSCallsite(argumentNodes, uds), nil
+}
+
+// The arguments are evaluated in the caller's scope, so they're built by the
+// importing RootNode.
+func (root *RootNode) buildCallsiteArgumentNodes(astNode *dsl.ASTNode) ([]IEvaluable, error) {
+	argumentNodes := make([]IEvaluable, len(astNode.Children))
+	for i, argumentASTNode := range astNode.Children {
+		argumentNode, err := root.BuildEvaluableNode(argumentASTNode)
+		if err != nil {
+			return nil, err
+		}
+		argumentNodes[i] = argumentNode
+	}
+	return argumentNodes, nil
+}
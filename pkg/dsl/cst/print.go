@@ -297,7 +297,7 @@ func (root *RootNode) buildPrintxStatementNode(
 // ----------------------------------------------------------------
 func (node *PrintStatementNode) Execute(state *runtime.State) (*BlockExitPayload, error) {
 	if len(node.expressionEvaluables) == 0 {
-		node.printToRedirectFunc(node.terminator, state)
+		return nil, node.printToRedirectFunc(node.terminator, state)
 	} else {
 		// 5x faster than fmt.Print() separately: note that os.Stdout is
 		// non-buffered in Go whereas stdout is buffered in C.
@@ -317,9 +317,8 @@ func (node *PrintStatementNode) Execute(state *runtime.State) (*BlockExitPayload
 			}
 		}
 		buffer.WriteString(node.terminator)
-		node.printToRedirectFunc(buffer.String(), state)
+		return nil, node.printToRedirectFunc(buffer.String(), state)
 	}
-	return nil, nil
 }
 
 // ----------------------------------------------------------------
@@ -360,6 +359,5 @@ func (node *PrintStatementNode) printToFileOrPipe(
 	}
 	outputFileName := redirectorTarget.String()
 
-	node.outputHandlerManager.WriteString(outputString, outputFileName)
-	return nil
+	return node.outputHandlerManager.WriteString(outputString, outputFileName)
 }
@@ -41,6 +41,9 @@ func NewEmptyRoot(
 		outputHandlerManagers:         list.New(),
 		recordWriterOptions:           recordWriterOptions,
 		dslInstanceType:               dslInstanceType,
+		importDirectories:             nil,
+		modules:                       make(map[string]*RootNode),
+		moduleCache:                   make(tModuleCache),
 	}
 }
 
@@ -69,8 +72,13 @@ type ASTBuildVisitorFunc func(dslString string, astNode *dsl.AST)
 // repl. The RootNode must be separately instantiated (e.g. NewEmptyRoot())
 // since the CST is partially reset on every line of input from the REPL
 // prompt.
+//
+// The dslImportDirectories are, for each DSL string, the directory where its
+// relative imports are looked for first -- e.g. that of its put -f file. It may
+// be nil, in which case only the root's import directories are used.
 func (root *RootNode) Build(
 	dslStrings []string,
+	dslImportDirectories []string,
 	dslInstanceType DSLInstanceType,
 	isReplImmediate bool,
 	doWarnings bool,
@@ -79,7 +87,7 @@ func (root *RootNode) Build(
 	hadWarnings = false
 	err = nil
 
-	for _, dslString := range dslStrings {
+	for i, dslString := range dslStrings {
 		astRootNode, err := buildASTFromStringWithMessage(dslString)
 		if err != nil {
 			// Error message already printed out
@@ -91,6 +99,10 @@ func (root *RootNode) Build(
 			astBuildVisitorFunc(dslString, astRootNode)
 		}
 
+		root.importingDirectory = ""
+		if dslImportDirectories != nil {
+			root.importingDirectory = dslImportDirectories[i]
+		}
 		hadWarnings, err = root.IngestAST(
 			astRootNode,
 			isReplImmediate,
@@ -193,6 +205,10 @@ func (root *RootNode) IngestAST(
 		return hadWarnings, err
 	}
 
+	if astHasCrossRecordState(ast.RootNode) {
+		root.hasCrossRecordState = true
+	}
+
 	return hadWarnings, nil
 }
 
@@ -270,6 +286,15 @@ func (root *RootNode) regexProtectPrePassAux(astNode *dsl.ASTNode) {
 				isCallsiteOfInterest = true
 			}
 		}
+	} else if astNode.Type == dsl.NodeTypeSwitchCase {
+		// All the values in 'case =~ "...", "...": { ... }' are regexes.
+		if astNode.Token != nil && string(astNode.Token.Lit) == "=~" {
+			for _, astValueNode := range astNode.Children[0].Children {
+				if astValueNode.Type == dsl.NodeTypeStringLiteral {
+					astValueNode.Type = dsl.NodeTypeRegex
+				}
+			}
+		}
 	}
 
 	for i, astChild := range astNode.Children {
@@ -317,9 +342,23 @@ func (root *RootNode) buildMainPass(ast *dsl.AST, isReplImmediate bool) error {
 	//         * DirectFieldValue "y"
 	//         * IntLiteral "4"
 
+	// Modules are imported first, so that their functions and subroutines can
+	// be called from anywhere in the DSL expression.
 	for _, astChild := range astChildren {
+		if astChild.Type == dsl.NodeTypeImportStatement {
+			err := root.BuildAndInstallModule(astChild)
+			if err != nil {
+				return err
+			}
+		}
+	}
 
-		if astChild.Type == dsl.NodeTypeNamedFunctionDefinition {
+	for _, astChild := range astChildren {
+
+		if astChild.Type == dsl.NodeTypeImportStatement {
+			// Already built above
+
+		} else if astChild.Type == dsl.NodeTypeNamedFunctionDefinition {
 			err := root.BuildAndInstallUDF(astChild)
 			if err != nil {
 				return err
@@ -347,13 +386,15 @@ func (root *RootNode) buildMainPass(ast *dsl.AST, isReplImmediate bool) error {
 			if err != nil {
 				return err
 			}
-			root.replImmediateBlock.AppendStatementNode(statementNode)
+			// Statements typed at the REPL prompt aren't observed by the
+			// REPL's step debugger.
+			root.replImmediateBlock.AppendStatementNode(statementNode, nil)
 		} else {
 			statementNode, err := root.BuildStatementNode(astChild)
 			if err != nil {
 				return err
 			}
-			root.mainBlock.AppendStatementNode(statementNode)
+			root.mainBlock.AppendStatementNode(statementNode, StatementToken(astChild))
 		}
 	}
 
@@ -504,6 +545,30 @@ func (root *RootNode) ResetForREPL() {
 	root.unresolvedSubroutineCallsites = list.New()
 }
 
+// BuildREPLCondition builds a standalone expression such as the '$x > 3' in
+// the REPL's ':break 12 if $x > 3', for the REPL to evaluate as it needs.
+func (root *RootNode) BuildREPLCondition(dslString string) (IEvaluable, error) {
+	ast, err := buildASTFromString(dslString)
+	if err != nil {
+		return nil, err
+	}
+	astChildren := ast.RootNode.Children
+	if len(astChildren) != 1 || astChildren[0].Type != dsl.NodeTypeBareBoolean {
+		return nil, fmt.Errorf("mlr: condition \"%s\" is not a single expression.", dslString)
+	}
+	root.regexProtectPrePass(ast)
+
+	conditionNode, err := root.BuildEvaluableNode(astChildren[0].Children[0])
+	if err != nil {
+		return nil, err
+	}
+	err = root.Resolve()
+	if err != nil {
+		return nil, err
+	}
+	return conditionNode, nil
+}
+
 // This is for the REPL's context-printer command.
 func (root *RootNode) ShowBlockReport() {
 	fmt.Printf("#begin %d\n", len(root.beginBlocks))
@@ -518,7 +583,7 @@ func (root *RootNode) ResetBeginBlocksForREPL() {
 
 // This is for the REPL's resetblocks command.
 func (root *RootNode) ResetMainBlockForREPL() {
-	root.mainBlock.executables = make([]IExecutable, 0)
+	root.mainBlock = NewStatementBlockNode()
 }
 
 // This is for the REPL's resetblocks command.
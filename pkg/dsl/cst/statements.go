@@ -75,6 +75,10 @@ func (root *RootNode) BuildStatementNode(
 		return root.BuildIfChainNode(astNode)
 	case dsl.NodeTypeCondBlock:
 		return root.BuildCondBlockNode(astNode)
+	case dsl.NodeTypeSwitchStatement:
+		return root.BuildSwitchStatementNode(astNode)
+	case dsl.NodeTypeTryCatchStatement:
+		return root.BuildTryCatchStatementNode(astNode)
 	case dsl.NodeTypeWhileLoop:
 		return root.BuildWhileLoopNode(astNode)
 	case dsl.NodeTypeDoWhileLoop:
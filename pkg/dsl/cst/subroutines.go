@@ -35,6 +35,17 @@ func (root *RootNode) BuildSubroutineCallsiteNode(astNode *dsl.ASTNode) (IExecut
 
 	subroutineName := string(astNode.Token.Lit)
 
+	//  - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
+	// Subroutines in imported modules, like 'call geo::report(...)'.
+
+	module, moduleSubroutineName, err := root.splitModuleCallsiteName(astNode)
+	if err != nil {
+		return nil, err
+	}
+	if module != nil {
+		return root.BuildModuleSubroutineCallsiteNode(astNode, module, moduleSubroutineName)
+	}
+
 	//  - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
 	// Look for a user-defined subroutine with the given name.
 
@@ -0,0 +1,191 @@
+// ================================================================
+// This is for switch statements.
+// ================================================================
+
+package cst
+
+import (
+	"fmt"
+
+	"github.com/johnkerl/miller/v6/pkg/bifs"
+	"github.com/johnkerl/miller/v6/pkg/dsl"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/parsing/token"
+	"github.com/johnkerl/miller/v6/pkg/runtime"
+)
+
+// ----------------------------------------------------------------
+type SwitchStatementNode struct {
+	subjectNode IEvaluable
+	switchCases []*SwitchCase
+	// Run if no other case matches, regardless of where it appears. May be nil.
+	defaultCase *SwitchCase
+}
+
+// ----------------------------------------------------------------
+// For each case: the values to compare the switch subject against, and the
+// statement-block part {...}. For "default", the values are nil.
+type SwitchCase struct {
+	valueNodes         []IEvaluable
+	valueTokens        []*token.Token
+	isRegex            bool // "case =~ ..." rather than "case ..."
+	statementBlockNode *StatementBlockNode
+}
+
+// ----------------------------------------------------------------
+// Sample AST:
+
+// DSL EXPRESSION:
+// switch ($x) { case 1, 2: { $y = "low" } case =~ "^a(.)": { $y = "\1" } default: { $y = "other" } }
+// AST:
+// * statement block
+//     * switch statement "switch"
+//         * direct field value "x"
+//         * switch cases
+//             * switch case "case"
+//                 * switch case values
+//                     * int literal "1"
+//                     * int literal "2"
+//                 * statement block
+//                     * assignment "="
+//                         * direct field value "y"
+//                         * string literal "low"
+//             * switch case "=~"
+//                 * switch case values
+//                     * string literal "^a(.)"
+//                 * statement block
+//                     * assignment "="
+//                         * direct field value "y"
+//                         * string literal "\1"
+//             * switch case "default"
+//                 * statement block
+//                     * assignment "="
+//                         * direct field value "y"
+//                         * string literal "other"
+
+func (root *RootNode) BuildSwitchStatementNode(astNode *dsl.ASTNode) (*SwitchStatementNode, error) {
+	lib.InternalCodingErrorIf(astNode.Type != dsl.NodeTypeSwitchStatement)
+	lib.InternalCodingErrorIf(len(astNode.Children) != 2)
+
+	subjectNode, err := root.BuildEvaluableNode(astNode.Children[0])
+	if err != nil {
+		return nil, err
+	}
+
+	switchCases := make([]*SwitchCase, 0)
+	var defaultCase *SwitchCase = nil
+
+	for _, astChild := range astNode.Children[1].Children {
+		lib.InternalCodingErrorIf(astChild.Type != dsl.NodeTypeSwitchCase)
+		keyword := string(astChild.Token.Lit) // "case", "=~", "default"
+		if keyword == "case" || keyword == "=~" {
+			lib.InternalCodingErrorIf(len(astChild.Children) != 2)
+			astValuesNode := astChild.Children[0]
+			lib.InternalCodingErrorIf(astValuesNode.Type != dsl.NodeTypeSwitchCaseValues)
+
+			valueNodes := make([]IEvaluable, len(astValuesNode.Children))
+			valueTokens := make([]*token.Token, len(astValuesNode.Children))
+			for i, astValueNode := range astValuesNode.Children {
+				valueNodes[i], err = root.BuildEvaluableNode(astValueNode)
+				if err != nil {
+					return nil, err
+				}
+				valueTokens[i] = astValueNode.Token
+			}
+			statementBlockNode, err := root.BuildStatementBlockNode(astChild.Children[1])
+			if err != nil {
+				return nil, err
+			}
+			switchCases = append(switchCases, &SwitchCase{
+				valueNodes:         valueNodes,
+				valueTokens:        valueTokens,
+				isRegex:            keyword == "=~",
+				statementBlockNode: statementBlockNode,
+			})
+
+		} else if keyword == "default" {
+			lib.InternalCodingErrorIf(len(astChild.Children) != 1)
+			if defaultCase != nil {
+				return nil, fmt.Errorf(
+					"mlr: switch statements may have at most one default case%s.",
+					dsl.TokenToLocationInfo(astChild.Token),
+				)
+			}
+			statementBlockNode, err := root.BuildStatementBlockNode(astChild.Children[0])
+			if err != nil {
+				return nil, err
+			}
+			defaultCase = &SwitchCase{
+				statementBlockNode: statementBlockNode,
+			}
+
+		} else {
+			lib.InternalCodingErrorIf(true)
+		}
+	}
+
+	return &SwitchStatementNode{
+		subjectNode: subjectNode,
+		switchCases: switchCases,
+		defaultCase: defaultCase,
+	}, nil
+}
+
+// ----------------------------------------------------------------
+// Execute runs the first case having a value equal to the subject -- using
+// the same comparison as the "==" operator -- or, for "case =~", a regex
+// matching the subject, with captures set as for the "=~" operator. There is
+// no fall-through from one case to the next.
+func (node *SwitchStatementNode) Execute(state *runtime.State) (*BlockExitPayload, error) {
+	subject := node.subjectNode.Evaluate(state)
+
+	matchingCase := node.defaultCase
+	for _, switchCase := range node.switchCases {
+		matches, err := switchCase.matches(subject, state)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			matchingCase = switchCase
+			break
+		}
+	}
+	if matchingCase == nil {
+		return nil, nil
+	}
+
+	blockExitPayload, err := matchingCase.statementBlockNode.Execute(state)
+	if err != nil {
+		return nil, err
+	}
+	// Pass break/continue out of the switch-block since they apply to the
+	// containing for/while/etc.
+	return blockExitPayload, nil
+}
+
+func (switchCase *SwitchCase) matches(subject *mlrval.Mlrval, state *runtime.State) (bool, error) {
+	for i, valueNode := range switchCase.valueNodes {
+		value := valueNode.Evaluate(state)
+		var result *mlrval.Mlrval
+		if switchCase.isRegex {
+			var captures []string
+			result, captures = bifs.BIF_string_matches_regexp(subject, value)
+			state.SetRegexCaptures(captures)
+		} else {
+			result = bifs.BIF_equals(subject, value)
+		}
+		if result.IsError() {
+			return false, fmt.Errorf(
+				"mlr: switch case could not be compared%s: %s.",
+				dsl.TokenToLocationInfo(switchCase.valueTokens[i]),
+				result.String(),
+			)
+		}
+		boolValue, isBool := result.GetBoolValue()
+		if isBool && boolValue {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,240 @@
+// ================================================================
+// This is for try/catch statements.
+//
+// Within a try-block, runtime errors are raised to be caught by the catch-block
+// rather than ending processing. These are of three kinds:
+//
+// * Errors returned from statement execution, such as assigning a string to a
+//   local variable declared as int.
+//
+// * Builtin functions returning an error value, such as strptime with an
+//   unparseable input. Outside of try-blocks, these are simply assigned as
+//   (error) values, as before.
+//
+// * Failed type-assertions, such as asserting_int("abc"). Outside of
+//   try-blocks, these end processing, as before.
+//
+// Raising happens during expression evaluation, for which there is no
+// error-return in the API, so the raised error is held in the runtime state
+// until the statement being executed returns. Statement blocks then return it
+// as an error, which passes up to the try-block. The same is true within
+// functions and subroutines called within try-blocks.
+// ================================================================
+
+package cst
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/dsl"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/parsing/token"
+	"github.com/johnkerl/miller/v6/pkg/runtime"
+)
+
+// ----------------------------------------------------------------
+type TryCatchStatementNode struct {
+	tryBlockNode   *StatementBlockNode
+	catchVariable  *runtime.StackVariable
+	catchBlockNode *StatementBlockNode
+	tryToken       *token.Token
+}
+
+// ----------------------------------------------------------------
+// Sample AST:
+
+// DSL EXPRESSION:
+// try { $t = strptime($d, "%Y") } catch (e) { $t = e["message"] }
+// AST:
+// * statement block
+//     * try/catch statement "try"
+//         * statement block
+//             * assignment "="
+//                 * direct field value "t"
+//                 * function callsite "strptime"
+//                     * direct field value "d"
+//                     * string literal "%Y"
+//         * local variable "e"
+//         * statement block
+//             * assignment "="
+//                 * direct field value "t"
+//                 * array or map index access "[]"
+//                     * local variable "e"
+//                     * string literal "message"
+
+func (root *RootNode) BuildTryCatchStatementNode(astNode *dsl.ASTNode) (*TryCatchStatementNode, error) {
+	lib.InternalCodingErrorIf(astNode.Type != dsl.NodeTypeTryCatchStatement)
+	lib.InternalCodingErrorIf(len(astNode.Children) != 3)
+
+	tryBlockNode, err := root.BuildStatementBlockNode(astNode.Children[0])
+	if err != nil {
+		return nil, err
+	}
+
+	variableASTNode := astNode.Children[1]
+	lib.InternalCodingErrorIf(variableASTNode.Type != dsl.NodeTypeLocalVariable)
+	lib.InternalCodingErrorIf(variableASTNode.Token == nil)
+	variableName := string(variableASTNode.Token.Lit)
+
+	catchBlockNode, err := root.BuildStatementBlockNode(astNode.Children[2])
+	if err != nil {
+		return nil, err
+	}
+
+	return &TryCatchStatementNode{
+		tryBlockNode:   tryBlockNode,
+		catchVariable:  runtime.NewStackVariable(variableName),
+		catchBlockNode: catchBlockNode,
+		tryToken:       astNode.Token,
+	}, nil
+}
+
+// ----------------------------------------------------------------
+// Execute runs the try-block. If that raises an error, the catch-block is run
+// with the catch variable bound to a map with the error message, the name of
+// the function which raised it (empty if it wasn't raised by a function call),
+// and the line and column where it was raised (or, if that's not known, of the
+// "try" keyword).
+func (node *TryCatchStatementNode) Execute(state *runtime.State) (*BlockExitPayload, error) {
+	state.TryDepth++
+	blockExitPayload, err := node.tryBlockNode.Execute(state)
+	state.TryDepth--
+
+	raisedError := state.RaisedError
+	state.RaisedError = nil
+	if raisedError == nil && err == nil {
+		// Pass break/continue/return out of the try-block since they apply to
+		// the containing for/while/etc. or function.
+		return blockExitPayload, nil
+	}
+	// A raised error may have led to another, e.g. a non-boolean if-condition.
+	// The former is the more informative.
+	if raisedError != nil {
+		err = raisedError
+	}
+
+	// Make a frame for the catch variable
+	state.Stack.PushStackFrame()
+	defer state.Stack.PopStackFrame()
+	err = state.Stack.SetAtScope(node.catchVariable, mlrval.FromMap(node.errorToMap(err)))
+	if err != nil {
+		return nil, err
+	}
+	// The catch-block will push its own frame
+	return node.catchBlockNode.Execute(state)
+}
+
+func (node *TryCatchStatementNode) errorToMap(err error) *mlrval.Mlrmap {
+	message := strings.TrimSpace(err.Error())
+	functionName := ""
+	sourceToken := node.tryToken
+	if raisedError, ok := err.(*RaisedError); ok {
+		message = raisedError.message
+		functionName = raisedError.functionName
+		if raisedError.sourceToken != nil {
+			sourceToken = raisedError.sourceToken
+		}
+	}
+
+	errorMap := mlrval.NewMlrmap()
+	errorMap.PutReference("message", mlrval.FromString(message))
+	errorMap.PutReference("function", mlrval.FromString(functionName))
+	errorMap.PutReference("line", mlrval.FromInt(int64(sourceToken.Pos.Line)))
+	errorMap.PutReference("column", mlrval.FromInt(int64(sourceToken.Pos.Column)))
+	return errorMap
+}
+
+// ================================================================
+// RaisedError is an error raised within a try-block, with the name and
+// location of the function which raised it, if known.
+type RaisedError struct {
+	message      string
+	functionName string
+	sourceToken  *token.Token // may be nil
+}
+
+func (raisedError *RaisedError) Error() string {
+	if raisedError.functionName == "" {
+		return raisedError.message
+	}
+	return fmt.Sprintf(
+		"mlr: %s raised an error%s: %s",
+		raisedError.functionName,
+		dsl.TokenToLocationInfo(raisedError.sourceToken),
+		raisedError.message,
+	)
+}
+
+// raiseIfInTry holds the error for the innermost try-block being executed, if
+// any -- keeping the first if more than one is raised before the statement
+// being executed returns. The return value is false if there is no try-block
+// to catch the error.
+func raiseIfInTry(state *runtime.State, raisedError *RaisedError) bool {
+	if state.TryDepth == 0 {
+		return false
+	}
+	if state.RaisedError == nil {
+		state.RaisedError = raisedError
+	}
+	return true
+}
+
+// ================================================================
+// ErrorRaisingCallsiteNode wraps builtin-function callsites so that, within
+// try-blocks, a function returning an error value raises the error. Outside
+// of try-blocks the value is returned as-is.
+type ErrorRaisingCallsiteNode struct {
+	callsiteNode IEvaluable
+	functionName string
+	sourceToken  *token.Token
+	// For functions which are passed error values on purpose, such as
+	// is_error, nothing is raised while evaluating their arguments.
+	handlesErrors bool
+}
+
+var errorHandlingFunctionNames = map[string]bool{
+	"???":             true,
+	"is_error":        true,
+	"typeof":          true,
+	"asserting_error": true,
+}
+
+func NewErrorRaisingCallsiteNode(
+	callsiteNode IEvaluable,
+	astNode *dsl.ASTNode,
+) *ErrorRaisingCallsiteNode {
+	functionName := string(astNode.Token.Lit)
+	return &ErrorRaisingCallsiteNode{
+		callsiteNode:  callsiteNode,
+		functionName:  functionName,
+		sourceToken:   astNode.Token,
+		handlesErrors: errorHandlingFunctionNames[functionName],
+	}
+}
+
+func (node *ErrorRaisingCallsiteNode) Evaluate(state *runtime.State) *mlrval.Mlrval {
+	if node.handlesErrors && state.TryDepth > 0 {
+		tryDepth := state.TryDepth
+		state.TryDepth = 0
+		output := node.callsiteNode.Evaluate(state)
+		state.TryDepth = tryDepth
+		return output
+	}
+
+	output := node.callsiteNode.Evaluate(state)
+	if state.TryDepth > 0 && output.IsError() {
+		message := node.functionName + " returned an error"
+		_, err := output.GetError()
+		if err != nil {
+			message = err.Error()
+		}
+		raiseIfInTry(state, &RaisedError{
+			message:      message,
+			functionName: node.functionName,
+			sourceToken:  node.sourceToken,
+		})
+	}
+	return output
+}
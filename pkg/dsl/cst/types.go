@@ -10,6 +10,7 @@ import (
 	"github.com/johnkerl/miller/v6/pkg/cli"
 	"github.com/johnkerl/miller/v6/pkg/dsl"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/parsing/token"
 	"github.com/johnkerl/miller/v6/pkg/runtime"
 )
 
@@ -50,6 +51,12 @@ type RootNode struct {
 	recordWriterOptions           *cli.TWriterOptions
 	dslInstanceType               DSLInstanceType // put, filter, repl
 	strictMode                    bool
+	importDirectories             []string             // see modules.go
+	importingDirectory            string               // see modules.go
+	modules                       map[string]*RootNode // keyed by namespace
+	moduleCache                   tModuleCache
+	isModule                      bool // see modules.go
+	hasCrossRecordState           bool // see cross_record_state.go
 }
 
 // ----------------------------------------------------------------
@@ -96,6 +103,9 @@ type IEvaluable interface {
 // Also implements IExecutable
 type StatementBlockNode struct {
 	executables []IExecutable
+	// For runtime.State's StatementObserver. Nil for statements which aren't
+	// to be observed.
+	statementTokens []*token.Token
 }
 
 // ----------------------------------------------------------------
@@ -22,6 +22,10 @@ type UDF struct {
 	// Function literals can access locals in their enclosing scope; named
 	// functions cannot.
 	isFunctionLiteral bool
+	// For when this function is passed to higher-order functions: see
+	// getHOFSpace. This is per UDF, not global, since with mlr --workers
+	// there are several copies of the CST being run at once.
+	hofSpaces map[string]*tHOFSpace
 }
 
 func NewUDF(
@@ -252,6 +256,13 @@ func (site *UDFCallsite) EvaluateWithArguments(
 	// being MT_ERROR should be mapped to MT_ERROR here (nominally,
 	// data-dependent). But error-return could be something not data-dependent.
 	if err != nil {
+		// Within a try-block, this is for the catch-block to handle.
+		if raiseIfInTry(state, &RaisedError{
+			message:      err.Error(),
+			functionName: udf.signature.funcOrSubrName,
+		}) {
+			return mlrval.FromError(err)
+		}
 		err2 := udf.signature.typeGatedReturnValue.Check(mlrval.FromError(err))
 		if err2 != nil {
 			fmt.Fprint(os.Stderr, err2)
@@ -401,7 +412,9 @@ func (root *RootNode) BuildAndInstallUDF(astNode *dsl.ASTNode) error {
 
 	functionName := string(astNode.Token.Lit)
 
-	if BuiltinFunctionManagerInstance.LookUp(functionName) != nil {
+	// Functions in modules are called with the namespace, so they don't
+	// override built-ins.
+	if !root.isModule && BuiltinFunctionManagerInstance.LookUp(functionName) != nil {
 		return fmt.Errorf(
 			"mlr: function named \"%s\" must not override a built-in function of the same name.",
 			functionName,
@@ -85,7 +85,7 @@ func validateASTAux(
 		}
 	}
 
-	// Check: begin/end/func/subr must be at top-level
+	// Check: begin/end/func/subr/import must be at top-level
 	if astNode.Type == dsl.NodeTypeBeginBlock {
 		if !atTopLevel {
 			return fmt.Errorf(
@@ -116,6 +116,12 @@ func validateASTAux(
 			)
 		}
 		nextLevelInUDS = true
+	} else if astNode.Type == dsl.NodeTypeImportStatement {
+		if !atTopLevel {
+			return fmt.Errorf(
+				"mlr: import statements can only be at top level.",
+			)
+		}
 	} else if astNode.Type == dsl.NodeTypeForLoopTwoVariable {
 		err := validateForLoopTwoVariableUniqueNames(astNode)
 		if err != nil {
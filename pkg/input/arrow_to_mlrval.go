@@ -0,0 +1,146 @@
+// ================================================================
+// Conversion of Apache Arrow column values to Miller values. This is shared by
+// the record-readers for the Arrow-backed binary formats.
+// ================================================================
+
+package input
+
+import (
+	"math"
+	"time"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+)
+
+// arrowRecordToMlrmaps converts each row of an Arrow record batch to a Miller
+// record, keyed by the schema's field names in schema order.
+func arrowRecordToMlrmaps(arrowRecord arrow.Record, dedupeFieldNames bool) ([]*mlrval.Mlrmap, error) {
+	numRows := int(arrowRecord.NumRows())
+	numColumns := int(arrowRecord.NumCols())
+	schema := arrowRecord.Schema()
+
+	records := make([]*mlrval.Mlrmap, numRows)
+	for i := 0; i < numRows; i++ {
+		records[i] = mlrval.NewMlrmapAsRecord()
+	}
+
+	for j := 0; j < numColumns; j++ {
+		key := schema.Field(j).Name
+		column := arrowRecord.Column(j)
+		for i := 0; i < numRows; i++ {
+			_, err := records[i].PutReferenceMaybeDedupe(key, arrowValueToMlrval(column, i), dedupeFieldNames)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return records, nil
+}
+
+// arrowValueToMlrval maps the i'th value of an Arrow array to a Miller value.
+// Nulls become empty values; integers, floats, and booleans keep their types;
+// lists become arrays, and structs and maps become maps. Dates and timestamps
+// are rendered as ISO8601 strings. Anything else uses Arrow's own string
+// formatting.
+func arrowValueToMlrval(column arrow.Array, i int) *mlrval.Mlrval {
+	if column.IsNull(i) {
+		return mlrval.VOID.Copy()
+	}
+
+	switch typedColumn := column.(type) {
+
+	case *array.Boolean:
+		return mlrval.FromBool(typedColumn.Value(i))
+
+	case *array.Int8:
+		return mlrval.FromInt(int64(typedColumn.Value(i)))
+	case *array.Int16:
+		return mlrval.FromInt(int64(typedColumn.Value(i)))
+	case *array.Int32:
+		return mlrval.FromInt(int64(typedColumn.Value(i)))
+	case *array.Int64:
+		return mlrval.FromInt(typedColumn.Value(i))
+	case *array.Uint8:
+		return mlrval.FromInt(int64(typedColumn.Value(i)))
+	case *array.Uint16:
+		return mlrval.FromInt(int64(typedColumn.Value(i)))
+	case *array.Uint32:
+		return mlrval.FromInt(int64(typedColumn.Value(i)))
+	case *array.Uint64:
+		value := typedColumn.Value(i)
+		if value > math.MaxInt64 {
+			return mlrval.FromFloat(float64(value))
+		}
+		return mlrval.FromInt(int64(value))
+
+	case *array.Float16:
+		return mlrval.FromFloat(float64(typedColumn.Value(i).Float32()))
+	case *array.Float32:
+		return mlrval.FromFloat(float64(typedColumn.Value(i)))
+	case *array.Float64:
+		return mlrval.FromFloat(typedColumn.Value(i))
+
+	case *array.Decimal128:
+		return mlrval.FromInferredType(typedColumn.ValueStr(i))
+	case *array.Decimal256:
+		return mlrval.FromInferredType(typedColumn.ValueStr(i))
+
+	case *array.String:
+		return mlrval.FromString(typedColumn.Value(i))
+	case *array.LargeString:
+		return mlrval.FromString(typedColumn.Value(i))
+	case *array.Binary:
+		return mlrval.FromString(string(typedColumn.Value(i)))
+	case *array.LargeBinary:
+		return mlrval.FromString(string(typedColumn.Value(i)))
+
+	case *array.Date32:
+		return mlrval.FromString(typedColumn.Value(i).ToTime().Format("2006-01-02"))
+	case *array.Date64:
+		return mlrval.FromString(typedColumn.Value(i).ToTime().Format("2006-01-02"))
+	case *array.Timestamp:
+		toTime, err := typedColumn.DataType().(*arrow.TimestampType).GetToTimeFunc()
+		if err != nil {
+			return mlrval.FromError(err)
+		}
+		return mlrval.FromString(toTime(typedColumn.Value(i)).Format(time.RFC3339Nano))
+
+	case *array.Dictionary:
+		return arrowValueToMlrval(typedColumn.Dictionary(), typedColumn.GetValueIndex(i))
+
+	case *array.Struct:
+		structType := typedColumn.DataType().(*arrow.StructType)
+		mapval := mlrval.NewMlrmap()
+		for k := 0; k < typedColumn.NumField(); k++ {
+			mapval.PutReference(structType.Field(k).Name, arrowValueToMlrval(typedColumn.Field(k), i))
+		}
+		return mlrval.FromMap(mapval)
+
+	// Must precede the list cases since an Arrow map is a list of key-value structs.
+	case *array.Map:
+		keys := typedColumn.Keys()
+		items := typedColumn.Items()
+		start, end := typedColumn.ValueOffsets(i)
+		mapval := mlrval.NewMlrmap()
+		for k := int(start); k < int(end); k++ {
+			mapval.PutReference(arrowValueToMlrval(keys, k).String(), arrowValueToMlrval(items, k))
+		}
+		return mlrval.FromMap(mapval)
+
+	case array.ListLike:
+		values := typedColumn.ListValues()
+		start, end := typedColumn.ValueOffsets(i)
+		arrayval := make([]*mlrval.Mlrval, 0, end-start)
+		for k := int(start); k < int(end); k++ {
+			arrayval = append(arrayval, arrowValueToMlrval(values, k))
+		}
+		return mlrval.FromArray(arrayval)
+
+	default:
+		return mlrval.FromString(column.ValueStr(i))
+	}
+}
@@ -5,7 +5,10 @@ package input
 
 import (
 	"container/list"
+	"io"
 
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/types"
 )
 
@@ -24,3 +27,54 @@ type IRecordReader interface {
 		downstreamDoneChannel <-chan bool, // for mlr head
 	)
 }
+
+// openFileForRead and openStdin are for the text-format record-readers: they
+// open the input as lib.OpenFileForRead and lib.OpenStdin do, then decode it
+// to UTF-8 per --iencoding.
+func openFileForRead(
+	filename string,
+	readerOptions *cli.TReaderOptions,
+) (io.ReadCloser, error) {
+	handle, err := lib.OpenFileForRead(
+		filename,
+		readerOptions.Prepipe,
+		readerOptions.PrepipeIsRaw,
+		readerOptions.FileInputEncoding,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return newCharsetDecodingReader(handle, filename, readerOptions)
+}
+
+func openStdin(
+	readerOptions *cli.TReaderOptions,
+) (io.ReadCloser, error) {
+	handle, err := lib.OpenStdin(
+		readerOptions.Prepipe,
+		readerOptions.PrepipeIsRaw,
+		readerOptions.FileInputEncoding,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return newCharsetDecodingReader(handle, "(stdin)", readerOptions)
+}
+
+func newCharsetDecodingReader(
+	handle io.ReadCloser,
+	filename string,
+	readerOptions *cli.TReaderOptions,
+) (io.ReadCloser, error) {
+	decodingHandle, err := lib.NewCharsetDecodingReader(
+		handle,
+		filename,
+		readerOptions.InputCharset,
+		readerOptions.CharsetErrorPolicy,
+	)
+	if err != nil {
+		handle.Close()
+		return nil, err
+	}
+	return decodingHandle, nil
+}
@@ -0,0 +1,225 @@
+// ================================================================
+// Arrow IPC is a binary, columnar format. It comes in two flavors: the
+// streaming format, which is a schema message followed by record batches, and
+// the file format (a.k.a. Feather V2), which wraps the same messages in a
+// header and footer. The streaming format is read incrementally; the file
+// format needs random access, so as with Parquet, non-seekable input is read
+// into memory first.
+//
+// More than one stream may be concatenated in the same input, e.g. when the
+// Miller Arrow writer starts a new stream on a schema change. Each is read in
+// turn with its own schema.
+// ================================================================
+
+package input
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/ipc"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// arrowFileMagic starts and ends Arrow IPC files, but not Arrow IPC streams.
+const arrowFileMagic = "ARROW1"
+
+type RecordReaderArrow struct {
+	readerOptions   *cli.TReaderOptions
+	recordsPerBatch int64 // distinct from readerOptions.RecordsPerBatch for join/repl
+}
+
+func NewRecordReaderArrow(
+	readerOptions *cli.TReaderOptions,
+	recordsPerBatch int64,
+) (*RecordReaderArrow, error) {
+	return &RecordReaderArrow{
+		readerOptions:   readerOptions,
+		recordsPerBatch: recordsPerBatch,
+	}, nil
+}
+
+func (reader *RecordReaderArrow) Read(
+	filenames []string,
+	context types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	errorChannel chan error,
+	downstreamDoneChannel <-chan bool, // for mlr head
+) {
+	if filenames != nil { // nil for mlr -n
+		err := reader.processFiles(filenames, &context, readerChannel, downstreamDoneChannel)
+		if err != nil {
+			errorChannel <- err
+		}
+	}
+	readerChannel <- types.NewEndOfStreamMarkerList(&context)
+}
+
+func (reader *RecordReaderArrow) processFiles(
+	filenames []string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool, // for mlr head
+) error {
+	if len(filenames) == 0 { // read from stdin
+		handle, err := lib.OpenStdin(
+			reader.readerOptions.Prepipe,
+			reader.readerOptions.PrepipeIsRaw,
+			reader.readerOptions.FileInputEncoding,
+		)
+		if err != nil {
+			return err
+		}
+		return reader.processHandle(handle, "(stdin)", context, readerChannel, downstreamDoneChannel)
+	}
+
+	for _, filename := range filenames {
+		handle, err := lib.OpenFileForRead(
+			filename,
+			reader.readerOptions.Prepipe,
+			reader.readerOptions.PrepipeIsRaw,
+			reader.readerOptions.FileInputEncoding,
+		)
+		if err != nil {
+			return err
+		}
+		err = reader.processHandle(handle, filename, context, readerChannel, downstreamDoneChannel)
+		handle.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (reader *RecordReaderArrow) processHandle(
+	handle io.Reader,
+	filename string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool, // for mlr head
+) error {
+	context.UpdateForStartOfFile(filename)
+
+	bufferedHandle := bufio.NewReader(handle)
+	magic, _ := bufferedHandle.Peek(len(arrowFileMagic))
+	if string(magic) == arrowFileMagic {
+		return reader.processFileFormat(bufferedHandle, filename, context, readerChannel, downstreamDoneChannel)
+	}
+
+	for {
+		// An empty input, or end of input after the last stream, is not an error.
+		if _, err := bufferedHandle.Peek(1); err != nil {
+			return nil
+		}
+		done, err := reader.processStream(bufferedHandle, filename, context, readerChannel, downstreamDoneChannel)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// processStream reads one IPC stream, through its end-of-stream marker. It
+// returns true if downstream processing is done.
+func (reader *RecordReaderArrow) processStream(
+	handle io.Reader,
+	filename string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool, // for mlr head
+) (bool, error) {
+	ipcReader, err := ipc.NewReader(handle, ipc.WithAllocator(memory.DefaultAllocator))
+	if err != nil {
+		return true, fmt.Errorf("could not read Arrow stream %s: %v", filename, err)
+	}
+	defer ipcReader.Release()
+
+	for ipcReader.Next() {
+		done, err := reader.processArrowRecord(ipcReader.Record(), context, readerChannel, downstreamDoneChannel)
+		if err != nil || done {
+			return true, err
+		}
+	}
+
+	if err := ipcReader.Err(); err != nil {
+		return true, fmt.Errorf("could not read Arrow stream %s: %v", filename, err)
+	}
+	return false, nil
+}
+
+func (reader *RecordReaderArrow) processFileFormat(
+	handle io.Reader,
+	filename string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool, // for mlr head
+) error {
+	contents, err := io.ReadAll(handle)
+	if err != nil && !lib.IsEOF(err) {
+		return err
+	}
+
+	fileReader, err := ipc.NewFileReader(bytes.NewReader(contents), ipc.WithAllocator(memory.DefaultAllocator))
+	if err != nil {
+		return fmt.Errorf("could not read Arrow file %s: %v", filename, err)
+	}
+	defer fileReader.Close()
+
+	for i := 0; i < fileReader.NumRecords(); i++ {
+		arrowRecord, err := fileReader.Record(i)
+		if err != nil {
+			return fmt.Errorf("could not read Arrow file %s: %v", filename, err)
+		}
+		done, err := reader.processArrowRecord(arrowRecord, context, readerChannel, downstreamDoneChannel)
+		if err != nil || done {
+			return err
+		}
+	}
+	return nil
+}
+
+// processArrowRecord sends one record batch down the reader channel, in
+// pieces of at most recordsPerBatch records. It returns true if downstream
+// processing is done.
+func (reader *RecordReaderArrow) processArrowRecord(
+	arrowRecord arrow.Record,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool, // for mlr head
+) (bool, error) {
+	// See if downstream processors will be ignoring further data (e.g. mlr
+	// head).  If so, stop reading. This makes 'mlr head hugefile' exit
+	// quickly, as it should.
+	select {
+	case _ = <-downstreamDoneChannel:
+		return true, nil
+	default:
+	}
+
+	records, err := arrowRecordToMlrmaps(arrowRecord, reader.readerOptions.DedupeFieldNames)
+	if err != nil {
+		return true, err
+	}
+
+	recordsAndContexts := list.New()
+	for _, record := range records {
+		context.UpdateForInputRecord()
+		recordsAndContexts.PushBack(types.NewRecordAndContext(record, context))
+		if int64(recordsAndContexts.Len()) >= reader.recordsPerBatch {
+			readerChannel <- recordsAndContexts
+			recordsAndContexts = list.New()
+		}
+	}
+	if recordsAndContexts.Len() > 0 {
+		readerChannel <- recordsAndContexts
+	}
+	return false, nil
+}
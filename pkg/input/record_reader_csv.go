@@ -58,11 +58,7 @@ func (reader *RecordReaderCSV) Read(
 ) {
 	if filenames != nil { // nil for mlr -n
 		if len(filenames) == 0 { // read from stdin
-			handle, err := lib.OpenStdin(
-				reader.readerOptions.Prepipe,
-				reader.readerOptions.PrepipeIsRaw,
-				reader.readerOptions.FileInputEncoding,
-			)
+			handle, err := openStdin(reader.readerOptions)
 			if err != nil {
 				errorChannel <- err
 			} else {
@@ -70,12 +66,7 @@ func (reader *RecordReaderCSV) Read(
 			}
 		} else {
 			for _, filename := range filenames {
-				handle, err := lib.OpenFileForRead(
-					filename,
-					reader.readerOptions.Prepipe,
-					reader.readerOptions.PrepipeIsRaw,
-					reader.readerOptions.FileInputEncoding,
-				)
+				handle, err := openFileForRead(filename, reader.readerOptions)
 				if err != nil {
 					errorChannel <- err
 				} else {
@@ -9,7 +9,6 @@ import (
 	"strings"
 
 	"github.com/johnkerl/miller/v6/pkg/cli"
-	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
 	"github.com/johnkerl/miller/v6/pkg/types"
 )
@@ -61,11 +60,7 @@ func (reader *RecordReaderDKVPNIDX) Read(
 ) {
 	if filenames != nil { // nil for mlr -n
 		if len(filenames) == 0 { // read from stdin
-			handle, err := lib.OpenStdin(
-				reader.readerOptions.Prepipe,
-				reader.readerOptions.PrepipeIsRaw,
-				reader.readerOptions.FileInputEncoding,
-			)
+			handle, err := openStdin(reader.readerOptions)
 			if err != nil {
 				errorChannel <- err
 			} else {
@@ -73,12 +68,7 @@ func (reader *RecordReaderDKVPNIDX) Read(
 			}
 		} else {
 			for _, filename := range filenames {
-				handle, err := lib.OpenFileForRead(
-					filename,
-					reader.readerOptions.Prepipe,
-					reader.readerOptions.PrepipeIsRaw,
-					reader.readerOptions.FileInputEncoding,
-				)
+				handle, err := openFileForRead(filename, reader.readerOptions)
 				if err != nil {
 					errorChannel <- err
 				} else {
@@ -8,12 +8,16 @@ import (
 
 func Create(readerOptions *cli.TReaderOptions, recordsPerBatch int64) (IRecordReader, error) {
 	switch readerOptions.InputFileFormat {
+	case "arrow":
+		return NewRecordReaderArrow(readerOptions, recordsPerBatch)
 	case "csv":
 		return NewRecordReaderCSV(readerOptions, recordsPerBatch)
 	case "csvlite":
 		return NewRecordReaderCSVLite(readerOptions, recordsPerBatch)
 	case "dkvp":
 		return NewRecordReaderDKVP(readerOptions, recordsPerBatch)
+	case "fixed":
+		return NewRecordReaderFixed(readerOptions, recordsPerBatch)
 	case "json":
 		return NewRecordReaderJSON(readerOptions, recordsPerBatch)
 	case "nidx":
@@ -22,12 +26,20 @@ func Create(readerOptions *cli.TReaderOptions, recordsPerBatch int64) (IRecordRe
 		return NewRecordReaderMarkdown(readerOptions, recordsPerBatch)
 	case "markdown":
 		return NewRecordReaderMarkdown(readerOptions, recordsPerBatch)
+	case "parquet":
+		return NewRecordReaderParquet(readerOptions, recordsPerBatch)
 	case "pprint":
 		return NewRecordReaderPPRINT(readerOptions, recordsPerBatch)
+	case "sqlite":
+		return NewRecordReaderSQLite(readerOptions, recordsPerBatch)
 	case "tsv":
 		return NewRecordReaderTSV(readerOptions, recordsPerBatch)
+	case "xlsx":
+		return NewRecordReaderXLSX(readerOptions, recordsPerBatch)
 	case "xtab":
 		return NewRecordReaderXTAB(readerOptions, recordsPerBatch)
+	case "yaml":
+		return NewRecordReaderYAML(readerOptions, recordsPerBatch)
 	case "gen":
 		return NewPseudoReaderGen(readerOptions, recordsPerBatch)
 	default:
@@ -0,0 +1,176 @@
+package input
+
+import (
+	"container/list"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// RecordReaderFixed is for fixed-width input, where each field is in a given
+// range of columns. Columns are characters, not bytes, so that UTF-8 data
+// lines up as it looks; use --iencoding for data in other character sets.
+type RecordReaderFixed struct {
+	readerOptions   *cli.TReaderOptions
+	recordsPerBatch int64 // distinct from readerOptions.RecordsPerBatch for join/repl
+	fields          []cli.TFixedWidthField
+}
+
+func NewRecordReaderFixed(
+	readerOptions *cli.TReaderOptions,
+	recordsPerBatch int64,
+) (*RecordReaderFixed, error) {
+	if len(readerOptions.FixedWidthFields) == 0 {
+		return nil, fmt.Errorf("fixed-width input requires --fixed-spec or --fixed-spec-file")
+	}
+	return &RecordReaderFixed{
+		readerOptions:   readerOptions,
+		recordsPerBatch: recordsPerBatch,
+		fields:          readerOptions.FixedWidthFields,
+	}, nil
+}
+
+func (reader *RecordReaderFixed) Read(
+	filenames []string,
+	context types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	errorChannel chan error,
+	downstreamDoneChannel <-chan bool, // for mlr head
+) {
+	if filenames != nil { // nil for mlr -n
+		if len(filenames) == 0 { // read from stdin
+			handle, err := openStdin(reader.readerOptions)
+			if err != nil {
+				errorChannel <- err
+			} else {
+				reader.processHandle(handle, "(stdin)", &context, readerChannel, errorChannel, downstreamDoneChannel)
+			}
+		} else {
+			for _, filename := range filenames {
+				handle, err := openFileForRead(filename, reader.readerOptions)
+				if err != nil {
+					errorChannel <- err
+				} else {
+					reader.processHandle(handle, filename, &context, readerChannel, errorChannel, downstreamDoneChannel)
+					handle.Close()
+				}
+			}
+		}
+	}
+	readerChannel <- types.NewEndOfStreamMarkerList(&context)
+}
+
+func (reader *RecordReaderFixed) processHandle(
+	handle io.Reader,
+	filename string,
+	context *types.Context,
+	readerChannel chan<- *list.List,
+	errorChannel chan<- error,
+	downstreamDoneChannel <-chan bool, // for mlr head
+) {
+	context.UpdateForStartOfFile(filename)
+	recordsPerBatch := reader.recordsPerBatch
+
+	lineReader := NewLineReader(NewBOMStrippingReader(handle), reader.readerOptions.IRS)
+	linesChannel := make(chan *list.List, recordsPerBatch)
+	go channelizedLineReader(lineReader, linesChannel, downstreamDoneChannel, recordsPerBatch)
+
+	for {
+		recordsAndContexts, eof := reader.getRecordBatch(linesChannel, context)
+		if recordsAndContexts.Len() > 0 {
+			readerChannel <- recordsAndContexts
+		}
+		if eof {
+			break
+		}
+	}
+}
+
+func (reader *RecordReaderFixed) getRecordBatch(
+	linesChannel <-chan *list.List,
+	context *types.Context,
+) (
+	recordsAndContexts *list.List,
+	eof bool,
+) {
+	recordsAndContexts = list.New()
+
+	lines, more := <-linesChannel
+	if !more {
+		return recordsAndContexts, true
+	}
+
+	for e := lines.Front(); e != nil; e = e.Next() {
+		line := e.Value.(string)
+
+		// Check for comments-in-data feature
+		// TODO: function-pointer this away
+		if reader.readerOptions.CommentHandling != cli.CommentsAreData {
+			if strings.HasPrefix(line, reader.readerOptions.CommentString) {
+				if reader.readerOptions.CommentHandling == cli.PassComments {
+					recordsAndContexts.PushBack(types.NewOutputString(line+"\n", context))
+					continue
+				} else if reader.readerOptions.CommentHandling == cli.SkipComments {
+					continue
+				}
+				// else comments are data
+			}
+		}
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		record := reader.recordFromLine(line)
+		context.UpdateForInputRecord()
+		recordAndContext := types.NewRecordAndContext(record, context)
+		recordsAndContexts.PushBack(recordAndContext)
+	}
+
+	return recordsAndContexts, false
+}
+
+func (reader *RecordReaderFixed) recordFromLine(line string) *mlrval.Mlrmap {
+	record := mlrval.NewMlrmapAsRecord()
+
+	// Byte offsets are character offsets for ASCII, the usual case.
+	var runes []rune = nil
+	length := len(line)
+	if !isASCII(line) {
+		runes = []rune(line)
+		length = len(runes)
+	}
+
+	for i := range reader.fields {
+		field := &reader.fields[i]
+		start := field.Start - 1
+		end := field.End
+		if end > length {
+			end = length
+		}
+		value := ""
+		if start < end {
+			if runes == nil {
+				value = line[start:end]
+			} else {
+				value = string(runes[start:end])
+			}
+		}
+		record.PutReference(field.Name, mlrval.FromDeferredType(strings.Trim(value, " ")))
+	}
+	return record
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package input
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+)
+
+func TestRecordFromFixedWidthLine(t *testing.T) {
+	readerOptions := cli.DefaultReaderOptions()
+	fields, err := cli.ParseFixedWidthSpec("id:1-4,name:5-12,flag:13,amount:16-20")
+	assert.Nil(t, err)
+	readerOptions.FixedWidthFields = fields
+	reader, err := NewRecordReaderFixed(&readerOptions, 1)
+	assert.NotNil(t, reader)
+	assert.Nil(t, err)
+
+	record := reader.recordFromLine("0001Ann Lee Y  12.50")
+	assert.Equal(t, int64(4), record.FieldCount)
+	assert.Equal(t, "0001", record.Get("id").String())
+	assert.Equal(t, "Ann Lee", record.Get("name").String())
+	assert.Equal(t, "Y", record.Get("flag").String())
+	assert.Equal(t, "12.50", record.Get("amount").String())
+
+	// Columns are characters, not bytes.
+	record = reader.recordFromLine("0002Zoë ÜnalN   3.25")
+	assert.Equal(t, "Zoë Ünal", record.Get("name").String())
+	assert.Equal(t, "N", record.Get("flag").String())
+	assert.Equal(t, "3.25", record.Get("amount").String())
+
+	// Fields past the end of the line are empty.
+	record = reader.recordFromLine("0003Bo")
+	assert.Equal(t, int64(4), record.FieldCount)
+	assert.Equal(t, "Bo", record.Get("name").String())
+	assert.Equal(t, "", record.Get("flag").String())
+	assert.Equal(t, "", record.Get("amount").String())
+
+	readerOptions.FixedWidthFields = nil
+	_, err = NewRecordReaderFixed(&readerOptions, 1)
+	assert.NotNil(t, err)
+}
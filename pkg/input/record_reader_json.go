@@ -4,12 +4,12 @@ import (
 	"container/list"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 
 	"encoding/json"
 
 	"github.com/johnkerl/miller/v6/pkg/cli"
-	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
 	"github.com/johnkerl/miller/v6/pkg/types"
 )
@@ -19,15 +19,24 @@ type RecordReaderJSON struct {
 	recordsPerBatch int64 // distinct from readerOptions.RecordsPerBatch for join/repl
 	// XXX 1513
 	sawBrackets bool
+	// From --json-records-path; empty for top-level records.
+	recordsPath []tJSONPathElement
 }
 
 func NewRecordReaderJSON(
 	readerOptions *cli.TReaderOptions,
 	recordsPerBatch int64,
 ) (*RecordReaderJSON, error) {
+	recordsPath, err := parseJSONRecordsPath(readerOptions.JSONRecordsPath)
+	if err != nil {
+		return nil, err
+	}
 	return &RecordReaderJSON{
 		readerOptions:   readerOptions,
 		recordsPerBatch: recordsPerBatch,
+		// Records from within a document are a list, even if there are none.
+		sawBrackets: len(recordsPath) > 0,
+		recordsPath: recordsPath,
 	}, nil
 }
 
@@ -40,11 +49,7 @@ func (reader *RecordReaderJSON) Read(
 ) {
 	if filenames != nil { // nil for mlr -n
 		if len(filenames) == 0 { // read from stdin
-			handle, err := lib.OpenStdin(
-				reader.readerOptions.Prepipe,
-				reader.readerOptions.PrepipeIsRaw,
-				reader.readerOptions.FileInputEncoding,
-			)
+			handle, err := openStdin(reader.readerOptions)
 			if err != nil {
 				errorChannel <- err
 			} else {
@@ -52,12 +57,7 @@ func (reader *RecordReaderJSON) Read(
 			}
 		} else {
 			for _, filename := range filenames {
-				handle, err := lib.OpenFileForRead(
-					filename,
-					reader.readerOptions.Prepipe,
-					reader.readerOptions.PrepipeIsRaw,
-					reader.readerOptions.FileInputEncoding,
-				)
+				handle, err := openFileForRead(filename, reader.readerOptions)
 				if err != nil {
 					errorChannel <- err
 				} else {
@@ -71,6 +71,10 @@ func (reader *RecordReaderJSON) Read(
 	readerChannel <- types.NewEndOfStreamMarkerList(&context)
 }
 
+// The input is read a token at a time, rather than a top-level value at a time,
+// so that records within a huge array -- at top level, or within a larger
+// document as selected by --json-records-path -- are streamed out in batches
+// without the enclosing array ever being in memory.
 func (reader *RecordReaderJSON) processHandle(
 	handle io.Reader,
 	filename string,
@@ -80,110 +84,304 @@ func (reader *RecordReaderJSON) processHandle(
 	downstreamDoneChannel <-chan bool, // for mlr head
 ) {
 	context.UpdateForStartOfFile(filename)
-	// TODO: comment
-	recordsPerBatch := reader.recordsPerBatch
 
 	if reader.readerOptions.CommentHandling != cli.CommentsAreData {
 		handle = NewJSONCommentEnabledReader(handle, reader.readerOptions, readerChannel)
 	}
 	decoder := json.NewDecoder(handle)
-	recordsAndContexts := list.New()
+	decoder.UseNumber()
+
+	batcher := &tJSONRecordBatcher{
+		recordsPerBatch:       reader.recordsPerBatch,
+		context:               context,
+		readerChannel:         readerChannel,
+		downstreamDoneChannel: downstreamDoneChannel,
+		recordsAndContexts:    list.New(),
+	}
 
-	eof := false
-	i := int64(0)
-	for {
-		// See if downstream processors will be ignoring further data (e.g. mlr
-		// head).  If so, stop reading. This makes 'mlr head hugefile' exit
-		// quickly, as it should. Do this channel-check every so often to avoid
-		// scheduler overhead.
-		i++
-		if i%recordsPerBatch == 0 {
-			select {
-			case _ = <-downstreamDoneChannel:
-				eof = true
-				break
-			default:
-				break
-			}
-			if eof {
-				break
+	for !batcher.downstreamDone {
+		startToken, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errorChannel <- err
+			return
+		}
+
+		err = reader.processValue(decoder, startToken, reader.recordsPath, batcher)
+		if err != nil {
+			errorChannel <- err
+			return
+		}
+	}
+
+	if batcher.recordsAndContexts.Len() > 0 {
+		readerChannel <- batcher.recordsAndContexts
+	}
+}
+
+// processValue follows the records path into the JSON value whose first token
+// has just been read. Where the path ends, the value is a record, or an array
+// of records. Parts of the input which aren't on the path are skipped over
+// without being decoded.
+func (reader *RecordReaderJSON) processValue(
+	decoder *json.Decoder,
+	startToken json.Token,
+	path []tJSONPathElement,
+	batcher *tJSONRecordBatcher,
+) error {
+	delimiter, isDelim := startToken.(json.Delim)
+
+	if len(path) == 0 {
+		if isDelim && delimiter == '[' {
+			reader.sawBrackets = true
+			for decoder.More() {
+				value, eof, err := mlrval.MlrvalDecodeFromJSON(decoder)
+				if eof {
+					return fmt.Errorf("mlr: JSON parser: unexpected premature EOF.")
+				}
+				if err != nil {
+					return err
+				}
+				err = batcher.put(value)
+				if err != nil || batcher.downstreamDone {
+					return err
+				}
 			}
+			return expectJSONDelimiter(decoder, ']')
 		}
 
-		mlrval, eof, err := mlrval.MlrvalDecodeFromJSON(decoder)
+		value, eof, err := mlrval.MlrvalDecodeFromJSONStartingWith(decoder, startToken)
 		if eof {
-			break
+			return fmt.Errorf("mlr: JSON parser: unexpected premature EOF.")
 		}
 		if err != nil {
-			errorChannel <- err
-			return
+			return err
 		}
+		return batcher.put(value)
+	}
+
+	element := path[0]
+	isObjectElement := element.kind == jsonPathKey || element.kind == jsonPathAnyKey
+	if !isDelim || (isObjectElement && delimiter != '{') || (!isObjectElement && delimiter != '[') {
+		// Not on the path
+		return skipJSONValue(decoder, startToken)
+	}
 
-		// Find out what we got.
-		// * Map is an input record: deliver it.
-		// * Array is OK if it's array of input record: deliver them.
-		// * Non-collection types are valid but unmillerable JSON.
-
-		if mlrval.IsMap() {
-			// TODO: make a helper method
-			record := mlrval.GetMap()
-			if record == nil {
-				errorChannel <- fmt.Errorf("internal coding error detected in JSON record-reader")
-				return
+	index := int64(0)
+	for decoder.More() {
+		onPath := false
+		if isObjectElement {
+			keyToken, err := decoder.Token()
+			if err != nil {
+				return err
 			}
-			context.UpdateForInputRecord()
-			recordsAndContexts.PushBack(types.NewRecordAndContext(record, context))
+			key, _ := keyToken.(string)
+			onPath = element.kind == jsonPathAnyKey || key == element.key
+		} else {
+			onPath = element.kind == jsonPathAnyIndex || index == element.index
+			index++
+		}
+
+		valueToken, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		if onPath {
+			err = reader.processValue(decoder, valueToken, path[1:], batcher)
+		} else {
+			err = skipJSONValue(decoder, valueToken)
+		}
+		if err != nil || batcher.downstreamDone {
+			return err
+		}
+	}
+
+	if isObjectElement {
+		return expectJSONDelimiter(decoder, '}')
+	} else {
+		return expectJSONDelimiter(decoder, ']')
+	}
+}
 
-			if int64(recordsAndContexts.Len()) >= recordsPerBatch {
-				readerChannel <- recordsAndContexts
-				recordsAndContexts = list.New()
+// skipJSONValue reads past the rest of the JSON value whose first token has
+// just been read.
+func skipJSONValue(decoder *json.Decoder, startToken json.Token) error {
+	delimiter, isDelim := startToken.(json.Delim)
+	if !isDelim || delimiter == '}' || delimiter == ']' {
+		return nil
+	}
+	depth := 1
+	for depth > 0 {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return fmt.Errorf("mlr: JSON parser: unexpected premature EOF.")
+		}
+		if err != nil {
+			return err
+		}
+		delimiter, isDelim := token.(json.Delim)
+		if isDelim {
+			if delimiter == '{' || delimiter == '[' {
+				depth++
+			} else {
+				depth--
 			}
+		}
+	}
+	return nil
+}
 
-		} else if mlrval.IsArray() {
+func expectJSONDelimiter(decoder *json.Decoder, expected json.Delim) error {
+	token, err := decoder.Token()
+	if err == io.EOF {
+		return fmt.Errorf("mlr: JSON parser: unexpected premature EOF.")
+	}
+	if err != nil {
+		return err
+	}
+	delimiter, isDelim := token.(json.Delim)
+	if !isDelim || delimiter != expected {
+		return fmt.Errorf("mlr: JSON reader: did not find closing token \"%s\"", string(expected))
+	}
+	return nil
+}
 
-			reader.sawBrackets = true
+// tJSONRecordBatcher accumulates records from the JSON input, sending them
+// along in batches.
+type tJSONRecordBatcher struct {
+	recordsPerBatch       int64
+	context               *types.Context
+	readerChannel         chan<- *list.List // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool       // for mlr head
+	recordsAndContexts    *list.List
+	downstreamDone        bool
+}
+
+func (batcher *tJSONRecordBatcher) put(value *mlrval.Mlrval) error {
+	// Non-collection types, and arrays other than at top level or at the end
+	// of the records path, are valid but unmillerable JSON.
+	if !value.IsMap() {
+		// TODO: more context
+		return fmt.Errorf(
+			"valid but unmillerable JSON. Expected map (JSON object); got %s.",
+			value.GetTypeName(),
+		)
+	}
+	record := value.GetMap()
+	if record == nil {
+		return fmt.Errorf("internal coding error detected in JSON record-reader")
+	}
+	batcher.context.UpdateForInputRecord()
+	batcher.recordsAndContexts.PushBack(types.NewRecordAndContext(record, batcher.context))
+
+	if int64(batcher.recordsAndContexts.Len()) >= batcher.recordsPerBatch {
+		batcher.readerChannel <- batcher.recordsAndContexts
+		batcher.recordsAndContexts = list.New()
+
+		// See if downstream processors will be ignoring further data (e.g. mlr
+		// head).  If so, stop reading. This makes 'mlr head hugefile' exit
+		// quickly, as it should.
+		select {
+		case _ = <-batcher.downstreamDoneChannel:
+			batcher.downstreamDone = true
+		default:
+		}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------
+// Paths for --json-records-path, such as .data.items[*]
+
+type tJSONPathElementKind int
+
+const (
+	jsonPathKey tJSONPathElementKind = iota
+	jsonPathAnyKey
+	jsonPathIndex
+	jsonPathAnyIndex
+)
 
-			records := mlrval.GetArray()
-			if records == nil {
-				errorChannel <- fmt.Errorf("internal coding error detected in JSON record-reader")
-				return
+type tJSONPathElement struct {
+	kind  tJSONPathElementKind
+	key   string
+	index int64
+}
+
+// parseJSONRecordsPath splits a path like .data.items[*] into its elements.
+// There may be a leading $, as in JSONPath, and the leading . may be omitted.
+// An empty path, or just . or $, is the top level.
+func parseJSONRecordsPath(path string) ([]tJSONPathElement, error) {
+	elements := make([]tJSONPathElement, 0)
+	rest := strings.TrimPrefix(path, "$")
+	if rest == "." {
+		return elements, nil
+	}
+	if rest != "" && rest[0] != '.' && rest[0] != '[' {
+		rest = "." + rest
+	}
+
+	for rest != "" {
+		if rest[0] == '.' {
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			name := rest[:end]
+			rest = rest[end:]
+			if name == "" {
+				return nil, fmt.Errorf("--json-records-path \"%s\": empty key name", path)
+			}
+			if name == "*" {
+				elements = append(elements, tJSONPathElement{kind: jsonPathAnyKey})
+			} else {
+				elements = append(elements, tJSONPathElement{kind: jsonPathKey, key: name})
 			}
 
-			for _, mlrval := range records {
-				if !mlrval.IsMap() {
-					// TODO: more context
-					errorChannel <- fmt.Errorf(
-						"valid but unmillerable JSON. Expected map (JSON object); got %s.",
-						mlrval.GetTypeName(),
-					)
-					return
+		} else if rest[0] == '[' {
+			end := strings.Index(rest, "]")
+			if strings.HasPrefix(rest, "[\"") {
+				// Quoted keys may contain ] or .
+				end = strings.Index(rest, "\"]")
+				if end >= 0 {
+					end++
 				}
-				record := mlrval.GetMap()
-				if record == nil {
-					errorChannel <- fmt.Errorf("internal coding error detected in JSON record-reader")
-					return
+			}
+			if end < 0 {
+				return nil, fmt.Errorf("--json-records-path \"%s\": missing \"]\"", path)
+			}
+			inside := rest[1:end]
+			rest = rest[end+1:]
+			if inside == "*" {
+				elements = append(elements, tJSONPathElement{kind: jsonPathAnyIndex})
+			} else if len(inside) >= 2 && strings.HasPrefix(inside, "\"") {
+				key, err := strconv.Unquote(inside)
+				if err != nil {
+					return nil, fmt.Errorf("--json-records-path \"%s\": cannot parse key %s", path, inside)
 				}
-				context.UpdateForInputRecord()
-				recordsAndContexts.PushBack(types.NewRecordAndContext(record, context))
-
-				if int64(recordsAndContexts.Len()) >= recordsPerBatch {
-					readerChannel <- recordsAndContexts
-					recordsAndContexts = list.New()
+				elements = append(elements, tJSONPathElement{kind: jsonPathKey, key: key})
+			} else {
+				index, err := strconv.ParseInt(inside, 10, 64)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf(
+						"--json-records-path \"%s\": array index \"%s\" must be * or a non-negative integer",
+						path, inside,
+					)
 				}
+				elements = append(elements, tJSONPathElement{kind: jsonPathIndex, index: index})
 			}
 
 		} else {
-			errorChannel <- fmt.Errorf(
-				"valid but unmillerable JSON. Expected map (JSON object); got %s.",
-				mlrval.GetTypeName(),
+			return nil, fmt.Errorf(
+				"--json-records-path \"%s\": expected \".\" or \"[\" at \"%s\"", path, rest,
 			)
-			return
 		}
 	}
 
-	if recordsAndContexts.Len() > 0 {
-		readerChannel <- recordsAndContexts
-	}
+	return elements, nil
 }
 
 // ================================================================
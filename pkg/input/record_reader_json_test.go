@@ -0,0 +1,37 @@
+package input
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJSONRecordsPath(t *testing.T) {
+	for _, path := range []string{"", ".", "$"} {
+		elements, err := parseJSONRecordsPath(path)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(elements))
+	}
+
+	elements, err := parseJSONRecordsPath(`$.data["the items"][*].*[2]`)
+	assert.Nil(t, err)
+	assert.Equal(t, []tJSONPathElement{
+		{kind: jsonPathKey, key: "data"},
+		{kind: jsonPathKey, key: "the items"},
+		{kind: jsonPathAnyIndex},
+		{kind: jsonPathAnyKey},
+		{kind: jsonPathIndex, index: 2},
+	}, elements)
+
+	elements, err = parseJSONRecordsPath("data.items")
+	assert.Nil(t, err)
+	assert.Equal(t, []tJSONPathElement{
+		{kind: jsonPathKey, key: "data"},
+		{kind: jsonPathKey, key: "items"},
+	}, elements)
+
+	for _, path := range []string{".a..b", ".a[", ".a[-1]", ".a[x]", `.a["b]`} {
+		_, err := parseJSONRecordsPath(path)
+		assert.NotNil(t, err, path)
+	}
+}
@@ -0,0 +1,174 @@
+// ================================================================
+// Parquet is a binary, columnar format with the schema stored in the file
+// footer. Reading the footer requires random access, so non-seekable input
+// (stdin, prepipes, compressed or remote files) is read into memory first.
+// Row groups are decoded a batch at a time via Arrow, and each batch of rows
+// is sent down the reader channel as a batch of records.
+// ================================================================
+
+package input
+
+import (
+	"bytes"
+	"container/list"
+	gocontext "context"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet"
+	"github.com/apache/arrow/go/v17/parquet/file"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+type RecordReaderParquet struct {
+	readerOptions   *cli.TReaderOptions
+	recordsPerBatch int64 // distinct from readerOptions.RecordsPerBatch for join/repl
+}
+
+func NewRecordReaderParquet(
+	readerOptions *cli.TReaderOptions,
+	recordsPerBatch int64,
+) (*RecordReaderParquet, error) {
+	return &RecordReaderParquet{
+		readerOptions:   readerOptions,
+		recordsPerBatch: recordsPerBatch,
+	}, nil
+}
+
+func (reader *RecordReaderParquet) Read(
+	filenames []string,
+	context types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	errorChannel chan error,
+	downstreamDoneChannel <-chan bool, // for mlr head
+) {
+	if filenames != nil { // nil for mlr -n
+		err := reader.processFiles(filenames, &context, readerChannel, downstreamDoneChannel)
+		if err != nil {
+			errorChannel <- err
+		}
+	}
+	readerChannel <- types.NewEndOfStreamMarkerList(&context)
+}
+
+func (reader *RecordReaderParquet) processFiles(
+	filenames []string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool, // for mlr head
+) error {
+	if len(filenames) == 0 { // read from stdin
+		handle, err := lib.OpenStdin(
+			reader.readerOptions.Prepipe,
+			reader.readerOptions.PrepipeIsRaw,
+			reader.readerOptions.FileInputEncoding,
+		)
+		if err != nil {
+			return err
+		}
+		return reader.processHandle(handle, "(stdin)", context, readerChannel, downstreamDoneChannel)
+	}
+
+	for _, filename := range filenames {
+		handle, err := lib.OpenFileForRead(
+			filename,
+			reader.readerOptions.Prepipe,
+			reader.readerOptions.PrepipeIsRaw,
+			reader.readerOptions.FileInputEncoding,
+		)
+		if err != nil {
+			return err
+		}
+		err = reader.processHandle(handle, filename, context, readerChannel, downstreamDoneChannel)
+		handle.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (reader *RecordReaderParquet) processHandle(
+	handle io.Reader,
+	filename string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool, // for mlr head
+) error {
+	context.UpdateForStartOfFile(filename)
+
+	seekableHandle, err := toReaderAtSeeker(handle)
+	if err != nil {
+		return err
+	}
+
+	parquetReader, err := file.NewParquetReader(seekableHandle)
+	if err != nil {
+		return fmt.Errorf("could not read Parquet file %s: %v", filename, err)
+	}
+	defer parquetReader.Close()
+
+	arrowReader, err := pqarrow.NewFileReader(
+		parquetReader,
+		pqarrow.ArrowReadProperties{BatchSize: reader.recordsPerBatch},
+		memory.DefaultAllocator,
+	)
+	if err != nil {
+		return fmt.Errorf("could not read Parquet file %s: %v", filename, err)
+	}
+
+	recordReader, err := arrowReader.GetRecordReader(gocontext.Background(), nil, nil)
+	if err != nil {
+		return fmt.Errorf("could not read Parquet file %s: %v", filename, err)
+	}
+	defer recordReader.Release()
+
+	for recordReader.Next() {
+		// See if downstream processors will be ignoring further data (e.g. mlr
+		// head).  If so, stop reading. This makes 'mlr head hugefile' exit
+		// quickly, as it should.
+		select {
+		case _ = <-downstreamDoneChannel:
+			return nil
+		default:
+		}
+
+		records, err := arrowRecordToMlrmaps(recordReader.Record(), reader.readerOptions.DedupeFieldNames)
+		if err != nil {
+			return err
+		}
+
+		recordsAndContexts := list.New()
+		for _, record := range records {
+			context.UpdateForInputRecord()
+			recordsAndContexts.PushBack(types.NewRecordAndContext(record, context))
+		}
+		readerChannel <- recordsAndContexts
+	}
+
+	if err := recordReader.Err(); err != nil && err != io.EOF {
+		return fmt.Errorf("could not read Parquet file %s: %v", filename, err)
+	}
+	return nil
+}
+
+// toReaderAtSeeker passes local files through as-is, since Parquet needs random
+// access. Anything else -- stdin, prepipes, decompressors, URLs -- is read
+// fully into memory.
+func toReaderAtSeeker(handle io.Reader) (parquet.ReaderAtSeeker, error) {
+	if seekableHandle, ok := handle.(parquet.ReaderAtSeeker); ok {
+		if _, err := seekableHandle.Seek(0, io.SeekCurrent); err == nil {
+			return seekableHandle, nil
+		}
+	}
+	contents, err := io.ReadAll(handle)
+	if err != nil && !lib.IsEOF(err) {
+		return nil, err
+	}
+	return bytes.NewReader(contents), nil
+}
@@ -0,0 +1,276 @@
+// ================================================================
+// SQLite input reads the rows of one table of a SQLite database file as
+// records. The table is given by --table, or may be omitted if the database
+// has only one table. SQLite needs a file on disk, so non-file input (stdin,
+// prepipes, compressed files) is copied to a temporary file first.
+// ================================================================
+
+package input
+
+import (
+	"container/list"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+type RecordReaderSQLite struct {
+	readerOptions   *cli.TReaderOptions
+	recordsPerBatch int64 // distinct from readerOptions.RecordsPerBatch for join/repl
+}
+
+func NewRecordReaderSQLite(
+	readerOptions *cli.TReaderOptions,
+	recordsPerBatch int64,
+) (*RecordReaderSQLite, error) {
+	return &RecordReaderSQLite{
+		readerOptions:   readerOptions,
+		recordsPerBatch: recordsPerBatch,
+	}, nil
+}
+
+func (reader *RecordReaderSQLite) Read(
+	filenames []string,
+	context types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	errorChannel chan error,
+	downstreamDoneChannel <-chan bool, // for mlr head
+) {
+	if filenames != nil { // nil for mlr -n
+		err := reader.processFiles(filenames, &context, readerChannel, downstreamDoneChannel)
+		if err != nil {
+			errorChannel <- err
+		}
+	}
+	readerChannel <- types.NewEndOfStreamMarkerList(&context)
+}
+
+func (reader *RecordReaderSQLite) processFiles(
+	filenames []string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool, // for mlr head
+) error {
+	if len(filenames) == 0 { // read from stdin
+		handle, err := lib.OpenStdin(
+			reader.readerOptions.Prepipe,
+			reader.readerOptions.PrepipeIsRaw,
+			reader.readerOptions.FileInputEncoding,
+		)
+		if err != nil {
+			return err
+		}
+		return reader.processHandle(handle, "(stdin)", context, readerChannel, downstreamDoneChannel)
+	}
+
+	for _, filename := range filenames {
+		handle, err := lib.OpenFileForRead(
+			filename,
+			reader.readerOptions.Prepipe,
+			reader.readerOptions.PrepipeIsRaw,
+			reader.readerOptions.FileInputEncoding,
+		)
+		if err != nil {
+			return err
+		}
+		err = reader.processHandle(handle, filename, context, readerChannel, downstreamDoneChannel)
+		handle.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (reader *RecordReaderSQLite) processHandle(
+	handle io.Reader,
+	filename string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool, // for mlr head
+) error {
+	context.UpdateForStartOfFile(filename)
+
+	path, isTemp, err := toSQLiteDatabasePath(handle)
+	if err != nil {
+		return err
+	}
+	if isTemp {
+		defer os.Remove(path)
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return fmt.Errorf("could not open SQLite database %s: %v", filename, err)
+	}
+	defer db.Close()
+
+	tableName, err := reader.getTableName(db, filename)
+	if err != nil {
+		return err
+	}
+
+	columnNames, err := lib.SQLiteGetColumnNames(db, tableName)
+	if err != nil {
+		return fmt.Errorf("could not read SQLite table \"%s\" in %s: %v", tableName, filename, err)
+	}
+	if len(columnNames) == 0 {
+		return fmt.Errorf("SQLite database %s has no table \"%s\"", filename, tableName)
+	}
+
+	// The unary plus is a no-op on values of any type, but as the columns are
+	// then expressions rather than column references, the SQLite driver
+	// doesn't convert values in DATE/DATETIME/TIMESTAMP columns to Go times
+	// -- we want those values as they are in the database.
+	selectExpressions := make([]string, len(columnNames))
+	for i, columnName := range columnNames {
+		quotedName := lib.SQLiteQuoteIdentifier(columnName)
+		selectExpressions[i] = "+" + quotedName + " AS " + quotedName
+	}
+	query := "SELECT " + strings.Join(selectExpressions, ", ") +
+		" FROM " + lib.SQLiteQuoteIdentifier(tableName)
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return fmt.Errorf("could not read SQLite table \"%s\" in %s: %v", tableName, filename, err)
+	}
+	defer rows.Close()
+
+	values := make([]interface{}, len(columnNames))
+	pointers := make([]interface{}, len(columnNames))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+
+	recordsAndContexts := list.New()
+	for rows.Next() {
+		err := rows.Scan(pointers...)
+		if err != nil {
+			return fmt.Errorf("could not read SQLite table \"%s\" in %s: %v", tableName, filename, err)
+		}
+
+		record := mlrval.NewMlrmapAsRecord()
+		for i, columnName := range columnNames {
+			record.PutReference(columnName, sqliteValueToMlrval(values[i]))
+		}
+		context.UpdateForInputRecord()
+		recordsAndContexts.PushBack(types.NewRecordAndContext(record, context))
+
+		if int64(recordsAndContexts.Len()) >= reader.recordsPerBatch {
+			readerChannel <- recordsAndContexts
+			recordsAndContexts = list.New()
+
+			// See if downstream processors will be ignoring further data (e.g.
+			// mlr head).  If so, stop reading. This makes 'mlr head hugefile'
+			// exit quickly, as it should.
+			eof := false
+			select {
+			case _ = <-downstreamDoneChannel:
+				eof = true
+				break
+			default:
+				break
+			}
+			if eof {
+				return nil
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("could not read SQLite table \"%s\" in %s: %v", tableName, filename, err)
+	}
+
+	if recordsAndContexts.Len() > 0 {
+		readerChannel <- recordsAndContexts
+	}
+	return nil
+}
+
+// getTableName returns the --table name if given, else the name of the
+// database's only table.
+func (reader *RecordReaderSQLite) getTableName(db *sql.DB, filename string) (string, error) {
+	if reader.readerOptions.SQLiteTableName != "" {
+		return reader.readerOptions.SQLiteTableName, nil
+	}
+
+	rows, err := db.Query(
+		"SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name",
+	)
+	if err != nil {
+		return "", fmt.Errorf("could not read SQLite database %s: %v", filename, err)
+	}
+	defer rows.Close()
+	tableNames := make([]string, 0)
+	for rows.Next() {
+		var tableName string
+		if err := rows.Scan(&tableName); err != nil {
+			return "", fmt.Errorf("could not read SQLite database %s: %v", filename, err)
+		}
+		tableNames = append(tableNames, tableName)
+	}
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("could not read SQLite database %s: %v", filename, err)
+	}
+
+	if len(tableNames) != 1 {
+		return "", fmt.Errorf(
+			"SQLite database %s has %d tables (%s): please specify one with --table",
+			filename, len(tableNames), strings.Join(tableNames, ", "),
+		)
+	}
+	return tableNames[0], nil
+}
+
+// sqliteValueToMlrval maps the SQLite storage classes to Miller types. Text
+// is type-inferred as with other text formats, since numbers are often stored
+// as text in SQLite; NULL is mapped to empty.
+func sqliteValueToMlrval(value interface{}) *mlrval.Mlrval {
+	switch v := value.(type) {
+	case nil:
+		return mlrval.VOID.Copy()
+	case int64:
+		return mlrval.FromInt(v)
+	case float64:
+		return mlrval.FromFloat(v)
+	case string:
+		return mlrval.FromDeferredType(v)
+	case []byte:
+		return mlrval.FromDeferredType(string(v))
+	default:
+		return mlrval.FromString(fmt.Sprintf("%v", v))
+	}
+}
+
+// toSQLiteDatabasePath returns the path of a plain disk file as-is. Other
+// input is copied to a temporary file, which the caller must remove.
+func toSQLiteDatabasePath(handle io.Reader) (path string, isTemp bool, err error) {
+	if file, ok := handle.(*os.File); ok && file != os.Stdin {
+		if info, err := file.Stat(); err == nil && info.Mode().IsRegular() {
+			return file.Name(), false, nil
+		}
+	}
+
+	tempFile, err := os.CreateTemp("", "mlr-sqlite-input-*.db")
+	if err != nil {
+		return "", false, err
+	}
+	_, err = io.Copy(tempFile, handle)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return "", false, err
+	}
+	return tempFile.Name(), true, nil
+}
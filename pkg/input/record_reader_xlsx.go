@@ -0,0 +1,721 @@
+// ================================================================
+// XLSX input reads the rows of one sheet of an Excel workbook as records. An
+// XLSX file is a zip archive of XML documents: the workbook lists the sheets,
+// each sheet has its rows of cells, and text and number formats are kept in
+// the shared-strings and styles documents. Zip archives need random access, so
+// non-seekable input (stdin, prepipes, compressed files) is read into memory
+// first. The sheet's XML is decoded a row at a time.
+// ================================================================
+
+package input
+
+import (
+	"archive/zip"
+	"container/list"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+type RecordReaderXLSX struct {
+	readerOptions   *cli.TReaderOptions
+	recordsPerBatch int64 // distinct from readerOptions.RecordsPerBatch for join/repl
+}
+
+func NewRecordReaderXLSX(
+	readerOptions *cli.TReaderOptions,
+	recordsPerBatch int64,
+) (*RecordReaderXLSX, error) {
+	return &RecordReaderXLSX{
+		readerOptions:   readerOptions,
+		recordsPerBatch: recordsPerBatch,
+	}, nil
+}
+
+func (reader *RecordReaderXLSX) Read(
+	filenames []string,
+	context types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	errorChannel chan error,
+	downstreamDoneChannel <-chan bool, // for mlr head
+) {
+	if filenames != nil { // nil for mlr -n
+		err := reader.processFiles(filenames, &context, readerChannel, downstreamDoneChannel)
+		if err != nil {
+			errorChannel <- err
+		}
+	}
+	readerChannel <- types.NewEndOfStreamMarkerList(&context)
+}
+
+func (reader *RecordReaderXLSX) processFiles(
+	filenames []string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool, // for mlr head
+) error {
+	if len(filenames) == 0 { // read from stdin
+		handle, err := lib.OpenStdin(
+			reader.readerOptions.Prepipe,
+			reader.readerOptions.PrepipeIsRaw,
+			reader.readerOptions.FileInputEncoding,
+		)
+		if err != nil {
+			return err
+		}
+		return reader.processHandle(handle, "(stdin)", context, readerChannel, downstreamDoneChannel)
+	}
+
+	for _, filename := range filenames {
+		handle, err := lib.OpenFileForRead(
+			filename,
+			reader.readerOptions.Prepipe,
+			reader.readerOptions.PrepipeIsRaw,
+			reader.readerOptions.FileInputEncoding,
+		)
+		if err != nil {
+			return err
+		}
+		err = reader.processHandle(handle, filename, context, readerChannel, downstreamDoneChannel)
+		handle.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (reader *RecordReaderXLSX) processHandle(
+	handle io.Reader,
+	filename string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool, // for mlr head
+) error {
+	context.UpdateForStartOfFile(filename)
+
+	seekableHandle, err := toReaderAtSeeker(handle)
+	if err != nil {
+		return err
+	}
+	size, err := seekableHandle.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	archive, err := zip.NewReader(seekableHandle, size)
+	if err != nil {
+		return fmt.Errorf("could not read XLSX file %s: %v", filename, err)
+	}
+
+	workbook, err := openXLSXWorkbook(archive)
+	if err != nil {
+		return fmt.Errorf("could not read XLSX file %s: %v", filename, err)
+	}
+	sheet, err := workbook.selectSheet(reader.readerOptions.XLSXSheet)
+	if err != nil {
+		return fmt.Errorf("XLSX file %s: %v", filename, err)
+	}
+
+	sheetHandle, err := workbook.open(sheet.path)
+	if err != nil {
+		return fmt.Errorf("could not read sheet \"%s\" of XLSX file %s: %v", sheet.name, filename, err)
+	}
+	defer sheetHandle.Close()
+
+	err = reader.processSheet(
+		xml.NewDecoder(sheetHandle), workbook, context, readerChannel, downstreamDoneChannel,
+	)
+	if err != nil {
+		return fmt.Errorf("could not read sheet \"%s\" of XLSX file %s: %v", sheet.name, filename, err)
+	}
+	return nil
+}
+
+func (reader *RecordReaderXLSX) processSheet(
+	decoder *xml.Decoder,
+	workbook *tXLSXWorkbook,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	downstreamDoneChannel <-chan bool, // for mlr head
+) error {
+	headerRowNumber := reader.readerOptions.XLSXHeaderRow
+	var header []string = nil
+	if reader.readerOptions.UseImplicitHeader {
+		header = make([]string, 0)
+	}
+
+	var rowNumber int64 = 0
+	recordsAndContexts := list.New()
+
+	for {
+		row, err := nextXLSXRow(decoder)
+		if err != nil {
+			return err
+		}
+		if row == nil {
+			break
+		}
+
+		// Rows without a row number are numbered consecutively.
+		if row.R > 0 {
+			rowNumber = row.R
+		} else {
+			rowNumber++
+		}
+		if headerRowNumber > 0 && rowNumber < headerRowNumber {
+			continue
+		}
+
+		values, err := reader.getRowValues(row, workbook)
+		if err != nil {
+			return fmt.Errorf("row %d: %v", rowNumber, err)
+		}
+		if values == nil {
+			continue // empty row
+		}
+
+		if header == nil {
+			if headerRowNumber > 0 && rowNumber > headerRowNumber {
+				return fmt.Errorf("header row %d is empty", headerRowNumber)
+			}
+			header = make([]string, len(values))
+			for i, value := range values {
+				if value != nil {
+					header[i] = value.String()
+				}
+			}
+			continue
+		}
+
+		record, err := reader.recordFromRowValues(header, values)
+		if err != nil {
+			return err
+		}
+		context.UpdateForInputRecord()
+		recordsAndContexts.PushBack(types.NewRecordAndContext(record, context))
+
+		if int64(recordsAndContexts.Len()) >= reader.recordsPerBatch {
+			readerChannel <- recordsAndContexts
+			recordsAndContexts = list.New()
+
+			// See if downstream processors will be ignoring further data (e.g.
+			// mlr head).  If so, stop reading. This makes 'mlr head hugefile'
+			// exit quickly, as it should.
+			eof := false
+			select {
+			case _ = <-downstreamDoneChannel:
+				eof = true
+				break
+			default:
+				break
+			}
+			if eof {
+				return nil
+			}
+		}
+	}
+
+	if recordsAndContexts.Len() > 0 {
+		readerChannel <- recordsAndContexts
+	}
+	return nil
+}
+
+// recordFromRowValues keys values by the header, or by one-up column number
+// where the header is empty or too short. Empty cells have empty values, up
+// to the end of the header or the last non-empty cell, whichever is later.
+func (reader *RecordReaderXLSX) recordFromRowValues(
+	header []string,
+	values []*mlrval.Mlrval,
+) (*mlrval.Mlrmap, error) {
+	record := mlrval.NewMlrmapAsRecord()
+	dedupeFieldNames := reader.readerOptions.DedupeFieldNames
+
+	n := len(values)
+	if n < len(header) {
+		n = len(header)
+	}
+	for i := 0; i < n; i++ {
+		var value *mlrval.Mlrval = nil
+		if i < len(values) {
+			value = values[i]
+		}
+		if value == nil {
+			value = mlrval.VOID.Copy()
+		}
+
+		key := ""
+		if i < len(header) {
+			key = header[i]
+		}
+		if key == "" {
+			key = strconv.Itoa(i + 1)
+		}
+		_, err := record.PutReferenceMaybeDedupe(key, value, dedupeFieldNames)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return record, nil
+}
+
+// getRowValues returns the row's cell values indexed by zero-up column, with
+// nil for missing cells, or nil if the row has no non-empty cells.
+func (reader *RecordReaderXLSX) getRowValues(
+	row *tXLSXRow,
+	workbook *tXLSXWorkbook,
+) ([]*mlrval.Mlrval, error) {
+	var values []*mlrval.Mlrval = nil
+	haveAny := false
+	for i := range row.Cells {
+		cell := &row.Cells[i]
+
+		// Cells without a reference follow on from the previous cell.
+		columnIndex := len(values)
+		if cell.R != "" {
+			var ok bool
+			columnIndex, ok = lib.ExcelCellReferenceColumnIndex(cell.R)
+			if !ok {
+				return nil, fmt.Errorf("invalid cell reference \"%s\"", cell.R)
+			}
+		}
+
+		value, err := reader.cellValue(cell, workbook)
+		if err != nil {
+			return nil, fmt.Errorf("cell %s: %v", cell.R, err)
+		}
+		if value == nil {
+			continue
+		}
+
+		for len(values) <= columnIndex {
+			values = append(values, nil)
+		}
+		values[columnIndex] = value
+		haveAny = true
+	}
+	if !haveAny {
+		return nil, nil
+	}
+	return values, nil
+}
+
+// cellValue maps a cell to a Miller value, or nil if the cell is empty.
+// Formula cells have the value computed when the workbook was last saved.
+func (reader *RecordReaderXLSX) cellValue(
+	cell *tXLSXCell,
+	workbook *tXLSXWorkbook,
+) (*mlrval.Mlrval, error) {
+	switch cell.T {
+	case "s": // shared string
+		index, err := strconv.Atoi(strings.TrimSpace(cell.V))
+		if err != nil || index < 0 || index >= len(workbook.sharedStrings) {
+			return nil, fmt.Errorf("invalid shared-string index \"%s\"", cell.V)
+		}
+		return textCellValue(workbook.sharedStrings[index]), nil
+
+	case "inlineStr":
+		if cell.IS == nil {
+			return nil, nil
+		}
+		return textCellValue(cell.IS.text()), nil
+
+	case "str": // formula result
+		return textCellValue(cell.V), nil
+
+	case "b":
+		if cell.V == "" {
+			return nil, nil
+		}
+		return mlrval.FromBool(strings.TrimSpace(cell.V) == "1"), nil
+
+	case "e": // error, such as #DIV/0!
+		return mlrval.FromString(cell.V), nil
+
+	case "d": // ISO 8601 date, which Excel itself doesn't write
+		if cell.V == "" {
+			return nil, nil
+		}
+		return mlrval.FromString(cell.V), nil
+
+	default: // "n" or absent: number
+		text := strings.TrimSpace(cell.V)
+		if text == "" {
+			return nil, nil
+		}
+		if workbook.isDateStyle(cell.S) && reader.readerOptions.XLSXDates != cli.XLSXDatesAsNumbers {
+			serial, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid date \"%s\"", text)
+			}
+			return reader.dateCellValue(serial, workbook.isTimeOnlyStyle(cell.S), workbook.date1904), nil
+		}
+		value := mlrval.FromInferredType(excelNumberText(text))
+		if !value.IsNumeric() {
+			return nil, fmt.Errorf("invalid number \"%s\"", text)
+		}
+		return value, nil
+	}
+}
+
+// excelNumberText rounds numbers to the 15 significant digits which Excel
+// shows, since it saves them with 17: 0.1+0.2 is saved as 0.30000000000000004.
+// Numbers with fewer digits keep their text, as with other formats.
+func excelNumberText(text string) string {
+	mantissa := text
+	if i := strings.IndexAny(text, "eE"); i >= 0 {
+		mantissa = text[:i]
+	}
+	mantissa = strings.TrimLeft(mantissa, "+-0.")
+	mantissa = strings.Replace(mantissa, ".", "", 1)
+	if len(mantissa) <= 15 {
+		return text
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return text
+	}
+	return strconv.FormatFloat(f, 'g', 15, 64)
+}
+
+// textCellValue type-infers text as for CSV, since numbers are often stored
+// as text in spreadsheets.
+func textCellValue(text string) *mlrval.Mlrval {
+	if text == "" {
+		return nil
+	}
+	return mlrval.FromDeferredType(text)
+}
+
+// dateCellValue formats dates as 2024-03-15, or 2024-03-15 09:30:00 if they
+// have times of day, and times of day in time-only formats as 09:30:00. Those
+// are strings rather than times, as they're not points in time.
+func (reader *RecordReaderXLSX) dateCellValue(serial float64, isTimeOnly bool, date1904 bool) *mlrval.Mlrval {
+	t := lib.ExcelSerialToTime(serial, date1904)
+
+	if isTimeOnly && serial < 1 {
+		return mlrval.FromString(t.Format("15:04:05"))
+	}
+
+	goFormat := "2006-01-02"
+	strftimeFormat := "%Y-%m-%d"
+	if t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0 || t.Nanosecond() != 0 {
+		goFormat = "2006-01-02 15:04:05"
+		strftimeFormat = "%Y-%m-%d %H:%M:%S"
+		if t.Nanosecond() != 0 {
+			goFormat = "2006-01-02 15:04:05.000"
+			strftimeFormat = "%Y-%m-%d %H:%M:%3S"
+		}
+	}
+	text := t.Format(goFormat)
+
+	if reader.readerOptions.XLSXDates == cli.XLSXDatesAsStrings {
+		return mlrval.FromString(text)
+	}
+	return mlrval.FromParsedTime(text, t, strftimeFormat)
+}
+
+// ----------------------------------------------------------------
+// The parts of the workbook which are needed for reading a sheet.
+
+type tXLSXWorkbook struct {
+	archive       *zip.Reader
+	sheets        []tXLSXSheet
+	sharedStrings []string
+	dateStyles    []bool // indexed by cell style index
+	timeOnly      []bool // likewise
+	date1904      bool
+}
+
+type tXLSXSheet struct {
+	name string
+	path string // within the zip archive
+}
+
+const xlsxRelationshipTypeOfficeDocument = "/officeDocument"
+const xlsxRelationshipTypeSharedStrings = "/sharedStrings"
+const xlsxRelationshipTypeStyles = "/styles"
+const xlsxRelationshipTypeWorksheet = "/worksheet"
+
+func openXLSXWorkbook(archive *zip.Reader) (*tXLSXWorkbook, error) {
+	workbook := &tXLSXWorkbook{archive: archive}
+
+	// The package relationships say where the workbook is; it's nearly always
+	// xl/workbook.xml.
+	workbookPath := "xl/workbook.xml"
+	packageRelationships, err := workbook.readRelationships("_rels/.rels", "")
+	if err == nil {
+		for _, relationship := range packageRelationships {
+			if strings.HasSuffix(relationship.Type, xlsxRelationshipTypeOfficeDocument) {
+				workbookPath = relationship.Target
+				break
+			}
+		}
+	}
+
+	var workbookXML tXLSXWorkbookXML
+	err = workbook.decode(workbookPath, &workbookXML)
+	if err != nil {
+		return nil, err
+	}
+	workbook.date1904 = workbookXML.WorkbookPr.Date1904 == "1" ||
+		workbookXML.WorkbookPr.Date1904 == "true"
+
+	workbookDirectory := path.Dir(workbookPath)
+	relationshipsPath := path.Join(workbookDirectory, "_rels", path.Base(workbookPath)+".rels")
+	relationships, err := workbook.readRelationships(relationshipsPath, workbookDirectory)
+	if err != nil {
+		return nil, err
+	}
+	targetsByID := make(map[string]string)
+	for _, relationship := range relationships {
+		targetsByID[relationship.ID] = relationship.Target
+		if strings.HasSuffix(relationship.Type, xlsxRelationshipTypeSharedStrings) {
+			err = workbook.readSharedStrings(relationship.Target)
+		} else if strings.HasSuffix(relationship.Type, xlsxRelationshipTypeStyles) {
+			err = workbook.readStyles(relationship.Target)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, sheetXML := range workbookXML.Sheets {
+		target, ok := targetsByID[sheetXML.relationshipID()]
+		if !ok {
+			return nil, fmt.Errorf("sheet \"%s\" not found in workbook", sheetXML.Name)
+		}
+		workbook.sheets = append(workbook.sheets, tXLSXSheet{name: sheetXML.Name, path: target})
+	}
+	return workbook, nil
+}
+
+// selectSheet finds a sheet by name, else by one-up index; the default is the
+// first sheet.
+func (workbook *tXLSXWorkbook) selectSheet(nameOrIndex string) (*tXLSXSheet, error) {
+	if len(workbook.sheets) == 0 {
+		return nil, fmt.Errorf("workbook has no sheets")
+	}
+	if nameOrIndex == "" {
+		return &workbook.sheets[0], nil
+	}
+	for i := range workbook.sheets {
+		if workbook.sheets[i].name == nameOrIndex {
+			return &workbook.sheets[i], nil
+		}
+	}
+	index, err := strconv.Atoi(nameOrIndex)
+	if err == nil && index >= 1 && index <= len(workbook.sheets) {
+		return &workbook.sheets[index-1], nil
+	}
+
+	names := make([]string, len(workbook.sheets))
+	for i := range workbook.sheets {
+		names[i] = workbook.sheets[i].name
+	}
+	return nil, fmt.Errorf(
+		"no sheet \"%s\"; sheets are %s", nameOrIndex, strings.Join(names, ", "),
+	)
+}
+
+func (workbook *tXLSXWorkbook) isDateStyle(styleIndex int) bool {
+	return styleIndex >= 0 && styleIndex < len(workbook.dateStyles) && workbook.dateStyles[styleIndex]
+}
+
+func (workbook *tXLSXWorkbook) isTimeOnlyStyle(styleIndex int) bool {
+	return styleIndex >= 0 && styleIndex < len(workbook.timeOnly) && workbook.timeOnly[styleIndex]
+}
+
+func (workbook *tXLSXWorkbook) open(archivePath string) (io.ReadCloser, error) {
+	// Zip entries are case-sensitive, but some writers aren't consistent
+	// about case between the relationships and the entries.
+	for _, file := range workbook.archive.File {
+		if strings.EqualFold(file.Name, archivePath) {
+			return file.Open()
+		}
+	}
+	return nil, fmt.Errorf("%s not found in archive", archivePath)
+}
+
+func (workbook *tXLSXWorkbook) decode(archivePath string, v interface{}) error {
+	handle, err := workbook.open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer handle.Close()
+	err = xml.NewDecoder(handle).Decode(v)
+	if err != nil {
+		return fmt.Errorf("%s: %v", archivePath, err)
+	}
+	return nil
+}
+
+// readRelationships reads a relationships document, resolving targets
+// relative to the given archive directory.
+func (workbook *tXLSXWorkbook) readRelationships(
+	archivePath string,
+	directory string,
+) ([]tXLSXRelationship, error) {
+	var relationshipsXML tXLSXRelationshipsXML
+	err := workbook.decode(archivePath, &relationshipsXML)
+	if err != nil {
+		return nil, err
+	}
+	for i := range relationshipsXML.Relationships {
+		relationship := &relationshipsXML.Relationships[i]
+		if strings.HasPrefix(relationship.Target, "/") {
+			relationship.Target = strings.TrimPrefix(relationship.Target, "/")
+		} else {
+			relationship.Target = path.Join(directory, relationship.Target)
+		}
+	}
+	return relationshipsXML.Relationships, nil
+}
+
+func (workbook *tXLSXWorkbook) readSharedStrings(archivePath string) error {
+	var sharedStringsXML tXLSXSharedStringsXML
+	err := workbook.decode(archivePath, &sharedStringsXML)
+	if err != nil {
+		return err
+	}
+	workbook.sharedStrings = make([]string, len(sharedStringsXML.Items))
+	for i := range sharedStringsXML.Items {
+		workbook.sharedStrings[i] = sharedStringsXML.Items[i].text()
+	}
+	return nil
+}
+
+func (workbook *tXLSXWorkbook) readStyles(archivePath string) error {
+	var stylesXML tXLSXStylesXML
+	err := workbook.decode(archivePath, &stylesXML)
+	if err != nil {
+		return err
+	}
+	formatCodes := make(map[int]string)
+	for _, numFmt := range stylesXML.NumFmts {
+		formatCodes[numFmt.NumFmtID] = numFmt.FormatCode
+	}
+	workbook.dateStyles = make([]bool, len(stylesXML.CellXfs))
+	workbook.timeOnly = make([]bool, len(stylesXML.CellXfs))
+	for i, xf := range stylesXML.CellXfs {
+		formatCode := formatCodes[xf.NumFmtID]
+		workbook.dateStyles[i] = lib.IsExcelDateFormat(xf.NumFmtID, formatCode)
+		workbook.timeOnly[i] = lib.IsExcelTimeOnlyFormat(xf.NumFmtID, formatCode)
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------
+// XML documents. Elements are matched by local name, so that both the usual
+// (transitional) and the strict OOXML namespaces are accepted.
+
+type tXLSXRelationshipsXML struct {
+	Relationships []tXLSXRelationship `xml:"Relationship"`
+}
+
+type tXLSXRelationship struct {
+	ID     string `xml:"Id,attr"`
+	Type   string `xml:"Type,attr"`
+	Target string `xml:"Target,attr"`
+}
+
+type tXLSXWorkbookXML struct {
+	WorkbookPr struct {
+		Date1904 string `xml:"date1904,attr"`
+	} `xml:"workbookPr"`
+	Sheets []tXLSXSheetXML `xml:"sheets>sheet"`
+}
+
+type tXLSXSheetXML struct {
+	Name  string     `xml:"name,attr"`
+	Attrs []xml.Attr `xml:",any,attr"`
+}
+
+// relationshipID returns the r:id attribute, whose namespace differs between
+// transitional and strict OOXML.
+func (sheetXML *tXLSXSheetXML) relationshipID() string {
+	for _, attr := range sheetXML.Attrs {
+		if attr.Name.Local == "id" && attr.Name.Space != "" {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+type tXLSXSharedStringsXML struct {
+	Items []tXLSXRichText `xml:"si"`
+}
+
+// tXLSXRichText is for shared and inline strings: either plain text, or runs
+// of formatted text. Phonetic runs, for East Asian text, are ignored.
+type tXLSXRichText struct {
+	T    *string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (richText *tXLSXRichText) text() string {
+	if richText.T != nil {
+		return *richText.T
+	}
+	var buffer strings.Builder
+	for _, run := range richText.Runs {
+		buffer.WriteString(run.T)
+	}
+	return buffer.String()
+}
+
+type tXLSXStylesXML struct {
+	NumFmts []struct {
+		NumFmtID   int    `xml:"numFmtId,attr"`
+		FormatCode string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtID int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+type tXLSXRow struct {
+	R     int64       `xml:"r,attr"`
+	Cells []tXLSXCell `xml:"c"`
+}
+
+type tXLSXCell struct {
+	R  string         `xml:"r,attr"`
+	T  string         `xml:"t,attr"`
+	S  int            `xml:"s,attr"`
+	V  string         `xml:"v"`
+	IS *tXLSXRichText `xml:"is"`
+}
+
+// nextXLSXRow decodes the next row of a sheet, or returns nil at the end.
+func nextXLSXRow(decoder *xml.Decoder) (*tXLSXRow, error) {
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		startElement, ok := token.(xml.StartElement)
+		if !ok || startElement.Name.Local != "row" {
+			continue
+		}
+		row := &tXLSXRow{}
+		err = decoder.DecodeElement(row, &startElement)
+		if err != nil {
+			return nil, err
+		}
+		return row, nil
+	}
+}
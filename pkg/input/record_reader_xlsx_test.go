@@ -0,0 +1,20 @@
+package input
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExcelNumberText(t *testing.T) {
+	assert.Equal(t, "17", excelNumberText("17"))
+	assert.Equal(t, "1234.5", excelNumberText("1234.5"))
+	assert.Equal(t, "1.5E-3", excelNumberText("1.5E-3"))
+	assert.Equal(t, "0.000123456789012345", excelNumberText("0.000123456789012345"))
+
+	// Saved with 17 significant digits, shown with 15
+	assert.Equal(t, "0.3", excelNumberText("0.30000000000000004"))
+	assert.Equal(t, "99.99", excelNumberText("99.989999999999995"))
+	assert.Equal(t, "-2.1", excelNumberText("-2.1000000000000001"))
+	assert.Equal(t, "1.23456789012346e-05", excelNumberText("1.2345678901234567E-5"))
+}
@@ -0,0 +1,153 @@
+package input
+
+import (
+	"container/list"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// RecordReaderYAML reads a stream of YAML documents. Each document which is a
+// map is a record, and each element of a document which is a sequence is a
+// record. Empty documents are skipped. YAML has its own comment syntax, so the
+// comments-in-data flags don't apply.
+type RecordReaderYAML struct {
+	readerOptions   *cli.TReaderOptions
+	recordsPerBatch int64 // distinct from readerOptions.RecordsPerBatch for join/repl
+}
+
+func NewRecordReaderYAML(
+	readerOptions *cli.TReaderOptions,
+	recordsPerBatch int64,
+) (*RecordReaderYAML, error) {
+	return &RecordReaderYAML{
+		readerOptions:   readerOptions,
+		recordsPerBatch: recordsPerBatch,
+	}, nil
+}
+
+func (reader *RecordReaderYAML) Read(
+	filenames []string,
+	context types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	errorChannel chan error,
+	downstreamDoneChannel <-chan bool, // for mlr head
+) {
+	if filenames != nil { // nil for mlr -n
+		if len(filenames) == 0 { // read from stdin
+			handle, err := openStdin(reader.readerOptions)
+			if err != nil {
+				errorChannel <- err
+			} else {
+				reader.processHandle(handle, "(stdin)", &context, readerChannel, errorChannel, downstreamDoneChannel)
+			}
+		} else {
+			for _, filename := range filenames {
+				handle, err := openFileForRead(filename, reader.readerOptions)
+				if err != nil {
+					errorChannel <- err
+				} else {
+					reader.processHandle(handle, filename, &context, readerChannel, errorChannel, downstreamDoneChannel)
+					handle.Close()
+				}
+			}
+		}
+	}
+	readerChannel <- types.NewEndOfStreamMarkerList(&context)
+}
+
+// Documents are decoded one at a time, so a stream of many documents isn't
+// held in memory all at once.
+func (reader *RecordReaderYAML) processHandle(
+	handle io.Reader,
+	filename string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	errorChannel chan error,
+	downstreamDoneChannel <-chan bool, // for mlr head
+) {
+	context.UpdateForStartOfFile(filename)
+
+	decoder := yaml.NewDecoder(handle)
+
+	batcher := &tJSONRecordBatcher{
+		recordsPerBatch:       reader.recordsPerBatch,
+		context:               context,
+		readerChannel:         readerChannel,
+		downstreamDoneChannel: downstreamDoneChannel,
+		recordsAndContexts:    list.New(),
+	}
+
+	for !batcher.downstreamDone {
+		var document yaml.Node
+		err := decoder.Decode(&document)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errorChannel <- fmt.Errorf("%s: %v", filename, err)
+			return
+		}
+
+		err = reader.processDocument(&document, batcher)
+		if err != nil {
+			errorChannel <- fmt.Errorf("%s: %v", filename, err)
+			return
+		}
+	}
+
+	if batcher.recordsAndContexts.Len() > 0 {
+		readerChannel <- batcher.recordsAndContexts
+	}
+}
+
+func (reader *RecordReaderYAML) processDocument(
+	document *yaml.Node,
+	batcher *tJSONRecordBatcher,
+) error {
+	if len(document.Content) == 0 {
+		return nil
+	}
+	node := document.Content[0]
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	if node.Kind == yaml.SequenceNode {
+		for _, element := range node.Content {
+			err := reader.putRecord(element, batcher)
+			if err != nil || batcher.downstreamDone {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null" {
+		return nil
+	}
+
+	return reader.putRecord(node, batcher)
+}
+
+func (reader *RecordReaderYAML) putRecord(
+	node *yaml.Node,
+	batcher *tJSONRecordBatcher,
+) error {
+	value, err := mlrval.MlrvalFromYAMLNode(node)
+	if err != nil {
+		return err
+	}
+	if !value.IsMap() {
+		return fmt.Errorf(
+			"valid but unmillerable YAML at line %d. Expected map; got %s",
+			node.Line, value.GetTypeName(),
+		)
+	}
+	return batcher.put(value)
+}
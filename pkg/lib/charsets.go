@@ -0,0 +1,399 @@
+// ================================================================
+// Character-set transcoding for --iencoding and --oencoding. Miller works in
+// UTF-8 internally; input in other character sets is decoded to UTF-8 as it's
+// read, and output is encoded from UTF-8 as it's written.
+//
+// This is distinct from TFileInputEncoding, which is about compression.
+// ================================================================
+
+package lib
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
+	"golang.org/x/text/transform"
+)
+
+type TCharsetErrorPolicy int
+
+const (
+	// Invalid input, or output characters not in the output character set,
+	// are an error.
+	CharsetErrorsStrict TCharsetErrorPolicy = iota
+	// Invalid input becomes U+FFFD, and output characters not in the output
+	// character set become the character set's substitute character.
+	CharsetErrorsReplace
+)
+
+// LookupCharset accepts the names at https://encoding.spec.whatwg.org/#names-and-labels,
+// such as utf-8, windows-1252 (or cp1252, or latin1), and shift_jis, along
+// with utf-32, utf-32le, and utf-32be. For utf-16 and utf-32 without an
+// endianness, input byte-order marks are honored, else little-endian is
+// assumed; output is little-endian with a byte-order mark.
+func LookupCharset(name string) (encoding.Encoding, error) {
+	return lookupCharset(name, false)
+}
+
+// lookupCharset is as LookupCharset, except that for input, byte-order marks
+// are honored -- and removed -- for all the UTF-16 and UTF-32 variants, even
+// those with an explicit endianness.
+func lookupCharset(name string, forInput bool) (encoding.Encoding, error) {
+	explicitBOMPolicy16 := unicode.IgnoreBOM
+	explicitBOMPolicy32 := utf32.IgnoreBOM
+	if forInput {
+		explicitBOMPolicy16 = unicode.UseBOM
+		explicitBOMPolicy32 = utf32.UseBOM
+	}
+
+	switch strings.ToLower(strings.ReplaceAll(name, "_", "-")) {
+	case "utf-16", "utf16":
+		return unicode.UTF16(unicode.LittleEndian, unicode.UseBOM), nil
+	case "utf-16le", "utf16le":
+		return unicode.UTF16(unicode.LittleEndian, explicitBOMPolicy16), nil
+	case "utf-16be", "utf16be":
+		return unicode.UTF16(unicode.BigEndian, explicitBOMPolicy16), nil
+	case "utf-32", "utf32":
+		return utf32.UTF32(utf32.LittleEndian, utf32.UseBOM), nil
+	case "utf-32le", "utf32le":
+		return utf32.UTF32(utf32.LittleEndian, explicitBOMPolicy32), nil
+	case "utf-32be", "utf32be":
+		return utf32.UTF32(utf32.BigEndian, explicitBOMPolicy32), nil
+	case "utf8":
+		return unicode.UTF8, nil
+	}
+	charset, err := htmlindex.Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("character encoding \"%s\" not found", name)
+	}
+	return charset, nil
+}
+
+// NewCharsetDecodingReader wraps an input handle so that it reads as UTF-8.
+// With an empty charset name, input is passed through as-is unless it starts
+// with a UTF-16 or UTF-32 byte-order mark. Since the record-readers don't all
+// check for read errors, in strict mode invalid input is a fatal error.
+func NewCharsetDecodingReader(
+	handle io.ReadCloser,
+	filename string,
+	charsetName string,
+	policy TCharsetErrorPolicy,
+) (io.ReadCloser, error) {
+	if charsetName == "" {
+		return newBOMSniffingReader(handle, filename, policy), nil
+	}
+	charset, err := lookupCharset(charsetName, true)
+	if err != nil {
+		return nil, err
+	}
+	return newCharsetDecodingReader(handle, filename, charset, charsetName, policy), nil
+}
+
+func newCharsetDecodingReader(
+	handle io.ReadCloser,
+	filename string,
+	charset encoding.Encoding,
+	charsetName string,
+	policy TCharsetErrorPolicy,
+) io.ReadCloser {
+	var transformer transform.Transformer
+	if charset == unicode.UTF8 {
+		if policy == CharsetErrorsStrict {
+			transformer = &charsetStrictChecker{charsetName: charsetName, checkUTF8: true}
+		} else {
+			transformer = unicode.UTF8.NewDecoder()
+		}
+	} else {
+		transformer = charset.NewDecoder()
+		if policy == CharsetErrorsStrict {
+			transformer = transform.Chain(transformer, &charsetStrictChecker{charsetName: charsetName})
+		}
+	}
+	return &charsetReadCloser{
+		originalHandle: handle,
+		reader:         transform.NewReader(handle, transformer),
+		filename:       filename,
+	}
+}
+
+// charsetReadCloser remedies the fact that transform.Reader does not
+// implement io.ReadCloser.
+type charsetReadCloser struct {
+	originalHandle io.ReadCloser
+	reader         io.Reader
+	filename       string
+}
+
+func (rc *charsetReadCloser) Read(p []byte) (n int, err error) {
+	n, err = rc.reader.Read(p)
+	var decodingError *charsetDecodingError
+	if errors.As(err, &decodingError) {
+		fmt.Fprintf(os.Stderr, "mlr: %s: %v.\n", rc.filename, err)
+		os.Exit(1)
+	}
+	return n, err
+}
+
+func (rc *charsetReadCloser) Close() error {
+	return rc.originalHandle.Close()
+}
+
+// charsetStrictChecker is a transformer which passes along UTF-8 unchanged
+// but errors on invalid UTF-8, or, after decoding from another character set,
+// on the U+FFFD replacement character which the decoder substitutes for
+// invalid input.
+type charsetStrictChecker struct {
+	charsetName string
+	checkUTF8   bool
+	offset      int64
+}
+
+var utf8ReplacementCharacter = []byte(string(utf8.RuneError))
+
+func (checker *charsetStrictChecker) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		r, size := utf8.DecodeRune(src[nSrc:])
+		if r == utf8.RuneError {
+			if !atEOF && !utf8.FullRune(src[nSrc:]) {
+				err = transform.ErrShortSrc
+				break
+			}
+			if checker.checkUTF8 && size == 1 {
+				return nDst, nSrc, &charsetDecodingError{checker.charsetName, checker.offset + int64(nSrc)}
+			}
+			if !checker.checkUTF8 && bytes.HasPrefix(src[nSrc:], utf8ReplacementCharacter) {
+				return nDst, nSrc, &charsetDecodingError{checker.charsetName, -1}
+			}
+		}
+		if nDst+size > len(dst) {
+			err = transform.ErrShortDst
+			break
+		}
+		nDst += copy(dst[nDst:], src[nSrc:nSrc+size])
+		nSrc += size
+	}
+	checker.offset += int64(nSrc)
+	return nDst, nSrc, err
+}
+
+func (checker *charsetStrictChecker) Reset() {
+	checker.offset = 0
+}
+
+// charsetDecodingError is for invalid input in strict mode. The byte offset is
+// known only for UTF-8, since for other character sets the checking is done
+// after decoding.
+type charsetDecodingError struct {
+	charsetName string
+	offset      int64 // -1 if unknown
+}
+
+func (e *charsetDecodingError) Error() string {
+	if e.offset < 0 {
+		return fmt.Sprintf("input is not valid %s", e.charsetName)
+	}
+	return fmt.Sprintf("input is not valid %s at byte %d", e.charsetName, e.offset)
+}
+
+// ----------------------------------------------------------------
+// bomSniffingReader looks at the first few bytes of input for a UTF-16 or
+// UTF-32 byte-order mark. If there is one, the input is decoded accordingly;
+// otherwise it's passed along as-is. UTF-8 byte-order marks are left for the
+// record-readers to handle.
+type bomSniffingReader struct {
+	originalHandle io.ReadCloser
+	filename       string
+	policy         TCharsetErrorPolicy
+	reader         io.Reader // nil until the sniffing is done
+}
+
+func newBOMSniffingReader(handle io.ReadCloser, filename string, policy TCharsetErrorPolicy) *bomSniffingReader {
+	return &bomSniffingReader{
+		originalHandle: handle,
+		filename:       filename,
+		policy:         policy,
+	}
+}
+
+var charsetBOMs = []struct {
+	bom         []byte
+	charsetName string
+}{
+	// UTF-32LE before UTF-16LE since the latter's BOM is a prefix of the former's.
+	{[]byte{0xff, 0xfe, 0x00, 0x00}, "utf-32le"},
+	{[]byte{0x00, 0x00, 0xfe, 0xff}, "utf-32be"},
+	{[]byte{0xff, 0xfe}, "utf-16le"},
+	{[]byte{0xfe, 0xff}, "utf-16be"},
+}
+
+func (bsr *bomSniffingReader) Read(p []byte) (n int, err error) {
+	if bsr.reader == nil {
+		bsr.sniff()
+	}
+	return bsr.reader.Read(p)
+}
+
+// sniff reads only as many bytes as it needs to decide, so that line-at-a-time
+// input from a pipe isn't held up.
+func (bsr *bomSniffingReader) sniff() {
+	prefix := make([]byte, 0, 4)
+	var err error
+	for err == nil && couldBeBOMPrefix(prefix) {
+		var n int
+		n, err = bsr.originalHandle.Read(prefix[len(prefix):cap(prefix)])
+		prefix = prefix[:len(prefix)+n]
+	}
+
+	// Put back what was read, along with any error which came with it.
+	var reader io.Reader = bytes.NewReader(prefix)
+	if err != nil {
+		reader = io.MultiReader(reader, &errorReader{err})
+	} else {
+		reader = io.MultiReader(reader, bsr.originalHandle)
+	}
+	bsr.reader = reader
+
+	for _, entry := range charsetBOMs {
+		if bytes.HasPrefix(prefix, entry.bom) {
+			charset, _ := lookupCharset(entry.charsetName, true)
+			bsr.reader = newCharsetDecodingReader(
+				io.NopCloser(reader), bsr.filename, charset, entry.charsetName, bsr.policy,
+			)
+			return
+		}
+	}
+}
+
+// couldBeBOMPrefix tells whether more input might make for a longer
+// byte-order mark than what's been seen so far.
+func couldBeBOMPrefix(prefix []byte) bool {
+	for _, entry := range charsetBOMs {
+		if len(prefix) < len(entry.bom) && bytes.HasPrefix(entry.bom, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (bsr *bomSniffingReader) Close() error {
+	return bsr.originalHandle.Close()
+}
+
+type errorReader struct {
+	err error
+}
+
+func (er *errorReader) Read(p []byte) (n int, err error) {
+	return 0, er.err
+}
+
+// ----------------------------------------------------------------
+
+// NewCharsetEncodingWriter wraps an output handle so that UTF-8 written to it
+// is encoded in the given character set. Since the record-writers don't check
+// for write errors, in strict mode an unencodable character is a fatal error.
+func NewCharsetEncodingWriter(
+	handle io.WriteCloser,
+	charsetName string,
+	policy TCharsetErrorPolicy,
+) (io.WriteCloser, error) {
+	charset, err := LookupCharset(charsetName)
+	if err != nil {
+		return nil, err
+	}
+	encoder := charset.NewEncoder()
+	if policy == CharsetErrorsReplace {
+		encoder = encoding.ReplaceUnsupported(encoder)
+	}
+	return &charsetWriteCloser{
+		originalHandle: handle,
+		writer:         transform.NewWriter(handle, encoder),
+		charsetName:    charsetName,
+	}, nil
+}
+
+type charsetWriteCloser struct {
+	originalHandle io.WriteCloser
+	writer         *transform.Writer
+	charsetName    string
+}
+
+func (wc *charsetWriteCloser) Write(p []byte) (n int, err error) {
+	n, err = wc.writer.Write(p)
+	if err != nil {
+		wc.exitOnError(err)
+	}
+	return n, err
+}
+
+// Close flushes any partial character held by the encoder. It does not close
+// the original handle, which may be standard output.
+func (wc *charsetWriteCloser) Close() error {
+	err := wc.writer.Close()
+	if err != nil {
+		wc.exitOnError(err)
+	}
+	return err
+}
+
+func (wc *charsetWriteCloser) exitOnError(err error) {
+	fmt.Fprintf(os.Stderr, "mlr: cannot write output as %s: %v\n", wc.charsetName, err)
+	os.Exit(1)
+}
+
+// ----------------------------------------------------------------
+
+// CharsetChecker finds characters which aren't in a character set. In strict
+// mode, output is checked with this a record at a time, so that a record which
+// can't be written is an error before any of it is written.
+type CharsetChecker struct {
+	encoder *encoding.Encoder
+}
+
+func NewCharsetChecker(charsetName string) (*CharsetChecker, error) {
+	charset, err := LookupCharset(charsetName)
+	if err != nil {
+		return nil, err
+	}
+	return &CharsetChecker{
+		encoder: charset.NewEncoder(),
+	}, nil
+}
+
+// Check returns the byte offset in s of the first character which isn't in
+// the character set, or -1 if they all are.
+func (checker *CharsetChecker) Check(s string) int {
+	if isASCII(s) {
+		return -1
+	}
+	_, err := checker.encoder.String(s)
+	if err == nil {
+		return -1
+	}
+	for offset, r := range s {
+		_, err := checker.encoder.String(string(r))
+		if err != nil {
+			return offset
+		}
+	}
+	// Not any one character, for stateful character sets
+	return 0
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+// ================================================================
+// Most Miller tests (thousands of them) are command-line-driven via
+// mlr regtest. Here are some cases needing special focus.
+// ================================================================
+
+package lib
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/transform"
+)
+
+type tDataForCharsetDecoding struct {
+	charsetName    string
+	policy         TCharsetErrorPolicy
+	input          string
+	expectedOutput string
+}
+
+var dataForCharsetDecoding = []tDataForCharsetDecoding{
+	// No charset name: only UTF-16 and UTF-32 byte-order marks are acted on.
+	{"", CharsetErrorsStrict, "", ""},
+	{"", CharsetErrorsStrict, "a=1\n", "a=1\n"},
+	{"", CharsetErrorsStrict, "\xff", "\xff"},
+	{"", CharsetErrorsStrict, "\xff\xfe", ""},
+	{"", CharsetErrorsStrict, "\xef\xbb\xbfa=1\n", "\xef\xbb\xbfa=1\n"},
+	{"", CharsetErrorsStrict, "\xff\xfea\x00=\x00\xe9\x00\n\x00", "a=é\n"},
+	{"", CharsetErrorsStrict, "\xfe\xff\x00a\x00=\x00\xe9\x00\n", "a=é\n"},
+	{"", CharsetErrorsStrict, "\xff\xfe\x00\x00a\x00\x00\x00\n\x00\x00\x00", "a\n"},
+	{"", CharsetErrorsStrict, "\x00\x00\xfe\xff\x00\x00\x00a\x00\x00\x00\n", "a\n"},
+
+	// Byte-order marks are honored and removed even with explicit endianness.
+	{"utf-16", CharsetErrorsStrict, "a\x00\n\x00", "a\n"},
+	{"utf-16", CharsetErrorsStrict, "\xfe\xff\x00a\x00\n", "a\n"},
+	{"utf-16le", CharsetErrorsStrict, "\xff\xfea\x00\n\x00", "a\n"},
+	{"UTF-16BE", CharsetErrorsStrict, "\x00a\x00\n", "a\n"},
+	{"utf-32le", CharsetErrorsStrict, "a\x00\x00\x00", "a"},
+
+	{"utf-8", CharsetErrorsStrict, "a=\xc3\xa9\n", "a=é\n"},
+	{"utf-8", CharsetErrorsReplace, "a=\xff\n", "a=�\n"},
+	{"shift_jis", CharsetErrorsStrict, "\x8d\xb2\x93\xa1", "佐藤"},
+	{"cp1252", CharsetErrorsStrict, "caf\xe9 \x80", "café €"},
+	{"latin1", CharsetErrorsStrict, "caf\xe9", "café"},
+}
+
+func TestCharsetDecodingReader(t *testing.T) {
+	for i, entry := range dataForCharsetDecoding {
+		reader, err := NewCharsetDecodingReader(
+			io.NopCloser(bytes.NewReader([]byte(entry.input))),
+			"test-input",
+			entry.charsetName,
+			entry.policy,
+		)
+		assert.Nil(t, err, "case %d", i)
+		output, err := io.ReadAll(reader)
+		assert.Nil(t, err, "case %d", i)
+		assert.Equal(t, entry.expectedOutput, string(output), "case %d", i)
+	}
+}
+
+func TestCharsetStrictChecker(t *testing.T) {
+	output, _, err := transform.String(
+		&charsetStrictChecker{charsetName: "utf-8", checkUTF8: true},
+		"a=�\n",
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, "a=�\n", output)
+
+	_, _, err = transform.String(
+		&charsetStrictChecker{charsetName: "utf-8", checkUTF8: true},
+		"a=1\nb=\xff\n",
+	)
+	assert.NotNil(t, err)
+	assert.Equal(t, "input is not valid utf-8 at byte 6", err.Error())
+
+	_, _, err = transform.String(
+		&charsetStrictChecker{charsetName: "shift_jis"},
+		"a=�\n",
+	)
+	assert.NotNil(t, err)
+	assert.Equal(t, "input is not valid shift_jis", err.Error())
+}
+
+type tDataForCharsetEncoding struct {
+	charsetName    string
+	policy         TCharsetErrorPolicy
+	input          string
+	expectedOutput string
+}
+
+var dataForCharsetEncoding = []tDataForCharsetEncoding{
+	{"utf-16", CharsetErrorsStrict, "a\n", "\xff\xfea\x00\n\x00"},
+	{"utf-16le", CharsetErrorsStrict, "a\n", "a\x00\n\x00"},
+	{"utf-16be", CharsetErrorsStrict, "a\n", "\x00a\x00\n"},
+	{"utf-32be", CharsetErrorsStrict, "a", "\x00\x00\x00a"},
+	{"shift_jis", CharsetErrorsStrict, "佐藤", "\x8d\xb2\x93\xa1"},
+	{"windows-1252", CharsetErrorsStrict, "café €", "caf\xe9 \x80"},
+	{"windows-1252", CharsetErrorsReplace, "a=佐藤", "a=\x1a\x1a"},
+}
+
+func TestCharsetEncodingWriter(t *testing.T) {
+	for i, entry := range dataForCharsetEncoding {
+		var buffer bytes.Buffer
+		writer, err := NewCharsetEncodingWriter(nopWriteCloser{&buffer}, entry.charsetName, entry.policy)
+		assert.Nil(t, err, "case %d", i)
+		// Split the input mid-character to check the encoder's buffering.
+		input := []byte(entry.input)
+		half := len(input)/2 + 1
+		if half > len(input) {
+			half = len(input)
+		}
+		writer.Write(input[:half])
+		writer.Write(input[half:])
+		writer.Close()
+		assert.Equal(t, entry.expectedOutput, buffer.String(), "case %d", i)
+	}
+}
+
+func TestCharsetChecker(t *testing.T) {
+	checker, err := NewCharsetChecker("windows-1252")
+	assert.Nil(t, err)
+	assert.Equal(t, -1, checker.Check("abc"))
+	assert.Equal(t, -1, checker.Check("café €"))
+	assert.Equal(t, 6, checker.Check("café 佐藤"))
+
+	checker, err = NewCharsetChecker("shift_jis")
+	assert.Nil(t, err)
+	assert.Equal(t, -1, checker.Check("佐藤"))
+	assert.Equal(t, 3, checker.Check("Café"))
+
+	_, err = NewCharsetChecker("nosuch")
+	assert.NotNil(t, err)
+}
+
+func TestLookupCharset(t *testing.T) {
+	_, err := LookupCharset("nosuch")
+	assert.NotNil(t, err)
+	assert.Equal(t, `character encoding "nosuch" not found`, err.Error())
+
+	for _, name := range []string{"utf-8", "utf8", "UTF-16", "utf_16le", "utf-32", "sjis", "cp1252", "iso-8859-1"} {
+		_, err := LookupCharset(name)
+		assert.Nil(t, err, name)
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,324 @@
+// ================================================================
+// Arbitrary-precision decimal numbers, for mlr --decimal. A decimal is an
+// arbitrary-size integer along with a scale, the number of digits after the
+// decimal point: e.g. 12.340 is 12340 with scale 3. Addition, subtraction,
+// and multiplication are exact, and keep scale as in pencil-and-paper
+// arithmetic: 0.10 + 0.2 is 0.30, and 1.5 * 1.25 is 1.875. Division is exact
+// up to DecimalDivisionScale digits after the decimal point. Rounding is half
+// away from zero, as is customary for currency.
+//
+// Values are immutable: all operations return new decimals.
+// ================================================================
+
+package lib
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DecimalDivisionScale is the minimum number of digits after the decimal
+// point for quotients which don't terminate, such as 1/3.
+const DecimalDivisionScale = 18
+
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32 // always non-negative
+}
+
+var bigTen = big.NewInt(10)
+
+func NewDecimalFromInt64(intValue int64) *Decimal {
+	return &Decimal{unscaled: big.NewInt(intValue), scale: 0}
+}
+
+func NewDecimalFromBigInt(bigValue *big.Int) *Decimal {
+	return &Decimal{unscaled: new(big.Int).Set(bigValue), scale: 0}
+}
+
+// TryDecimalFromString parses plain or scientific decimal notation such as
+// "12.34", "-.5", "7.", or "1.25e3". Hex, binary, octal, infinities, and NaN
+// are not decimals.
+func TryDecimalFromString(input string) (*Decimal, bool) {
+	s := input
+	negative := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	exponent := int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return nil, false
+		}
+		exponent = e
+		s = s[:i]
+	}
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if intPart == "" && fracPart == "" {
+		return nil, false
+	}
+	if !isAllDigits(intPart) || !isAllDigits(fracPart) {
+		return nil, false
+	}
+
+	unscaled, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return nil, false
+	}
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+
+	scale := int64(len(fracPart)) - exponent
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(int32(-scale)))
+		scale = 0
+	}
+	if scale > 1<<30 {
+		return nil, false
+	}
+	return &Decimal{unscaled: unscaled, scale: int32(scale)}, true
+}
+
+func isAllDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// NewDecimalFromFloat64 gives the shortest decimal which round-trips to the
+// float, e.g. 0.1 rather than 0.1000000000000000055511151231257827. It
+// returns false for infinities and NaN.
+func NewDecimalFromFloat64(floatValue float64) (*Decimal, bool) {
+	return TryDecimalFromString(strconv.FormatFloat(floatValue, 'f', -1, 64))
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// ----------------------------------------------------------------
+// ACCESSORS
+
+func (d *Decimal) String() string {
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if d.scale > 0 {
+		if len(digits) <= int(d.scale) {
+			digits = strings.Repeat("0", int(d.scale)-len(digits)+1) + digits
+		}
+		n := len(digits) - int(d.scale)
+		digits = digits[:n] + "." + digits[n:]
+	}
+	if d.unscaled.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+func (d *Decimal) Scale() int32 {
+	return d.scale
+}
+
+func (d *Decimal) Sign() int {
+	return d.unscaled.Sign()
+}
+
+func (d *Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Rat returns the exact value as a rational number.
+func (d *Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.unscaled, pow10(d.scale))
+}
+
+// IsInteger is true when there are no non-zero digits after the decimal
+// point.
+func (d *Decimal) IsInteger() bool {
+	if d.scale == 0 {
+		return true
+	}
+	return new(big.Int).Rem(d.unscaled, pow10(d.scale)).Sign() == 0
+}
+
+// Int64 returns the integer part, and whether that fits in 64 bits.
+func (d *Decimal) Int64() (int64, bool) {
+	intPart := d.Trunc().unscaled
+	return intPart.Int64(), intPart.IsInt64()
+}
+
+// BigInt returns the integer part.
+func (d *Decimal) BigInt() *big.Int {
+	return new(big.Int).Set(d.Trunc().unscaled)
+}
+
+// ----------------------------------------------------------------
+// ARITHMETIC
+
+// rescale returns the unscaled value at a scale at least as large as the
+// current one.
+func (d *Decimal) rescale(scale int32) *big.Int {
+	if scale == d.scale {
+		return d.unscaled
+	}
+	return new(big.Int).Mul(d.unscaled, pow10(scale-d.scale))
+}
+
+func maxScale(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func (d *Decimal) Add(other *Decimal) *Decimal {
+	scale := maxScale(d.scale, other.scale)
+	sum := new(big.Int).Add(d.rescale(scale), other.rescale(scale))
+	return &Decimal{unscaled: sum, scale: scale}
+}
+
+func (d *Decimal) Sub(other *Decimal) *Decimal {
+	scale := maxScale(d.scale, other.scale)
+	difference := new(big.Int).Sub(d.rescale(scale), other.rescale(scale))
+	return &Decimal{unscaled: difference, scale: scale}
+}
+
+func (d *Decimal) Mul(other *Decimal) *Decimal {
+	product := new(big.Int).Mul(d.unscaled, other.unscaled)
+	return &Decimal{unscaled: product, scale: d.scale + other.scale}
+}
+
+func (d *Decimal) Neg() *Decimal {
+	return &Decimal{unscaled: new(big.Int).Neg(d.unscaled), scale: d.scale}
+}
+
+func (d *Decimal) Abs() *Decimal {
+	return &Decimal{unscaled: new(big.Int).Abs(d.unscaled), scale: d.scale}
+}
+
+func (d *Decimal) Cmp(other *Decimal) int {
+	scale := maxScale(d.scale, other.scale)
+	return d.rescale(scale).Cmp(other.rescale(scale))
+}
+
+// Quo returns the quotient, or false on division by zero. Quotients which
+// terminate are exact, with trailing zeroes trimmed down to the larger of
+// the two scales: e.g. 10.00 / 4 is 2.50. Others are rounded at
+// DecimalDivisionScale digits.
+func (d *Decimal) Quo(other *Decimal) (*Decimal, bool) {
+	if other.unscaled.Sign() == 0 {
+		return nil, false
+	}
+	minScale := maxScale(d.scale, other.scale)
+	scale := maxScale(minScale, DecimalDivisionScale)
+
+	// d.unscaled / 10^d.scale / (other.unscaled / 10^other.scale), times
+	// 10^scale for the result's unscaled value
+	numerator := new(big.Int).Mul(d.unscaled, pow10(scale-d.scale+other.scale))
+	quotient := roundedQuotient(numerator, other.unscaled)
+
+	result := &Decimal{unscaled: quotient, scale: scale}
+	return result.trimTrailingZeroes(minScale), true
+}
+
+// QuoFloor returns the quotient rounded toward negative infinity, as for the
+// // operator, or false on division by zero.
+func (d *Decimal) QuoFloor(other *Decimal) (*Decimal, bool) {
+	if other.unscaled.Sign() == 0 {
+		return nil, false
+	}
+	scale := maxScale(d.scale, other.scale)
+	quotient := floorQuotient(d.rescale(scale), other.rescale(scale))
+	return &Decimal{unscaled: quotient, scale: 0}, true
+}
+
+// Mod returns the remainder having the sign of the divisor, as for the %
+// operator, or false on division by zero.
+func (d *Decimal) Mod(other *Decimal) (*Decimal, bool) {
+	quotient, ok := d.QuoFloor(other)
+	if !ok {
+		return nil, false
+	}
+	return d.Sub(quotient.Mul(other)), true
+}
+
+// Round rounds half away from zero to the given number of digits after the
+// decimal point, padding with zeroes if there are fewer.
+func (d *Decimal) Round(scale int32) *Decimal {
+	if scale >= d.scale {
+		return &Decimal{unscaled: d.rescale(scale), scale: scale}
+	}
+	quotient := roundedQuotient(d.unscaled, pow10(d.scale-scale))
+	return &Decimal{unscaled: quotient, scale: scale}
+}
+
+// Trunc rounds toward zero to an integer.
+func (d *Decimal) Trunc() *Decimal {
+	if d.scale == 0 {
+		return d
+	}
+	quotient := new(big.Int).Quo(d.unscaled, pow10(d.scale))
+	return &Decimal{unscaled: quotient, scale: 0}
+}
+
+func (d *Decimal) Floor() *Decimal {
+	if d.scale == 0 {
+		return d
+	}
+	return &Decimal{unscaled: floorQuotient(d.unscaled, pow10(d.scale)), scale: 0}
+}
+
+func (d *Decimal) Ceil() *Decimal {
+	return d.Neg().Floor().Neg()
+}
+
+func (d *Decimal) trimTrailingZeroes(minScale int32) *Decimal {
+	unscaled := d.unscaled
+	scale := d.scale
+	remainder := new(big.Int)
+	for scale > minScale {
+		quotient, r := new(big.Int).QuoRem(unscaled, bigTen, remainder)
+		if r.Sign() != 0 {
+			break
+		}
+		unscaled = quotient
+		scale--
+	}
+	return &Decimal{unscaled: unscaled, scale: scale}
+}
+
+// roundedQuotient is a/b rounded half away from zero.
+func roundedQuotient(a, b *big.Int) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(a, b, new(big.Int))
+	twiceRemainder := new(big.Int).Abs(remainder)
+	twiceRemainder.Lsh(twiceRemainder, 1)
+	if twiceRemainder.CmpAbs(b) >= 0 {
+		if (a.Sign() < 0) != (b.Sign() < 0) {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient
+}
+
+// floorQuotient is a/b rounded toward negative infinity.
+func floorQuotient(a, b *big.Int) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(a, b, new(big.Int))
+	if remainder.Sign() != 0 && (remainder.Sign() < 0) != (b.Sign() < 0) {
+		quotient.Sub(quotient, big.NewInt(1))
+	}
+	return quotient
+}
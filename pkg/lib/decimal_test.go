@@ -0,0 +1,82 @@
+// ================================================================
+// Most Miller tests (thousands of them) are command-line-driven via
+// mlr regtest. Here are some cases needing special focus.
+// ================================================================
+
+package lib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustDecimal(t *testing.T, input string) *Decimal {
+	d, ok := TryDecimalFromString(input)
+	assert.True(t, ok, input)
+	return d
+}
+
+func TestDecimalParseAndString(t *testing.T) {
+	cases := [][2]string{
+		{"0", "0"},
+		{"12.340", "12.340"},
+		{"-0.05", "-0.05"},
+		{"+.5", "0.5"},
+		{"7.", "7"},
+		{"1.25e3", "1250"},
+		{"125e-4", "0.0125"},
+		{"123456789012345678901234567890.5", "123456789012345678901234567890.5"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c[1], mustDecimal(t, c[0]).String(), c[0])
+	}
+
+	for _, input := range []string{"", "-", ".", "abc", "0x10", "1e", "NaN", "Inf", "1.2.3"} {
+		_, ok := TryDecimalFromString(input)
+		assert.False(t, ok, input)
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := mustDecimal(t, "0.1")
+	b := mustDecimal(t, "0.2")
+	assert.Equal(t, "0.3", a.Add(b).String())
+	assert.Equal(t, "0.30", mustDecimal(t, "0.10").Add(b).String())
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	assert.Equal(t, "1.875", mustDecimal(t, "1.5").Mul(mustDecimal(t, "1.25")).String())
+
+	q, ok := mustDecimal(t, "10.00").Quo(NewDecimalFromInt64(4))
+	assert.True(t, ok)
+	assert.Equal(t, "2.50", q.String())
+	q, _ = NewDecimalFromInt64(1).Quo(NewDecimalFromInt64(3))
+	assert.Equal(t, "0.333333333333333333", q.String())
+	q, _ = NewDecimalFromInt64(-2).Quo(NewDecimalFromInt64(3))
+	assert.Equal(t, "-0.666666666666666667", q.String())
+	_, ok = a.Quo(NewDecimalFromInt64(0))
+	assert.False(t, ok)
+
+	q, _ = mustDecimal(t, "-7.5").QuoFloor(NewDecimalFromInt64(2))
+	assert.Equal(t, "-4", q.String())
+	m, _ := mustDecimal(t, "-7.5").Mod(NewDecimalFromInt64(2))
+	assert.Equal(t, "0.5", m.String())
+
+	assert.Equal(t, -1, a.Cmp(b))
+	assert.Equal(t, 0, mustDecimal(t, "0.10").Cmp(a))
+}
+
+func TestDecimalRounding(t *testing.T) {
+	assert.Equal(t, "2.68", mustDecimal(t, "2.675").Round(2).String())
+	assert.Equal(t, "-2.68", mustDecimal(t, "-2.675").Round(2).String())
+	assert.Equal(t, "2.67", mustDecimal(t, "2.6749").Round(2).String())
+	assert.Equal(t, "1.50", mustDecimal(t, "1.5").Round(2).String())
+	assert.Equal(t, "-3", mustDecimal(t, "-2.5").Round(0).String())
+
+	assert.Equal(t, "-2", mustDecimal(t, "-2.5").Trunc().String())
+	assert.Equal(t, "-3", mustDecimal(t, "-2.5").Floor().String())
+	assert.Equal(t, "-2", mustDecimal(t, "-2.5").Ceil().String())
+	assert.Equal(t, "3", mustDecimal(t, "2.5").Ceil().String())
+
+	assert.True(t, mustDecimal(t, "3.000").IsInteger())
+	assert.False(t, mustDecimal(t, "3.001").IsInteger())
+}
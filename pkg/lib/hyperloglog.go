@@ -0,0 +1,151 @@
+// ================================================================
+// HyperLogLog for approximate distinct counts in bounded memory, after
+// Flajolet et al., with the small-range correction by linear counting.
+//
+// The precision p gives 2^p one-byte registers; the standard error is about
+// 1.04 / sqrt(2^p), e.g. 0.8% for the default p = 14 using 16KB.
+// ================================================================
+
+package lib
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"math/bits"
+)
+
+const DefaultHyperLogLogPrecision = 14
+const MinHyperLogLogPrecision = 4
+const MaxHyperLogLogPrecision = 18
+
+type HyperLogLog struct {
+	precision uint
+	registers []uint8
+}
+
+// NewHyperLogLog expects a precision which has been checked to be between
+// MinHyperLogLogPrecision and MaxHyperLogLogPrecision.
+func NewHyperLogLog(precision int) *HyperLogLog {
+	InternalCodingErrorIf(precision < MinHyperLogLogPrecision || precision > MaxHyperLogLogPrecision)
+	return &HyperLogLog{
+		precision: uint(precision),
+		registers: make([]uint8, 1<<precision),
+	}
+}
+
+func (hll *HyperLogLog) Add(value string) {
+	hash := hashForHyperLogLog(value)
+	index := hash >> (64 - hll.precision)
+	// The remaining bits, with a sentinel so the rank is at most 64-p+1.
+	remaining := hash<<hll.precision | 1<<(hll.precision-1)
+	rank := uint8(bits.LeadingZeros64(remaining) + 1)
+	if rank > hll.registers[index] {
+		hll.registers[index] = rank
+	}
+}
+
+func (hll *HyperLogLog) Count() int64 {
+	m := float64(len(hll.registers))
+	sum := 0.0
+	zeroCount := 0
+	for _, register := range hll.registers {
+		sum += 1.0 / float64(uint64(1)<<register)
+		if register == 0 {
+			zeroCount++
+		}
+	}
+
+	estimate := hyperLogLogAlpha(m) * m * m / sum
+	if estimate <= 2.5*m && zeroCount > 0 {
+		estimate = m * math.Log(m/float64(zeroCount))
+	}
+	return int64(math.Round(estimate))
+}
+
+func hyperLogLogAlpha(m float64) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/m)
+	}
+}
+
+// FNV-1a followed by the MurmurHash3 finalizer, since HyperLogLog needs all
+// 64 bits well-mixed and FNV's high bits are not.
+func hashForHyperLogLog(value string) uint64 {
+	hasher := fnv.New64a()
+	hasher.Write([]byte(value))
+	hash := hasher.Sum64()
+	hash ^= hash >> 33
+	hash *= 0xff51afd7ed558ccd
+	hash ^= hash >> 33
+	hash *= 0xc4ceb9fe1a85ec53
+	hash ^= hash >> 33
+	return hash
+}
+
+// Merge combines the other sketch into this one, as for combining distinct
+// counts over separate parts of the input. The precisions must be the same.
+func (hll *HyperLogLog) Merge(other *HyperLogLog) error {
+	if hll.precision != other.precision {
+		return fmt.Errorf(
+			"cannot merge HyperLogLog sketches with different precisions %d and %d",
+			hll.precision, other.precision,
+		)
+	}
+	for i, register := range other.registers {
+		if register > hll.registers[i] {
+			hll.registers[i] = register
+		}
+	}
+	return nil
+}
+
+// MarshalBinary serializes the sketch as the precision then the registers,
+// deflated since they're mostly zero for low cardinalities.
+func (hll *HyperLogLog) MarshalBinary() ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte(byte(hll.precision))
+	writer, err := flate.NewWriter(&buffer, flate.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	_, err = writer.Write(hll.registers)
+	if err != nil {
+		return nil, err
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func (hll *HyperLogLog) UnmarshalBinary(buffer []byte) error {
+	if len(buffer) < 1 {
+		return fmt.Errorf("HyperLogLog data is empty")
+	}
+	precision := int(buffer[0])
+	if precision < MinHyperLogLogPrecision || precision > MaxHyperLogLogPrecision {
+		return fmt.Errorf("HyperLogLog data has invalid precision %d", precision)
+	}
+	registers, err := io.ReadAll(io.LimitReader(flate.NewReader(bytes.NewReader(buffer[1:])), 1<<precision+1))
+	if err != nil {
+		return fmt.Errorf("HyperLogLog data is invalid: %v", err)
+	}
+	if len(registers) != 1<<precision {
+		return fmt.Errorf("HyperLogLog data has %d registers; expected %d", len(registers), 1<<precision)
+	}
+	hll.precision = uint(precision)
+	hll.registers = registers
+	return nil
+}
@@ -0,0 +1,67 @@
+// ================================================================
+// Most Miller tests (thousands of them) are command-line-driven via
+// mlr regtest. Here are some cases needing special focus.
+// ================================================================
+
+package lib
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHyperLogLogEmpty(t *testing.T) {
+	hll := NewHyperLogLog(DefaultHyperLogLogPrecision)
+	assert.Equal(t, int64(0), hll.Count())
+}
+
+func TestHyperLogLogSmall(t *testing.T) {
+	hll := NewHyperLogLog(DefaultHyperLogLogPrecision)
+	for i := 0; i < 3; i++ {
+		hll.Add("abc")
+		hll.Add("def")
+		hll.Add("1")
+	}
+	assert.Equal(t, int64(3), hll.Count())
+}
+
+func TestHyperLogLogLarge(t *testing.T) {
+	for _, precision := range []int{10, DefaultHyperLogLogPrecision} {
+		hll := NewHyperLogLog(precision)
+		n := 200000
+		for i := 0; i < n; i++ {
+			hll.Add(strconv.Itoa(i))
+			hll.Add(strconv.Itoa(i))
+		}
+		standardError := 1.04 / math.Sqrt(float64(int(1)<<precision))
+		relativeError := math.Abs(float64(hll.Count())-float64(n)) / float64(n)
+		assert.Less(t, relativeError, 4*standardError, "precision=%d", precision)
+	}
+}
+
+func TestHyperLogLogMergeAndMarshal(t *testing.T) {
+	hll1 := NewHyperLogLog(DefaultHyperLogLogPrecision)
+	hll2 := NewHyperLogLog(DefaultHyperLogLogPrecision)
+	for i := 0; i < 30000; i++ {
+		hll1.Add(strconv.Itoa(i))
+	}
+	for i := 20000; i < 50000; i++ {
+		hll2.Add(strconv.Itoa(i))
+	}
+
+	bytes, err := hll2.MarshalBinary()
+	assert.Nil(t, err)
+	roundTrip := &HyperLogLog{}
+	assert.Nil(t, roundTrip.UnmarshalBinary(bytes))
+	assert.Equal(t, hll2.Count(), roundTrip.Count())
+
+	assert.Nil(t, hll1.Merge(roundTrip))
+	relativeError := math.Abs(float64(hll1.Count())-50000) / 50000
+	assert.Less(t, relativeError, 0.03)
+
+	assert.NotNil(t, hll1.Merge(NewHyperLogLog(10)))
+	assert.NotNil(t, roundTrip.UnmarshalBinary(bytes[:1]))
+}
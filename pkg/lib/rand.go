@@ -7,6 +7,7 @@ package lib
 import (
 	"math/rand"
 	"os"
+	"sync"
 	"time"
 )
 
@@ -15,27 +16,39 @@ var defaultSeed = time.Now().UnixNano() ^ int64(os.Getpid())
 var source = rand.NewSource(defaultSeed)
 var generator = rand.New(source)
 
+// The generator isn't safe for concurrent use, and with mlr --workers several
+// DSL instances may be drawing from it at once.
+var generatorMutex sync.Mutex
+
 // Users can request specific seeds if they want the same random-number
 // sequence on each run.
 func SeedRandom(seed int64) {
+	generatorMutex.Lock()
+	defer generatorMutex.Unlock()
 	source = rand.NewSource(seed)
 	generator = rand.New(source)
 }
 
 func RandFloat64() float64 {
+	generatorMutex.Lock()
+	defer generatorMutex.Unlock()
 	return generator.Float64()
 }
 func RandUint32() uint32 {
+	generatorMutex.Lock()
+	defer generatorMutex.Unlock()
 	return generator.Uint32()
 }
 func RandInt63() int64 {
+	generatorMutex.Lock()
+	defer generatorMutex.Unlock()
 	return generator.Int63()
 }
 func RandRange(lowInclusive, highExclusive int64) int64 {
 	if lowInclusive == highExclusive {
 		return lowInclusive
 	} else {
-		u := generator.Int63()
+		u := RandInt63()
 		// TODO: test divide-by-zero cases in UT
 		return lowInclusive + (u % (highExclusive - lowInclusive))
 	}
@@ -71,7 +71,14 @@ var cacheMutex sync.Mutex
 // cached compiles, and for any extras that appear during record processing, we simply recompile
 // each time.
 func regexpCompileCached(s string) (*regexp.Regexp, error) {
-	if len(regexpCache) > cacheMaxSize {
+	cacheMutex.Lock()
+	r, ok := regexpCache[s]
+	full := len(regexpCache) > cacheMaxSize
+	cacheMutex.Unlock()
+	if ok {
+		return r, nil
+	}
+	if full {
 		return regexp.Compile(s)
 	}
 	r, err := regexp.Compile(s)
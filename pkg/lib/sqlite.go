@@ -0,0 +1,34 @@
+package lib
+
+import (
+	"database/sql"
+	"strings"
+)
+
+// SQLiteQuoteIdentifier double-quotes a table or column name for use in SQL
+// statements, so that names needn't be valid SQL identifiers.
+func SQLiteQuoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// SQLiteGetColumnNames returns the column names of a table, in table order.
+// The returned slice is empty if the table doesn't exist.
+func SQLiteGetColumnNames(db *sql.DB, tableName string) ([]string, error) {
+	rows, err := db.Query("SELECT name FROM pragma_table_info(?) ORDER BY cid", tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columnNames := make([]string, 0)
+	for rows.Next() {
+		var columnName string
+		if err := rows.Scan(&columnName); err != nil {
+			return nil, err
+		}
+		columnNames = append(columnNames, columnName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return columnNames, nil
+}
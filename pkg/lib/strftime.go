@@ -0,0 +1,109 @@
+package lib
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/lestrrat-go/strftime"
+)
+
+// Strftime formats the time using the format string, with Miller's
+// extensions: %1S through %9S for fractional seconds, %N and %O for
+// nanoseconds, and %s for epoch seconds.
+func Strftime(t time.Time, formatString string) (string, error) {
+	// Miller fractional-second formats are like "%6S", and were so in the C
+	// implementation. However, in the strftime package we're using in the Go
+	// port, extension-formats are only a single byte so we need to rewrite
+	// them to "%6".
+	formatString = extensionRegex.ReplaceAllString(formatString, "$1")
+
+	formatter, err := strftime.New(formatString, strftimeExtensions)
+	if err != nil {
+		return "", err
+	}
+	return formatter.FormatString(t), nil
+}
+
+var extensionRegex = regexp.MustCompile("([1-9])S")
+
+// ----------------------------------------------------------------
+// This is support for %1S .. %9S in format strings, using github.com/lestrrat-go/strftime.
+
+var strftimeExtensions strftime.Option
+
+// This is a helper function for the appenders below, which let people get
+// 1..9 decimal places in the seconds of their strftime format strings.
+func specificationHelper(b []byte, t time.Time, sprintfFormat string, quotient int) []byte {
+	seconds := int(t.Second())
+	fractional := int(t.Nanosecond() / quotient)
+	secondsString := fmt.Sprintf("%02d", seconds)
+	b = append(b, secondsString...)
+	b = append(b, '.')
+	fractionalString := fmt.Sprintf(sprintfFormat, fractional)
+	b = append(b, fractionalString...)
+	return b
+}
+
+func init() {
+	appender1 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		return specificationHelper(b, t, "%01d", 100000000)
+	})
+	appender2 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		return specificationHelper(b, t, "%02d", 10000000)
+	})
+	appender3 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		return specificationHelper(b, t, "%03d", 1000000)
+	})
+	appender4 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		return specificationHelper(b, t, "%04d", 100000)
+	})
+	appender5 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		return specificationHelper(b, t, "%05d", 10000)
+	})
+	appender6 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		return specificationHelper(b, t, "%06d", 1000)
+	})
+	appender7 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		return specificationHelper(b, t, "%07d", 100)
+	})
+	appender8 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		return specificationHelper(b, t, "%09d", 10)
+	})
+	appender9 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		return specificationHelper(b, t, "%09d", 1)
+	})
+	appenderN := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		nanos := int(t.Nanosecond())
+		s := fmt.Sprintf("%09d", nanos)
+		//return append(b, []byte(s))
+		return append(b, s...)
+	})
+	appenderO := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		nanos := int(t.Nanosecond())
+		s := fmt.Sprintf("%d", nanos)
+		//return append(b, []byte(s))
+		return append(b, s...)
+	})
+	appenderS := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
+		epochSeconds := t.Unix()
+		s := fmt.Sprintf("%d", epochSeconds)
+		return append(b, s...)
+	})
+
+	ss := strftime.NewSpecificationSet()
+	ss.Set('1', appender1)
+	ss.Set('2', appender2)
+	ss.Set('3', appender3)
+	ss.Set('4', appender4)
+	ss.Set('5', appender5)
+	ss.Set('6', appender6)
+	ss.Set('7', appender7)
+	ss.Set('8', appender8)
+	ss.Set('9', appender9)
+	ss.Set('N', appenderN)
+	ss.Set('O', appenderO)
+	ss.Set('s', appenderS)
+
+	strftimeExtensions = strftime.WithSpecificationSet(ss)
+}
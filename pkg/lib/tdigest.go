@@ -0,0 +1,237 @@
+// ================================================================
+// t-digest for approximate quantiles in bounded memory, after Dunning and
+// Ertl, "Computing Extremely Accurate Quantiles Using t-Digests". This is the
+// merging variant: incoming values are buffered, and when the buffer fills
+// they're sorted and merged along with the existing centroids.
+//
+// The compression parameter bounds the number of centroids: larger values
+// give more accuracy and use more memory. Accuracy is best near the tails,
+// i.e. for p1 and p99 more than for p50. With few enough values, every value
+// is its own centroid and the quantiles are exact up to interpolation.
+// ================================================================
+
+package lib
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+)
+
+const DefaultTDigestCompression = 100.0
+
+type TDigest struct {
+	compression float64
+
+	// Sorted by mean
+	means   []float64
+	weights []float64
+
+	// Not yet merged into the centroids
+	bufferedMeans   []float64
+	bufferedWeights []float64
+
+	totalWeight float64 // including buffered
+	min         float64
+	max         float64
+}
+
+func NewTDigest(compression float64) *TDigest {
+	bufferSize := int(5 * compression)
+	return &TDigest{
+		compression:     compression,
+		means:           make([]float64, 0),
+		weights:         make([]float64, 0),
+		bufferedMeans:   make([]float64, 0, bufferSize),
+		bufferedWeights: make([]float64, 0, bufferSize),
+		totalWeight:     0,
+		min:             math.Inf(1),
+		max:             math.Inf(-1),
+	}
+}
+
+func (digest *TDigest) Add(value float64) {
+	digest.addWeighted(value, 1)
+}
+
+func (digest *TDigest) addWeighted(mean float64, weight float64) {
+	if math.IsNaN(mean) {
+		return
+	}
+	if len(digest.bufferedMeans) >= cap(digest.bufferedMeans) {
+		digest.compress()
+	}
+	digest.bufferedMeans = append(digest.bufferedMeans, mean)
+	digest.bufferedWeights = append(digest.bufferedWeights, weight)
+	digest.totalWeight += weight
+	if mean < digest.min {
+		digest.min = mean
+	}
+	if mean > digest.max {
+		digest.max = mean
+	}
+}
+
+// Count is the number of values added.
+func (digest *TDigest) Count() int64 {
+	return int64(digest.totalWeight)
+}
+
+// Quantile returns the approximate q-th quantile, for q from 0 to 1. The
+// second return value is false if no values have been added.
+func (digest *TDigest) Quantile(q float64) (float64, bool) {
+	digest.compress()
+	n := len(digest.means)
+	if n == 0 {
+		return 0, false
+	}
+	if q <= 0 {
+		return digest.min, true
+	}
+	if q >= 1 {
+		return digest.max, true
+	}
+	if n == 1 {
+		return digest.means[0], true
+	}
+
+	// Each centroid is taken to be centered at the middle of its weight, with
+	// the min at position 0 and the max at position totalWeight. Interpolate
+	// linearly between those.
+	position := q * digest.totalWeight
+	previousMean := digest.min
+	previousCenter := 0.0
+	cumulativeWeight := 0.0
+	for i := 0; i < n; i++ {
+		center := cumulativeWeight + digest.weights[i]/2
+		if position < center {
+			return interpolate(previousCenter, previousMean, center, digest.means[i], position), true
+		}
+		previousMean = digest.means[i]
+		previousCenter = center
+		cumulativeWeight += digest.weights[i]
+	}
+	return interpolate(previousCenter, previousMean, digest.totalWeight, digest.max, position), true
+}
+
+func interpolate(x0, y0, x1, y1, x float64) float64 {
+	if x1 <= x0 {
+		return y0
+	}
+	return y0 + (x-x0)/(x1-x0)*(y1-y0)
+}
+
+// compress merges the buffered values into the centroids.
+func (digest *TDigest) compress() {
+	if len(digest.bufferedMeans) == 0 {
+		return
+	}
+
+	means := append(digest.means, digest.bufferedMeans...)
+	weights := append(digest.weights, digest.bufferedWeights...)
+	indices := make([]int, len(means))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return means[indices[i]] < means[indices[j]]
+	})
+
+	mergedMeans := make([]float64, 0, len(digest.means)+1)
+	mergedWeights := make([]float64, 0, len(digest.means)+1)
+
+	// A centroid may grow until it spans one unit of the scale function
+	// k(q) = compression / (2 pi) * asin(2q - 1), which keeps centroids small
+	// near the tails.
+	weightSoFar := 0.0
+	currentMean := means[indices[0]]
+	currentWeight := weights[indices[0]]
+	weightLimit := digest.totalWeight * digest.quantileLimit(0)
+	for _, index := range indices[1:] {
+		if weightSoFar+currentWeight+weights[index] <= weightLimit {
+			currentWeight += weights[index]
+			currentMean += (means[index] - currentMean) * weights[index] / currentWeight
+		} else {
+			mergedMeans = append(mergedMeans, currentMean)
+			mergedWeights = append(mergedWeights, currentWeight)
+			weightSoFar += currentWeight
+			weightLimit = digest.totalWeight * digest.quantileLimit(weightSoFar/digest.totalWeight)
+			currentMean = means[index]
+			currentWeight = weights[index]
+		}
+	}
+	mergedMeans = append(mergedMeans, currentMean)
+	mergedWeights = append(mergedWeights, currentWeight)
+
+	digest.means = mergedMeans
+	digest.weights = mergedWeights
+	digest.bufferedMeans = digest.bufferedMeans[:0]
+	digest.bufferedWeights = digest.bufferedWeights[:0]
+}
+
+// quantileLimit is how far a centroid starting at quantile q may extend.
+func (digest *TDigest) quantileLimit(q float64) float64 {
+	k := digest.compression/(2*math.Pi)*math.Asin(2*q-1) + 1
+	if k >= digest.compression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/digest.compression) + 1) / 2
+}
+
+// Merge adds the other digest's centroids into this one, as for combining
+// digests computed over separate parts of the input.
+func (digest *TDigest) Merge(other *TDigest) {
+	other.compress()
+	for i := range other.means {
+		digest.addWeighted(other.means[i], other.weights[i])
+	}
+	if other.min < digest.min {
+		digest.min = other.min
+	}
+	if other.max > digest.max {
+		digest.max = other.max
+	}
+}
+
+// MarshalBinary serializes the digest as the compression, min, and max, then
+// the centroids' means and weights, as little-endian float64s.
+func (digest *TDigest) MarshalBinary() ([]byte, error) {
+	digest.compress()
+	values := make([]float64, 0, 3+2*len(digest.means))
+	values = append(values, digest.compression, digest.min, digest.max)
+	for i := range digest.means {
+		values = append(values, digest.means[i], digest.weights[i])
+	}
+	buffer := make([]byte, 8*len(values))
+	for i, value := range values {
+		binary.LittleEndian.PutUint64(buffer[8*i:], math.Float64bits(value))
+	}
+	return buffer, nil
+}
+
+func (digest *TDigest) UnmarshalBinary(buffer []byte) error {
+	if len(buffer) < 24 || len(buffer)%16 != 8 {
+		return fmt.Errorf("t-digest data has invalid length %d", len(buffer))
+	}
+	values := make([]float64, len(buffer)/8)
+	for i := range values {
+		values[i] = math.Float64frombits(binary.LittleEndian.Uint64(buffer[8*i:]))
+	}
+	if !(values[0] >= 1) {
+		return fmt.Errorf("t-digest data has invalid compression %v", values[0])
+	}
+
+	*digest = *NewTDigest(values[0])
+	digest.min = values[1]
+	digest.max = values[2]
+	for i := 3; i < len(values); i += 2 {
+		if !(values[i+1] > 0) {
+			return fmt.Errorf("t-digest data has invalid weight %v", values[i+1])
+		}
+		digest.means = append(digest.means, values[i])
+		digest.weights = append(digest.weights, values[i+1])
+		digest.totalWeight += values[i+1]
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// ================================================================
+// Most Miller tests (thousands of them) are command-line-driven via
+// mlr regtest. Here are some cases needing special focus.
+// ================================================================
+
+package lib
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTDigestEmpty(t *testing.T) {
+	digest := NewTDigest(DefaultTDigestCompression)
+	_, ok := digest.Quantile(0.5)
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), digest.Count())
+}
+
+func TestTDigestSmall(t *testing.T) {
+	digest := NewTDigest(DefaultTDigestCompression)
+	for _, value := range []float64{5, 3, 1, 4, 2} {
+		digest.Add(value)
+	}
+	assert.Equal(t, int64(5), digest.Count())
+
+	q, ok := digest.Quantile(0.0)
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, q)
+	q, _ = digest.Quantile(0.5)
+	assert.Equal(t, 3.0, q)
+	q, _ = digest.Quantile(1.0)
+	assert.Equal(t, 5.0, q)
+}
+
+func TestTDigestLarge(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	n := 100000
+	values := make([]float64, n)
+	digest := NewTDigest(DefaultTDigestCompression)
+	for i := range values {
+		values[i] = rng.NormFloat64()
+		digest.Add(values[i])
+	}
+	sort.Float64s(values)
+
+	assert.Less(t, len(digest.means), int(DefaultTDigestCompression))
+	for _, q := range []float64{0.001, 0.01, 0.25, 0.5, 0.75, 0.99, 0.999} {
+		estimate, ok := digest.Quantile(q)
+		assert.True(t, ok)
+		// Compare ranks rather than values
+		rank := float64(sort.SearchFloat64s(values, estimate)) / float64(n)
+		assert.Less(t, math.Abs(rank-q), 0.005, "q=%v", q)
+	}
+}
+
+func TestTDigestMergeAndMarshal(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	n := 50000
+	values := make([]float64, 2*n)
+	digest1 := NewTDigest(DefaultTDigestCompression)
+	digest2 := NewTDigest(DefaultTDigestCompression)
+	for i := range values {
+		values[i] = rng.ExpFloat64()
+		if i < n {
+			digest1.Add(values[i])
+		} else {
+			digest2.Add(values[i])
+		}
+	}
+	sort.Float64s(values)
+
+	bytes, err := digest2.MarshalBinary()
+	assert.Nil(t, err)
+	roundTrip := &TDigest{}
+	assert.Nil(t, roundTrip.UnmarshalBinary(bytes))
+	assert.Equal(t, digest2.Count(), roundTrip.Count())
+
+	digest1.Merge(roundTrip)
+	assert.Equal(t, int64(2*n), digest1.Count())
+	q, _ := digest1.Quantile(0.0)
+	assert.Equal(t, values[0], q)
+	q, _ = digest1.Quantile(1.0)
+	assert.Equal(t, values[2*n-1], q)
+	for _, q := range []float64{0.01, 0.5, 0.99} {
+		estimate, _ := digest1.Quantile(q)
+		rank := float64(sort.SearchFloat64s(values, estimate)) / float64(2*n)
+		assert.Less(t, math.Abs(rank-q), 0.005, "q=%v", q)
+	}
+
+	assert.NotNil(t, roundTrip.UnmarshalBinary(bytes[:20]))
+}
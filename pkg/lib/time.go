@@ -3,6 +3,8 @@ package lib
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -188,3 +190,153 @@ func epochNanosecondsToTime(epochNanoseconds int64, doLocal bool, location *time
 		return time.Unix(intPart, fractionalPart).UTC()
 	}
 }
+
+// ----------------------------------------------------------------
+// Calendar buckets, for the timebucket verb and the timefloor/timeceil DSL
+// functions. Buckets are computed in the location of the given time, so day,
+// week, month, quarter, and year buckets start at local midnight, and across
+// a daylight-saving change the hour buckets are those of the wall clock.
+
+type TimeBucketUnit struct {
+	name     string
+	multiple int
+}
+
+// Multiples such as 15minute or 6hour must divide evenly into the next-larger
+// unit, so that buckets line up the same way within every hour, day, or year.
+// Zero means any multiple is allowed.
+var timeBucketUnitMaxMultiples = map[string]int{
+	"second":  60,
+	"minute":  60,
+	"hour":    24,
+	"day":     1,
+	"week":    1,
+	"month":   12,
+	"quarter": 1,
+	"year":    0,
+}
+
+// ParseTimeBucketUnit accepts second, minute, hour, day, week (ISO, starting on
+// Monday), month, quarter, or year, with an optional plural and an optional
+// leading multiple such as 15minute or 6hours.
+func ParseTimeBucketUnit(spec string) (*TimeBucketUnit, error) {
+	i := 0
+	for i < len(spec) && spec[i] >= '0' && spec[i] <= '9' {
+		i++
+	}
+	multiple := 1
+	if i > 0 {
+		var err error
+		multiple, err = strconv.Atoi(spec[:i])
+		if err != nil || multiple < 1 {
+			return nil, fmt.Errorf("time unit \"%s\": multiple must be a positive integer", spec)
+		}
+	}
+
+	name := strings.TrimSuffix(spec[i:], "s")
+	if name == "isoweek" {
+		name = "week"
+	}
+	maxMultiple, ok := timeBucketUnitMaxMultiples[name]
+	if !ok {
+		return nil, fmt.Errorf(
+			"time unit \"%s\" not recognized; expected second, minute, hour, day, week, month, quarter, or year, "+
+				"optionally with a multiple such as 15minute",
+			spec,
+		)
+	}
+	if maxMultiple == 1 && multiple != 1 {
+		return nil, fmt.Errorf("time unit \"%s\": %s does not take a multiple", spec, name)
+	}
+	if maxMultiple > 1 && maxMultiple%multiple != 0 {
+		return nil, fmt.Errorf("time unit \"%s\": multiple must divide %d", spec, maxMultiple)
+	}
+
+	return &TimeBucketUnit{name: name, multiple: multiple}, nil
+}
+
+// Floor returns the start of the bucket containing t.
+func (unit *TimeBucketUnit) Floor(t time.Time) time.Time {
+	n := unit.multiple
+	year, month, day := t.Date()
+	location := t.Location()
+
+	// Sub-day units are taken off the absolute time rather than rebuilt from
+	// the wall clock, so that when clocks go back, the repeated hour is two
+	// distinct buckets.
+	subMinute := time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+	switch unit.name {
+	case "second":
+		return t.Add(-time.Duration(t.Second()%n)*time.Second - time.Duration(t.Nanosecond()))
+	case "minute":
+		return t.Add(-time.Duration(t.Minute()%n)*time.Minute - subMinute)
+	case "hour":
+		if n == 1 {
+			return t.Add(-time.Duration(t.Minute())*time.Minute - subMinute)
+		}
+		return time.Date(year, month, day, t.Hour()-t.Hour()%n, 0, 0, 0, location)
+	case "day":
+		return time.Date(year, month, day, 0, 0, 0, 0, location)
+	case "week":
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, location)
+	case "month":
+		return time.Date(year, month-(month-1)%time.Month(n), 1, 0, 0, 0, 0, location)
+	case "quarter":
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, location)
+	default: // year
+		offset := year % n
+		if offset < 0 {
+			offset += n
+		}
+		return time.Date(year-offset, time.January, 1, 0, 0, 0, 0, location)
+	}
+}
+
+// Ceil returns t if it is at the start of a bucket, else the start of the
+// next one.
+func (unit *TimeBucketUnit) Ceil(t time.Time) time.Time {
+	start := unit.Floor(t)
+	if start.Equal(t) {
+		return start
+	}
+	return unit.Next(start)
+}
+
+// Next returns the start of the bucket after the one containing t.
+func (unit *TimeBucketUnit) Next(t time.Time) time.Time {
+	start := unit.Floor(t)
+	year, month, day := start.Date()
+	location := start.Location()
+	n := unit.multiple
+
+	switch unit.name {
+	case "day":
+		return time.Date(year, month, day+1, 0, 0, 0, 0, location)
+	case "week":
+		return time.Date(year, month, day+7, 0, 0, 0, 0, location)
+	case "month":
+		return time.Date(year, month+time.Month(n), 1, 0, 0, 0, 0, location)
+	case "quarter":
+		return time.Date(year, month+3, 1, 0, 0, 0, 0, location)
+	case "year":
+		return time.Date(year+n, time.January, 1, 0, 0, 0, 0, location)
+	}
+
+	// Sub-day units step in absolute time. Across a daylight-saving change
+	// that can land back in the same bucket, in which case keep stepping.
+	base := time.Second
+	if unit.name == "minute" {
+		base = time.Minute
+	} else if unit.name == "hour" {
+		base = time.Hour
+	}
+	candidate := start.Add(time.Duration(n) * base)
+	for {
+		next := unit.Floor(candidate)
+		if next.After(start) {
+			return next
+		}
+		candidate = candidate.Add(base)
+	}
+}
@@ -99,3 +99,72 @@ func TestEpochNanosecondsToGMT(t *testing.T) {
 		assert.Equal(t, entry.expectedOutput, EpochNanosecondsToGMT(entry.epochNanoseconds))
 	}
 }
+
+// ----------------------------------------------------------------
+type tDataForTimeBucket struct {
+	unit          string
+	input         string
+	expectedFloor string
+	expectedCeil  string
+}
+
+var dataForTimeBucket = []tDataForTimeBucket{
+	{"second", "2023-05-17T13:47:12.5Z", "2023-05-17T13:47:12Z", "2023-05-17T13:47:13Z"},
+	{"15minute", "2023-05-17T13:47:12Z", "2023-05-17T13:45:00Z", "2023-05-17T14:00:00Z"},
+	{"hour", "2023-05-17T13:00:00Z", "2023-05-17T13:00:00Z", "2023-05-17T13:00:00Z"},
+	{"6hours", "2023-05-17T13:47:12Z", "2023-05-17T12:00:00Z", "2023-05-17T18:00:00Z"},
+	{"day", "2023-12-31T13:47:12Z", "2023-12-31T00:00:00Z", "2024-01-01T00:00:00Z"},
+	{"week", "2023-05-21T13:47:12Z", "2023-05-15T00:00:00Z", "2023-05-22T00:00:00Z"},
+	{"isoweek", "2023-05-15T00:00:00Z", "2023-05-15T00:00:00Z", "2023-05-15T00:00:00Z"},
+	{"month", "2023-02-17T13:47:12Z", "2023-02-01T00:00:00Z", "2023-03-01T00:00:00Z"},
+	{"quarter", "2023-12-17T13:47:12Z", "2023-10-01T00:00:00Z", "2024-01-01T00:00:00Z"},
+	{"year", "2023-05-17T13:47:12Z", "2023-01-01T00:00:00Z", "2024-01-01T00:00:00Z"},
+	{"10year", "2023-05-17T13:47:12Z", "2020-01-01T00:00:00Z", "2030-01-01T00:00:00Z"},
+	// Time zones and daylight-saving changes
+	{"day", "2023-05-17T01:00:00-04:00", "2023-05-17T00:00:00-04:00", "2023-05-18T00:00:00-04:00"},
+	{"hour", "2023-11-05T01:30:00-05:00", "2023-11-05T01:00:00-05:00", "2023-11-05T02:00:00-05:00"},
+}
+
+func TestTimeBucket(t *testing.T) {
+	for _, entry := range dataForTimeBucket {
+		unit, err := ParseTimeBucketUnit(entry.unit)
+		assert.Nil(t, err)
+		input, err := time.Parse(time.RFC3339Nano, entry.input)
+		assert.Nil(t, err)
+		assert.Equal(t, entry.expectedFloor, unit.Floor(input).Format(time.RFC3339), entry.unit)
+		assert.Equal(t, entry.expectedCeil, unit.Ceil(input).Format(time.RFC3339), entry.unit)
+	}
+}
+
+func TestTimeBucketDST(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	assert.Nil(t, err)
+	unit, _ := ParseTimeBucketUnit("hour")
+
+	// Clocks go back at 2am: the 1am hour happens twice, as two buckets.
+	start := time.Date(2023, 11, 5, 0, 30, 0, 0, location)
+	hours := []string{}
+	for bucket := unit.Floor(start); len(hours) < 4; bucket = unit.Next(bucket) {
+		hours = append(hours, bucket.Format("15:04 MST"))
+	}
+	assert.Equal(t, []string{"00:00 EDT", "01:00 EDT", "01:00 EST", "02:00 EST"}, hours)
+
+	// Clocks go forward at 2am: there is no 2am bucket, and the day is 23 hours.
+	start = time.Date(2023, 3, 12, 0, 30, 0, 0, location)
+	hours = []string{}
+	for bucket := unit.Floor(start); len(hours) < 3; bucket = unit.Next(bucket) {
+		hours = append(hours, bucket.Format("15:04 MST"))
+	}
+	assert.Equal(t, []string{"00:00 EST", "01:00 EST", "03:00 EDT"}, hours)
+
+	day, _ := ParseTimeBucketUnit("day")
+	dayStart := day.Floor(start)
+	assert.Equal(t, 23*time.Hour, day.Next(dayStart).Sub(dayStart))
+}
+
+func TestParseTimeBucketUnitErrors(t *testing.T) {
+	for _, spec := range []string{"", "fortnight", "0hour", "7minute", "2day", "2quarter"} {
+		_, err := ParseTimeBucketUnit(spec)
+		assert.NotNil(t, err, spec)
+	}
+}
@@ -0,0 +1,163 @@
+// ================================================================
+// Helpers for XLSX (Office Open XML spreadsheet) input and output: cell
+// references, and dates, which Excel stores as numbers of days since an epoch
+// and tells apart from other numbers only by their cells' number formats.
+// ================================================================
+
+package lib
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExcelColumnName maps zero-up column indices to column names: 0 is A, 25 is
+// Z, 26 is AA, and so on.
+func ExcelColumnName(index int) string {
+	var buffer [8]byte
+	i := len(buffer)
+	for index >= 0 {
+		i--
+		buffer[i] = byte('A' + index%26)
+		index = index/26 - 1
+	}
+	return string(buffer[i:])
+}
+
+// ExcelCellReferenceColumnIndex returns the zero-up column index of a cell
+// reference such as B12.
+func ExcelCellReferenceColumnIndex(reference string) (int, bool) {
+	index := 0
+	n := 0
+	for n < len(reference) {
+		c := reference[n]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if c < 'A' || c > 'Z' {
+			break
+		}
+		index = index*26 + int(c-'A'+1)
+		n++
+	}
+	if n == 0 || n > 3 {
+		return 0, false
+	}
+	return index - 1, true
+}
+
+// ExcelCellReference returns the reference, such as B12, for a zero-up
+// column index and a one-up row number.
+func ExcelCellReference(columnIndex int, rowNumber int64) string {
+	return ExcelColumnName(columnIndex) + strconv.FormatInt(rowNumber, 10)
+}
+
+// Excel's 1900 date system counts 1900-01-01 as day 1, and inherits from
+// Lotus 1-2-3 a 1900-02-29 as day 60, so from 1900-03-01 on, day 0 is
+// effectively 1899-12-30. The 1904 date system, from old Mac Excel, counts
+// 1904-01-01 as day 0.
+var excelEpoch1900 = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+var excelEpoch1904 = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// ExcelSerialToTime converts an Excel date serial number to a time in UTC,
+// rounded to the millisecond. Excel has no time zones.
+func ExcelSerialToTime(serial float64, date1904 bool) time.Time {
+	epoch := excelEpoch1900
+	if date1904 {
+		epoch = excelEpoch1904
+	} else if serial < 61 {
+		epoch = epoch.AddDate(0, 0, 1)
+	}
+	days := math.Floor(serial)
+	milliseconds := math.Round((serial - days) * 86400 * 1000)
+	return epoch.AddDate(0, 0, int(days)).Add(time.Duration(milliseconds) * time.Millisecond)
+}
+
+// TimeToExcelSerial converts a time to an Excel date serial number in the
+// 1900 date system, using the time's wall-clock date and time in its own
+// location. Times before 1900-03-01 aren't supported.
+func TimeToExcelSerial(t time.Time) (float64, bool) {
+	wallClock := time.Date(
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC,
+	)
+	serial := float64(wallClock.Sub(excelEpoch1900)) / float64(24*time.Hour)
+	if serial < 61 {
+		return 0, false
+	}
+	return serial, true
+}
+
+// IsExcelDateFormat says whether a cell number format is for dates and/or
+// times. Formats 14-22 and 45-47 are the built-in date and time formats, and
+// 27-36 and 50-58 are further built-in ones for East Asian locales. Other
+// formats are given by their format codes, such as yyyy-mm-dd.
+func IsExcelDateFormat(numFmtID int, formatCode string) bool {
+	switch {
+	case numFmtID >= 14 && numFmtID <= 22:
+		return true
+	case numFmtID >= 27 && numFmtID <= 36:
+		return true
+	case numFmtID >= 45 && numFmtID <= 47:
+		return true
+	case numFmtID >= 50 && numFmtID <= 58:
+		return true
+	}
+	if formatCode == "" {
+		return false
+	}
+	return strings.ContainsAny(excelFormatCodeTokens(formatCode), "ymdhsYMDHS")
+}
+
+// IsExcelTimeOnlyFormat says whether a date format has times of day but not
+// dates, such as h:mm:ss.
+func IsExcelTimeOnlyFormat(numFmtID int, formatCode string) bool {
+	switch numFmtID {
+	case 18, 19, 20, 21, 45, 46, 47:
+		return true
+	}
+	if formatCode == "" {
+		return false
+	}
+	tokens := excelFormatCodeTokens(formatCode)
+	return strings.ContainsAny(tokens, "hsHS") && !strings.ContainsAny(tokens, "ydYD")
+}
+
+// excelFormatCodeTokens strips the literal text from a format code -- quoted
+// strings, backslash-escaped characters, and bracketed colors and conditions
+// -- leaving the formatting characters. Elapsed-time brackets such as [h] are
+// kept. Only the first section, for positive numbers, is used.
+func excelFormatCodeTokens(formatCode string) string {
+	var buffer strings.Builder
+	inQuotes := false
+	for i := 0; i < len(formatCode); i++ {
+		c := formatCode[i]
+		switch {
+		case inQuotes:
+			if c == '"' {
+				inQuotes = false
+			}
+		case c == '"':
+			inQuotes = true
+		case c == '\\' || c == '_' || c == '*':
+			i++ // the next character is literal, or padding
+		case c == '[':
+			end := strings.IndexByte(formatCode[i:], ']')
+			if end < 0 {
+				return buffer.String()
+			}
+			bracketed := strings.ToLower(formatCode[i+1 : i+end])
+			if bracketed == "h" || bracketed == "hh" || bracketed == "m" || bracketed == "mm" ||
+				bracketed == "s" || bracketed == "ss" {
+				buffer.WriteString(bracketed)
+			}
+			i += end
+		case c == ';':
+			return buffer.String()
+		default:
+			buffer.WriteByte(c)
+		}
+	}
+	return buffer.String()
+}
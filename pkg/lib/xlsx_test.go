@@ -0,0 +1,88 @@
+// ================================================================
+// Most Miller tests (thousands of them) are command-line-driven via
+// mlr regtest. Here are some cases needing special focus.
+// ================================================================
+
+package lib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExcelColumnNames(t *testing.T) {
+	assert.Equal(t, "A", ExcelColumnName(0))
+	assert.Equal(t, "Z", ExcelColumnName(25))
+	assert.Equal(t, "AA", ExcelColumnName(26))
+	assert.Equal(t, "AZ", ExcelColumnName(51))
+	assert.Equal(t, "BA", ExcelColumnName(52))
+	assert.Equal(t, "XFD", ExcelColumnName(16383))
+
+	for _, index := range []int{0, 25, 26, 701, 702, 16383} {
+		back, ok := ExcelCellReferenceColumnIndex(ExcelCellReference(index, 12))
+		assert.True(t, ok)
+		assert.Equal(t, index, back)
+	}
+
+	index, ok := ExcelCellReferenceColumnIndex("c7")
+	assert.True(t, ok)
+	assert.Equal(t, 2, index)
+	_, ok = ExcelCellReferenceColumnIndex("17")
+	assert.False(t, ok)
+	_, ok = ExcelCellReferenceColumnIndex("ABCD1")
+	assert.False(t, ok)
+}
+
+func TestExcelSerialToTime(t *testing.T) {
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), ExcelSerialToTime(45366, false))
+	assert.Equal(t, time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC), ExcelSerialToTime(45366.395833333336, false))
+	assert.Equal(t, time.Date(2028, 3, 16, 0, 0, 0, 0, time.UTC), ExcelSerialToTime(45366, true))
+
+	// Around Excel's nonexistent 1900-02-29
+	assert.Equal(t, time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), ExcelSerialToTime(1, false))
+	assert.Equal(t, time.Date(1900, 2, 28, 0, 0, 0, 0, time.UTC), ExcelSerialToTime(59, false))
+	assert.Equal(t, time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC), ExcelSerialToTime(61, false))
+
+	// Rounded to the millisecond
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 1, 0, time.UTC), ExcelSerialToTime(45366+1.0/86400, false))
+}
+
+func TestTimeToExcelSerial(t *testing.T) {
+	serial, ok := TimeToExcelSerial(time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, 45366.5, serial)
+
+	// Wall-clock time, not UTC
+	location := time.FixedZone("UTC+9", 9*3600)
+	serial, ok = TimeToExcelSerial(time.Date(2024, 3, 15, 12, 0, 0, 0, location))
+	assert.True(t, ok)
+	assert.Equal(t, 45366.5, serial)
+
+	_, ok = TimeToExcelSerial(time.Date(1900, 2, 28, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestIsExcelDateFormat(t *testing.T) {
+	assert.True(t, IsExcelDateFormat(14, ""))
+	assert.True(t, IsExcelDateFormat(22, ""))
+	assert.True(t, IsExcelDateFormat(46, ""))
+	assert.False(t, IsExcelDateFormat(0, ""))
+	assert.False(t, IsExcelDateFormat(4, "#,##0.00"))
+
+	assert.True(t, IsExcelDateFormat(164, "yyyy-mm-dd"))
+	assert.True(t, IsExcelDateFormat(164, `dd/mm/yyyy\ hh:mm`))
+	assert.True(t, IsExcelDateFormat(164, "[$-409]mmmm d, yyyy;@"))
+	assert.True(t, IsExcelDateFormat(164, "[h]:mm:ss"))
+	assert.False(t, IsExcelDateFormat(164, `"Day "0`))
+	assert.False(t, IsExcelDateFormat(164, `[Red]#,##0.00;[Blue]\-#,##0.00`))
+	assert.False(t, IsExcelDateFormat(164, `0.00\s`))
+	assert.False(t, IsExcelDateFormat(164, `#,##0_);(#,##0)`))
+
+	assert.True(t, IsExcelTimeOnlyFormat(20, ""))
+	assert.False(t, IsExcelTimeOnlyFormat(14, ""))
+	assert.True(t, IsExcelTimeOnlyFormat(164, "hh:mm:ss AM/PM"))
+	assert.True(t, IsExcelTimeOnlyFormat(164, "[h]:mm"))
+	assert.False(t, IsExcelTimeOnlyFormat(164, "yyyy-mm-dd hh:mm"))
+}
@@ -95,5 +95,7 @@ func (mv *Mlrval) Increment() {
 		mv.intf = mv.intf.(int64) + 1
 	} else if mv.mvtype == MT_FLOAT {
 		mv.intf = mv.intf.(float64) + 1.0
+	} else if mv.mvtype == MT_DECIMAL {
+		mv.intf = mv.intf.(*lib.Decimal).Add(lib.NewDecimalFromInt64(1))
 	}
 }
@@ -14,6 +14,8 @@
 package mlrval
 
 import (
+	"time"
+
 	"github.com/johnkerl/miller/v6/pkg/lib"
 )
 
@@ -121,6 +123,25 @@ func cmp_b_ff(input1, input2 *Mlrval) int {
 func cmp_b_bb(input1, input2 *Mlrval) int {
 	return int_cmp(int64(lib.BoolToInt(input1.intf.(bool))), int64(lib.BoolToInt(input2.intf.(bool))))
 }
+func cmp_b_tt(input1, input2 *Mlrval) int {
+	return input1.intf.(*timeval).t.Compare(input2.intf.(*timeval).t)
+}
+func cmp_b_dd(input1, input2 *Mlrval) int {
+	return int_cmp(int64(input1.intf.(time.Duration)), int64(input2.intf.(time.Duration)))
+}
+
+// Decimals against ints and decimals compare exactly; against floats, as
+// floats.
+func cmp_b_decimal(input1, input2 *Mlrval) int {
+	if input1.mvtype == MT_FLOAT || input2.mvtype == MT_FLOAT {
+		a, _ := input1.GetNumericToFloatValue()
+		b, _ := input2.GetNumericToFloatValue()
+		return float_cmp(a, b)
+	}
+	a, _ := input1.GetNumericToDecimalValue()
+	b, _ := input2.GetNumericToDecimalValue()
+	return a.Cmp(b)
+}
 
 // TODO: cmp on array & map
 //func eq_b_aa(input1, input2 *Mlrval) bool {
@@ -147,16 +168,19 @@ func cmp_b_bb(input1, input2 *Mlrval) int {
 //}
 
 var cmp_dispositions = [MT_DIM][MT_DIM]CmpFuncInt{
-	//       .  INT        FLOAT     BOOL      VOID      STRING    ARRAY  MAP    FUNC   ERROR  NULL   ABSENT
-	/*INT    */ {cmp_b_ii, cmp_b_if, _less, _less, _less, _less, _less, _less, _less, _less, _less},
-	/*FLOAT  */ {cmp_b_fi, cmp_b_ff, _less, _less, _less, _less, _less, _less, _less, _less, _less},
-	/*BOOL   */ {_more, _more, cmp_b_bb, _less, _less, _less, _less, _less, _less, _less, _less},
-	/*VOID   */ {_more, _more, _more, cmp_b_ss, cmp_b_ss, _less, _less, _less, _less, _less, _less},
-	/*STRING */ {_more, _more, _more, cmp_b_ss, cmp_b_ss, _less, _less, _less, _less, _less, _less},
-	/*ARRAY  */ {_more, _more, _more, _more, _more, _same, _less, _less, _less, _less, _less},
-	/*MAP    */ {_more, _more, _more, _more, _more, _more, _same, _less, _less, _less, _less},
-	/*func   */ {_more, _more, _more, _more, _more, _more, _more, _same, _less, _less, _less},
-	/*ERROR  */ {_more, _more, _more, _more, _more, _more, _more, _more, _same, _less, _less},
-	/*NULL   */ {_more, _more, _more, _more, _more, _more, _more, _more, _more, _same, _less},
-	/*ABSENT */ {_more, _more, _more, _more, _more, _more, _more, _more, _more, _more, _same},
+	//       .  INT        FLOAT     BOOL      VOID      STRING    ARRAY  MAP    FUNC   ERROR  NULL   ABSENT TIME      DURATION DECIMAL
+	/*INT    */ {cmp_b_ii, cmp_b_if, _less, _less, _less, _less, _less, _less, _less, _less, _less, _less, _less, cmp_b_decimal},
+	/*FLOAT  */ {cmp_b_fi, cmp_b_ff, _less, _less, _less, _less, _less, _less, _less, _less, _less, _less, _less, cmp_b_decimal},
+	/*BOOL   */ {_more, _more, cmp_b_bb, _less, _less, _less, _less, _less, _less, _less, _less, _less, _less, _more},
+	/*VOID   */ {_more, _more, _more, cmp_b_ss, cmp_b_ss, _less, _less, _less, _less, _less, _less, _more, _more, _more},
+	/*STRING */ {_more, _more, _more, cmp_b_ss, cmp_b_ss, _less, _less, _less, _less, _less, _less, _more, _more, _more},
+	/*ARRAY  */ {_more, _more, _more, _more, _more, _same, _less, _less, _less, _less, _less, _more, _more, _more},
+	/*MAP    */ {_more, _more, _more, _more, _more, _more, _same, _less, _less, _less, _less, _more, _more, _more},
+	/*func   */ {_more, _more, _more, _more, _more, _more, _more, _same, _less, _less, _less, _more, _more, _more},
+	/*ERROR  */ {_more, _more, _more, _more, _more, _more, _more, _more, _same, _less, _less, _more, _more, _more},
+	/*NULL   */ {_more, _more, _more, _more, _more, _more, _more, _more, _more, _same, _less, _more, _more, _more},
+	/*ABSENT */ {_more, _more, _more, _more, _more, _more, _more, _more, _more, _more, _same, _more, _more, _more},
+	/*TIME   */ {_more, _more, _more, _less, _less, _less, _less, _less, _less, _less, _less, cmp_b_tt, _less, _more},
+	/*DURATION*/ {_more, _more, _more, _less, _less, _less, _less, _less, _less, _less, _less, _more, cmp_b_dd, _more},
+	/*DECIMAL*/ {cmp_b_decimal, cmp_b_decimal, _less, _less, _less, _less, _less, _less, _less, _less, _less, _less, _less, cmp_b_decimal},
 }
@@ -2,12 +2,17 @@ package mlrval
 
 import (
 	"fmt"
+	"math/big"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+
+	"github.com/johnkerl/miller/v6/pkg/lib"
 )
 
 //----------------------------------------------------------------
@@ -54,7 +59,11 @@ func SetFloatOutputFormat(formatString string) error {
 	return nil
 }
 
+// formatterCache is shared by everything which formats numbers, which with
+// mlr --workers includes several DSL instances at once; hence the mutex. The
+// formatters themselves are stateless.
 var formatterCache map[string]IFormatter = make(map[string]IFormatter)
+var formatterCacheMutex sync.Mutex
 
 type IFormatter interface {
 	Format(mlrval *Mlrval) *Mlrval
@@ -64,6 +73,9 @@ type IFormatter interface {
 func GetFormatter(
 	userLevelFormatString string,
 ) (IFormatter, error) {
+	formatterCacheMutex.Lock()
+	defer formatterCacheMutex.Unlock()
+
 	// Cache hit
 	formatter, ok := formatterCache[userLevelFormatString]
 	if ok {
@@ -164,6 +176,11 @@ func (formatter *formatterToFloat) Format(mv *Mlrval) *Mlrval {
 		formatted := fmt.Sprintf(formatter.goFormatString, floatValue)
 		return TryFromFloatString(formatted)
 	}
+	if mv.IsDecimal() {
+		rounded := roundDecimalForFormat(mv.AcquireDecimalValue(), formatter.goFormatString)
+		formatted := fmt.Sprintf(formatter.goFormatString, decimalToBigFloat(rounded))
+		return TryFromDecimalString(formatted)
+	}
 	intValue, isInt := mv.GetIntValue()
 	if isInt {
 		formatted := fmt.Sprintf(formatter.goFormatString, float64(intValue))
@@ -176,6 +193,30 @@ func (formatter *formatterToFloat) FormatFloat(floatValue float64) string {
 	return fmt.Sprintf(formatter.goFormatString, floatValue)
 }
 
+// Decimals are rounded exactly, half away from zero, to the precision of %f
+// formats, before formatting.
+var fixedPointFormatRegex = regexp.MustCompile(`%[-+ #0]*[0-9]*(\.[0-9]*)?[fF]`)
+
+func roundDecimalForFormat(decimalValue *lib.Decimal, goFormatString string) *lib.Decimal {
+	matches := fixedPointFormatRegex.FindStringSubmatch(goFormatString)
+	if matches == nil {
+		return decimalValue
+	}
+	precision := 6 // as in Go and C
+	if matches[1] != "" {
+		precision, _ = strconv.Atoi(matches[1][1:])
+	}
+	return decimalValue.Round(int32(precision))
+}
+
+// decimalToBigFloat has enough mantissa bits that formatting the result at
+// the decimal's own precision gives back its digits.
+func decimalToBigFloat(decimalValue *lib.Decimal) *big.Float {
+	s := decimalValue.String()
+	f, _ := new(big.Float).SetPrec(uint(len(s))*4 + 64).SetString(s)
+	return f
+}
+
 // ----------------------------------------------------------------
 
 func getLanguageTag() language.Tag {
@@ -212,6 +253,10 @@ func (formatter *formatterToSeparatedInt) Format(mv *Mlrval) *Mlrval {
 		formatted := formatter.printer.Sprintf(formatter.goFormatString, int(floatValue))
 		return TryFromIntString(formatted)
 	}
+	if mv.IsDecimal() {
+		formatted := formatter.printer.Sprintf(formatter.goFormatString, int(mv.AcquireDecimalValue().Float64()))
+		return TryFromIntString(formatted)
+	}
 	return mv
 }
 
@@ -239,6 +284,12 @@ func (formatter *formatterToSeparatedFloat) Format(mv *Mlrval) *Mlrval {
 		formatted := formatter.printer.Sprintf(formatter.goFormatString, floatValue)
 		return TryFromFloatString(formatted)
 	}
+	if mv.IsDecimal() {
+		// Rounding first means the float only needs to be close enough.
+		rounded := roundDecimalForFormat(mv.AcquireDecimalValue(), formatter.goFormatString)
+		formatted := formatter.printer.Sprintf(formatter.goFormatString, rounded.Float64())
+		return TryFromDecimalString(formatted)
+	}
 	intValue, isInt := mv.GetIntValue()
 	if isInt {
 		formatted := formatter.printer.Sprintf(formatter.goFormatString, float64(intValue))
@@ -274,6 +325,15 @@ func (formatter *formatterToInt) Format(mv *Mlrval) *Mlrval {
 		formatted := fmt.Sprintf(formatter.goFormatString, int(floatValue))
 		return TryFromIntString(formatted)
 	}
+	if mv.IsDecimal() {
+		// Integer parts can be larger than 64 bits
+		formatted := fmt.Sprintf(formatter.goFormatString, mv.AcquireDecimalValue().BigInt())
+		intValue, ok := lib.TryIntFromString(formatted)
+		if ok {
+			return FromPrevalidatedIntString(formatted, intValue)
+		}
+		return TryFromDecimalString(formatted)
+	}
 	return mv
 }
 
@@ -3,6 +3,7 @@ package mlrval
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/johnkerl/miller/v6/pkg/lib"
 )
@@ -60,6 +61,8 @@ func (mv *Mlrval) GetNumericToFloatValue() (floatValue float64, isFloat bool) {
 		return mv.intf.(float64), true
 	} else if mv.Type() == MT_INT {
 		return float64(mv.intf.(int64)), true
+	} else if mv.Type() == MT_DECIMAL {
+		return mv.intf.(*lib.Decimal).Float64(), true
 	} else {
 		return -888.0, false
 	}
@@ -70,11 +73,28 @@ func (mv *Mlrval) GetNumericToFloatValueOrError(funcname string) (floatValue flo
 		return mv.intf.(float64), nil
 	} else if mv.Type() == MT_INT {
 		return float64(mv.intf.(int64)), nil
+	} else if mv.Type() == MT_DECIMAL {
+		return mv.intf.(*lib.Decimal).Float64(), nil
 	} else {
 		return -888.0, FromNotNumericError(funcname, mv)
 	}
 }
 
+// GetNumericToDecimalValue is for ints, decimals, and floats. Floats are
+// their shortest decimal representation, e.g. 0.1 not 0.1000000000000000055.
+func (mv *Mlrval) GetNumericToDecimalValue() (decimalValue *lib.Decimal, isNumeric bool) {
+	switch mv.Type() {
+	case MT_DECIMAL:
+		return mv.intf.(*lib.Decimal), true
+	case MT_INT:
+		return lib.NewDecimalFromInt64(mv.intf.(int64)), true
+	case MT_FLOAT:
+		return lib.NewDecimalFromFloat64(mv.intf.(float64))
+	default:
+		return nil, false
+	}
+}
+
 func (mv *Mlrval) GetNumericNegativeorDie() bool {
 	floatValue, ok := mv.GetNumericToFloatValue()
 	lib.InternalCodingErrorIf(!ok)
@@ -172,6 +192,28 @@ func (mv *Mlrval) AcquireMapValue() *Mlrmap {
 	return mv.intf.(*Mlrmap)
 }
 
+func (mv *Mlrval) AcquireDecimalValue() *lib.Decimal {
+	lib.InternalCodingErrorIf(mv.mvtype != MT_DECIMAL)
+	return mv.intf.(*lib.Decimal)
+}
+
+func (mv *Mlrval) AcquireTimeValue() time.Time {
+	lib.InternalCodingErrorIf(mv.mvtype != MT_TIME)
+	return mv.intf.(*timeval).t
+}
+
+// AcquireTimeFormat returns the strftime format a time value prints with, or
+// empty for RFC 3339.
+func (mv *Mlrval) AcquireTimeFormat() string {
+	lib.InternalCodingErrorIf(mv.mvtype != MT_TIME)
+	return mv.intf.(*timeval).format
+}
+
+func (mv *Mlrval) AcquireDurationValue() time.Duration {
+	lib.InternalCodingErrorIf(mv.mvtype != MT_DURATION)
+	return mv.intf.(time.Duration)
+}
+
 func (mv *Mlrval) GetNumericToFloatValueOrDie() (floatValue float64) {
 	floatValue, ok := mv.GetNumericToFloatValue()
 	if !ok {
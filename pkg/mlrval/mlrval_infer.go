@@ -3,6 +3,7 @@ package mlrval
 import (
 	"strconv"
 
+	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/scan"
 )
 
@@ -44,6 +45,21 @@ func SetInferrerStringOnly() {
 	packageLevelInferrer = inferString
 }
 
+// Support for mlr --decimal. This is independent of the above: e.g. with -O
+// and --decimal, 0377 is an int and 1.5 is a decimal.
+var decimalMode = false
+
+// SetDecimalMode makes numbers with decimal points, in data or in DSL
+// literals, decimals rather than floats. Also, ints which overflow 64 bits
+// become decimals rather than floats.
+func SetDecimalMode() {
+	decimalMode = true
+}
+
+func IsDecimalMode() bool {
+	return decimalMode
+}
+
 // ----------------------------------------------------------------
 
 func inferNormally(mv *Mlrval) *Mlrval {
@@ -104,8 +120,20 @@ func inferDecimalInt(mv *Mlrval) *Mlrval {
 	if err == nil {
 		return mv.SetFromPrevalidatedIntString(mv.printrep, intval)
 	} else {
-		return mv.SetFromString(mv.printrep)
+		return inferMaybeBigInt(mv)
+	}
+}
+
+// inferMaybeBigInt is for decimal ints which don't fit in 64 bits. These are
+// strings, except with mlr --decimal.
+func inferMaybeBigInt(mv *Mlrval) *Mlrval {
+	if decimalMode {
+		decimalValue, ok := lib.TryDecimalFromString(mv.printrep)
+		if ok {
+			return mv.SetFromPrevalidatedDecimalString(mv.printrep, decimalValue)
+		}
 	}
+	return mv.SetFromString(mv.printrep)
 }
 
 // TODO: comment
@@ -114,7 +142,7 @@ func inferLeadingZeroDecimalIntAsInt(mv *Mlrval) *Mlrval {
 	if err == nil {
 		return mv.SetFromPrevalidatedIntString(mv.printrep, intval)
 	} else {
-		return mv.SetFromString(mv.printrep)
+		return inferMaybeBigInt(mv)
 	}
 }
 
@@ -193,6 +221,13 @@ func inferBinaryInt(mv *Mlrval) *Mlrval {
 
 // TODO: comment
 func inferMaybeFloat(mv *Mlrval) *Mlrval {
+	if decimalMode {
+		// Things like NaN and 0x1.8p3 are still floats
+		decimalValue, ok := lib.TryDecimalFromString(mv.printrep)
+		if ok {
+			return mv.SetFromPrevalidatedDecimalString(mv.printrep, decimalValue)
+		}
+	}
 	floatval, err := strconv.ParseFloat(mv.printrep, 64)
 	if err == nil {
 		return mv.SetFromPrevalidatedFloatString(mv.printrep, floatval)
@@ -10,7 +10,7 @@ import (
 
 func (mv *Mlrval) IsLegit() bool {
 	t := mv.Type()
-	return MT_INT <= t && t < MT_ERROR
+	return (MT_INT <= t && t < MT_ERROR) || t > MT_ABSENT
 }
 
 // TODO: comment no JIT-infer here -- absent is non-inferrable and we needn't take the expense of JIT.
@@ -98,9 +98,14 @@ func (mv *Mlrval) IsFloat() bool {
 	return mv.Type() == MT_FLOAT
 }
 
+func (mv *Mlrval) IsDecimal() bool {
+	return mv.Type() == MT_DECIMAL
+}
+
+// IsNumeric is true for ints, floats, and decimals.
 func (mv *Mlrval) IsNumeric() bool {
 	t := mv.Type()
-	return t == MT_INT || t == MT_FLOAT
+	return t == MT_INT || t == MT_FLOAT || t == MT_DECIMAL
 }
 
 func (mv *Mlrval) IsIntZero() bool {
@@ -142,3 +147,11 @@ func (mv *Mlrval) IsArrayOrMap() bool {
 func (mv *Mlrval) IsFunction() bool {
 	return mv.mvtype == MT_FUNC
 }
+
+func (mv *Mlrval) IsTime() bool {
+	return mv.mvtype == MT_TIME
+}
+
+func (mv *Mlrval) IsDuration() bool {
+	return mv.mvtype == MT_DURATION
+}
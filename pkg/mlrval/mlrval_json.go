@@ -142,6 +142,19 @@ func MlrvalDecodeFromJSON(decoder *json.Decoder) (
 		return nil, false, err
 	}
 
+	return MlrvalDecodeFromJSONStartingWith(decoder, startToken)
+}
+
+// MlrvalDecodeFromJSONStartingWith is for callers which have already read the
+// first token of the value, such as the JSON record-reader when it's streaming
+// through the input looking for records.
+func MlrvalDecodeFromJSONStartingWith(decoder *json.Decoder, startToken json.Token) (
+	mlrval *Mlrval,
+	eof bool,
+	err error,
+) {
+	decoder.UseNumber()
+
 	delimiter, isDelim := startToken.(json.Delim)
 	if !isDelim {
 		if startToken == nil {
@@ -295,12 +308,16 @@ func (mv *Mlrval) marshalJSONAux(
 		return mv.marshalJSONInt(outputIsStdout)
 	case MT_FLOAT:
 		return mv.marshalJSONFloat(outputIsStdout)
+	case MT_DECIMAL:
+		return mv.marshalJSONDecimal(outputIsStdout)
 	case MT_BOOL:
 		return mv.marshalJSONBool(outputIsStdout)
 	case MT_ARRAY:
 		return mv.marshalJSONArray(jsonFormatting, elementNestingDepth, outputIsStdout)
 	case MT_MAP:
 		return mv.marshalJSONMap(jsonFormatting, elementNestingDepth, outputIsStdout)
+	case MT_TIME, MT_DURATION:
+		return mv.marshalJSONTimeOrDuration(outputIsStdout)
 	case MT_DIM: // MT_DIM is one past the last valid type
 		return "", fmt.Errorf("mlr: internal coding error detected")
 	}
@@ -351,6 +368,14 @@ func (mv *Mlrval) marshalJSONString(outputIsStdout bool) (string, error) {
 	return colorizer.MaybeColorizeValue(millerJSONEncodeString(mv.printrep), outputIsStdout), nil
 }
 
+// ----------------------------------------------------------------
+// Times and durations are written as strings, in their print formats.
+func (mv *Mlrval) marshalJSONTimeOrDuration(outputIsStdout bool) (string, error) {
+	lib.InternalCodingErrorIf(mv.mvtype != MT_TIME && mv.mvtype != MT_DURATION)
+
+	return colorizer.MaybeColorizeValue(millerJSONEncodeString(mv.String()), outputIsStdout), nil
+}
+
 // Wraps with double-quotes and escape-encoded JSON-special characters.
 //
 // Per https://www.json.org/json-en.html:
@@ -414,6 +439,12 @@ func (mv *Mlrval) marshalJSONFloat(outputIsStdout bool) (string, error) {
 	return colorizer.MaybeColorizeValue(mv.String(), outputIsStdout), nil
 }
 
+// ----------------------------------------------------------------
+func (mv *Mlrval) marshalJSONDecimal(outputIsStdout bool) (string, error) {
+	lib.InternalCodingErrorIf(mv.mvtype != MT_DECIMAL)
+	return colorizer.MaybeColorizeValue(mv.String(), outputIsStdout), nil
+}
+
 // ----------------------------------------------------------------
 func (mv *Mlrval) marshalJSONBool(outputIsStdout bool) (string, error) {
 	lib.InternalCodingErrorIf(mv.mvtype != MT_BOOL)
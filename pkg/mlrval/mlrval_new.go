@@ -7,6 +7,7 @@ package mlrval
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/johnkerl/miller/v6/pkg/lib"
 )
@@ -363,3 +364,74 @@ func FromMap(mapval *Mlrmap) *Mlrval {
 func FromEmptyMap() *Mlrval {
 	return FromMap(NewMlrmap())
 }
+
+func FromDecimal(input *lib.Decimal) *Mlrval {
+	return &Mlrval{
+		mvtype:        MT_DECIMAL,
+		printrepValid: false,
+		intf:          input,
+	}
+}
+
+// FromPrevalidatedDecimalString keeps the original string, e.g. 1.50 rather
+// than 1.5, for output.
+func FromPrevalidatedDecimalString(input string, decimalValue *lib.Decimal) *Mlrval {
+	mv := &Mlrval{}
+	mv.SetFromPrevalidatedDecimalString(input, decimalValue)
+	return mv
+}
+
+func (mv *Mlrval) SetFromPrevalidatedDecimalString(input string, decimalValue *lib.Decimal) *Mlrval {
+	mv.printrep = input
+	mv.printrepValid = true
+	mv.intf = decimalValue
+	mv.mvtype = MT_DECIMAL
+	return mv
+}
+
+// TryFromDecimalString is as TryFromFloatString, for formatting decimals.
+func TryFromDecimalString(input string) *Mlrval {
+	decimalValue, ok := lib.TryDecimalFromString(input)
+	if ok {
+		return FromPrevalidatedDecimalString(input, decimalValue)
+	} else {
+		return FromString(input)
+	}
+}
+
+// timeval is the payload for MT_TIME. The location is that of the Go time.
+type timeval struct {
+	t time.Time
+	// As for strftime. If empty, the time prints as RFC 3339 with nanoseconds
+	// as needed.
+	format string
+}
+
+// FromTime is for computed times, such as time plus duration. These print
+// using the format.
+func FromTime(t time.Time, format string) *Mlrval {
+	return &Mlrval{
+		mvtype:        MT_TIME,
+		printrepValid: false,
+		intf:          &timeval{t: t, format: format},
+	}
+}
+
+// FromParsedTime is for times parsed from a string using a format, so they
+// print back exactly as they were.
+func FromParsedTime(input string, t time.Time, format string) *Mlrval {
+	return &Mlrval{
+		mvtype:        MT_TIME,
+		printrep:      input,
+		printrepValid: true,
+		intf:          &timeval{t: t, format: format},
+	}
+}
+
+func FromDuration(d time.Duration) *Mlrval {
+	return &Mlrval{
+		mvtype:        MT_DURATION,
+		printrepValid: false,
+		intf:          d,
+	}
+}
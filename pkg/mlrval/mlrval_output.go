@@ -5,6 +5,9 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+	"time"
+
+	"github.com/johnkerl/miller/v6/pkg/lib"
 )
 
 // Must have non-pointer receiver in order to implement the fmt.Stringer
@@ -110,6 +113,21 @@ func (mv *Mlrval) setPrintRep() {
 				os.Exit(1)
 			}
 			mv.printrep = string(bytes)
+
+		case MT_TIME:
+			tv := mv.intf.(*timeval)
+			if tv.format == "" {
+				mv.printrep = tv.t.Format(time.RFC3339Nano)
+			} else {
+				// The format was checked when the time was parsed
+				mv.printrep, _ = lib.Strftime(tv.t, tv.format)
+			}
+
+		case MT_DURATION:
+			mv.printrep = mv.intf.(time.Duration).String()
+
+		case MT_DECIMAL:
+			mv.printrep = mv.intf.(*lib.Decimal).String()
 		}
 		mv.printrepValid = true
 	}
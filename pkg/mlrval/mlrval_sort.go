@@ -2,12 +2,15 @@
 // For sorting
 //
 // Sort rules (same for min, max, and comparator):
-// * NUMERICS < BOOL < STRINGS < ERROR < ABSENT
+// * NUMERICS < BOOL < TIMES < DURATIONS < STRINGS < ERROR < ABSENT
 // * error == error (singleton type)
 // * absent == absent (singleton type)
 // * string compares on strings
 // * numeric compares on numbers
 // * false < true
+// * times compare by instant, regardless of location
+// * unlike the DSL's == and < operators, times and durations don't compare
+//   with numbers as seconds: this is collation order only
 // ================================================================
 // ================================================================
 
@@ -2,6 +2,7 @@ package mlrval
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -13,7 +14,7 @@ import (
 // e.g. "hello" < "true".
 //
 // Numerical sort rules (same for min, max, and comparator):
-// * NUMERICS < BOOL < STRINGS < ERROR < ABSENT
+// * NUMERICS < BOOL < TIMES < DURATIONS < STRINGS < ERROR < ABSENT
 // * error == error (singleton type)
 // * absent == absent (singleton type)
 // * string compares on strings
@@ -75,3 +76,24 @@ func TestComparators(t *testing.T) {
 
 	assert.Equal(t, -1, NumericAscendingComparator(FromErrorString("foo"), ABSENT))
 }
+
+func TestTimeAndDurationComparators(t *testing.T) {
+	t1 := FromTime(time.Unix(100, 0).UTC(), "")
+	t2 := FromTime(time.Unix(200, 0).UTC(), "")
+	// Same instant, different location
+	t2east := FromTime(time.Unix(200, 0).In(time.FixedZone("east", 3600)), "")
+	d1 := FromDuration(time.Second)
+	d2 := FromDuration(time.Minute)
+
+	assert.Equal(t, -1, NumericAscendingComparator(t1, t2))
+	assert.Equal(t, 1, NumericAscendingComparator(t2, t1))
+	assert.Equal(t, 0, NumericAscendingComparator(t2, t2east))
+	assert.Equal(t, -1, NumericAscendingComparator(d1, d2))
+	assert.Equal(t, 0, NumericAscendingComparator(d2, d2))
+
+	assert.Equal(t, -1, NumericAscendingComparator(FromInt(1000), t1))
+	assert.Equal(t, -1, NumericAscendingComparator(FromBool(true), t1))
+	assert.Equal(t, -1, NumericAscendingComparator(t2, d1))
+	assert.Equal(t, -1, NumericAscendingComparator(d2, FromString("abc")))
+	assert.Equal(t, -1, NumericAscendingComparator(d2, ABSENT))
+}
@@ -83,7 +83,9 @@ type MVType int8
 //
 // Also note the ordering of types reflects the sort order for mixed types,
 // with the exception that ints and floats sort numerically. So 1 < "abc" and 1
-// < "1", and 7 < true; but 1 < 1.1 < 2 < 2.2.
+// < "1", and 7 < true; but 1 < 1.1 < 2 < 2.2. Times and durations, added
+// later, are another exception: they sort after booleans and before strings.
+// Decimals sort numerically along with ints and floats.
 const (
 	// Type not yet determined: during JSON decode, or for JIT-data from file
 	// data whose type doesn't need to be determined yet. For example, when we
@@ -132,12 +134,25 @@ const (
 	// Key not present in input record, e.g. 'foo = $nosuchkey'
 	MT_ABSENT MVType = 10
 
+	// intf is *timeval: a Go time, with its location, and the strftime format
+	// to print it with. E.g. from the time DSL function, or from the cast verb's
+	// time type.
+	MT_TIME MVType = 11
+
+	// intf is time.Duration, e.g. from subtracting one time from another
+	MT_DURATION MVType = 12
+
+	// intf is *lib.Decimal. With mlr --decimal, numbers with decimal points
+	// are decimals rather than floats, and ints which overflow become
+	// decimals with no digits after the decimal point.
+	MT_DECIMAL MVType = 13
+
 	// Not a type -- this is a dimension for disposition vectors and
 	// disposition matrices. For example, when we want to add two mlrvals,
 	// instead of if/elsing or switching on the types of both operands, we
 	// instead jump directly to a type-specific function in a matrix of
 	// function pointers which is MT_DIM x MT_DIM.
-	MT_DIM MVType = 11
+	MT_DIM MVType = 14
 )
 
 var TYPE_NAMES = [MT_DIM]string{
@@ -152,14 +167,19 @@ var TYPE_NAMES = [MT_DIM]string{
 	"error",
 	"null",
 	"absent",
+	"time",
+	"duration",
+	"decimal",
 }
 
 // For typed assignments in the DSL
 
 // TODO: comment more re typedecls
+// Decimals are floats for typedecls, so that 'float x = 0.5' works with mlr
+// --decimal.
 const MT_TYPE_MASK_INT = 1 << MT_INT
-const MT_TYPE_MASK_FLOAT = 1 << MT_FLOAT
-const MT_TYPE_MASK_NUM = (1 << MT_INT) | (1 << MT_FLOAT)
+const MT_TYPE_MASK_FLOAT = (1 << MT_FLOAT) | (1 << MT_DECIMAL)
+const MT_TYPE_MASK_NUM = (1 << MT_INT) | (1 << MT_FLOAT) | (1 << MT_DECIMAL)
 const MT_TYPE_MASK_BOOL = 1 << MT_BOOL
 const MT_TYPE_MASK_STRING = (1 << MT_STRING) | (1 << MT_VOID)
 const MT_TYPE_MASK_ARRAY = 1 << MT_ARRAY
@@ -171,7 +191,10 @@ const MT_TYPE_MASK_VAR = (1 << MT_INT) |
 	(1 << MT_NULL) |
 	(1 << MT_STRING) |
 	(1 << MT_ARRAY) |
-	(1 << MT_MAP)
+	(1 << MT_MAP) |
+	(1 << MT_TIME) |
+	(1 << MT_DURATION) |
+	(1 << MT_DECIMAL)
 const MT_TYPE_MASK_FUNC = 1 << MT_FUNC
 
 // Not exposed in userspace
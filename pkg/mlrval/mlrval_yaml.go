@@ -0,0 +1,261 @@
+// ================================================================
+// Conversion between Mlrvals and YAML nodes, for YAML input and output.
+// Nested YAML maps and sequences are Miller maps and arrays, as with JSON.
+//
+// Please see also https://pkg.go.dev/gopkg.in/yaml.v3
+// ================================================================
+
+package mlrval
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MlrvalFromYAMLNode converts a decoded YAML node. As with JSON, quoted
+// strings are strings -- so "12" is a string while 12 is a number -- and
+// numbers are type-inferred from their original text so they're written back
+// out as they came in.
+func MlrvalFromYAMLNode(node *yaml.Node) (*Mlrval, error) {
+	return mlrvalFromYAMLNodeAux(node, 0)
+}
+
+// Aliases can refer to their own ancestors; this keeps us from following them
+// forever.
+const yamlMaxAliasDepth = 1000
+
+func mlrvalFromYAMLNodeAux(node *yaml.Node, depth int) (*Mlrval, error) {
+	if depth > yamlMaxAliasDepth {
+		return nil, fmt.Errorf("YAML aliases nested too deeply at line %d", node.Line)
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return NULL, nil
+		}
+		return mlrvalFromYAMLNodeAux(node.Content[0], depth)
+
+	case yaml.AliasNode:
+		return mlrvalFromYAMLNodeAux(node.Alias, depth+1)
+
+	case yaml.SequenceNode:
+		mv := FromEmptyArray()
+		for _, child := range node.Content {
+			element, err := mlrvalFromYAMLNodeAux(child, depth)
+			if err != nil {
+				return nil, err
+			}
+			mv.ArrayAppend(element)
+		}
+		return mv, nil
+
+	case yaml.MappingNode:
+		mlrmap := NewMlrmap()
+		err := putYAMLMappingNode(mlrmap, node, depth)
+		if err != nil {
+			return nil, err
+		}
+		return FromMap(mlrmap), nil
+
+	case yaml.ScalarNode:
+		return mlrvalFromYAMLScalarNode(node)
+	}
+
+	return nil, fmt.Errorf("unhandled YAML node kind %d at line %d", node.Kind, node.Line)
+}
+
+// putYAMLMappingNode puts the key-value pairs of a YAML mapping into a Miller
+// map. Merge keys, as in "<<: *defaults", bring in the pairs of the merged
+// mapping(s) except where the mapping has its own value for a key.
+func putYAMLMappingNode(mlrmap *Mlrmap, node *yaml.Node, depth int) error {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode := node.Content[i]
+		valueNode := node.Content[i+1]
+
+		if keyNode.Kind == yaml.ScalarNode && keyNode.ShortTag() == "!!merge" {
+			err := mergeYAMLMappingNode(mlrmap, valueNode, depth+1)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		for keyNode.Kind == yaml.AliasNode {
+			keyNode = keyNode.Alias
+		}
+		if keyNode.Kind != yaml.ScalarNode {
+			return fmt.Errorf("YAML map keys must be scalars; got a collection at line %d", keyNode.Line)
+		}
+
+		value, err := mlrvalFromYAMLNodeAux(valueNode, depth)
+		if err != nil {
+			return err
+		}
+		mlrmap.PutReference(keyNode.Value, value)
+	}
+	return nil
+}
+
+func mergeYAMLMappingNode(mlrmap *Mlrmap, node *yaml.Node, depth int) error {
+	if depth > yamlMaxAliasDepth {
+		return fmt.Errorf("YAML aliases nested too deeply at line %d", node.Line)
+	}
+	switch node.Kind {
+	case yaml.AliasNode:
+		return mergeYAMLMappingNode(mlrmap, node.Alias, depth+1)
+	case yaml.SequenceNode:
+		for _, child := range node.Content {
+			err := mergeYAMLMappingNode(mlrmap, child, depth)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case yaml.MappingNode:
+		merged := NewMlrmap()
+		err := putYAMLMappingNode(merged, node, depth)
+		if err != nil {
+			return err
+		}
+		for pe := merged.Head; pe != nil; pe = pe.Next {
+			if !mlrmap.Has(pe.Key) {
+				mlrmap.PutReference(pe.Key, pe.Value)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("YAML merge key must refer to a map at line %d", node.Line)
+}
+
+// Miller infers the other YAML number forms itself.
+var yamlInfNaNRegex = regexp.MustCompile(`^([-+]?\.(inf|Inf|INF)|\.(nan|NaN|NAN))$`)
+
+func mlrvalFromYAMLScalarNode(node *yaml.Node) (*Mlrval, error) {
+	switch node.ShortTag() {
+	case "!!null":
+		return NULL, nil
+
+	case "!!bool":
+		var boolValue bool
+		err := node.Decode(&boolValue)
+		if err != nil {
+			return nil, err
+		}
+		return FromBool(boolValue), nil
+
+	case "!!int", "!!float":
+		// As with other file formats, numbers with leading zeroes are strings
+		// unless -O is given.
+		mv := FromInferredType(node.Value)
+		if mv.IsNumeric() || !yamlInfNaNRegex.MatchString(node.Value) {
+			return mv, nil
+		}
+		var floatValue float64
+		err := node.Decode(&floatValue)
+		if err != nil {
+			return nil, err
+		}
+		return FromFloat(floatValue), nil
+
+	default:
+		// Strings, timestamps, binary, and application-specific tags are all
+		// strings for Miller.
+		return FromString(node.Value), nil
+	}
+}
+
+// ----------------------------------------------------------------
+
+// YAMLNodeFromMlrmap converts a record, or other map, for YAML output.
+func YAMLNodeFromMlrmap(mlrmap *Mlrmap) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for pe := mlrmap.Head; pe != nil; pe = pe.Next {
+		if pe.Value.IsAbsent() {
+			continue
+		}
+		node.Content = append(
+			node.Content,
+			yamlNodeFromString(pe.Key),
+			YAMLNodeFromMlrval(pe.Value),
+		)
+	}
+	return node
+}
+
+// YAMLNodeFromMlrval converts a value for YAML output. Strings are quoted
+// where needed to keep them from being read back in as numbers or booleans.
+func YAMLNodeFromMlrval(mv *Mlrval) *yaml.Node {
+	switch mv.Type() {
+	case MT_MAP:
+		return YAMLNodeFromMlrmap(mv.GetMap())
+
+	case MT_ARRAY:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for _, element := range mv.GetArray() {
+			node.Content = append(node.Content, YAMLNodeFromMlrval(element))
+		}
+		return node
+
+	case MT_NULL:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+
+	case MT_BOOL:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: mv.String()}
+
+	case MT_INT, MT_FLOAT, MT_DECIMAL:
+		return yamlNodeFromNumber(mv)
+
+	default:
+		return yamlNodeFromString(mv.String())
+	}
+}
+
+// yamlNodeFromString lets the YAML library choose the quoting, which also
+// covers strings such as "yes" and "off" which are booleans in YAML 1.1.
+func yamlNodeFromString(input string) *yaml.Node {
+	node := &yaml.Node{}
+	err := node.Encode(input)
+	if err != nil {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: input, Style: yaml.DoubleQuotedStyle}
+	}
+	return node
+}
+
+// These are the YAML 1.2 core-schema number forms; Miller's number formats
+// which aren't among them, such as 0b1011 or 1_000, are written in decimal.
+var yamlIntRegex = regexp.MustCompile(`^([-+]?[0-9]+|0o[0-7]+|0x[0-9a-fA-F]+)$`)
+var yamlFloatRegex = regexp.MustCompile(`^[-+]?(\.[0-9]+|[0-9]+(\.[0-9]*)?)([eE][-+]?[0-9]+)?$`)
+
+func yamlNodeFromNumber(mv *Mlrval) *yaml.Node {
+	output := mv.String()
+	if yamlIntRegex.MatchString(output) {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: output}
+	}
+	if yamlFloatRegex.MatchString(output) {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: output}
+	}
+
+	if intValue, ok := mv.GetIntValue(); ok {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.FormatInt(intValue, 10)}
+	}
+	if floatValue, ok := mv.GetNumericToFloatValue(); ok {
+		switch {
+		case math.IsNaN(floatValue):
+			output = ".nan"
+		case math.IsInf(floatValue, 1):
+			output = ".inf"
+		case math.IsInf(floatValue, -1):
+			output = "-.inf"
+		default:
+			output = strconv.FormatFloat(floatValue, 'g', -1, 64)
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: output}
+	}
+	// E.g. a number formatted by --ofmt or fmtnum into a non-numeric string
+	return yamlNodeFromString(output)
+}
@@ -0,0 +1,81 @@
+package mlrval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func decodeYAMLForTest(t *testing.T, input string) *Mlrval {
+	var node yaml.Node
+	assert.Nil(t, yaml.Unmarshal([]byte(input), &node))
+	mv, err := MlrvalFromYAMLNode(&node)
+	assert.Nil(t, err)
+	return mv
+}
+
+func encodeYAMLForTest(t *testing.T, mv *Mlrval) string {
+	output, err := yaml.Marshal(YAMLNodeFromMlrval(mv))
+	assert.Nil(t, err)
+	return string(output)
+}
+
+func TestMlrvalFromYAMLNodeScalars(t *testing.T) {
+	mv := decodeYAMLForTest(t, "a: 0123\nb: \"0123\"\nc: 1.50\nd: true\ne: ~\nf: yes\ng: .inf\n")
+	assert.True(t, mv.IsMap())
+	record := mv.GetMap()
+
+	// Leading zeroes, as with CSV and other formats
+	assert.Equal(t, MT_STRING, record.Get("a").Type())
+	assert.Equal(t, "0123", record.Get("a").String())
+	assert.Equal(t, MT_STRING, record.Get("b").Type())
+	assert.Equal(t, MT_FLOAT, record.Get("c").Type())
+	assert.Equal(t, "1.50", record.Get("c").String())
+	assert.Equal(t, MT_BOOL, record.Get("d").Type())
+	assert.Equal(t, MT_NULL, record.Get("e").Type())
+	// YAML 1.2, so this is a string
+	assert.Equal(t, MT_STRING, record.Get("f").Type())
+	assert.Equal(t, MT_FLOAT, record.Get("g").Type())
+}
+
+func TestMlrvalFromYAMLNodeCollections(t *testing.T) {
+	mv := decodeYAMLForTest(t, "base: &base {x: 1, y: 2}\nderived:\n  <<: *base\n  y: 3\nlist: [a, [b, c]]\n")
+	record := mv.GetMap()
+
+	derived := record.Get("derived").GetMap()
+	assert.Equal(t, "1", derived.Get("x").String())
+	assert.Equal(t, "3", derived.Get("y").String())
+
+	list := record.Get("list").GetArray()
+	assert.Equal(t, 2, len(list))
+	assert.Equal(t, "a", list[0].String())
+	assert.True(t, list[1].IsArray())
+
+	var node yaml.Node
+	assert.Nil(t, yaml.Unmarshal([]byte("? [a, b]\n: c\n"), &node))
+	_, err := MlrvalFromYAMLNode(&node)
+	assert.NotNil(t, err)
+}
+
+func TestYAMLNodeFromMlrval(t *testing.T) {
+	record := NewMlrmapAsRecord()
+	record.PutCopy("id", FromInferredType("7"))
+	record.PutCopy("zip", FromString("01234"))
+	record.PutCopy("flag", FromString("on"))
+	record.PutCopy("ok", FromBool(false))
+	record.PutCopy("hex", FromInferredType("0xff"))
+	record.PutCopy("bin", FromInferredType("0b101"))
+	record.PutCopy("nothing", ABSENT)
+
+	assert.Equal(t,
+		"id: 7\nzip: \"01234\"\nflag: \"on\"\nok: false\nhex: 0xff\nbin: 5\n",
+		encodeYAMLForTest(t, FromMap(record)),
+	)
+
+	// Round trip
+	back := decodeYAMLForTest(t, encodeYAMLForTest(t, FromMap(record))).GetMap()
+	assert.Equal(t, MT_STRING, back.Get("zip").Type())
+	assert.Equal(t, MT_STRING, back.Get("flag").Type())
+	assert.Equal(t, MT_INT, back.Get("hex").Type())
+}
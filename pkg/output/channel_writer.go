@@ -5,8 +5,12 @@ import (
 	"container/list"
 	"fmt"
 	"os"
+	"strconv"
+	"unicode/utf8"
 
 	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
 	"github.com/johnkerl/miller/v6/pkg/types"
 )
 
@@ -19,6 +23,7 @@ func ChannelWriter(
 	bufferedOutputStream *bufio.Writer,
 	outputIsStdout bool,
 ) {
+	charsetChecker := newOutputCharsetChecker(writerOptions)
 
 	for {
 		recordsAndContexts := <-writerChannel
@@ -26,6 +31,7 @@ func ChannelWriter(
 			recordsAndContexts,
 			recordWriter,
 			writerOptions,
+			charsetChecker,
 			dataProcessingErrorChannel,
 			bufferedOutputStream,
 			outputIsStdout,
@@ -48,6 +54,7 @@ func channelWriterHandleBatch(
 	recordsAndContexts *list.List,
 	recordWriter IRecordWriter,
 	writerOptions *cli.TWriterOptions,
+	charsetChecker *lib.CharsetChecker, // nil unless strict --oencoding
 	dataProcessingErrorChannel chan<- bool,
 	bufferedOutputStream *bufio.Writer,
 	outputIsStdout bool,
@@ -94,6 +101,14 @@ func channelWriterHandleBatch(
 				}
 			}
 
+			if charsetChecker != nil {
+				err := checkOutputCharset(charsetChecker, writerOptions, record, recordAndContext.OutputString, context)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "mlr: %v\n", err)
+					return true, true
+				}
+			}
+
 			if record != nil {
 				err := recordWriter.Write(record, context, bufferedOutputStream, outputIsStdout)
 				if err != nil {
@@ -128,3 +143,47 @@ func channelWriterHandleBatch(
 	}
 	return false, false
 }
+
+// checkOutputCharset is for strict --oencoding. Output stops before a record,
+// or print-statement output, which isn't all in the output character set,
+// rather than partway through it, and the error says where the unencodable
+// character is.
+func checkOutputCharset(
+	charsetChecker *lib.CharsetChecker,
+	writerOptions *cli.TWriterOptions,
+	record *mlrval.Mlrmap,
+	outputString string,
+	context *types.Context,
+) error {
+	where := fmt.Sprintf("NR=%d FNR=%d FILENAME=%s", context.NR, context.FNR, context.FILENAME)
+	if record != nil {
+		for pe := record.Head; pe != nil; pe = pe.Next {
+			err := checkOutputCharsetOf(charsetChecker, writerOptions, pe.Key, where+", field name")
+			if err != nil {
+				return err
+			}
+			err = checkOutputCharsetOf(charsetChecker, writerOptions, pe.Value.String(), where+", field "+strconv.Quote(pe.Key))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return checkOutputCharsetOf(charsetChecker, writerOptions, outputString, where+", print output")
+}
+
+func checkOutputCharsetOf(
+	charsetChecker *lib.CharsetChecker,
+	writerOptions *cli.TWriterOptions,
+	s string,
+	what string,
+) error {
+	offset := charsetChecker.Check(s)
+	if offset < 0 {
+		return nil
+	}
+	r, _ := utf8.DecodeRuneInString(s[offset:])
+	return fmt.Errorf(
+		"cannot write output as %s: at %s: character %q (%U) at byte %d of %q is not in the character set",
+		writerOptions.OutputCharset, what, r, r, offset, s,
+	)
+}
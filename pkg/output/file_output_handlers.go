@@ -207,6 +207,7 @@ func (manager *MultiOutputHandlerManager) Close() []error {
 type FileOutputHandler struct {
 	filename             string
 	handle               io.WriteCloser
+	encodingWriter       io.WriteCloser // for --oencoding; doesn't close handle
 	bufferedOutputStream *bufio.Writer
 	closeable            bool
 
@@ -219,6 +220,12 @@ type FileOutputHandler struct {
 	recordOutputChannel  chan *list.List // list of *types.RecordAndContext
 	recordDoneChannel    chan bool
 	recordErroredChannel chan bool
+	recordWriterErrored  bool
+
+	// For formats such as SQLite where the record-writer writes to the file
+	// itself, rather than to the output stream. Such files can't have
+	// print/dump output mixed in.
+	recordsOnly bool
 }
 
 func newOutputHandlerCommon(
@@ -227,10 +234,17 @@ func newOutputHandlerCommon(
 	closeable bool,
 	recordWriterOptions *cli.TWriterOptions,
 ) *FileOutputHandler {
+	encodingWriter, err := NewCharsetEncodingWriter(handle, recordWriterOptions)
+	if err != nil {
+		// The character-set name was checked at command-line parse.
+		fmt.Fprintf(os.Stderr, "mlr: %v\n", err)
+		os.Exit(1)
+	}
 	return &FileOutputHandler{
 		filename:             filename,
 		handle:               handle,
-		bufferedOutputStream: bufio.NewWriter(handle),
+		encodingWriter:       encodingWriter,
+		bufferedOutputStream: bufio.NewWriter(encodingWriter),
 		closeable:            closeable,
 
 		recordWriterOptions:  recordWriterOptions,
@@ -238,9 +252,42 @@ func newOutputHandlerCommon(
 		recordOutputChannel:  nil,
 		recordDoneChannel:    nil,
 		recordErroredChannel: nil,
+
+		recordsOnly: false,
 	}
 }
 
+// newSQLiteOutputHandler is for SQLite output, where the record-writer opens
+// the database file itself. For "> filename" the table is replaced; for ">>
+// filename" it's appended to.
+func newSQLiteOutputHandler(
+	filename string,
+	recordWriterOptions *cli.TWriterOptions,
+	doAppend bool,
+) (*FileOutputHandler, error) {
+	recordWriter, err := NewRecordWriterSQLiteToFile(recordWriterOptions, filename, doAppend)
+	if err != nil {
+		return nil, err
+	}
+	handler := newOutputHandlerCommon(
+		filename,
+		nopWriteCloser{io.Discard},
+		false,
+		recordWriterOptions,
+	)
+	handler.recordWriter = recordWriter
+	handler.recordsOnly = true
+	return handler, nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
 // ----------------------------------------------------------------
 func NewFileOutputHandler(
 	filename string,
@@ -258,6 +305,9 @@ func NewFileWriteOutputHandler(
 	filename string,
 	recordWriterOptions *cli.TWriterOptions,
 ) (*FileOutputHandler, error) {
+	if recordWriterOptions.OutputFileFormat == "sqlite" {
+		return newSQLiteOutputHandler(filename, recordWriterOptions, false)
+	}
 	handle, err := os.OpenFile(
 		filename,
 		os.O_CREATE|os.O_WRONLY|os.O_TRUNC,
@@ -278,6 +328,12 @@ func NewFileAppendOutputHandler(
 	filename string,
 	recordWriterOptions *cli.TWriterOptions,
 ) (*FileOutputHandler, error) {
+	if recordWriterOptions.OutputFileFormat == "sqlite" {
+		return newSQLiteOutputHandler(filename, recordWriterOptions, true)
+	}
+	if recordWriterOptions.OutputFileFormat == "xlsx" {
+		return nil, fmt.Errorf("XLSX output cannot be appended to file %s", filename)
+	}
 	handle, err := os.OpenFile(
 		filename,
 		os.O_CREATE|os.O_WRONLY|os.O_APPEND,
@@ -298,6 +354,9 @@ func NewPipeWriteOutputHandler(
 	commandString string,
 	recordWriterOptions *cli.TWriterOptions,
 ) (*FileOutputHandler, error) {
+	if recordWriterOptions.OutputFileFormat == "sqlite" {
+		return nil, fmt.Errorf("SQLite output cannot be piped to command \"%s\"", commandString)
+	}
 	writePipe, err := lib.OpenOutboundHalfPipe(commandString)
 	if err != nil {
 		return nil, fmt.Errorf("could not launch command \"%s\" for pipe-to.", commandString)
@@ -335,6 +394,9 @@ func newStderrOutputHandler(
 
 // ----------------------------------------------------------------
 func (handler *FileOutputHandler) WriteString(outputString string) error {
+	if handler.recordsOnly {
+		return fmt.Errorf("cannot write non-record output to SQLite file %s", handler.filename)
+	}
 	_, err := handler.bufferedOutputStream.WriteString(outputString)
 	return err
 }
@@ -344,7 +406,7 @@ func (handler *FileOutputHandler) WriteRecordAndContext(
 	outrecAndContext *types.RecordAndContext,
 ) error {
 	// Lazily create the record-writer and output channel.
-	if handler.recordWriter == nil {
+	if handler.recordOutputChannel == nil {
 		err := handler.setUpRecordWriter()
 		if err != nil {
 			return err
@@ -354,20 +416,37 @@ func (handler *FileOutputHandler) WriteRecordAndContext(
 	// TODO: myybe refactor to batch better
 	ell := list.New()
 	ell.PushBack(outrecAndContext)
-	handler.recordOutputChannel <- ell
-	return nil
+	return handler.sendToRecordWriter(ell)
+}
+
+// sendToRecordWriter passes records to the record-writer, unless it has
+// stopped on error, such as a record which can't be written in the --oencoding
+// character set, in which case it would never take them.
+func (handler *FileOutputHandler) sendToRecordWriter(ell *list.List) error {
+	if handler.recordWriterErrored {
+		return errors.New("exiting due to data error") // details already printed
+	}
+	select {
+	case handler.recordOutputChannel <- ell:
+		return nil
+	case _ = <-handler.recordErroredChannel:
+		handler.recordWriterErrored = true
+		return errors.New("exiting due to data error") // details already printed
+	}
 }
 
 func (handler *FileOutputHandler) setUpRecordWriter() error {
-	if handler.recordWriter != nil {
+	if handler.recordOutputChannel != nil {
 		return nil
 	}
 
-	recordWriter, err := Create(handler.recordWriterOptions)
-	if err != nil {
-		return err
+	if handler.recordWriter == nil {
+		recordWriter, err := Create(handler.recordWriterOptions)
+		if err != nil {
+			return err
+		}
+		handler.recordWriter = recordWriter
 	}
-	handler.recordWriter = recordWriter
 
 	handler.recordOutputChannel = make(chan *list.List, 1) // list of *types.RecordAndContext
 	handler.recordDoneChannel = make(chan bool, 1)
@@ -393,7 +472,10 @@ func (handler *FileOutputHandler) Close() (retval error) {
 	if handler.recordOutputChannel != nil {
 		// TODO: see if we need a real context
 		emptyContext := types.Context{}
-		handler.recordOutputChannel <- types.NewEndOfStreamMarkerList(&emptyContext)
+		err := handler.sendToRecordWriter(types.NewEndOfStreamMarkerList(&emptyContext))
+		if err != nil {
+			return err
+		}
 
 		// Wait for the output channel to drain
 		done := false
@@ -415,6 +497,7 @@ func (handler *FileOutputHandler) Close() (retval error) {
 	}
 
 	handler.bufferedOutputStream.Flush()
+	handler.encodingWriter.Close()
 	if handler.closeable {
 		return handler.handle.Close()
 	} else { // e.g. stdout
@@ -0,0 +1,210 @@
+// ================================================================
+// Conversion of Miller records to Apache Arrow record batches. This is shared
+// by the record-writers for the Arrow-backed binary formats.
+//
+// Binary columnar formats need a schema up front, while Miller records are
+// schema-free. So the writers hold back a batch of records, infer a schema
+// from them, and then hold the rest of the record stream to that schema.
+// ================================================================
+
+package output
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+)
+
+// arrowColumnKind is the widest Miller type seen in a column so far.
+type arrowColumnKind int
+
+const (
+	arrowColumnKindUnknown arrowColumnKind = iota // only empty values seen so far
+	arrowColumnKindInt
+	arrowColumnKindFloat
+	arrowColumnKindBool
+	arrowColumnKindString
+)
+
+func arrowColumnKindOf(value *mlrval.Mlrval) arrowColumnKind {
+	switch value.Type() {
+	case mlrval.MT_INT:
+		return arrowColumnKindInt
+	case mlrval.MT_FLOAT:
+		return arrowColumnKindFloat
+	case mlrval.MT_BOOL:
+		return arrowColumnKindBool
+	case mlrval.MT_VOID, mlrval.MT_ABSENT, mlrval.MT_NULL:
+		return arrowColumnKindUnknown
+	default:
+		return arrowColumnKindString
+	}
+}
+
+// widen returns the narrowest kind which can hold values of both kinds:
+// ints widen to floats, and anything else which is mixed widens to string.
+func (kind arrowColumnKind) widen(other arrowColumnKind) arrowColumnKind {
+	if kind == other || other == arrowColumnKindUnknown {
+		return kind
+	}
+	if kind == arrowColumnKindUnknown {
+		return other
+	}
+	if (kind == arrowColumnKindInt && other == arrowColumnKindFloat) ||
+		(kind == arrowColumnKindFloat && other == arrowColumnKindInt) {
+		return arrowColumnKindFloat
+	}
+	return arrowColumnKindString
+}
+
+func (kind arrowColumnKind) arrowDataType() arrow.DataType {
+	switch kind {
+	case arrowColumnKindInt:
+		return arrow.PrimitiveTypes.Int64
+	case arrowColumnKindFloat:
+		return arrow.PrimitiveTypes.Float64
+	case arrowColumnKindBool:
+		return arrow.FixedWidthTypes.Boolean
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// inferArrowSchema makes a schema having the union of the records' field
+// names, in order of first appearance, with each column typed by the widest
+// value seen in it. All columns are nullable since records need not have all
+// fields, and since empty values are written as nulls.
+func inferArrowSchema(records []*mlrval.Mlrmap) *arrow.Schema {
+	fieldNames := make([]string, 0)
+	kinds := make(map[string]arrowColumnKind)
+
+	for _, record := range records {
+		for pe := record.Head; pe != nil; pe = pe.Next {
+			kind, present := kinds[pe.Key]
+			if !present {
+				fieldNames = append(fieldNames, pe.Key)
+			}
+			kinds[pe.Key] = kind.widen(arrowColumnKindOf(pe.Value))
+		}
+	}
+
+	fields := make([]arrow.Field, len(fieldNames))
+	for i, fieldName := range fieldNames {
+		fields[i] = arrow.Field{
+			Name:     fieldName,
+			Type:     kinds[fieldName].arrowDataType(),
+			Nullable: true,
+		}
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+// mlrmapsToArrowRecord builds a record batch for the given schema. Fields
+// absent from a record are written as nulls; fields not in the schema, or
+// values which don't fit their column's type, are errors.
+func mlrmapsToArrowRecord(
+	schema *arrow.Schema,
+	records []*mlrval.Mlrmap,
+) (arrow.Record, error) {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+
+	for _, record := range records {
+		for pe := record.Head; pe != nil; pe = pe.Next {
+			if !schema.HasField(pe.Key) {
+				return nil, fmt.Errorf(
+					"field \"%s\" is not in the schema inferred from the first records; schema fields are %s",
+					pe.Key, schemaFieldNamesForMessage(schema),
+				)
+			}
+		}
+
+		for i, field := range schema.Fields() {
+			value := record.Get(field.Name)
+			err := appendMlrvalToArrowBuilder(builder.Field(i), value)
+			if err != nil {
+				return nil, fmt.Errorf("field \"%s\": %v", field.Name, err)
+			}
+		}
+	}
+
+	return builder.NewRecord(), nil
+}
+
+// mlrmapFitsArrowSchema says whether a record can be written with the given
+// schema: all its fields must be in the schema, and each value must be empty
+// or no wider than its column's type. If not, the reason is returned.
+func mlrmapFitsArrowSchema(schema *arrow.Schema, record *mlrval.Mlrmap) (bool, string) {
+	for pe := record.Head; pe != nil; pe = pe.Next {
+		indices := schema.FieldIndices(pe.Key)
+		if len(indices) == 0 {
+			return false, fmt.Sprintf("field \"%s\" is not in the schema", pe.Key)
+		}
+		columnKind := arrowColumnKindOfDataType(schema.Field(indices[0]).Type)
+		if columnKind.widen(arrowColumnKindOf(pe.Value)) != columnKind {
+			return false, fmt.Sprintf(
+				"field \"%s\" value %s does not fit column type %s",
+				pe.Key, pe.Value.StringMaybeQuoted(), schema.Field(indices[0]).Type,
+			)
+		}
+	}
+	return true, ""
+}
+
+func arrowColumnKindOfDataType(dataType arrow.DataType) arrowColumnKind {
+	switch dataType.ID() {
+	case arrow.INT64:
+		return arrowColumnKindInt
+	case arrow.FLOAT64:
+		return arrowColumnKindFloat
+	case arrow.BOOL:
+		return arrowColumnKindBool
+	default:
+		return arrowColumnKindString
+	}
+}
+
+func appendMlrvalToArrowBuilder(fieldBuilder array.Builder, value *mlrval.Mlrval) error {
+	if value == nil || arrowColumnKindOf(value) == arrowColumnKindUnknown {
+		fieldBuilder.AppendNull()
+		return nil
+	}
+
+	switch typedBuilder := fieldBuilder.(type) {
+	case *array.Int64Builder:
+		intValue, ok := value.GetIntValue()
+		if !ok {
+			return fmt.Errorf("value %s is not an int", value.StringMaybeQuoted())
+		}
+		typedBuilder.Append(intValue)
+	case *array.Float64Builder:
+		floatValue, ok := value.GetNumericToFloatValue()
+		if !ok {
+			return fmt.Errorf("value %s is not a number", value.StringMaybeQuoted())
+		}
+		typedBuilder.Append(floatValue)
+	case *array.BooleanBuilder:
+		boolValue, ok := value.GetBoolValue()
+		if !ok {
+			return fmt.Errorf("value %s is not a boolean", value.StringMaybeQuoted())
+		}
+		typedBuilder.Append(boolValue)
+	case *array.StringBuilder:
+		typedBuilder.Append(value.String())
+	default:
+		return fmt.Errorf("internal coding error: unhandled Arrow builder type %T", fieldBuilder)
+	}
+	return nil
+}
+
+func schemaFieldNamesForMessage(schema *arrow.Schema) string {
+	fieldNames := make([]string, schema.NumFields())
+	for i, field := range schema.Fields() {
+		fieldNames[i] = field.Name
+	}
+	return fmt.Sprintf("%q", fieldNames)
+}
@@ -0,0 +1,123 @@
+package output
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/ipc"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// Records are written in Arrow IPC streaming format, one record batch at a
+// time. The schema of each stream is inferred from its first batch of records.
+// If a later record doesn't fit that schema -- a new field, or a value too wide
+// for its column's type -- the current stream is ended and a new one is started
+// with a new schema. With mlr -x, that is an error instead.
+const arrowRecordsPerBatch = cli.DEFAULT_RECORDS_PER_BATCH
+
+// ----------------------------------------------------------------
+type RecordWriterArrow struct {
+	// Parameters:
+	writerOptions *cli.TWriterOptions
+
+	// State:
+	schema         *arrow.Schema
+	ipcWriter      *ipc.Writer
+	pendingRecords []*mlrval.Mlrmap
+}
+
+// ----------------------------------------------------------------
+func NewRecordWriterArrow(writerOptions *cli.TWriterOptions) (*RecordWriterArrow, error) {
+	return &RecordWriterArrow{
+		writerOptions:  writerOptions,
+		pendingRecords: make([]*mlrval.Mlrmap, 0, arrowRecordsPerBatch),
+	}, nil
+}
+
+// ----------------------------------------------------------------
+func (writer *RecordWriterArrow) Write(
+	outrec *mlrval.Mlrmap,
+	context *types.Context,
+	bufferedOutputStream *bufio.Writer,
+	outputIsStdout bool,
+) error {
+	if outrec == nil {
+		// End of record stream
+		return writer.endStream(bufferedOutputStream)
+	}
+
+	if writer.schema != nil {
+		fits, reason := mlrmapFitsArrowSchema(writer.schema, outrec)
+		if !fits {
+			if writer.writerOptions.FailOnDataError {
+				return fmt.Errorf(
+					"arrow writer: record at NR=%d FNR=%d FILENAME=%s does not fit the schema inferred from the first records: %s; schema fields are %s",
+					context.NR, context.FNR, context.FILENAME, reason, schemaFieldNamesForMessage(writer.schema),
+				)
+			}
+			err := writer.endStream(bufferedOutputStream)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.pendingRecords = append(writer.pendingRecords, outrec)
+	if len(writer.pendingRecords) < arrowRecordsPerBatch {
+		return nil
+	}
+	return writer.writeBatch(bufferedOutputStream)
+}
+
+func (writer *RecordWriterArrow) writeBatch(bufferedOutputStream *bufio.Writer) error {
+	if writer.ipcWriter == nil {
+		writer.schema = inferArrowSchema(writer.pendingRecords)
+		writer.ipcWriter = ipc.NewWriter(
+			bufferedOutputStream,
+			ipc.WithSchema(writer.schema),
+			ipc.WithAllocator(memory.DefaultAllocator),
+		)
+	}
+
+	arrowRecord, err := mlrmapsToArrowRecord(writer.schema, writer.pendingRecords)
+	if err != nil {
+		return fmt.Errorf("arrow writer: %v", err)
+	}
+	defer arrowRecord.Release()
+
+	err = writer.ipcWriter.Write(arrowRecord)
+	if err != nil {
+		return fmt.Errorf("arrow writer: %v", err)
+	}
+
+	writer.pendingRecords = writer.pendingRecords[:0]
+	return nil
+}
+
+// endStream writes out any pending records, then the end-of-stream marker.
+// The next record written, if any, starts a new stream.
+func (writer *RecordWriterArrow) endStream(bufferedOutputStream *bufio.Writer) error {
+	if len(writer.pendingRecords) > 0 {
+		err := writer.writeBatch(bufferedOutputStream)
+		if err != nil {
+			return err
+		}
+	}
+	if writer.ipcWriter == nil {
+		// No records at all: write nothing, as with the other output formats.
+		return nil
+	}
+
+	err := writer.ipcWriter.Close()
+	if err != nil {
+		return fmt.Errorf("arrow writer: %v", err)
+	}
+	writer.ipcWriter = nil
+	writer.schema = nil
+	return nil
+}
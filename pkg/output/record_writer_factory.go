@@ -2,18 +2,24 @@ package output
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
 )
 
 func Create(writerOptions *cli.TWriterOptions) (IRecordWriter, error) {
 	switch writerOptions.OutputFileFormat {
+	case "arrow":
+		return NewRecordWriterArrow(writerOptions)
 	case "csv":
 		return NewRecordWriterCSV(writerOptions)
 	case "csvlite":
 		return NewRecordWriterCSVLite(writerOptions)
 	case "dkvp":
 		return NewRecordWriterDKVP(writerOptions)
+	case "fixed":
+		return NewRecordWriterFixed(writerOptions)
 	case "json":
 		return NewRecordWriterJSON(writerOptions)
 	case "md":
@@ -22,13 +28,62 @@ func Create(writerOptions *cli.TWriterOptions) (IRecordWriter, error) {
 		return NewRecordWriterMarkdown(writerOptions)
 	case "nidx":
 		return NewRecordWriterNIDX(writerOptions)
+	case "parquet":
+		return NewRecordWriterParquet(writerOptions)
 	case "pprint":
 		return NewRecordWriterPPRINT(writerOptions)
+	case "sqlite":
+		return NewRecordWriterSQLite(writerOptions)
 	case "tsv":
 		return NewRecordWriterTSV(writerOptions)
+	case "xlsx":
+		return NewRecordWriterXLSX(writerOptions)
 	case "xtab":
 		return NewRecordWriterXTAB(writerOptions)
+	case "yaml":
+		return NewRecordWriterYAML(writerOptions)
 	default:
 		return nil, fmt.Errorf("output file format \"%s\" not found", writerOptions.OutputFileFormat)
 	}
 }
+
+// NewCharsetEncodingWriter wraps an output stream so that it's written in the
+// --oencoding character set, if any. Closing the wrapper flushes it but
+// doesn't close the output stream. Output in the binary formats is written
+// as-is.
+func NewCharsetEncodingWriter(
+	handle io.WriteCloser,
+	writerOptions *cli.TWriterOptions,
+) (io.WriteCloser, error) {
+	switch writerOptions.OutputFileFormat {
+	case "arrow", "parquet", "sqlite", "xlsx":
+		return nopWriteCloser{handle}, nil
+	}
+	if writerOptions.OutputCharset == "" {
+		return nopWriteCloser{handle}, nil
+	}
+	return lib.NewCharsetEncodingWriter(
+		handle,
+		writerOptions.OutputCharset,
+		writerOptions.CharsetErrorPolicy,
+	)
+}
+
+// newOutputCharsetChecker returns a checker for the --oencoding character set
+// in strict mode, so that records can be checked before they're written, or
+// nil if there's nothing to check.
+func newOutputCharsetChecker(writerOptions *cli.TWriterOptions) *lib.CharsetChecker {
+	switch writerOptions.OutputFileFormat {
+	case "arrow", "parquet", "sqlite", "xlsx":
+		return nil
+	}
+	if writerOptions.OutputCharset == "" || writerOptions.CharsetErrorPolicy != lib.CharsetErrorsStrict {
+		return nil
+	}
+	checker, err := lib.NewCharsetChecker(writerOptions.OutputCharset)
+	if err != nil {
+		// The character-set name was checked at command-line parse.
+		return nil
+	}
+	return checker
+}
@@ -0,0 +1,90 @@
+package output
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// RecordWriterFixed is for fixed-width output. Each field in the column
+// specification is written in its columns, padded with spaces or truncated as
+// needed; record fields not in the specification are not written.
+type RecordWriterFixed struct {
+	writerOptions *cli.TWriterOptions
+	fields        []cli.TFixedWidthField
+}
+
+func NewRecordWriterFixed(writerOptions *cli.TWriterOptions) (*RecordWriterFixed, error) {
+	if len(writerOptions.FixedWidthFields) == 0 {
+		return nil, fmt.Errorf("fixed-width output requires --fixed-spec or --fixed-spec-file")
+	}
+	return &RecordWriterFixed{
+		writerOptions: writerOptions,
+		fields:        writerOptions.FixedWidthFields,
+	}, nil
+}
+
+func (writer *RecordWriterFixed) Write(
+	outrec *mlrval.Mlrmap,
+	_ *types.Context,
+	bufferedOutputStream *bufio.Writer,
+	outputIsStdout bool,
+) error {
+	if outrec == nil {
+		// End of record stream: nothing special for this output format
+		return nil
+	}
+
+	column := 1
+	for i := range writer.fields {
+		field := &writer.fields[i]
+
+		if field.Start > column {
+			bufferedOutputStream.WriteString(strings.Repeat(" ", field.Start-column))
+		}
+
+		value := ""
+		mvalue := outrec.Get(field.Name)
+		if mvalue != nil {
+			value = mvalue.String()
+		}
+
+		width := field.Width()
+		valueWidth := utf8.RuneCountInString(value)
+		if valueWidth > width {
+			value = truncateToCharacters(value, width)
+			valueWidth = width
+		}
+		padding := strings.Repeat(" ", width-valueWidth)
+		if field.RightAligned {
+			bufferedOutputStream.WriteString(padding)
+			bufferedOutputStream.WriteString(value)
+		} else {
+			bufferedOutputStream.WriteString(value)
+			bufferedOutputStream.WriteString(padding)
+		}
+
+		column = field.End + 1
+	}
+	bufferedOutputStream.WriteString(writer.writerOptions.ORS)
+
+	return nil
+}
+
+// truncateToCharacters returns the first n characters of s, which has more
+// than n.
+func truncateToCharacters(s string, n int) string {
+	i := 0
+	for byteIndex := range s {
+		if i == n {
+			return s[:byteIndex]
+		}
+		i++
+	}
+	return s
+}
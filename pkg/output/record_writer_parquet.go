@@ -0,0 +1,102 @@
+package output
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/parquet"
+	"github.com/apache/arrow/go/v17/parquet/compress"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// Records are written out one row group at a time. The schema is inferred from
+// the first row group's worth of records, so this is also how far ahead the
+// writer looks when deciding column types.
+const parquetRecordsPerRowGroup = 10000
+
+// ----------------------------------------------------------------
+type RecordWriterParquet struct {
+	// Parameters:
+	writerOptions *cli.TWriterOptions
+
+	// State:
+	schema         *arrow.Schema
+	fileWriter     *pqarrow.FileWriter
+	pendingRecords []*mlrval.Mlrmap
+}
+
+// ----------------------------------------------------------------
+func NewRecordWriterParquet(writerOptions *cli.TWriterOptions) (*RecordWriterParquet, error) {
+	return &RecordWriterParquet{
+		writerOptions:  writerOptions,
+		pendingRecords: make([]*mlrval.Mlrmap, 0, parquetRecordsPerRowGroup),
+	}, nil
+}
+
+// ----------------------------------------------------------------
+func (writer *RecordWriterParquet) Write(
+	outrec *mlrval.Mlrmap,
+	_ *types.Context,
+	bufferedOutputStream *bufio.Writer,
+	outputIsStdout bool,
+) error {
+	if outrec != nil {
+		writer.pendingRecords = append(writer.pendingRecords, outrec)
+		if len(writer.pendingRecords) < parquetRecordsPerRowGroup {
+			return nil
+		}
+		return writer.writeRowGroup(bufferedOutputStream)
+	}
+
+	// End of record stream
+	if len(writer.pendingRecords) > 0 {
+		err := writer.writeRowGroup(bufferedOutputStream)
+		if err != nil {
+			return err
+		}
+	}
+	if writer.fileWriter == nil {
+		// No records at all: write nothing, as with the other output formats.
+		return nil
+	}
+	err := writer.fileWriter.Close()
+	if err != nil {
+		return fmt.Errorf("parquet writer: %v", err)
+	}
+	return nil
+}
+
+func (writer *RecordWriterParquet) writeRowGroup(bufferedOutputStream *bufio.Writer) error {
+	if writer.fileWriter == nil {
+		writer.schema = inferArrowSchema(writer.pendingRecords)
+		fileWriter, err := pqarrow.NewFileWriter(
+			writer.schema,
+			bufferedOutputStream,
+			parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy)),
+			pqarrow.DefaultWriterProps(),
+		)
+		if err != nil {
+			return fmt.Errorf("parquet writer: %v", err)
+		}
+		writer.fileWriter = fileWriter
+	}
+
+	arrowRecord, err := mlrmapsToArrowRecord(writer.schema, writer.pendingRecords)
+	if err != nil {
+		return fmt.Errorf("parquet writer: %v", err)
+	}
+	defer arrowRecord.Release()
+
+	err = writer.fileWriter.Write(arrowRecord)
+	if err != nil {
+		return fmt.Errorf("parquet writer: %v", err)
+	}
+
+	writer.pendingRecords = writer.pendingRecords[:0]
+	return nil
+}
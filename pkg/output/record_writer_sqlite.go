@@ -0,0 +1,364 @@
+// ================================================================
+// SQLite output writes records as rows of a table in a SQLite database file.
+// The table is created if it doesn't exist, with column types inferred from
+// the first records written; columns are added as new field names appear.
+//
+// Since SQLite databases are written in place, rather than as a byte stream,
+// there are three ways this writer is used:
+//
+// * Main output with --sqlite-output-file: rows are appended to the table in
+//   that database file.
+// * Main output to stdout: a new database is built in a temporary file, which
+//   is copied to stdout at end of stream.
+// * DSL tee/emit redirects, and the tee and split verbs: "> file" replaces the
+//   table in that database file, and ">> file" appends to it.
+// ================================================================
+
+package output
+
+import (
+	"bufio"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// Rows are inserted a batch at a time. New columns' types are inferred from
+// the batch in which they first appear.
+const sqliteRecordsPerBatch = cli.DEFAULT_RECORDS_PER_BATCH
+
+const defaultSQLiteTableName = "records"
+
+// ----------------------------------------------------------------
+type RecordWriterSQLite struct {
+	// Parameters:
+	writerOptions *cli.TWriterOptions
+	tableName     string
+	databasePath  string
+	doAppend      bool // else, replace the table
+	copyToStream  bool // the database is a temporary file to be copied to the output stream
+
+	// State:
+	db               *sql.DB
+	tx               *sql.Tx
+	columnNameSet    map[string]bool
+	insertStatements map[string]*sql.Stmt // keyed by joined field names
+	pendingRecords   []*mlrval.Mlrmap
+}
+
+// NewRecordWriterSQLite is for main output: to the --sqlite-output-file
+// database if given, else to stdout.
+func NewRecordWriterSQLite(writerOptions *cli.TWriterOptions) (*RecordWriterSQLite, error) {
+	if writerOptions.SQLiteOutputFileName != "" {
+		return NewRecordWriterSQLiteToFile(writerOptions, writerOptions.SQLiteOutputFileName, true)
+	}
+	writer := newRecordWriterSQLite(writerOptions, "", false)
+	writer.copyToStream = true
+	return writer, nil
+}
+
+// NewRecordWriterSQLiteToFile is for redirected output, where the database
+// file name is given by the redirect.
+func NewRecordWriterSQLiteToFile(
+	writerOptions *cli.TWriterOptions,
+	databasePath string,
+	doAppend bool,
+) (*RecordWriterSQLite, error) {
+	return newRecordWriterSQLite(writerOptions, databasePath, doAppend), nil
+}
+
+func newRecordWriterSQLite(
+	writerOptions *cli.TWriterOptions,
+	databasePath string,
+	doAppend bool,
+) *RecordWriterSQLite {
+	tableName := writerOptions.SQLiteTableName
+	if tableName == "" {
+		tableName = defaultSQLiteTableName
+	}
+	return &RecordWriterSQLite{
+		writerOptions:    writerOptions,
+		tableName:        tableName,
+		databasePath:     databasePath,
+		doAppend:         doAppend,
+		copyToStream:     false,
+		columnNameSet:    make(map[string]bool),
+		insertStatements: make(map[string]*sql.Stmt),
+		pendingRecords:   make([]*mlrval.Mlrmap, 0, sqliteRecordsPerBatch),
+	}
+}
+
+// ----------------------------------------------------------------
+func (writer *RecordWriterSQLite) Write(
+	outrec *mlrval.Mlrmap,
+	_ *types.Context,
+	bufferedOutputStream *bufio.Writer,
+	outputIsStdout bool,
+) error {
+	if outrec != nil {
+		writer.pendingRecords = append(writer.pendingRecords, outrec)
+		if len(writer.pendingRecords) < sqliteRecordsPerBatch {
+			return nil
+		}
+		return writer.writeBatch()
+	}
+
+	// End of record stream
+	if len(writer.pendingRecords) > 0 {
+		err := writer.writeBatch()
+		if err != nil {
+			return err
+		}
+	}
+	if writer.db == nil {
+		// No records at all: write nothing, as with the other output formats.
+		return nil
+	}
+	return writer.finish(bufferedOutputStream)
+}
+
+func (writer *RecordWriterSQLite) writeBatch() error {
+	if writer.db == nil {
+		err := writer.open()
+		if err != nil {
+			return writer.wrapError(err)
+		}
+	}
+
+	err := writer.addNewColumns()
+	if err != nil {
+		return writer.wrapError(err)
+	}
+
+	for _, record := range writer.pendingRecords {
+		err := writer.insertRecord(record)
+		if err != nil {
+			return writer.wrapError(err)
+		}
+	}
+	writer.pendingRecords = writer.pendingRecords[:0]
+	return nil
+}
+
+func (writer *RecordWriterSQLite) wrapError(err error) error {
+	if writer.copyToStream {
+		return fmt.Errorf("sqlite writer: table \"%s\": %v", writer.tableName, err)
+	}
+	return fmt.Errorf("sqlite writer: %s table \"%s\": %v", writer.databasePath, writer.tableName, err)
+}
+
+// open opens the database and starts the transaction within which all rows are
+// written.
+func (writer *RecordWriterSQLite) open() error {
+	if writer.copyToStream {
+		tempFile, err := os.CreateTemp("", "mlr-sqlite-output-*.db")
+		if err != nil {
+			return err
+		}
+		tempFile.Close()
+		writer.databasePath = tempFile.Name()
+	}
+
+	db, err := sql.Open("sqlite", writer.databasePath)
+	if err != nil {
+		return err
+	}
+	writer.db = db
+
+	// If we're appending to an existing table, start from its columns.
+	if writer.doAppend {
+		columnNames, err := lib.SQLiteGetColumnNames(db, writer.tableName)
+		if err != nil {
+			return err
+		}
+		for _, columnName := range columnNames {
+			writer.columnNameSet[columnName] = true
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	writer.tx = tx
+
+	if !writer.doAppend {
+		_, err := tx.Exec("DROP TABLE IF EXISTS " + lib.SQLiteQuoteIdentifier(writer.tableName))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addNewColumns creates the table, or adds columns to it, for field names in
+// the pending records which aren't yet columns.
+func (writer *RecordWriterSQLite) addNewColumns() error {
+	newColumnNames := make([]string, 0)
+	newColumnKinds := make(map[string]arrowColumnKind)
+	for _, record := range writer.pendingRecords {
+		for pe := record.Head; pe != nil; pe = pe.Next {
+			if writer.columnNameSet[pe.Key] {
+				continue
+			}
+			kind, seen := newColumnKinds[pe.Key]
+			if !seen {
+				newColumnNames = append(newColumnNames, pe.Key)
+			}
+			newColumnKinds[pe.Key] = kind.widen(arrowColumnKindOf(pe.Value))
+		}
+	}
+	if len(newColumnNames) == 0 {
+		return nil
+	}
+
+	quotedTableName := lib.SQLiteQuoteIdentifier(writer.tableName)
+	if len(writer.columnNameSet) == 0 {
+		columnDefinitions := make([]string, len(newColumnNames))
+		for i, columnName := range newColumnNames {
+			columnDefinitions[i] = lib.SQLiteQuoteIdentifier(columnName) + " " +
+				sqliteColumnType(newColumnKinds[columnName])
+		}
+		_, err := writer.tx.Exec(
+			"CREATE TABLE " + quotedTableName + " (" + strings.Join(columnDefinitions, ", ") + ")",
+		)
+		if err != nil {
+			return err
+		}
+	} else {
+		for _, columnName := range newColumnNames {
+			_, err := writer.tx.Exec(
+				"ALTER TABLE " + quotedTableName + " ADD COLUMN " +
+					lib.SQLiteQuoteIdentifier(columnName) + " " +
+					sqliteColumnType(newColumnKinds[columnName]),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, columnName := range newColumnNames {
+		writer.columnNameSet[columnName] = true
+	}
+	return nil
+}
+
+// sqliteColumnType maps inferred Miller types to SQLite column types. SQLite
+// has no boolean type, so booleans are written as text, as are maps and arrays
+// (as JSON) if auto-flatten is off.
+func sqliteColumnType(kind arrowColumnKind) string {
+	switch kind {
+	case arrowColumnKindInt:
+		return "INTEGER"
+	case arrowColumnKindFloat:
+		return "REAL"
+	default:
+		return "TEXT"
+	}
+}
+
+func (writer *RecordWriterSQLite) insertRecord(record *mlrval.Mlrmap) error {
+	if record.IsEmpty() {
+		// There's no such thing as a row with no columns.
+		return nil
+	}
+
+	statement, err := writer.getInsertStatement(record)
+	if err != nil {
+		return err
+	}
+
+	values := make([]interface{}, 0, record.FieldCount)
+	for pe := record.Head; pe != nil; pe = pe.Next {
+		values = append(values, mlrvalToSQLiteValue(pe.Value))
+	}
+	_, err = statement.Exec(values...)
+	return err
+}
+
+// getInsertStatement returns a prepared statement for records having the same
+// field names as the given one.
+func (writer *RecordWriterSQLite) getInsertStatement(record *mlrval.Mlrmap) (*sql.Stmt, error) {
+	fieldNames := record.GetKeys()
+	key := strings.Join(fieldNames, "\x00")
+	statement, ok := writer.insertStatements[key]
+	if ok {
+		return statement, nil
+	}
+
+	quotedNames := make([]string, len(fieldNames))
+	placeholders := make([]string, len(fieldNames))
+	for i, fieldName := range fieldNames {
+		quotedNames[i] = lib.SQLiteQuoteIdentifier(fieldName)
+		placeholders[i] = "?"
+	}
+	statement, err := writer.tx.Prepare(
+		"INSERT INTO " + lib.SQLiteQuoteIdentifier(writer.tableName) +
+			" (" + strings.Join(quotedNames, ", ") + ")" +
+			" VALUES (" + strings.Join(placeholders, ", ") + ")",
+	)
+	if err != nil {
+		return nil, err
+	}
+	writer.insertStatements[key] = statement
+	return statement, nil
+}
+
+// mlrvalToSQLiteValue maps Miller values to SQLite values. Empty values are
+// written as NULL.
+func mlrvalToSQLiteValue(value *mlrval.Mlrval) interface{} {
+	switch value.Type() {
+	case mlrval.MT_INT:
+		intValue, _ := value.GetIntValue()
+		return intValue
+	case mlrval.MT_FLOAT:
+		floatValue, _ := value.GetNumericToFloatValue()
+		return floatValue
+	case mlrval.MT_VOID, mlrval.MT_ABSENT, mlrval.MT_NULL:
+		return nil
+	default:
+		return value.String()
+	}
+}
+
+// finish commits the transaction and closes the database, copying it to the
+// output stream if it's a temporary file.
+func (writer *RecordWriterSQLite) finish(bufferedOutputStream *bufio.Writer) error {
+	for _, statement := range writer.insertStatements {
+		statement.Close()
+	}
+	err := writer.tx.Commit()
+	if err != nil {
+		writer.db.Close()
+		return writer.wrapError(err)
+	}
+	err = writer.db.Close()
+	if err != nil {
+		return writer.wrapError(err)
+	}
+
+	if !writer.copyToStream {
+		return nil
+	}
+	defer os.Remove(writer.databasePath)
+	handle, err := os.Open(writer.databasePath)
+	if err != nil {
+		return writer.wrapError(err)
+	}
+	defer handle.Close()
+	_, err = io.Copy(bufferedOutputStream, handle)
+	if err != nil {
+		return writer.wrapError(err)
+	}
+	return nil
+}
@@ -0,0 +1,405 @@
+// ================================================================
+// XLSX output writes records to the sheets of an Excel workbook. A new sheet
+// is started whenever the field names change, much as CSV output starts a new
+// header block, and when a sheet reaches Excel's maximum number of rows. The
+// workbook is a zip archive, which can only be written once all the records
+// are in, so the sheets are kept in memory until end of stream.
+//
+// The split verb uses WriteToSheet to put each of its groups in its own
+// sheet, rather than in its own file.
+// ================================================================
+
+package output
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+const xlsxDefaultSheetName = "Sheet1"
+const xlsxMaxSheetNameLength = 31
+const xlsxMaxRowsPerSheet = 1048576
+
+// Integers beyond this lose precision as Excel numbers, so they're written as
+// text.
+const xlsxMaxExactInt = 1 << 53
+
+// These are indices into the cellXfs in xlsxStylesXML.
+const xlsxStyleDate = 1
+const xlsxStyleDateTime = 2
+
+// ----------------------------------------------------------------
+type RecordWriterXLSX struct {
+	writerOptions *cli.TWriterOptions
+
+	sheets             []*tXLSXOutputSheet
+	latestSheetByGroup map[string]*tXLSXOutputSheet
+	sheetCountByGroup  map[string]int
+	usedSheetNames     map[string]bool // lowercased, as Excel sheet names are case-insensitive
+}
+
+type tXLSXOutputSheet struct {
+	name         string
+	joinedHeader string
+	rowCount     int64
+	rows         bytes.Buffer // the sheetData XML
+}
+
+func NewRecordWriterXLSX(writerOptions *cli.TWriterOptions) (*RecordWriterXLSX, error) {
+	return &RecordWriterXLSX{
+		writerOptions:      writerOptions,
+		sheets:             make([]*tXLSXOutputSheet, 0),
+		latestSheetByGroup: make(map[string]*tXLSXOutputSheet),
+		sheetCountByGroup:  make(map[string]int),
+		usedSheetNames:     make(map[string]bool),
+	}, nil
+}
+
+// ----------------------------------------------------------------
+func (writer *RecordWriterXLSX) Write(
+	outrec *mlrval.Mlrmap,
+	_ *types.Context,
+	bufferedOutputStream *bufio.Writer,
+	outputIsStdout bool,
+) error {
+	if outrec == nil {
+		// End of record stream
+		return writer.WriteWorkbook(bufferedOutputStream)
+	}
+	writer.WriteToSheet(outrec, "")
+	return nil
+}
+
+// WriteToSheet adds a record to the sheet for the given name, or, for the
+// empty name, to the main sequence of sheets named by --sheet. If the record's
+// field names differ from those of the sheet, or the sheet is full, it's
+// continued in a new sheet with " (2)", " (3)", etc. appended to the name.
+func (writer *RecordWriterXLSX) WriteToSheet(outrec *mlrval.Mlrmap, sheetName string) {
+	if outrec.IsEmpty() {
+		// There's no such thing as a row with no cells.
+		return
+	}
+
+	joinedHeader := outrec.GetKeysJoined()
+	sheet := writer.latestSheetByGroup[sheetName]
+	if sheet == nil || sheet.joinedHeader != joinedHeader || sheet.rowCount >= xlsxMaxRowsPerSheet {
+		sheet = writer.newSheet(sheetName, joinedHeader)
+		if !writer.writerOptions.HeaderlessOutput {
+			sheet.writeHeaderRow(outrec)
+		}
+	}
+
+	sheet.writeRow(outrec)
+}
+
+func (writer *RecordWriterXLSX) newSheet(sheetName string, joinedHeader string) *tXLSXOutputSheet {
+	sheet := &tXLSXOutputSheet{
+		name:         writer.makeSheetName(sheetName),
+		joinedHeader: joinedHeader,
+	}
+	writer.sheets = append(writer.sheets, sheet)
+	writer.latestSheetByGroup[sheetName] = sheet
+	return sheet
+}
+
+// makeSheetName makes a unique, valid sheet name: at most 31 characters, none
+// of which are []:*?/\, and not starting or ending with an apostrophe.
+func (writer *RecordWriterXLSX) makeSheetName(sheetName string) string {
+	baseName := sheetName
+	if baseName == "" {
+		baseName = writer.writerOptions.XLSXSheet
+		if baseName == "" {
+			baseName = xlsxDefaultSheetName
+		}
+	}
+	baseName = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) || r < ' ' {
+			return '_'
+		}
+		return r
+	}, baseName)
+	if strings.HasPrefix(baseName, "'") {
+		baseName = "_" + baseName[1:]
+	}
+	if strings.HasSuffix(baseName, "'") {
+		baseName = baseName[:len(baseName)-1] + "_"
+	}
+
+	for {
+		writer.sheetCountByGroup[sheetName]++
+		count := writer.sheetCountByGroup[sheetName]
+		suffix := ""
+		if count > 1 {
+			suffix = fmt.Sprintf(" (%d)", count)
+		}
+		name := truncateToCharacters(baseName, xlsxMaxSheetNameLength-utf8.RuneCountInString(suffix)) + suffix
+		if !writer.usedSheetNames[strings.ToLower(name)] {
+			writer.usedSheetNames[strings.ToLower(name)] = true
+			return name
+		}
+	}
+}
+
+// ----------------------------------------------------------------
+func (sheet *tXLSXOutputSheet) writeHeaderRow(outrec *mlrval.Mlrmap) {
+	sheet.rowCount++
+	sheet.startRow()
+	i := 0
+	for pe := outrec.Head; pe != nil; pe = pe.Next {
+		sheet.writeTextCell(i, pe.Key)
+		i++
+	}
+	sheet.rows.WriteString("</row>")
+}
+
+func (sheet *tXLSXOutputSheet) writeRow(outrec *mlrval.Mlrmap) {
+	sheet.rowCount++
+	sheet.startRow()
+	i := 0
+	for pe := outrec.Head; pe != nil; pe = pe.Next {
+		sheet.writeCell(i, pe.Value)
+		i++
+	}
+	sheet.rows.WriteString("</row>")
+}
+
+func (sheet *tXLSXOutputSheet) startRow() {
+	sheet.rows.WriteString(`<row r="`)
+	sheet.rows.WriteString(strconv.FormatInt(sheet.rowCount, 10))
+	sheet.rows.WriteString(`">`)
+}
+
+// writeCell writes ints and floats as numbers, booleans as booleans, and times
+// as dates, and everything else as text. Empty values are empty cells.
+func (sheet *tXLSXOutputSheet) writeCell(columnIndex int, value *mlrval.Mlrval) {
+	switch value.Type() {
+	case mlrval.MT_VOID, mlrval.MT_ABSENT:
+		return
+
+	case mlrval.MT_INT:
+		intValue, _ := value.GetIntValue()
+		if intValue >= -xlsxMaxExactInt && intValue <= xlsxMaxExactInt {
+			sheet.writeValueCell(columnIndex, "", 0, strconv.FormatInt(intValue, 10))
+			return
+		}
+
+	case mlrval.MT_FLOAT, mlrval.MT_DECIMAL:
+		// The output string is as formatted by --ofmt or fmtnum.
+		floatValue, err := strconv.ParseFloat(value.String(), 64)
+		if err == nil && !math.IsInf(floatValue, 0) && !math.IsNaN(floatValue) {
+			sheet.writeValueCell(columnIndex, "", 0, strconv.FormatFloat(floatValue, 'g', -1, 64))
+			return
+		}
+
+	case mlrval.MT_BOOL:
+		if value.String() == "true" {
+			sheet.writeValueCell(columnIndex, "b", 0, "1")
+		} else {
+			sheet.writeValueCell(columnIndex, "b", 0, "0")
+		}
+		return
+
+	case mlrval.MT_TIME:
+		t := value.AcquireTimeValue()
+		serial, ok := lib.TimeToExcelSerial(t)
+		if ok {
+			style := xlsxStyleDateTime
+			if serial == math.Floor(serial) {
+				style = xlsxStyleDate
+			}
+			sheet.writeValueCell(columnIndex, "", style, strconv.FormatFloat(serial, 'g', -1, 64))
+			return
+		}
+	}
+
+	sheet.writeTextCell(columnIndex, value.String())
+}
+
+func (sheet *tXLSXOutputSheet) writeValueCell(columnIndex int, cellType string, style int, text string) {
+	sheet.rows.WriteString(`<c r="`)
+	sheet.rows.WriteString(lib.ExcelCellReference(columnIndex, sheet.rowCount))
+	if cellType != "" {
+		sheet.rows.WriteString(`" t="`)
+		sheet.rows.WriteString(cellType)
+	}
+	if style != 0 {
+		sheet.rows.WriteString(`" s="`)
+		sheet.rows.WriteString(strconv.Itoa(style))
+	}
+	sheet.rows.WriteString(`"><v>`)
+	sheet.rows.WriteString(text)
+	sheet.rows.WriteString(`</v></c>`)
+}
+
+// writeTextCell writes inline text, rather than using a shared-strings table,
+// so that rows can be written as they come.
+func (sheet *tXLSXOutputSheet) writeTextCell(columnIndex int, text string) {
+	if text == "" {
+		return
+	}
+	sheet.rows.WriteString(`<c r="`)
+	sheet.rows.WriteString(lib.ExcelCellReference(columnIndex, sheet.rowCount))
+	sheet.rows.WriteString(`" t="inlineStr"><is><t xml:space="preserve">`)
+	xml.EscapeText(&sheet.rows, []byte(text))
+	sheet.rows.WriteString(`</t></is></c>`)
+}
+
+// ----------------------------------------------------------------
+
+// WriteWorkbook writes the sheets as an XLSX file. If there are no sheets,
+// nothing is written, as with the other output formats.
+func (writer *RecordWriterXLSX) WriteWorkbook(outputStream io.Writer) error {
+	if len(writer.sheets) == 0 {
+		return nil
+	}
+
+	archive := zip.NewWriter(outputStream)
+
+	var contentTypes strings.Builder
+	var workbookSheets strings.Builder
+	var workbookRelationships strings.Builder
+	for i, sheet := range writer.sheets {
+		sheetNumber := i + 1
+		fmt.Fprintf(&contentTypes,
+			`<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`,
+			sheetNumber)
+		fmt.Fprintf(&workbookSheets, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`,
+			xlsxEscapeAttribute(sheet.name), sheetNumber, sheetNumber)
+		fmt.Fprintf(&workbookRelationships,
+			`<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`,
+			sheetNumber, sheetNumber)
+	}
+	stylesID := len(writer.sheets) + 1
+	fmt.Fprintf(&workbookRelationships,
+		`<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`,
+		stylesID)
+
+	parts := []struct {
+		name     string
+		contents string
+	}{
+		{"[Content_Types].xml", fmt.Sprintf(xlsxContentTypesXML, contentTypes.String())},
+		{"_rels/.rels", xlsxPackageRelationshipsXML},
+		{"xl/workbook.xml", fmt.Sprintf(xlsxWorkbookXML, workbookSheets.String())},
+		{"xl/_rels/workbook.xml.rels", fmt.Sprintf(xlsxRelationshipsXML, workbookRelationships.String())},
+		{"xl/styles.xml", xlsxStylesXML},
+	}
+	for _, part := range parts {
+		err := writeXLSXPart(archive, part.name, part.contents)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, sheet := range writer.sheets {
+		partWriter, err := createXLSXPart(archive, fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1))
+		if err != nil {
+			return fmt.Errorf("xlsx writer: %v", err)
+		}
+		_, err = io.WriteString(partWriter, xlsxWorksheetXMLHead)
+		if err == nil {
+			_, err = partWriter.Write(sheet.rows.Bytes())
+		}
+		if err == nil {
+			_, err = io.WriteString(partWriter, xlsxWorksheetXMLTail)
+		}
+		if err != nil {
+			return fmt.Errorf("xlsx writer: %v", err)
+		}
+	}
+
+	err := archive.Close()
+	if err != nil {
+		return fmt.Errorf("xlsx writer: %v", err)
+	}
+	return nil
+}
+
+// Parts are all dated at the start of the zip epoch, so that the same records
+// make the same file.
+var xlsxPartModificationTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func createXLSXPart(archive *zip.Writer, name string) (io.Writer, error) {
+	return archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: xlsxPartModificationTime,
+	})
+}
+
+func writeXLSXPart(archive *zip.Writer, name string, contents string) error {
+	partWriter, err := createXLSXPart(archive, name)
+	if err == nil {
+		_, err = io.WriteString(partWriter, contents)
+	}
+	if err != nil {
+		return fmt.Errorf("xlsx writer: %v", err)
+	}
+	return nil
+}
+
+func xlsxEscapeAttribute(s string) string {
+	var buffer bytes.Buffer
+	xml.EscapeText(&buffer, []byte(s))
+	return buffer.String()
+}
+
+const xlsxXMLDeclaration = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+const xlsxContentTypesXML = xlsxXMLDeclaration +
+	`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`%s</Types>`
+
+const xlsxPackageRelationshipsXML = xlsxXMLDeclaration +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbookXML = xlsxXMLDeclaration +
+	`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets>%s</sheets></workbook>`
+
+const xlsxRelationshipsXML = xlsxXMLDeclaration +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">%s</Relationships>`
+
+// Style 0 is the default; styles 1 and 2 are for dates and date-times.
+const xlsxStylesXML = xlsxXMLDeclaration +
+	`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="2">` +
+	`<numFmt numFmtId="164" formatCode="yyyy-mm-dd"/>` +
+	`<numFmt numFmtId="165" formatCode="yyyy-mm-dd hh:mm:ss"/>` +
+	`</numFmts>` +
+	`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="3">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="165" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+const xlsxWorksheetXMLHead = xlsxXMLDeclaration +
+	`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const xlsxWorksheetXMLTail = `</sheetData></worksheet>`
@@ -0,0 +1,62 @@
+package output
+
+import (
+	"bufio"
+	"bytes"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// RecordWriterYAML writes each record as a YAML document, with "---" between
+// documents, so the output can be read back in by Miller or other YAML tools.
+type RecordWriterYAML struct {
+	writerOptions *cli.TWriterOptions
+	wroteAny      bool
+	buffer        bytes.Buffer
+}
+
+func NewRecordWriterYAML(writerOptions *cli.TWriterOptions) (*RecordWriterYAML, error) {
+	return &RecordWriterYAML{
+		writerOptions: writerOptions,
+		wroteAny:      false,
+	}, nil
+}
+
+func (writer *RecordWriterYAML) Write(
+	outrec *mlrval.Mlrmap,
+	_ *types.Context,
+	bufferedOutputStream *bufio.Writer,
+	outputIsStdout bool,
+) error {
+	if outrec == nil {
+		// End of record stream: nothing special for this output format
+		return nil
+	}
+
+	// The encoder is per record since closing it is what finishes a document,
+	// and its own "---" separators would only be written between documents
+	// which it encoded.
+	writer.buffer.Reset()
+	encoder := yaml.NewEncoder(&writer.buffer)
+	encoder.SetIndent(2)
+	err := encoder.Encode(mlrval.YAMLNodeFromMlrmap(outrec))
+	if err != nil {
+		return err
+	}
+	err = encoder.Close()
+	if err != nil {
+		return err
+	}
+
+	if writer.wroteAny {
+		bufferedOutputStream.WriteString("---\n")
+	}
+	bufferedOutputStream.Write(writer.buffer.Bytes())
+	writer.wroteAny = true
+
+	return nil
+}
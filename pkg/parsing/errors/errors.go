@@ -48,6 +48,12 @@ func (e *Error) String() string {
 	return w.String()
 }
 
+// tokenDisplayNames are for token names in the grammar which aren't what's
+// typed, e.g. since "import" is a GOCC keyword.
+var tokenDisplayNames = map[string]string{
+	"kw_import": "import",
+}
+
 func (e *Error) Error() string {
 	w := new(strings.Builder)
 	fmt.Fprintf(
@@ -85,6 +91,9 @@ func (e *Error) Error() string {
 			if line != "" {
 				line += " "
 			}
+			if displayName, ok := tokenDisplayNames[expected]; ok {
+				expected = displayName
+			}
 			line += expected
 			if len(line) > 70 {
 				fmt.Fprintf(w, "  %s\n", line)
@@ -29,7 +29,7 @@ var ActTab = ActionTable{
 		Ignore: "!whitespace",
 	},
 	ActionRow{ // S2
-		Accept: 98,
+		Accept: 102,
 		Ignore: "",
 	},
 	ActionRow{ // S3
@@ -45,11 +45,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S6
-		Accept: 93,
+		Accept: 97,
 		Ignore: "",
 	},
 	ActionRow{ // S7
-		Accept: 83,
+		Accept: 87,
 		Ignore: "",
 	},
 	ActionRow{ // S8
@@ -61,11 +61,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S10
-		Accept: 90,
+		Accept: 94,
 		Ignore: "",
 	},
 	ActionRow{ // S11
-		Accept: 86,
+		Accept: 90,
 		Ignore: "",
 	},
 	ActionRow{ // S12
@@ -73,27 +73,27 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S13
-		Accept: 87,
+		Accept: 91,
 		Ignore: "",
 	},
 	ActionRow{ // S14
-		Accept: 97,
+		Accept: 101,
 		Ignore: "",
 	},
 	ActionRow{ // S15
-		Accept: 91,
+		Accept: 95,
 		Ignore: "",
 	},
 	ActionRow{ // S16
-		Accept: 105,
+		Accept: 109,
 		Ignore: "",
 	},
 	ActionRow{ // S17
-		Accept: 105,
+		Accept: 109,
 		Ignore: "",
 	},
 	ActionRow{ // S18
-		Accept: 70,
+		Accept: 74,
 		Ignore: "",
 	},
 	ActionRow{ // S19
@@ -101,7 +101,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S20
-		Accept: 80,
+		Accept: 84,
 		Ignore: "",
 	},
 	ActionRow{ // S21
@@ -113,7 +113,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S23
-		Accept: 69,
+		Accept: 73,
 		Ignore: "",
 	},
 	ActionRow{ // S24
@@ -149,7 +149,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S32
-		Accept: 114,
+		Accept: 118,
 		Ignore: "",
 	},
 	ActionRow{ // S33
@@ -157,7 +157,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S34
-		Accept: 82,
+		Accept: 86,
 		Ignore: "",
 	},
 	ActionRow{ // S35
@@ -241,11 +241,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S55
-		Accept: 99,
+		Accept: 103,
 		Ignore: "",
 	},
 	ActionRow{ // S56
-		Accept: 77,
+		Accept: 81,
 		Ignore: "",
 	},
 	ActionRow{ // S57
@@ -253,7 +253,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S58
-		Accept: 103,
+		Accept: 107,
 		Ignore: "",
 	},
 	ActionRow{ // S59
@@ -293,67 +293,67 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S68
-		Accept: 67,
+		Accept: 71,
 		Ignore: "",
 	},
 	ActionRow{ // S69
-		Accept: 73,
+		Accept: 77,
 		Ignore: "",
 	},
 	ActionRow{ // S70
-		Accept: 56,
+		Accept: 60,
 		Ignore: "",
 	},
 	ActionRow{ // S71
-		Accept: 102,
+		Accept: 106,
 		Ignore: "",
 	},
 	ActionRow{ // S72
-		Accept: 64,
+		Accept: 68,
 		Ignore: "",
 	},
 	ActionRow{ // S73
-		Accept: 61,
+		Accept: 65,
 		Ignore: "",
 	},
 	ActionRow{ // S74
-		Accept: 63,
+		Accept: 67,
 		Ignore: "",
 	},
 	ActionRow{ // S75
-		Accept: 94,
+		Accept: 98,
 		Ignore: "",
 	},
 	ActionRow{ // S76
-		Accept: 88,
+		Accept: 92,
 		Ignore: "",
 	},
 	ActionRow{ // S77
-		Accept: 89,
+		Accept: 93,
 		Ignore: "",
 	},
 	ActionRow{ // S78
-		Accept: 95,
+		Accept: 99,
 		Ignore: "",
 	},
 	ActionRow{ // S79
-		Accept: 106,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S80
-		Accept: 62,
+		Accept: 66,
 		Ignore: "",
 	},
 	ActionRow{ // S81
-		Accept: 92,
+		Accept: 96,
 		Ignore: "",
 	},
 	ActionRow{ // S82
-		Accept: 65,
+		Accept: 69,
 		Ignore: "",
 	},
 	ActionRow{ // S83
-		Accept: 106,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S84
@@ -373,55 +373,55 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S88
-		Accept: 84,
+		Accept: 152,
 		Ignore: "",
 	},
 	ActionRow{ // S89
-		Accept: 81,
+		Accept: 88,
 		Ignore: "",
 	},
 	ActionRow{ // S90
-		Accept: 76,
+		Accept: 85,
 		Ignore: "",
 	},
 	ActionRow{ // S91
-		Accept: 74,
+		Accept: 80,
 		Ignore: "",
 	},
 	ActionRow{ // S92
-		Accept: 79,
+		Accept: 78,
 		Ignore: "",
 	},
 	ActionRow{ // S93
-		Accept: 10,
+		Accept: 83,
 		Ignore: "",
 	},
 	ActionRow{ // S94
-		Accept: 100,
+		Accept: 10,
 		Ignore: "",
 	},
 	ActionRow{ // S95
-		Accept: 38,
+		Accept: 104,
 		Ignore: "",
 	},
 	ActionRow{ // S96
-		Accept: 35,
+		Accept: 38,
 		Ignore: "",
 	},
 	ActionRow{ // S97
-		Accept: 36,
+		Accept: 35,
 		Ignore: "",
 	},
 	ActionRow{ // S98
-		Accept: 35,
+		Accept: 36,
 		Ignore: "",
 	},
 	ActionRow{ // S99
-		Accept: 0,
+		Accept: 35,
 		Ignore: "",
 	},
 	ActionRow{ // S100
-		Accept: 40,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S101
@@ -469,15 +469,15 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S112
-		Accept: 122,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S113
-		Accept: 123,
+		Accept: 126,
 		Ignore: "",
 	},
 	ActionRow{ // S114
-		Accept: 40,
+		Accept: 127,
 		Ignore: "",
 	},
 	ActionRow{ // S115
@@ -493,19 +493,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S118
-		Accept: 128,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S119
-		Accept: 57,
+		Accept: 132,
 		Ignore: "",
 	},
 	ActionRow{ // S120
-		Accept: 72,
+		Accept: 61,
 		Ignore: "",
 	},
 	ActionRow{ // S121
-		Accept: 40,
+		Accept: 76,
 		Ignore: "",
 	},
 	ActionRow{ // S122
@@ -517,7 +517,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S124
-		Accept: 40,
+		Accept: 44,
 		Ignore: "",
 	},
 	ActionRow{ // S125
@@ -533,7 +533,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S128
-		Accept: 137,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S129
@@ -545,7 +545,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S131
-		Accept: 40,
+		Accept: 143,
 		Ignore: "",
 	},
 	ActionRow{ // S132
@@ -581,11 +581,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S140
-		Accept: 133,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S141
-		Accept: 139,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S142
@@ -593,7 +593,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S143
-		Accept: 40,
+		Accept: 137,
 		Ignore: "",
 	},
 	ActionRow{ // S144
@@ -601,7 +601,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S145
-		Accept: 40,
+		Accept: 145,
 		Ignore: "",
 	},
 	ActionRow{ // S146
@@ -633,51 +633,51 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S153
-		Accept: 55,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S154
-		Accept: 71,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S155
-		Accept: 75,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S156
-		Accept: 104,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S157
-		Accept: 103,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S158
-		Accept: 32,
+		Accept: 59,
 		Ignore: "",
 	},
 	ActionRow{ // S159
-		Accept: 0,
+		Accept: 75,
 		Ignore: "",
 	},
 	ActionRow{ // S160
-		Accept: 0,
+		Accept: 79,
 		Ignore: "",
 	},
 	ActionRow{ // S161
-		Accept: 0,
+		Accept: 108,
 		Ignore: "",
 	},
 	ActionRow{ // S162
-		Accept: 52,
+		Accept: 107,
 		Ignore: "",
 	},
 	ActionRow{ // S163
-		Accept: 68,
+		Accept: 32,
 		Ignore: "",
 	},
 	ActionRow{ // S164
-		Accept: 96,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S165
@@ -685,19 +685,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S166
-		Accept: 66,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S167
-		Accept: 106,
+		Accept: 56,
 		Ignore: "",
 	},
 	ActionRow{ // S168
-		Accept: 0,
+		Accept: 72,
 		Ignore: "",
 	},
 	ActionRow{ // S169
-		Accept: 0,
+		Accept: 100,
 		Ignore: "",
 	},
 	ActionRow{ // S170
@@ -705,155 +705,155 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S171
-		Accept: 106,
+		Accept: 70,
 		Ignore: "",
 	},
 	ActionRow{ // S172
-		Accept: 105,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S173
-		Accept: 105,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S174
-		Accept: 105,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S175
-		Accept: 58,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S176
-		Accept: 78,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S177
-		Accept: 59,
+		Accept: 109,
 		Ignore: "",
 	},
 	ActionRow{ // S178
-		Accept: 85,
+		Accept: 109,
 		Ignore: "",
 	},
 	ActionRow{ // S179
-		Accept: 53,
+		Accept: 109,
 		Ignore: "",
 	},
 	ActionRow{ // S180
-		Accept: 101,
+		Accept: 62,
 		Ignore: "",
 	},
 	ActionRow{ // S181
-		Accept: 35,
+		Accept: 82,
 		Ignore: "",
 	},
 	ActionRow{ // S182
-		Accept: 35,
+		Accept: 63,
 		Ignore: "",
 	},
 	ActionRow{ // S183
-		Accept: 35,
+		Accept: 89,
 		Ignore: "",
 	},
 	ActionRow{ // S184
-		Accept: 0,
+		Accept: 57,
 		Ignore: "",
 	},
 	ActionRow{ // S185
-		Accept: 0,
+		Accept: 105,
 		Ignore: "",
 	},
 	ActionRow{ // S186
-		Accept: 127,
+		Accept: 35,
 		Ignore: "",
 	},
 	ActionRow{ // S187
-		Accept: 40,
+		Accept: 35,
 		Ignore: "",
 	},
 	ActionRow{ // S188
-		Accept: 40,
+		Accept: 35,
 		Ignore: "",
 	},
 	ActionRow{ // S189
-		Accept: 124,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S190
-		Accept: 116,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S191
-		Accept: 115,
+		Accept: 131,
 		Ignore: "",
 	},
 	ActionRow{ // S192
-		Accept: 117,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S193
-		Accept: 109,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S194
-		Accept: 112,
+		Accept: 128,
 		Ignore: "",
 	},
 	ActionRow{ // S195
-		Accept: 40,
+		Accept: 120,
 		Ignore: "",
 	},
 	ActionRow{ // S196
-		Accept: 110,
+		Accept: 119,
 		Ignore: "",
 	},
 	ActionRow{ // S197
-		Accept: 119,
+		Accept: 121,
 		Ignore: "",
 	},
 	ActionRow{ // S198
-		Accept: 118,
+		Accept: 113,
 		Ignore: "",
 	},
 	ActionRow{ // S199
-		Accept: 120,
+		Accept: 116,
 		Ignore: "",
 	},
 	ActionRow{ // S200
-		Accept: 129,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S201
-		Accept: 51,
+		Accept: 114,
 		Ignore: "",
 	},
 	ActionRow{ // S202
-		Accept: 39,
+		Accept: 123,
 		Ignore: "",
 	},
 	ActionRow{ // S203
-		Accept: 41,
+		Accept: 122,
 		Ignore: "",
 	},
 	ActionRow{ // S204
-		Accept: 40,
+		Accept: 124,
 		Ignore: "",
 	},
 	ActionRow{ // S205
-		Accept: 40,
+		Accept: 133,
 		Ignore: "",
 	},
 	ActionRow{ // S206
-		Accept: 40,
+		Accept: 55,
 		Ignore: "",
 	},
 	ActionRow{ // S207
-		Accept: 40,
+		Accept: 39,
 		Ignore: "",
 	},
 	ActionRow{ // S208
-		Accept: 40,
+		Accept: 45,
 		Ignore: "",
 	},
 	ActionRow{ // S209
@@ -877,7 +877,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S214
-		Accept: 132,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S215
@@ -897,7 +897,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S219
-		Accept: 138,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S220
@@ -905,11 +905,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S221
-		Accept: 44,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S222
-		Accept: 45,
+		Accept: 136,
 		Ignore: "",
 	},
 	ActionRow{ // S223
@@ -917,7 +917,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S224
-		Accept: 46,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S225
@@ -929,11 +929,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S227
-		Accept: 40,
+		Accept: 144,
 		Ignore: "",
 	},
 	ActionRow{ // S228
-		Accept: 47,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S229
@@ -941,11 +941,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S230
-		Accept: 21,
+		Accept: 48,
 		Ignore: "",
 	},
 	ActionRow{ // S231
-		Accept: 40,
+		Accept: 49,
 		Ignore: "",
 	},
 	ActionRow{ // S232
@@ -953,7 +953,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S233
-		Accept: 48,
+		Accept: 50,
 		Ignore: "",
 	},
 	ActionRow{ // S234
@@ -961,75 +961,75 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S235
-		Accept: 50,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S236
-		Accept: 104,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S237
-		Accept: 33,
+		Accept: 51,
 		Ignore: "",
 	},
 	ActionRow{ // S238
-		Accept: 0,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S239
-		Accept: 31,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S240
-		Accept: 31,
+		Accept: 21,
 		Ignore: "",
 	},
 	ActionRow{ // S241
-		Accept: 0,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S242
-		Accept: 0,
+		Accept: 141,
 		Ignore: "",
 	},
 	ActionRow{ // S243
-		Accept: 0,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S244
-		Accept: 106,
+		Accept: 52,
 		Ignore: "",
 	},
 	ActionRow{ // S245
-		Accept: 0,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S246
-		Accept: 0,
+		Accept: 54,
 		Ignore: "",
 	},
 	ActionRow{ // S247
-		Accept: 0,
+		Accept: 108,
 		Ignore: "",
 	},
 	ActionRow{ // S248
-		Accept: 106,
+		Accept: 33,
 		Ignore: "",
 	},
 	ActionRow{ // S249
-		Accept: 106,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S250
-		Accept: 106,
+		Accept: 31,
 		Ignore: "",
 	},
 	ActionRow{ // S251
-		Accept: 60,
+		Accept: 31,
 		Ignore: "",
 	},
 	ActionRow{ // S252
-		Accept: 54,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S253
@@ -1037,67 +1037,67 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S254
-		Accept: 37,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S255
-		Accept: 37,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S256
-		Accept: 40,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S257
-		Accept: 40,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S258
-		Accept: 111,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S259
-		Accept: 40,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S260
-		Accept: 42,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S261
-		Accept: 40,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S262
-		Accept: 130,
+		Accept: 64,
 		Ignore: "",
 	},
 	ActionRow{ // S263
-		Accept: 40,
+		Accept: 58,
 		Ignore: "",
 	},
 	ActionRow{ // S264
-		Accept: 19,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S265
-		Accept: 40,
+		Accept: 37,
 		Ignore: "",
 	},
 	ActionRow{ // S266
-		Accept: 134,
+		Accept: 37,
 		Ignore: "",
 	},
 	ActionRow{ // S267
-		Accept: 135,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S268
-		Accept: 24,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S269
-		Accept: 40,
+		Accept: 115,
 		Ignore: "",
 	},
 	ActionRow{ // S270
@@ -1105,7 +1105,7 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S271
-		Accept: 40,
+		Accept: 46,
 		Ignore: "",
 	},
 	ActionRow{ // S272
@@ -1113,11 +1113,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S273
-		Accept: 142,
+		Accept: 134,
 		Ignore: "",
 	},
 	ActionRow{ // S274
-		Accept: 108,
+		Accept: 41,
 		Ignore: "",
 	},
 	ActionRow{ // S275
@@ -1133,55 +1133,55 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S278
-		Accept: 40,
+		Accept: 19,
 		Ignore: "",
 	},
 	ActionRow{ // S279
-		Accept: 143,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S280
-		Accept: 107,
+		Accept: 138,
 		Ignore: "",
 	},
 	ActionRow{ // S281
-		Accept: 40,
+		Accept: 139,
 		Ignore: "",
 	},
 	ActionRow{ // S282
-		Accept: 40,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S283
-		Accept: 0,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S284
-		Accept: 106,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S285
-		Accept: 106,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S286
-		Accept: 0,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S287
-		Accept: 0,
+		Accept: 148,
 		Ignore: "",
 	},
 	ActionRow{ // S288
-		Accept: 106,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S289
-		Accept: 106,
+		Accept: 112,
 		Ignore: "",
 	},
 	ActionRow{ // S290
-		Accept: 106,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S291
@@ -1193,11 +1193,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S293
-		Accept: 131,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S294
-		Accept: 140,
+		Accept: 149,
 		Ignore: "",
 	},
 	ActionRow{ // S295
@@ -1205,47 +1205,47 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S296
-		Accept: 20,
+		Accept: 111,
 		Ignore: "",
 	},
 	ActionRow{ // S297
-		Accept: 23,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S298
-		Accept: 22,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S299
-		Accept: 27,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S300
-		Accept: 40,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S301
-		Accept: 107,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S302
-		Accept: 40,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S303
-		Accept: 43,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S304
-		Accept: 49,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S305
-		Accept: 14,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S306
-		Accept: 40,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S307
@@ -1257,67 +1257,67 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S309
-		Accept: 7,
+		Accept: 135,
 		Ignore: "",
 	},
 	ActionRow{ // S310
-		Accept: 136,
+		Accept: 146,
 		Ignore: "",
 	},
 	ActionRow{ // S311
-		Accept: 0,
+		Accept: 43,
 		Ignore: "",
 	},
 	ActionRow{ // S312
-		Accept: 106,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S313
-		Accept: 106,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S314
-		Accept: 40,
+		Accept: 20,
 		Ignore: "",
 	},
 	ActionRow{ // S315
-		Accept: 40,
+		Accept: 23,
 		Ignore: "",
 	},
 	ActionRow{ // S316
-		Accept: 40,
+		Accept: 22,
 		Ignore: "",
 	},
 	ActionRow{ // S317
-		Accept: 40,
+		Accept: 27,
 		Ignore: "",
 	},
 	ActionRow{ // S318
-		Accept: 17,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S319
-		Accept: 8,
+		Accept: 111,
 		Ignore: "",
 	},
 	ActionRow{ // S320
-		Accept: 16,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S321
-		Accept: 144,
+		Accept: 47,
 		Ignore: "",
 	},
 	ActionRow{ // S322
-		Accept: 13,
+		Accept: 53,
 		Ignore: "",
 	},
 	ActionRow{ // S323
-		Accept: 12,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S324
-		Accept: 0,
+		Accept: 14,
 		Ignore: "",
 	},
 	ActionRow{ // S325
@@ -1325,11 +1325,11 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S326
-		Accept: 126,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S327
-		Accept: 121,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S328
@@ -1337,31 +1337,127 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S329
-		Accept: 18,
+		Accept: 7,
 		Ignore: "",
 	},
 	ActionRow{ // S330
-		Accept: 0,
+		Accept: 142,
 		Ignore: "",
 	},
 	ActionRow{ // S331
-		Accept: 125,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S332
-		Accept: 141,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S333
-		Accept: 0,
+		Accept: 110,
 		Ignore: "",
 	},
 	ActionRow{ // S334
-		Accept: 0,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S335
-		Accept: 113,
+		Accept: 40,
+		Ignore: "",
+	},
+	ActionRow{ // S336
+		Accept: 40,
+		Ignore: "",
+	},
+	ActionRow{ // S337
+		Accept: 40,
+		Ignore: "",
+	},
+	ActionRow{ // S338
+		Accept: 40,
+		Ignore: "",
+	},
+	ActionRow{ // S339
+		Accept: 17,
+		Ignore: "",
+	},
+	ActionRow{ // S340
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S341
+		Accept: 151,
+		Ignore: "",
+	},
+	ActionRow{ // S342
+		Accept: 16,
+		Ignore: "",
+	},
+	ActionRow{ // S343
+		Accept: 150,
+		Ignore: "",
+	},
+	ActionRow{ // S344
+		Accept: 13,
+		Ignore: "",
+	},
+	ActionRow{ // S345
+		Accept: 12,
+		Ignore: "",
+	},
+	ActionRow{ // S346
+		Accept: 140,
+		Ignore: "",
+	},
+	ActionRow{ // S347
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S348
+		Accept: 40,
+		Ignore: "",
+	},
+	ActionRow{ // S349
+		Accept: 130,
+		Ignore: "",
+	},
+	ActionRow{ // S350
+		Accept: 125,
+		Ignore: "",
+	},
+	ActionRow{ // S351
+		Accept: 40,
+		Ignore: "",
+	},
+	ActionRow{ // S352
+		Accept: 42,
+		Ignore: "",
+	},
+	ActionRow{ // S353
+		Accept: 18,
+		Ignore: "",
+	},
+	ActionRow{ // S354
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S355
+		Accept: 129,
+		Ignore: "",
+	},
+	ActionRow{ // S356
+		Accept: 147,
+		Ignore: "",
+	},
+	ActionRow{ // S357
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S358
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S359
+		Accept: 117,
 		Ignore: "",
 	},
 }
@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 336
-	NumSymbols = 653
+	NumStates  = 360
+	NumSymbols = 688
 )
 
 type Lexer struct {
@@ -226,560 +226,595 @@ Lexer symbols:
 94: 'g'
 95: 'i'
 96: 'n'
-97: 'd'
-98: 'o'
-99: 'e'
-100: 'l'
-101: 'i'
-102: 'f'
-103: 'e'
-104: 'l'
-105: 's'
-106: 'e'
+97: 'c'
+98: 'a'
+99: 's'
+100: 'e'
+101: 'c'
+102: 'a'
+103: 't'
+104: 'c'
+105: 'h'
+106: 'd'
 107: 'e'
-108: 'n'
-109: 'd'
-110: 'f'
-111: 'i'
-112: 'l'
-113: 't'
-114: 'e'
-115: 'r'
-116: 'f'
-117: 'o'
-118: 'r'
-119: 'i'
-120: 'f'
-121: 'i'
-122: 'n'
-123: 'w'
-124: 'h'
-125: 'i'
-126: 'l'
-127: 'e'
-128: 'b'
-129: 'r'
+108: 'f'
+109: 'a'
+110: 'u'
+111: 'l'
+112: 't'
+113: 'd'
+114: 'o'
+115: 'e'
+116: 'l'
+117: 'i'
+118: 'f'
+119: 'e'
+120: 'l'
+121: 's'
+122: 'e'
+123: 'e'
+124: 'n'
+125: 'd'
+126: 'f'
+127: 'i'
+128: 'l'
+129: 't'
 130: 'e'
-131: 'a'
-132: 'k'
-133: 'c'
-134: 'o'
-135: 'n'
-136: 't'
+131: 'r'
+132: 'f'
+133: 'o'
+134: 'r'
+135: 'i'
+136: 'f'
 137: 'i'
 138: 'n'
-139: 'u'
-140: 'e'
-141: 'r'
-142: 'e'
-143: 't'
-144: 'u'
-145: 'r'
-146: 'n'
-147: 'f'
-148: 'u'
-149: 'n'
-150: 'c'
-151: 's'
-152: 'u'
+139: 's'
+140: 'w'
+141: 'i'
+142: 't'
+143: 'c'
+144: 'h'
+145: 't'
+146: 'r'
+147: 'y'
+148: 'w'
+149: 'h'
+150: 'i'
+151: 'l'
+152: 'e'
 153: 'b'
 154: 'r'
-155: 'c'
+155: 'e'
 156: 'a'
-157: 'l'
-158: 'l'
-159: 'a'
-160: 'r'
-161: 'r'
-162: 'b'
-163: 'o'
-164: 'o'
-165: 'l'
-166: 'f'
-167: 'l'
-168: 'o'
-169: 'a'
-170: 't'
-171: 'i'
-172: 'n'
-173: 't'
-174: 'm'
-175: 'a'
-176: 'p'
-177: 'n'
-178: 'u'
-179: 'm'
-180: 's'
-181: 't'
-182: 'r'
-183: 'v'
-184: 'a'
-185: 'r'
-186: 'f'
-187: 'u'
-188: 'n'
-189: 'c'
-190: 't'
-191: 'u'
-192: 'n'
-193: 's'
-194: 'e'
-195: 't'
-196: 'd'
-197: 'u'
-198: 'm'
-199: 'p'
-200: 'e'
-201: 'd'
-202: 'u'
-203: 'm'
-204: 'p'
-205: 'e'
-206: 'm'
-207: 'i'
-208: 't'
-209: '1'
-210: 'e'
-211: 'm'
-212: 'i'
-213: 't'
-214: 'e'
-215: 'm'
-216: 'i'
-217: 't'
-218: 'p'
-219: 'e'
-220: 'm'
-221: 'i'
-222: 't'
-223: 'f'
-224: 'e'
-225: 'p'
-226: 'r'
-227: 'i'
-228: 'n'
-229: 't'
-230: 'e'
-231: 'p'
-232: 'r'
-233: 'i'
-234: 'n'
-235: 't'
-236: 'n'
+157: 'k'
+158: 'c'
+159: 'o'
+160: 'n'
+161: 't'
+162: 'i'
+163: 'n'
+164: 'u'
+165: 'e'
+166: 'r'
+167: 'e'
+168: 't'
+169: 'u'
+170: 'r'
+171: 'n'
+172: 'f'
+173: 'u'
+174: 'n'
+175: 'c'
+176: 's'
+177: 'u'
+178: 'b'
+179: 'r'
+180: 'c'
+181: 'a'
+182: 'l'
+183: 'l'
+184: 'i'
+185: 'm'
+186: 'p'
+187: 'o'
+188: 'r'
+189: 't'
+190: 'a'
+191: 's'
+192: 'a'
+193: 'r'
+194: 'r'
+195: 'b'
+196: 'o'
+197: 'o'
+198: 'l'
+199: 'f'
+200: 'l'
+201: 'o'
+202: 'a'
+203: 't'
+204: 'i'
+205: 'n'
+206: 't'
+207: 'm'
+208: 'a'
+209: 'p'
+210: 'n'
+211: 'u'
+212: 'm'
+213: 's'
+214: 't'
+215: 'r'
+216: 'v'
+217: 'a'
+218: 'r'
+219: 'f'
+220: 'u'
+221: 'n'
+222: 'c'
+223: 't'
+224: 'u'
+225: 'n'
+226: 's'
+227: 'e'
+228: 't'
+229: 'd'
+230: 'u'
+231: 'm'
+232: 'p'
+233: 'e'
+234: 'd'
+235: 'u'
+236: 'm'
 237: 'p'
-238: 'r'
-239: 'i'
-240: 'n'
+238: 'e'
+239: 'm'
+240: 'i'
 241: 't'
-242: 'p'
-243: 'r'
-244: 'i'
-245: 'n'
+242: '1'
+243: 'e'
+244: 'm'
+245: 'i'
 246: 't'
-247: 'n'
-248: 't'
-249: 'e'
-250: 'e'
-251: 's'
-252: 't'
-253: 'd'
-254: 'o'
-255: 'u'
-256: 't'
-257: 's'
-258: 't'
-259: 'd'
-260: 'e'
-261: 'r'
-262: 'r'
-263: '$'
-264: '$'
-265: '{'
-266: '}'
-267: '$'
-268: '*'
-269: '@'
-270: '@'
-271: '{'
-272: '}'
-273: '@'
-274: '*'
-275: 'a'
-276: 'l'
-277: 'l'
-278: '%'
-279: '%'
-280: '%'
-281: 'p'
-282: 'a'
-283: 'n'
-284: 'i'
-285: 'c'
-286: '%'
-287: '%'
-288: '%'
-289: ';'
-290: '{'
-291: '}'
-292: '='
-293: '>'
-294: '>'
-295: '>'
-296: '|'
-297: ','
-298: '('
-299: ')'
+247: 'e'
+248: 'm'
+249: 'i'
+250: 't'
+251: 'p'
+252: 'e'
+253: 'm'
+254: 'i'
+255: 't'
+256: 'f'
+257: 'e'
+258: 'p'
+259: 'r'
+260: 'i'
+261: 'n'
+262: 't'
+263: 'e'
+264: 'p'
+265: 'r'
+266: 'i'
+267: 'n'
+268: 't'
+269: 'n'
+270: 'p'
+271: 'r'
+272: 'i'
+273: 'n'
+274: 't'
+275: 'p'
+276: 'r'
+277: 'i'
+278: 'n'
+279: 't'
+280: 'n'
+281: 't'
+282: 'e'
+283: 'e'
+284: 's'
+285: 't'
+286: 'd'
+287: 'o'
+288: 'u'
+289: 't'
+290: 's'
+291: 't'
+292: 'd'
+293: 'e'
+294: 'r'
+295: 'r'
+296: '$'
+297: '$'
+298: '{'
+299: '}'
 300: '$'
-301: '['
-302: ']'
-303: '$'
-304: '['
-305: '['
-306: '$'
-307: '['
-308: '['
-309: '['
-310: '@'
-311: '['
-312: '|'
-313: '|'
-314: '='
-315: '^'
-316: '^'
-317: '='
-318: '&'
-319: '&'
-320: '='
-321: '?'
-322: '?'
-323: '='
-324: '?'
-325: '?'
-326: '?'
-327: '='
-328: '|'
-329: '='
-330: '&'
-331: '='
-332: '^'
-333: '='
-334: '<'
-335: '<'
-336: '='
-337: '>'
-338: '>'
-339: '='
-340: '>'
-341: '>'
-342: '>'
-343: '='
-344: '+'
-345: '='
-346: '.'
+301: '*'
+302: '@'
+303: '@'
+304: '{'
+305: '}'
+306: '@'
+307: '*'
+308: 'a'
+309: 'l'
+310: 'l'
+311: '%'
+312: '%'
+313: '%'
+314: 'p'
+315: 'a'
+316: 'n'
+317: 'i'
+318: 'c'
+319: '%'
+320: '%'
+321: '%'
+322: ';'
+323: '{'
+324: '}'
+325: '='
+326: '>'
+327: '>'
+328: '>'
+329: '|'
+330: ','
+331: '('
+332: ')'
+333: '$'
+334: '['
+335: ']'
+336: '$'
+337: '['
+338: '['
+339: '$'
+340: '['
+341: '['
+342: '['
+343: '@'
+344: '['
+345: '|'
+346: '|'
 347: '='
-348: '-'
-349: '='
-350: '*'
-351: '='
-352: '/'
+348: '^'
+349: '^'
+350: '='
+351: '&'
+352: '&'
 353: '='
-354: '/'
-355: '/'
+354: '?'
+355: '?'
 356: '='
-357: '%'
-358: '='
-359: '*'
-360: '*'
-361: '='
-362: '?'
-363: ':'
-364: '|'
-365: '|'
-366: '^'
-367: '^'
-368: '&'
-369: '&'
-370: '='
-371: '~'
-372: '!'
-373: '='
-374: '~'
-375: '='
+357: '?'
+358: '?'
+359: '?'
+360: '='
+361: '|'
+362: '='
+363: '&'
+364: '='
+365: '^'
+366: '='
+367: '<'
+368: '<'
+369: '='
+370: '>'
+371: '>'
+372: '='
+373: '>'
+374: '>'
+375: '>'
 376: '='
-377: '!'
+377: '+'
 378: '='
-379: '<'
+379: '.'
 380: '='
-381: '>'
-382: '>'
-383: '='
-384: '<'
-385: '<'
+381: '-'
+382: '='
+383: '*'
+384: '='
+385: '/'
 386: '='
-387: '^'
-388: '&'
-389: '<'
-390: '<'
-391: '>'
-392: '>'
-393: '>'
-394: '+'
-395: '-'
-396: '.'
-397: '+'
-398: '.'
-399: '-'
-400: '*'
-401: '/'
-402: '/'
-403: '/'
-404: '%'
-405: '.'
-406: '*'
-407: '.'
-408: '/'
-409: '.'
-410: '/'
-411: '/'
-412: '.'
-413: '!'
-414: '~'
-415: '?'
-416: '?'
-417: '?'
-418: '?'
-419: '?'
-420: '*'
-421: '*'
-422: '['
-423: '['
-424: '['
-425: '['
-426: '['
-427: '['
-428: '_'
-429: '_'
-430: '\n'
-431: ' '
-432: '!'
-433: '#'
-434: '$'
-435: '%'
-436: '&'
-437: '''
-438: '\'
-439: '('
-440: ')'
-441: '*'
-442: '+'
-443: ','
-444: '-'
+387: '/'
+388: '/'
+389: '='
+390: '%'
+391: '='
+392: '*'
+393: '*'
+394: '='
+395: '?'
+396: ':'
+397: '|'
+398: '|'
+399: '^'
+400: '^'
+401: '&'
+402: '&'
+403: '='
+404: '~'
+405: '!'
+406: '='
+407: '~'
+408: '='
+409: '='
+410: '!'
+411: '='
+412: '<'
+413: '='
+414: '>'
+415: '>'
+416: '='
+417: '<'
+418: '<'
+419: '='
+420: '^'
+421: '&'
+422: '<'
+423: '<'
+424: '>'
+425: '>'
+426: '>'
+427: '+'
+428: '-'
+429: '.'
+430: '+'
+431: '.'
+432: '-'
+433: '*'
+434: '/'
+435: '/'
+436: '/'
+437: '%'
+438: '.'
+439: '*'
+440: '.'
+441: '/'
+442: '.'
+443: '/'
+444: '/'
 445: '.'
-446: '/'
-447: ':'
-448: ';'
-449: '<'
-450: '='
-451: '>'
+446: '!'
+447: '~'
+448: '?'
+449: '?'
+450: '?'
+451: '?'
 452: '?'
-453: '@'
-454: '['
-455: ']'
-456: '^'
-457: '_'
-458: '`'
-459: '{'
-460: '|'
-461: '}'
-462: '~'
-463: '\'
-464: '\'
-465: '\'
-466: '"'
-467: '\'
-468: '['
-469: '\'
-470: ']'
-471: '\'
-472: '.'
+453: '*'
+454: '*'
+455: '['
+456: '['
+457: '['
+458: '['
+459: '['
+460: '['
+461: ':'
+462: ':'
+463: '_'
+464: '_'
+465: '\n'
+466: ' '
+467: '!'
+468: '#'
+469: '$'
+470: '%'
+471: '&'
+472: '''
 473: '\'
-474: '*'
-475: '\'
-476: '%'
-477: '\'
-478: '^'
-479: '\'
-480: '$'
-481: '\'
-482: '+'
-483: '\'
-484: '('
-485: '\'
-486: ')'
-487: '\'
-488: '&'
-489: '\'
-490: 'A'
-491: '\'
-492: 'B'
-493: '\'
-494: 'C'
-495: '\'
-496: 'D'
-497: '\'
-498: 'G'
+474: '('
+475: ')'
+476: '*'
+477: '+'
+478: ','
+479: '-'
+480: '.'
+481: '/'
+482: ':'
+483: ';'
+484: '<'
+485: '='
+486: '>'
+487: '?'
+488: '@'
+489: '['
+490: ']'
+491: '^'
+492: '_'
+493: '`'
+494: '{'
+495: '|'
+496: '}'
+497: '~'
+498: '\'
 499: '\'
-500: 'H'
-501: '\'
-502: 'K'
-503: '\'
-504: 'L'
-505: '\'
-506: 'N'
-507: '\'
-508: 'P'
-509: '\'
-510: 'R'
-511: '\'
-512: 'S'
-513: '\'
-514: 'U'
-515: '\'
-516: 'V'
-517: '\'
-518: 'W'
-519: '\'
-520: 'X'
-521: '\'
-522: 'Z'
-523: '\'
-524: 'a'
-525: '\'
-526: 'b'
-527: '\'
-528: 'c'
-529: '\'
-530: 'd'
-531: '\'
-532: 'f'
-533: '\'
-534: 'g'
-535: '\'
-536: 'h'
-537: '\'
-538: 'k'
-539: '\'
-540: 'l'
-541: '\'
-542: 'n'
-543: '\'
-544: 'p'
-545: '\'
-546: 'r'
-547: '\'
-548: 's'
-549: '\'
-550: 't'
-551: '\'
-552: 'u'
-553: '\'
-554: 'v'
-555: '\'
-556: 'w'
-557: '\'
-558: 'x'
-559: '\'
-560: 'z'
-561: '\'
-562: '0'
-563: '\'
-564: '1'
-565: '\'
-566: '2'
-567: '\'
-568: '3'
-569: '\'
-570: '4'
-571: '\'
-572: '5'
-573: '\'
-574: '6'
-575: '\'
-576: '7'
-577: '\'
-578: '8'
-579: '\'
-580: '9'
-581: 'e'
-582: 'E'
-583: 't'
-584: 'r'
-585: 'u'
-586: 'e'
-587: 'f'
-588: 'a'
-589: 'l'
-590: 's'
-591: 'e'
-592: ' '
-593: '!'
-594: '#'
-595: '$'
-596: '%'
-597: '&'
-598: '''
-599: '\'
-600: '('
-601: ')'
-602: '*'
-603: '+'
-604: ','
-605: '-'
-606: '.'
-607: '/'
-608: ':'
-609: ';'
-610: '<'
-611: '='
-612: '>'
-613: '?'
-614: '@'
-615: '['
-616: ']'
-617: '^'
-618: '_'
-619: '`'
-620: '|'
-621: '~'
-622: '\'
-623: '{'
-624: '\'
-625: '}'
-626: ' '
-627: '\t'
-628: '\n'
-629: '\r'
-630: '#'
-631: '\n'
-632: 'a'-'z'
-633: 'A'-'Z'
-634: \u00a0-\u00ff
-635: \u0100-\U0010ffff
-636: '0'-'9'
-637: '0'-'9'
-638: 'a'-'f'
-639: 'A'-'F'
-640: '0'-'7'
-641: '0'-'1'
-642: 'A'-'Z'
-643: 'a'-'z'
-644: '0'-'9'
-645: \u00a0-\u00ff
-646: \u0100-\U0010ffff
-647: 'A'-'Z'
-648: 'a'-'z'
-649: '0'-'9'
-650: \u00a0-\u00ff
-651: \u0100-\U0010ffff
-652: .
+500: '\'
+501: '"'
+502: '\'
+503: '['
+504: '\'
+505: ']'
+506: '\'
+507: '.'
+508: '\'
+509: '*'
+510: '\'
+511: '%'
+512: '\'
+513: '^'
+514: '\'
+515: '$'
+516: '\'
+517: '+'
+518: '\'
+519: '('
+520: '\'
+521: ')'
+522: '\'
+523: '&'
+524: '\'
+525: 'A'
+526: '\'
+527: 'B'
+528: '\'
+529: 'C'
+530: '\'
+531: 'D'
+532: '\'
+533: 'G'
+534: '\'
+535: 'H'
+536: '\'
+537: 'K'
+538: '\'
+539: 'L'
+540: '\'
+541: 'N'
+542: '\'
+543: 'P'
+544: '\'
+545: 'R'
+546: '\'
+547: 'S'
+548: '\'
+549: 'U'
+550: '\'
+551: 'V'
+552: '\'
+553: 'W'
+554: '\'
+555: 'X'
+556: '\'
+557: 'Z'
+558: '\'
+559: 'a'
+560: '\'
+561: 'b'
+562: '\'
+563: 'c'
+564: '\'
+565: 'd'
+566: '\'
+567: 'f'
+568: '\'
+569: 'g'
+570: '\'
+571: 'h'
+572: '\'
+573: 'k'
+574: '\'
+575: 'l'
+576: '\'
+577: 'n'
+578: '\'
+579: 'p'
+580: '\'
+581: 'r'
+582: '\'
+583: 's'
+584: '\'
+585: 't'
+586: '\'
+587: 'u'
+588: '\'
+589: 'v'
+590: '\'
+591: 'w'
+592: '\'
+593: 'x'
+594: '\'
+595: 'z'
+596: '\'
+597: '0'
+598: '\'
+599: '1'
+600: '\'
+601: '2'
+602: '\'
+603: '3'
+604: '\'
+605: '4'
+606: '\'
+607: '5'
+608: '\'
+609: '6'
+610: '\'
+611: '7'
+612: '\'
+613: '8'
+614: '\'
+615: '9'
+616: 'e'
+617: 'E'
+618: 't'
+619: 'r'
+620: 'u'
+621: 'e'
+622: 'f'
+623: 'a'
+624: 'l'
+625: 's'
+626: 'e'
+627: ' '
+628: '!'
+629: '#'
+630: '$'
+631: '%'
+632: '&'
+633: '''
+634: '\'
+635: '('
+636: ')'
+637: '*'
+638: '+'
+639: ','
+640: '-'
+641: '.'
+642: '/'
+643: ':'
+644: ';'
+645: '<'
+646: '='
+647: '>'
+648: '?'
+649: '@'
+650: '['
+651: ']'
+652: '^'
+653: '_'
+654: '`'
+655: '|'
+656: '~'
+657: '\'
+658: '{'
+659: '\'
+660: '}'
+661: ' '
+662: '\t'
+663: '\n'
+664: '\r'
+665: '#'
+666: '\n'
+667: 'a'-'z'
+668: 'A'-'Z'
+669: \u00a0-\u00ff
+670: \u0100-\U0010ffff
+671: '0'-'9'
+672: '0'-'9'
+673: 'a'-'f'
+674: 'A'-'F'
+675: '0'-'7'
+676: '0'-'1'
+677: 'A'-'Z'
+678: 'a'-'z'
+679: '0'-'9'
+680: \u00a0-\u00ff
+681: \u0100-\U0010ffff
+682: 'A'-'Z'
+683: 'a'-'z'
+684: '0'-'9'
+685: \u00a0-\u00ff
+686: \u0100-\U0010ffff
+687: .
 */
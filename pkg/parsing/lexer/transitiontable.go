@@ -412,6 +412,8 @@ var TransTab = TransitionTable{
 	// S18
 	func(r rune) int {
 		switch {
+		case r == 58: // [':',':']
+			return 88
 		}
 		return NoState
 	},
@@ -425,9 +427,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 60: // ['<','<']
-			return 88
-		case r == 61: // ['=','=']
 			return 89
+		case r == 61: // ['=','=']
+			return 90
 		}
 		return NoState
 	},
@@ -435,9 +437,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 90
-		case r == 126: // ['~','~']
 			return 91
+		case r == 126: // ['~','~']
+			return 92
 		}
 		return NoState
 	},
@@ -445,9 +447,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 92
-		case r == 62: // ['>','>']
 			return 93
+		case r == 62: // ['>','>']
+			return 94
 		}
 		return NoState
 	},
@@ -455,7 +457,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 63: // ['?','?']
-			return 94
+			return 95
 		}
 		return NoState
 	},
@@ -463,21 +465,21 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 42: // ['*','*']
-			return 95
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 96
-		case r == 91: // ['[','[']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 97
-		case r == 95: // ['_','_']
+		case r == 91: // ['[','[']
 			return 98
+		case r == 95: // ['_','_']
+			return 99
 		case 97 <= r && r <= 122: // ['a','z']
-			return 96
+			return 97
 		case r == 123: // ['{','{']
-			return 99
+			return 100
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 96
+			return 97
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 96
+			return 97
 		}
 		return NoState
 	},
@@ -485,17 +487,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -503,21 +505,21 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 77: // ['A','M']
 			return 101
+		case 65 <= r && r <= 77: // ['A','M']
+			return 102
 		case r == 78: // ['N','N']
-			return 103
+			return 104
 		case 79 <= r && r <= 90: // ['O','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -525,29 +527,29 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 72: // ['A','H']
 			return 101
+		case 65 <= r && r <= 72: // ['A','H']
+			return 102
 		case r == 73: // ['I','I']
-			return 104
+			return 105
 		case 74 <= r && r <= 75: // ['J','K']
-			return 101
+			return 102
 		case r == 76: // ['L','L']
-			return 105
+			return 106
 		case r == 77: // ['M','M']
-			return 101
+			return 102
 		case r == 78: // ['N','N']
-			return 106
+			return 107
 		case 79 <= r && r <= 90: // ['O','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -555,33 +557,33 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 69: // ['A','E']
 			return 101
+		case 65 <= r && r <= 69: // ['A','E']
+			return 102
 		case r == 70: // ['F','F']
-			return 107
+			return 108
 		case 71 <= r && r <= 79: // ['G','O']
-			return 101
+			return 102
 		case r == 80: // ['P','P']
-			return 108
+			return 109
 		case r == 81: // ['Q','Q']
-			return 101
+			return 102
 		case r == 82: // ['R','R']
-			return 109
+			return 110
 		case 83 <= r && r <= 90: // ['S','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 109: // ['a','m']
-			return 101
+			return 102
 		case r == 110: // ['n','n']
-			return 110
+			return 111
 		case 111 <= r && r <= 122: // ['o','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -589,17 +591,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
-			return 111
+			return 112
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -607,27 +609,27 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 69: // ['A','E']
 			return 101
+		case 65 <= r && r <= 69: // ['A','E']
+			return 102
 		case r == 70: // ['F','F']
-			return 112
+			return 113
 		case 71 <= r && r <= 81: // ['G','Q']
-			return 101
+			return 102
 		case r == 82: // ['R','R']
-			return 113
+			return 114
 		case 83 <= r && r <= 90: // ['S','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case r == 97: // ['a','a']
-			return 114
+			return 115
 		case 98 <= r && r <= 122: // ['b','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -635,29 +637,29 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 69: // ['A','E']
 			return 101
+		case 65 <= r && r <= 69: // ['A','E']
+			return 102
 		case r == 70: // ['F','F']
-			return 115
+			return 116
 		case 71 <= r && r <= 79: // ['G','O']
-			return 101
+			return 102
 		case r == 80: // ['P','P']
-			return 116
+			return 117
 		case r == 81: // ['Q','Q']
-			return 101
+			return 102
 		case r == 82: // ['R','R']
-			return 117
+			return 118
 		case 83 <= r && r <= 90: // ['S','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -665,7 +667,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 91: // ['[','[']
-			return 118
+			return 119
 		}
 		return NoState
 	},
@@ -679,9 +681,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 119
-		case r == 94: // ['^','^']
 			return 120
+		case r == 94: // ['^','^']
+			return 121
 		}
 		return NoState
 	},
@@ -689,17 +691,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -707,25 +709,27 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 107: // ['a','k']
-			return 101
+			return 102
 		case r == 108: // ['l','l']
-			return 121
+			return 122
 		case 109 <= r && r <= 113: // ['m','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 122
-		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 123
+		case r == 115: // ['s','s']
+			return 124
+		case 116 <= r && r <= 122: // ['t','z']
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -733,29 +737,29 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 100: // ['a','d']
-			return 101
+			return 102
 		case r == 101: // ['e','e']
-			return 123
+			return 125
 		case 102 <= r && r <= 110: // ['f','n']
-			return 101
+			return 102
 		case r == 111: // ['o','o']
-			return 124
+			return 126
 		case 112 <= r && r <= 113: // ['p','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 125
+			return 127
 		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -763,23 +767,23 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case r == 97: // ['a','a']
-			return 126
+			return 128
 		case 98 <= r && r <= 110: // ['b','n']
-			return 101
+			return 102
 		case r == 111: // ['o','o']
-			return 127
+			return 129
 		case 112 <= r && r <= 122: // ['p','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -787,25 +791,29 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 100: // ['a','d']
+			return 102
+		case r == 101: // ['e','e']
+			return 130
+		case 102 <= r && r <= 110: // ['f','n']
 			return 102
-		case 97 <= r && r <= 110: // ['a','n']
-			return 101
 		case r == 111: // ['o','o']
-			return 128
+			return 131
 		case 112 <= r && r <= 116: // ['p','t']
-			return 101
+			return 102
 		case r == 117: // ['u','u']
-			return 129
+			return 132
 		case 118 <= r && r <= 122: // ['v','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -813,33 +821,33 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 99: // ['a','c']
-			return 101
+			return 102
 		case r == 100: // ['d','d']
-			return 130
+			return 133
 		case 101 <= r && r <= 107: // ['e','k']
-			return 101
+			return 102
 		case r == 108: // ['l','l']
-			return 131
+			return 134
 		case r == 109: // ['m','m']
-			return 132
+			return 135
 		case r == 110: // ['n','n']
-			return 133
+			return 136
 		case r == 111: // ['o','o']
-			return 101
+			return 102
 		case r == 112: // ['p','p']
-			return 134
+			return 137
 		case 113 <= r && r <= 122: // ['q','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -847,35 +855,35 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case r == 97: // ['a','a']
-			return 135
+			return 138
 		case 98 <= r && r <= 104: // ['b','h']
-			return 101
+			return 102
 		case r == 105: // ['i','i']
-			return 136
+			return 139
 		case 106 <= r && r <= 107: // ['j','k']
-			return 101
+			return 102
 		case r == 108: // ['l','l']
-			return 137
+			return 140
 		case 109 <= r && r <= 110: // ['m','n']
-			return 101
+			return 102
 		case r == 111: // ['o','o']
-			return 138
+			return 141
 		case 112 <= r && r <= 116: // ['p','t']
-			return 101
+			return 102
 		case r == 117: // ['u','u']
-			return 139
+			return 142
 		case 118 <= r && r <= 122: // ['v','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -883,25 +891,27 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 101: // ['a','e']
-			return 101
+			return 102
 		case r == 102: // ['f','f']
-			return 140
-		case 103 <= r && r <= 109: // ['g','m']
-			return 101
+			return 143
+		case 103 <= r && r <= 108: // ['g','l']
+			return 102
+		case r == 109: // ['m','m']
+			return 144
 		case r == 110: // ['n','n']
-			return 141
+			return 145
 		case 111 <= r && r <= 122: // ['o','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -909,19 +919,19 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case r == 97: // ['a','a']
-			return 142
+			return 146
 		case 98 <= r && r <= 122: // ['b','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -929,21 +939,21 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 116: // ['a','t']
-			return 101
+			return 102
 		case r == 117: // ['u','u']
-			return 143
+			return 147
 		case 118 <= r && r <= 122: // ['v','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -951,21 +961,21 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 113: // ['a','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 144
+			return 148
 		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -973,21 +983,21 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 100: // ['a','d']
-			return 101
+			return 102
 		case r == 101: // ['e','e']
-			return 145
+			return 149
 		case 102 <= r && r <= 122: // ['f','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -995,23 +1005,27 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 115: // ['a','s']
-			return 101
+			return 102
 		case r == 116: // ['t','t']
-			return 146
+			return 150
 		case r == 117: // ['u','u']
-			return 147
-		case 118 <= r && r <= 122: // ['v','z']
-			return 101
+			return 151
+		case r == 118: // ['v','v']
+			return 102
+		case r == 119: // ['w','w']
+			return 152
+		case 120 <= r && r <= 122: // ['x','z']
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -1019,25 +1033,25 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 100: // ['a','d']
-			return 101
+			return 102
 		case r == 101: // ['e','e']
-			return 148
+			return 153
 		case 102 <= r && r <= 113: // ['f','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 149
+			return 154
 		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -1045,21 +1059,21 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 109: // ['a','m']
-			return 101
+			return 102
 		case r == 110: // ['n','n']
-			return 150
+			return 155
 		case 111 <= r && r <= 122: // ['o','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -1067,19 +1081,19 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case r == 97: // ['a','a']
-			return 151
+			return 156
 		case 98 <= r && r <= 122: // ['b','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -1087,21 +1101,21 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 103: // ['a','g']
-			return 101
+			return 102
 		case r == 104: // ['h','h']
-			return 152
+			return 157
 		case 105 <= r && r <= 122: // ['i','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
@@ -1115,9 +1129,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 153
+			return 158
 		case r == 124: // ['|','|']
-			return 154
+			return 159
 		}
 		return NoState
 	},
@@ -1137,7 +1151,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 126: // ['~','~']
-			return 155
+			return 160
 		}
 		return NoState
 	},
@@ -1229,7 +1243,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 105: // ['i','i']
-			return 156
+			return 161
 		}
 		return NoState
 	},
@@ -1237,7 +1251,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 34: // ['"','"']
-			return 157
+			return 162
 		case r == 36: // ['$','$']
 			return 57
 		case r == 37: // ['%','%']
@@ -1409,7 +1423,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 91: // ['[','[']
-			return 158
+			return 163
 		}
 		return NoState
 	},
@@ -1435,75 +1449,75 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 32: // [' ',' ']
-			return 159
+			return 164
 		case r == 33: // ['!','!']
-			return 159
+			return 164
 		case r == 35: // ['#','#']
-			return 159
+			return 164
 		case r == 36: // ['$','$']
-			return 159
+			return 164
 		case r == 37: // ['%','%']
-			return 159
+			return 164
 		case r == 38: // ['&','&']
-			return 159
+			return 164
 		case r == 39: // [''',''']
-			return 159
+			return 164
 		case r == 40: // ['(','(']
-			return 159
+			return 164
 		case r == 41: // [')',')']
-			return 159
+			return 164
 		case r == 42: // ['*','*']
-			return 159
+			return 164
 		case r == 43: // ['+','+']
-			return 159
+			return 164
 		case r == 44: // [',',',']
-			return 159
+			return 164
 		case r == 45: // ['-','-']
-			return 159
+			return 164
 		case r == 46: // ['.','.']
-			return 159
+			return 164
 		case r == 47: // ['/','/']
-			return 159
+			return 164
 		case 48 <= r && r <= 57: // ['0','9']
-			return 159
+			return 164
 		case r == 58: // [':',':']
-			return 159
+			return 164
 		case r == 59: // [';',';']
-			return 159
+			return 164
 		case r == 60: // ['<','<']
-			return 159
+			return 164
 		case r == 61: // ['=','=']
-			return 159
+			return 164
 		case r == 62: // ['>','>']
-			return 159
+			return 164
 		case r == 63: // ['?','?']
-			return 159
+			return 164
 		case r == 64: // ['@','@']
-			return 159
+			return 164
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 159
+			return 164
 		case r == 91: // ['[','[']
-			return 159
+			return 164
 		case r == 92: // ['\','\']
-			return 160
+			return 165
 		case r == 93: // [']',']']
-			return 159
+			return 164
 		case r == 94: // ['^','^']
-			return 159
+			return 164
 		case r == 95: // ['_','_']
-			return 159
+			return 164
 		case r == 96: // ['`','`']
-			return 159
+			return 164
 		case 97 <= r && r <= 122: // ['a','z']
-			return 159
+			return 164
 		case r == 124: // ['|','|']
-			return 159
+			return 164
 		case r == 126: // ['~','~']
-			return 159
+			return 164
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 159
+			return 164
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 159
+			return 164
 		}
 		return NoState
 	},
@@ -1511,7 +1525,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 37: // ['%','%']
-			return 161
+			return 166
 		}
 		return NoState
 	},
@@ -1525,7 +1539,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 162
+			return 167
 		}
 		return NoState
 	},
@@ -1539,7 +1553,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 163
+			return 168
 		}
 		return NoState
 	},
@@ -1583,7 +1597,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 47: // ['/','/']
-			return 164
+			return 169
 		}
 		return NoState
 	},
@@ -1593,9 +1607,9 @@ var TransTab = TransitionTable{
 		case 48 <= r && r <= 57: // ['0','9']
 			return 79
 		case r == 69: // ['E','E']
-			return 165
+			return 170
 		case r == 101: // ['e','e']
-			return 165
+			return 170
 		}
 		return NoState
 	},
@@ -1609,7 +1623,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 166
+			return 171
 		}
 		return NoState
 	},
@@ -1623,11 +1637,11 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 167
+			return 172
 		case r == 69: // ['E','E']
-			return 168
+			return 173
 		case r == 101: // ['e','e']
-			return 168
+			return 173
 		}
 		return NoState
 	},
@@ -1635,11 +1649,11 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 43: // ['+','+']
-			return 169
+			return 174
 		case r == 45: // ['-','-']
-			return 170
+			return 175
 		case 48 <= r && r <= 57: // ['0','9']
-			return 171
+			return 176
 		}
 		return NoState
 	},
@@ -1647,7 +1661,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 49: // ['0','1']
-			return 172
+			return 177
 		}
 		return NoState
 	},
@@ -1655,7 +1669,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 55: // ['0','7']
-			return 173
+			return 178
 		}
 		return NoState
 	},
@@ -1663,33 +1677,33 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 174
+			return 179
 		case 65 <= r && r <= 70: // ['A','F']
-			return 174
+			return 179
 		case 97 <= r && r <= 102: // ['a','f']
-			return 174
+			return 179
 		}
 		return NoState
 	},
 	// S88
 	func(r rune) int {
 		switch {
-		case r == 61: // ['=','=']
-			return 175
 		}
 		return NoState
 	},
 	// S89
 	func(r rune) int {
 		switch {
-		case r == 62: // ['>','>']
-			return 176
+		case r == 61: // ['=','=']
+			return 180
 		}
 		return NoState
 	},
 	// S90
 	func(r rune) int {
 		switch {
+		case r == 62: // ['>','>']
+			return 181
 		}
 		return NoState
 	},
@@ -1708,10 +1722,6 @@ var TransTab = TransitionTable{
 	// S93
 	func(r rune) int {
 		switch {
-		case r == 61: // ['=','=']
-			return 177
-		case r == 62: // ['>','>']
-			return 178
 		}
 		return NoState
 	},
@@ -1719,1269 +1729,1373 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 179
-		case r == 63: // ['?','?']
-			return 180
+			return 182
+		case r == 62: // ['>','>']
+			return 183
 		}
 		return NoState
 	},
 	// S95
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 184
+		case r == 63: // ['?','?']
+			return 185
 		}
 		return NoState
 	},
 	// S96
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S97
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 181
+			return 186
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 182
+			return 187
 		case r == 95: // ['_','_']
-			return 183
+			return 188
 		case 97 <= r && r <= 122: // ['a','z']
-			return 182
+			return 187
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 182
+			return 187
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 182
+			return 187
 		}
 		return NoState
 	},
-	// S97
+	// S98
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S98
+	// S99
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 181
+			return 186
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 182
+			return 187
 		case r == 95: // ['_','_']
-			return 183
+			return 188
 		case 97 <= r && r <= 122: // ['a','z']
-			return 182
+			return 187
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 182
+			return 187
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 182
+			return 187
 		}
 		return NoState
 	},
-	// S99
+	// S100
 	func(r rune) int {
 		switch {
 		case r == 32: // [' ',' ']
-			return 184
+			return 189
 		case r == 33: // ['!','!']
-			return 184
+			return 189
 		case r == 35: // ['#','#']
-			return 184
+			return 189
 		case r == 36: // ['$','$']
-			return 184
+			return 189
 		case r == 37: // ['%','%']
-			return 184
+			return 189
 		case r == 38: // ['&','&']
-			return 184
+			return 189
 		case r == 39: // [''',''']
-			return 184
+			return 189
 		case r == 40: // ['(','(']
-			return 184
+			return 189
 		case r == 41: // [')',')']
-			return 184
+			return 189
 		case r == 42: // ['*','*']
-			return 184
+			return 189
 		case r == 43: // ['+','+']
-			return 184
+			return 189
 		case r == 44: // [',',',']
-			return 184
+			return 189
 		case r == 45: // ['-','-']
-			return 184
+			return 189
 		case r == 46: // ['.','.']
-			return 184
+			return 189
 		case r == 47: // ['/','/']
-			return 184
+			return 189
 		case 48 <= r && r <= 57: // ['0','9']
-			return 184
+			return 189
 		case r == 58: // [':',':']
-			return 184
+			return 189
 		case r == 59: // [';',';']
-			return 184
+			return 189
 		case r == 60: // ['<','<']
-			return 184
+			return 189
 		case r == 61: // ['=','=']
-			return 184
+			return 189
 		case r == 62: // ['>','>']
-			return 184
+			return 189
 		case r == 63: // ['?','?']
-			return 184
+			return 189
 		case r == 64: // ['@','@']
-			return 184
+			return 189
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 184
+			return 189
 		case r == 91: // ['[','[']
-			return 184
+			return 189
 		case r == 92: // ['\','\']
-			return 185
+			return 190
 		case r == 93: // [']',']']
-			return 184
+			return 189
 		case r == 94: // ['^','^']
-			return 184
+			return 189
 		case r == 95: // ['_','_']
-			return 184
+			return 189
 		case r == 96: // ['`','`']
-			return 184
+			return 189
 		case 97 <= r && r <= 122: // ['a','z']
-			return 184
+			return 189
 		case r == 124: // ['|','|']
-			return 184
+			return 189
 		case r == 126: // ['~','~']
-			return 184
+			return 189
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 184
+			return 189
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 184
+			return 189
 		}
 		return NoState
 	},
-	// S100
+	// S101
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S101
+	// S102
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S102
+	// S103
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S103
+	// S104
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 85: // ['A','U']
 			return 101
+		case 65 <= r && r <= 85: // ['A','U']
+			return 102
 		case r == 86: // ['V','V']
-			return 186
+			return 191
 		case 87 <= r && r <= 90: // ['W','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S104
+	// S105
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 75: // ['A','K']
 			return 101
+		case 65 <= r && r <= 75: // ['A','K']
+			return 102
 		case r == 76: // ['L','L']
-			return 187
+			return 192
 		case 77 <= r && r <= 90: // ['M','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S105
+	// S106
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
+			return 101
 		case r == 65: // ['A','A']
-			return 188
+			return 193
 		case 66 <= r && r <= 90: // ['B','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S106
+	// S107
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 81: // ['A','Q']
 			return 101
+		case 65 <= r && r <= 81: // ['A','Q']
+			return 102
 		case r == 82: // ['R','R']
-			return 189
+			return 194
 		case 83 <= r && r <= 90: // ['S','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S107
+	// S108
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 82: // ['A','R']
 			return 101
+		case 65 <= r && r <= 82: // ['A','R']
+			return 102
 		case r == 83: // ['S','S']
-			return 190
+			return 195
 		case 84 <= r && r <= 90: // ['T','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S108
+	// S109
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 82: // ['A','R']
 			return 101
+		case 65 <= r && r <= 82: // ['A','R']
+			return 102
 		case r == 83: // ['S','S']
-			return 191
+			return 196
 		case 84 <= r && r <= 90: // ['T','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S109
+	// S110
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 82: // ['A','R']
 			return 101
+		case 65 <= r && r <= 82: // ['A','R']
+			return 102
 		case r == 83: // ['S','S']
-			return 192
+			return 197
 		case 84 <= r && r <= 90: // ['T','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S110
+	// S111
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 101: // ['a','e']
-			return 101
+			return 102
 		case r == 102: // ['f','f']
-			return 193
+			return 198
 		case 103 <= r && r <= 122: // ['g','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S111
+	// S112
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 68: // ['A','D']
 			return 101
+		case 65 <= r && r <= 68: // ['A','D']
+			return 102
 		case r == 69: // ['E','E']
-			return 194
+			return 199
 		case 70 <= r && r <= 79: // ['F','O']
-			return 101
+			return 102
 		case r == 80: // ['P','P']
-			return 195
+			return 200
 		case 81 <= r && r <= 90: // ['Q','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S112
+	// S113
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S113
+	// S114
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S114
+	// S115
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 77: // ['A','M']
 			return 101
+		case 65 <= r && r <= 77: // ['A','M']
+			return 102
 		case r == 78: // ['N','N']
-			return 196
+			return 201
 		case 79 <= r && r <= 90: // ['O','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S115
+	// S116
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 82: // ['A','R']
 			return 101
+		case 65 <= r && r <= 82: // ['A','R']
+			return 102
 		case r == 83: // ['S','S']
-			return 197
+			return 202
 		case 84 <= r && r <= 90: // ['T','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S116
+	// S117
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 82: // ['A','R']
 			return 101
+		case 65 <= r && r <= 82: // ['A','R']
+			return 102
 		case r == 83: // ['S','S']
-			return 198
+			return 203
 		case 84 <= r && r <= 90: // ['T','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S117
+	// S118
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 82: // ['A','R']
 			return 101
+		case 65 <= r && r <= 82: // ['A','R']
+			return 102
 		case r == 83: // ['S','S']
-			return 199
+			return 204
 		case 84 <= r && r <= 90: // ['T','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S118
+	// S119
 	func(r rune) int {
 		switch {
 		case r == 91: // ['[','[']
-			return 200
+			return 205
 		}
 		return NoState
 	},
-	// S119
+	// S120
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S120
+	// S121
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 201
+			return 206
 		}
 		return NoState
 	},
-	// S121
+	// S122
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 107: // ['a','k']
-			return 101
+			return 102
 		case r == 108: // ['l','l']
-			return 202
+			return 207
 		case 109 <= r && r <= 122: // ['m','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S122
+	// S123
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 113: // ['a','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 203
+			return 208
 		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S123
+	// S124
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
 			return 102
-		case 97 <= r && r <= 102: // ['a','f']
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S125
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 102: // ['a','f']
+			return 102
 		case r == 103: // ['g','g']
-			return 204
+			return 209
 		case 104 <= r && r <= 122: // ['h','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S124
+	// S126
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 110: // ['a','n']
-			return 101
+			return 102
 		case r == 111: // ['o','o']
-			return 205
+			return 210
 		case 112 <= r && r <= 122: // ['p','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S125
+	// S127
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 100: // ['a','d']
-			return 101
+			return 102
 		case r == 101: // ['e','e']
-			return 206
+			return 211
 		case 102 <= r && r <= 122: // ['f','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S126
+	// S128
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 107: // ['a','k']
-			return 101
+			return 102
 		case r == 108: // ['l','l']
-			return 207
-		case 109 <= r && r <= 122: // ['m','z']
-			return 101
+			return 212
+		case 109 <= r && r <= 114: // ['m','r']
+			return 102
+		case r == 115: // ['s','s']
+			return 213
+		case r == 116: // ['t','t']
+			return 214
+		case 117 <= r && r <= 122: // ['u','z']
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S127
+	// S129
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 109: // ['a','m']
-			return 101
+			return 102
 		case r == 110: // ['n','n']
-			return 208
+			return 215
 		case 111 <= r && r <= 122: // ['o','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S128
+	// S130
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 101: // ['a','e']
+			return 102
+		case r == 102: // ['f','f']
+			return 216
+		case 103 <= r && r <= 122: // ['g','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S129
+	// S131
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
 			return 102
-		case 97 <= r && r <= 108: // ['a','l']
-			return 101
-		case r == 109: // ['m','m']
-			return 209
-		case 110 <= r && r <= 122: // ['n','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S130
+	// S132
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 108: // ['a','l']
+			return 102
+		case r == 109: // ['m','m']
+			return 217
+		case 110 <= r && r <= 122: // ['n','z']
 			return 102
-		case 97 <= r && r <= 116: // ['a','t']
-			return 101
-		case r == 117: // ['u','u']
-			return 210
-		case 118 <= r && r <= 122: // ['v','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S131
+	// S133
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 116: // ['a','t']
 			return 102
-		case 97 <= r && r <= 104: // ['a','h']
+		case r == 117: // ['u','u']
+			return 218
+		case 118 <= r && r <= 122: // ['v','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S134
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 104: // ['a','h']
+			return 102
 		case r == 105: // ['i','i']
-			return 211
+			return 219
 		case 106 <= r && r <= 114: // ['j','r']
-			return 101
+			return 102
 		case r == 115: // ['s','s']
-			return 212
+			return 220
 		case 116 <= r && r <= 122: // ['t','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S132
+	// S135
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 104: // ['a','h']
-			return 101
+			return 102
 		case r == 105: // ['i','i']
-			return 213
+			return 221
 		case 106 <= r && r <= 122: // ['j','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S133
+	// S136
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 99: // ['a','c']
-			return 101
+			return 102
 		case r == 100: // ['d','d']
-			return 214
+			return 222
 		case 101 <= r && r <= 122: // ['e','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S134
+	// S137
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 113: // ['a','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 215
+			return 223
 		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S135
+	// S138
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 107: // ['a','k']
-			return 101
+			return 102
 		case r == 108: // ['l','l']
-			return 216
+			return 224
 		case 109 <= r && r <= 122: // ['m','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S136
+	// S139
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 107: // ['a','k']
-			return 101
+			return 102
 		case r == 108: // ['l','l']
-			return 217
+			return 225
 		case 109 <= r && r <= 122: // ['m','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S137
+	// S140
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 110: // ['a','n']
-			return 101
+			return 102
 		case r == 111: // ['o','o']
-			return 218
+			return 226
 		case 112 <= r && r <= 122: // ['p','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S138
+	// S141
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 113: // ['a','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 219
+			return 227
 		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S139
+	// S142
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 109: // ['a','m']
-			return 101
+			return 102
 		case r == 110: // ['n','n']
-			return 220
+			return 228
 		case 111 <= r && r <= 122: // ['o','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S140
+	// S143
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S141
+	// S144
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 111: // ['a','o']
+			return 102
+		case r == 112: // ['p','p']
+			return 229
+		case 113 <= r && r <= 122: // ['q','z']
 			return 102
-		case 97 <= r && r <= 115: // ['a','s']
-			return 101
-		case r == 116: // ['t','t']
-			return 221
-		case 117 <= r && r <= 122: // ['u','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S142
+	// S145
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 115: // ['a','s']
+			return 102
+		case r == 116: // ['t','t']
+			return 230
+		case 117 <= r && r <= 122: // ['u','z']
 			return 102
-		case 97 <= r && r <= 111: // ['a','o']
-			return 101
-		case r == 112: // ['p','p']
-			return 222
-		case 113 <= r && r <= 122: // ['q','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S143
+	// S146
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 111: // ['a','o']
+			return 102
+		case r == 112: // ['p','p']
+			return 231
+		case 113 <= r && r <= 122: // ['q','z']
 			return 102
-		case 97 <= r && r <= 107: // ['a','k']
-			return 101
-		case r == 108: // ['l','l']
-			return 223
-		case r == 109: // ['m','m']
-			return 224
-		case 110 <= r && r <= 122: // ['n','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S144
+	// S147
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 107: // ['a','k']
 			return 102
-		case 97 <= r && r <= 104: // ['a','h']
+		case r == 108: // ['l','l']
+			return 232
+		case r == 109: // ['m','m']
+			return 233
+		case 110 <= r && r <= 122: // ['n','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S148
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 104: // ['a','h']
+			return 102
 		case r == 105: // ['i','i']
-			return 225
+			return 234
 		case 106 <= r && r <= 122: // ['j','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S145
+	// S149
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 115: // ['a','s']
-			return 101
+			return 102
 		case r == 116: // ['t','t']
-			return 226
+			return 235
 		case 117 <= r && r <= 122: // ['u','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S146
+	// S150
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 99: // ['a','c']
-			return 101
+			return 102
 		case r == 100: // ['d','d']
-			return 227
+			return 236
 		case 101 <= r && r <= 113: // ['e','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 228
+			return 237
 		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S147
+	// S151
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case r == 97: // ['a','a']
-			return 101
+			return 102
 		case r == 98: // ['b','b']
-			return 229
+			return 238
 		case 99 <= r && r <= 122: // ['c','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S148
+	// S152
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 104: // ['a','h']
 			return 102
-		case 97 <= r && r <= 100: // ['a','d']
+		case r == 105: // ['i','i']
+			return 239
+		case 106 <= r && r <= 122: // ['j','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S153
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 100: // ['a','d']
+			return 102
 		case r == 101: // ['e','e']
-			return 230
+			return 240
 		case 102 <= r && r <= 122: // ['f','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S149
+	// S154
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 116: // ['a','t']
-			return 101
+			return 102
 		case r == 117: // ['u','u']
-			return 231
-		case 118 <= r && r <= 122: // ['v','z']
-			return 101
+			return 241
+		case 118 <= r && r <= 120: // ['v','x']
+			return 102
+		case r == 121: // ['y','y']
+			return 242
+		case r == 122: // ['z','z']
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S150
+	// S155
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 114: // ['a','r']
-			return 101
+			return 102
 		case r == 115: // ['s','s']
-			return 232
+			return 243
 		case 116 <= r && r <= 122: // ['t','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S151
+	// S156
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 113: // ['a','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 233
+			return 244
 		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S152
+	// S157
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 104: // ['a','h']
-			return 101
+			return 102
 		case r == 105: // ['i','i']
-			return 234
+			return 245
 		case 106 <= r && r <= 122: // ['j','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S153
+	// S158
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S154
+	// S159
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 235
+			return 246
 		}
 		return NoState
 	},
-	// S155
+	// S160
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S156
+	// S161
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S157
+	// S162
 	func(r rune) int {
 		switch {
 		case r == 10: // ['\n','\n']
@@ -3051,7 +3165,7 @@ var TransTab = TransitionTable{
 		case 97 <= r && r <= 104: // ['a','h']
 			return 57
 		case r == 105: // ['i','i']
-			return 236
+			return 247
 		case 106 <= r && r <= 122: // ['j','z']
 			return 57
 		case r == 123: // ['{','{']
@@ -3069,1373 +3183,1499 @@ var TransTab = TransitionTable{
 		}
 		return NoState
 	},
-	// S158
+	// S163
 	func(r rune) int {
 		switch {
 		case r == 91: // ['[','[']
-			return 237
+			return 248
 		}
 		return NoState
 	},
-	// S159
+	// S164
 	func(r rune) int {
 		switch {
 		case r == 32: // [' ',' ']
-			return 159
+			return 164
 		case r == 33: // ['!','!']
-			return 159
+			return 164
 		case r == 35: // ['#','#']
-			return 159
+			return 164
 		case r == 36: // ['$','$']
-			return 159
+			return 164
 		case r == 37: // ['%','%']
-			return 159
+			return 164
 		case r == 38: // ['&','&']
-			return 159
+			return 164
 		case r == 39: // [''',''']
-			return 159
+			return 164
 		case r == 40: // ['(','(']
-			return 159
+			return 164
 		case r == 41: // [')',')']
-			return 159
+			return 164
 		case r == 42: // ['*','*']
-			return 159
+			return 164
 		case r == 43: // ['+','+']
-			return 159
+			return 164
 		case r == 44: // [',',',']
-			return 159
+			return 164
 		case r == 45: // ['-','-']
-			return 159
+			return 164
 		case r == 46: // ['.','.']
-			return 159
+			return 164
 		case r == 47: // ['/','/']
-			return 159
+			return 164
 		case 48 <= r && r <= 57: // ['0','9']
-			return 159
+			return 164
 		case r == 58: // [':',':']
-			return 159
+			return 164
 		case r == 59: // [';',';']
-			return 159
+			return 164
 		case r == 60: // ['<','<']
-			return 159
+			return 164
 		case r == 61: // ['=','=']
-			return 159
+			return 164
 		case r == 62: // ['>','>']
-			return 159
+			return 164
 		case r == 63: // ['?','?']
-			return 159
+			return 164
 		case r == 64: // ['@','@']
-			return 159
+			return 164
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 159
+			return 164
 		case r == 91: // ['[','[']
-			return 159
+			return 164
 		case r == 92: // ['\','\']
-			return 238
+			return 249
 		case r == 93: // [']',']']
-			return 159
+			return 164
 		case r == 94: // ['^','^']
-			return 159
+			return 164
 		case r == 95: // ['_','_']
-			return 159
+			return 164
 		case r == 96: // ['`','`']
-			return 159
+			return 164
 		case 97 <= r && r <= 122: // ['a','z']
-			return 159
+			return 164
 		case r == 124: // ['|','|']
-			return 159
+			return 164
 		case r == 125: // ['}','}']
-			return 239
+			return 250
 		case r == 126: // ['~','~']
-			return 159
+			return 164
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 159
+			return 164
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 159
+			return 164
 		}
 		return NoState
 	},
-	// S160
+	// S165
 	func(r rune) int {
 		switch {
 		case r == 123: // ['{','{']
-			return 159
+			return 164
 		case r == 125: // ['}','}']
-			return 240
+			return 251
 		}
 		return NoState
 	},
-	// S161
+	// S166
 	func(r rune) int {
 		switch {
 		case r == 112: // ['p','p']
-			return 241
+			return 252
 		}
 		return NoState
 	},
-	// S162
+	// S167
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S163
+	// S168
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S164
+	// S169
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S165
+	// S170
 	func(r rune) int {
 		switch {
 		case r == 43: // ['+','+']
-			return 242
+			return 253
 		case r == 45: // ['-','-']
-			return 243
+			return 254
 		case 48 <= r && r <= 57: // ['0','9']
-			return 244
+			return 255
 		}
 		return NoState
 	},
-	// S166
+	// S171
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S167
+	// S172
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 167
+			return 172
 		case r == 69: // ['E','E']
-			return 245
+			return 256
 		case r == 101: // ['e','e']
-			return 245
+			return 256
 		}
 		return NoState
 	},
-	// S168
+	// S173
 	func(r rune) int {
 		switch {
 		case r == 43: // ['+','+']
-			return 246
+			return 257
 		case r == 45: // ['-','-']
-			return 247
+			return 258
 		case 48 <= r && r <= 57: // ['0','9']
-			return 248
+			return 259
 		}
 		return NoState
 	},
-	// S169
+	// S174
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 249
+			return 260
 		}
 		return NoState
 	},
-	// S170
+	// S175
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 250
+			return 261
 		}
 		return NoState
 	},
-	// S171
+	// S176
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 171
+			return 176
 		}
 		return NoState
 	},
-	// S172
+	// S177
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 49: // ['0','1']
-			return 172
+			return 177
 		}
 		return NoState
 	},
-	// S173
+	// S178
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 55: // ['0','7']
-			return 173
+			return 178
 		}
 		return NoState
 	},
-	// S174
+	// S179
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 174
+			return 179
 		case 65 <= r && r <= 70: // ['A','F']
-			return 174
+			return 179
 		case 97 <= r && r <= 102: // ['a','f']
-			return 174
+			return 179
 		}
 		return NoState
 	},
-	// S175
+	// S180
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S176
+	// S181
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S177
+	// S182
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S178
+	// S183
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 251
+			return 262
 		}
 		return NoState
 	},
-	// S179
+	// S184
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S180
+	// S185
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 252
+			return 263
 		}
 		return NoState
 	},
-	// S181
+	// S186
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 181
+			return 186
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 182
+			return 187
 		case r == 95: // ['_','_']
-			return 183
+			return 188
 		case 97 <= r && r <= 122: // ['a','z']
-			return 182
+			return 187
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 182
+			return 187
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 182
+			return 187
 		}
 		return NoState
 	},
-	// S182
+	// S187
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 181
+			return 186
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 182
+			return 187
 		case r == 95: // ['_','_']
-			return 183
+			return 188
 		case 97 <= r && r <= 122: // ['a','z']
-			return 182
+			return 187
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 182
+			return 187
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 182
+			return 187
 		}
 		return NoState
 	},
-	// S183
+	// S188
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 181
+			return 186
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 182
+			return 187
 		case r == 95: // ['_','_']
-			return 183
+			return 188
 		case 97 <= r && r <= 122: // ['a','z']
-			return 182
+			return 187
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 182
+			return 187
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 182
+			return 187
 		}
 		return NoState
 	},
-	// S184
+	// S189
 	func(r rune) int {
 		switch {
 		case r == 32: // [' ',' ']
-			return 184
+			return 189
 		case r == 33: // ['!','!']
-			return 184
+			return 189
 		case r == 35: // ['#','#']
-			return 184
+			return 189
 		case r == 36: // ['$','$']
-			return 184
+			return 189
 		case r == 37: // ['%','%']
-			return 184
+			return 189
 		case r == 38: // ['&','&']
-			return 184
+			return 189
 		case r == 39: // [''',''']
-			return 184
+			return 189
 		case r == 40: // ['(','(']
-			return 184
+			return 189
 		case r == 41: // [')',')']
-			return 184
+			return 189
 		case r == 42: // ['*','*']
-			return 184
+			return 189
 		case r == 43: // ['+','+']
-			return 184
+			return 189
 		case r == 44: // [',',',']
-			return 184
+			return 189
 		case r == 45: // ['-','-']
-			return 184
+			return 189
 		case r == 46: // ['.','.']
-			return 184
+			return 189
 		case r == 47: // ['/','/']
-			return 184
+			return 189
 		case 48 <= r && r <= 57: // ['0','9']
-			return 184
+			return 189
 		case r == 58: // [':',':']
-			return 184
+			return 189
 		case r == 59: // [';',';']
-			return 184
+			return 189
 		case r == 60: // ['<','<']
-			return 184
+			return 189
 		case r == 61: // ['=','=']
-			return 184
+			return 189
 		case r == 62: // ['>','>']
-			return 184
+			return 189
 		case r == 63: // ['?','?']
-			return 184
+			return 189
 		case r == 64: // ['@','@']
-			return 184
+			return 189
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 184
+			return 189
 		case r == 91: // ['[','[']
-			return 184
+			return 189
 		case r == 92: // ['\','\']
-			return 253
+			return 264
 		case r == 93: // [']',']']
-			return 184
+			return 189
 		case r == 94: // ['^','^']
-			return 184
+			return 189
 		case r == 95: // ['_','_']
-			return 184
+			return 189
 		case r == 96: // ['`','`']
-			return 184
+			return 189
 		case 97 <= r && r <= 122: // ['a','z']
-			return 184
+			return 189
 		case r == 124: // ['|','|']
-			return 184
+			return 189
 		case r == 125: // ['}','}']
-			return 254
+			return 265
 		case r == 126: // ['~','~']
-			return 184
+			return 189
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 184
+			return 189
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 184
+			return 189
 		}
 		return NoState
 	},
-	// S185
+	// S190
 	func(r rune) int {
 		switch {
 		case r == 123: // ['{','{']
-			return 184
+			return 189
 		case r == 125: // ['}','}']
-			return 255
+			return 266
 		}
 		return NoState
 	},
-	// S186
+	// S191
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S187
+	// S192
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 68: // ['A','D']
 			return 101
+		case 65 <= r && r <= 68: // ['A','D']
+			return 102
 		case r == 69: // ['E','E']
-			return 256
+			return 267
 		case 70 <= r && r <= 90: // ['F','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S188
+	// S193
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 83: // ['A','S']
 			return 101
+		case 65 <= r && r <= 83: // ['A','S']
+			return 102
 		case r == 84: // ['T','T']
-			return 257
+			return 268
 		case 85 <= r && r <= 90: // ['U','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S189
+	// S194
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S190
+	// S195
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S191
+	// S196
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S192
+	// S197
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S193
+	// S198
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S194
+	// S199
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S195
+	// S200
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 72: // ['A','H']
 			return 101
+		case 65 <= r && r <= 72: // ['A','H']
+			return 102
 		case r == 73: // ['I','I']
-			return 258
+			return 269
 		case 74 <= r && r <= 90: // ['J','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S196
+	// S201
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S197
+	// S202
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S198
+	// S203
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S199
+	// S204
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S200
+	// S205
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S201
+	// S206
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S202
+	// S207
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S203
+	// S208
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S204
+	// S209
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 104: // ['a','h']
-			return 101
+			return 102
 		case r == 105: // ['i','i']
-			return 259
+			return 270
 		case 106 <= r && r <= 122: // ['j','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S205
+	// S210
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 107: // ['a','k']
-			return 101
+			return 102
 		case r == 108: // ['l','l']
-			return 260
+			return 271
 		case 109 <= r && r <= 122: // ['m','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S206
+	// S211
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case r == 97: // ['a','a']
-			return 261
+			return 272
 		case 98 <= r && r <= 122: // ['b','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S207
+	// S212
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 107: // ['a','k']
-			return 101
+			return 102
 		case r == 108: // ['l','l']
-			return 262
+			return 273
 		case 109 <= r && r <= 122: // ['m','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S208
+	// S213
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
+			return 101
 		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 100: // ['a','d']
+			return 102
+		case r == 101: // ['e','e']
+			return 274
+		case 102 <= r && r <= 122: // ['f','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S214
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 98: // ['a','b']
 			return 102
-		case 97 <= r && r <= 115: // ['a','s']
+		case r == 99: // ['c','c']
+			return 275
+		case 100 <= r && r <= 122: // ['d','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S215
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 115: // ['a','s']
+			return 102
 		case r == 116: // ['t','t']
-			return 263
+			return 276
 		case 117 <= r && r <= 122: // ['u','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S209
+	// S216
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case r == 97: // ['a','a']
+			return 277
+		case 98 <= r && r <= 122: // ['b','z']
 			return 102
-		case 97 <= r && r <= 111: // ['a','o']
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S217
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 111: // ['a','o']
+			return 102
 		case r == 112: // ['p','p']
-			return 264
+			return 278
 		case 113 <= r && r <= 122: // ['q','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S210
+	// S218
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 108: // ['a','l']
-			return 101
+			return 102
 		case r == 109: // ['m','m']
-			return 265
+			return 279
 		case 110 <= r && r <= 122: // ['n','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S211
+	// S219
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 101: // ['a','e']
-			return 101
+			return 102
 		case r == 102: // ['f','f']
-			return 266
+			return 280
 		case 103 <= r && r <= 122: // ['g','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S212
+	// S220
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 100: // ['a','d']
-			return 101
+			return 102
 		case r == 101: // ['e','e']
-			return 267
+			return 281
 		case 102 <= r && r <= 122: // ['f','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S213
+	// S221
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 115: // ['a','s']
-			return 101
+			return 102
 		case r == 116: // ['t','t']
-			return 268
+			return 282
 		case 117 <= r && r <= 122: // ['u','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S214
+	// S222
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S215
+	// S223
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 104: // ['a','h']
-			return 101
+			return 102
 		case r == 105: // ['i','i']
-			return 269
+			return 283
 		case 106 <= r && r <= 122: // ['j','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S216
+	// S224
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 114: // ['a','r']
-			return 101
+			return 102
 		case r == 115: // ['s','s']
-			return 270
+			return 284
 		case 116 <= r && r <= 122: // ['t','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S217
+	// S225
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 115: // ['a','s']
-			return 101
+			return 102
 		case r == 116: // ['t','t']
-			return 271
+			return 285
 		case 117 <= r && r <= 122: // ['u','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S218
+	// S226
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case r == 97: // ['a','a']
-			return 272
+			return 286
 		case 98 <= r && r <= 122: // ['b','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S219
+	// S227
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S220
+	// S228
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 98: // ['a','b']
-			return 101
+			return 102
 		case r == 99: // ['c','c']
-			return 273
+			return 287
 		case 100 <= r && r <= 122: // ['d','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S221
+	// S229
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 110: // ['a','n']
+			return 102
+		case r == 111: // ['o','o']
+			return 288
+		case 112 <= r && r <= 122: // ['p','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S222
+	// S230
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S223
+	// S231
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
 			return 102
+		}
+		return NoState
+	},
+	// S232
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 107: // ['a','k']
-			return 101
+			return 102
 		case r == 108: // ['l','l']
-			return 274
+			return 289
 		case 109 <= r && r <= 122: // ['m','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S224
+	// S233
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S225
+	// S234
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 109: // ['a','m']
-			return 101
+			return 102
 		case r == 110: // ['n','n']
-			return 275
+			return 290
 		case 111 <= r && r <= 122: // ['o','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S226
+	// S235
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 116: // ['a','t']
-			return 101
+			return 102
 		case r == 117: // ['u','u']
-			return 276
+			return 291
 		case 118 <= r && r <= 122: // ['v','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S227
+	// S236
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 100: // ['a','d']
-			return 101
+			return 102
 		case r == 101: // ['e','e']
-			return 277
+			return 292
 		case 102 <= r && r <= 110: // ['f','n']
-			return 101
+			return 102
 		case r == 111: // ['o','o']
-			return 278
+			return 293
 		case 112 <= r && r <= 122: // ['p','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S228
+	// S237
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S229
+	// S238
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 113: // ['a','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 279
+			return 294
 		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S230
+	// S239
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 115: // ['a','s']
+			return 102
+		case r == 116: // ['t','t']
+			return 295
+		case 117 <= r && r <= 122: // ['u','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S231
+	// S240
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
 			return 102
-		case 97 <= r && r <= 100: // ['a','d']
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S241
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 100: // ['a','d']
+			return 102
 		case r == 101: // ['e','e']
-			return 280
+			return 296
 		case 102 <= r && r <= 122: // ['f','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S232
+	// S242
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
 			return 102
-		case 97 <= r && r <= 100: // ['a','d']
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S243
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 100: // ['a','d']
+			return 102
 		case r == 101: // ['e','e']
-			return 281
+			return 297
 		case 102 <= r && r <= 122: // ['f','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S233
+	// S244
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S234
+	// S245
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 107: // ['a','k']
-			return 101
+			return 102
 		case r == 108: // ['l','l']
-			return 282
+			return 298
 		case 109 <= r && r <= 122: // ['m','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S235
+	// S246
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S236
+	// S247
 	func(r rune) int {
 		switch {
 		case r == 10: // ['\n','\n']
@@ -4519,1715 +4759,1981 @@ var TransTab = TransitionTable{
 		}
 		return NoState
 	},
-	// S237
+	// S248
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S238
+	// S249
 	func(r rune) int {
 		switch {
 		case r == 123: // ['{','{']
-			return 159
+			return 164
 		case r == 125: // ['}','}']
-			return 240
+			return 251
 		}
 		return NoState
 	},
-	// S239
+	// S250
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S240
+	// S251
 	func(r rune) int {
 		switch {
 		case r == 32: // [' ',' ']
-			return 159
+			return 164
 		case r == 33: // ['!','!']
-			return 159
+			return 164
 		case r == 35: // ['#','#']
-			return 159
+			return 164
 		case r == 36: // ['$','$']
-			return 159
+			return 164
 		case r == 37: // ['%','%']
-			return 159
+			return 164
 		case r == 38: // ['&','&']
-			return 159
+			return 164
 		case r == 39: // [''',''']
-			return 159
+			return 164
 		case r == 40: // ['(','(']
-			return 159
+			return 164
 		case r == 41: // [')',')']
-			return 159
+			return 164
 		case r == 42: // ['*','*']
-			return 159
+			return 164
 		case r == 43: // ['+','+']
-			return 159
+			return 164
 		case r == 44: // [',',',']
-			return 159
+			return 164
 		case r == 45: // ['-','-']
-			return 159
+			return 164
 		case r == 46: // ['.','.']
-			return 159
+			return 164
 		case r == 47: // ['/','/']
-			return 159
+			return 164
 		case 48 <= r && r <= 57: // ['0','9']
-			return 159
+			return 164
 		case r == 58: // [':',':']
-			return 159
+			return 164
 		case r == 59: // [';',';']
-			return 159
+			return 164
 		case r == 60: // ['<','<']
-			return 159
+			return 164
 		case r == 61: // ['=','=']
-			return 159
+			return 164
 		case r == 62: // ['>','>']
-			return 159
+			return 164
 		case r == 63: // ['?','?']
-			return 159
+			return 164
 		case r == 64: // ['@','@']
-			return 159
+			return 164
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 159
+			return 164
 		case r == 91: // ['[','[']
-			return 159
+			return 164
 		case r == 92: // ['\','\']
-			return 238
+			return 249
 		case r == 93: // [']',']']
-			return 159
+			return 164
 		case r == 94: // ['^','^']
-			return 159
+			return 164
 		case r == 95: // ['_','_']
-			return 159
+			return 164
 		case r == 96: // ['`','`']
-			return 159
+			return 164
 		case 97 <= r && r <= 122: // ['a','z']
-			return 159
+			return 164
 		case r == 124: // ['|','|']
-			return 159
+			return 164
 		case r == 125: // ['}','}']
-			return 239
+			return 250
 		case r == 126: // ['~','~']
-			return 159
+			return 164
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 159
+			return 164
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 159
+			return 164
 		}
 		return NoState
 	},
-	// S241
+	// S252
 	func(r rune) int {
 		switch {
 		case r == 97: // ['a','a']
-			return 283
+			return 299
 		}
 		return NoState
 	},
-	// S242
+	// S253
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 284
+			return 300
 		}
 		return NoState
 	},
-	// S243
+	// S254
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 285
+			return 301
 		}
 		return NoState
 	},
-	// S244
+	// S255
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 244
+			return 255
 		}
 		return NoState
 	},
-	// S245
+	// S256
 	func(r rune) int {
 		switch {
 		case r == 43: // ['+','+']
-			return 286
+			return 302
 		case r == 45: // ['-','-']
-			return 287
+			return 303
 		case 48 <= r && r <= 57: // ['0','9']
-			return 288
+			return 304
 		}
 		return NoState
 	},
-	// S246
+	// S257
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 289
+			return 305
 		}
 		return NoState
 	},
-	// S247
+	// S258
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 290
+			return 306
 		}
 		return NoState
 	},
-	// S248
+	// S259
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 248
+			return 259
 		}
 		return NoState
 	},
-	// S249
+	// S260
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 249
+			return 260
 		}
 		return NoState
 	},
-	// S250
+	// S261
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 250
+			return 261
 		}
 		return NoState
 	},
-	// S251
+	// S262
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S252
+	// S263
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S253
+	// S264
 	func(r rune) int {
 		switch {
 		case r == 123: // ['{','{']
-			return 184
+			return 189
 		case r == 125: // ['}','}']
-			return 255
+			return 266
 		}
 		return NoState
 	},
-	// S254
+	// S265
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S255
+	// S266
 	func(r rune) int {
 		switch {
 		case r == 32: // [' ',' ']
-			return 184
+			return 189
 		case r == 33: // ['!','!']
-			return 184
+			return 189
 		case r == 35: // ['#','#']
-			return 184
+			return 189
 		case r == 36: // ['$','$']
-			return 184
+			return 189
 		case r == 37: // ['%','%']
-			return 184
+			return 189
 		case r == 38: // ['&','&']
-			return 184
+			return 189
 		case r == 39: // [''',''']
-			return 184
+			return 189
 		case r == 40: // ['(','(']
-			return 184
+			return 189
 		case r == 41: // [')',')']
-			return 184
+			return 189
 		case r == 42: // ['*','*']
-			return 184
+			return 189
 		case r == 43: // ['+','+']
-			return 184
+			return 189
 		case r == 44: // [',',',']
-			return 184
+			return 189
 		case r == 45: // ['-','-']
-			return 184
+			return 189
 		case r == 46: // ['.','.']
-			return 184
+			return 189
 		case r == 47: // ['/','/']
-			return 184
+			return 189
 		case 48 <= r && r <= 57: // ['0','9']
-			return 184
+			return 189
 		case r == 58: // [':',':']
-			return 184
+			return 189
 		case r == 59: // [';',';']
-			return 184
+			return 189
 		case r == 60: // ['<','<']
-			return 184
+			return 189
 		case r == 61: // ['=','=']
-			return 184
+			return 189
 		case r == 62: // ['>','>']
-			return 184
+			return 189
 		case r == 63: // ['?','?']
-			return 184
+			return 189
 		case r == 64: // ['@','@']
-			return 184
+			return 189
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 184
+			return 189
 		case r == 91: // ['[','[']
-			return 184
+			return 189
 		case r == 92: // ['\','\']
-			return 253
+			return 264
 		case r == 93: // [']',']']
-			return 184
+			return 189
 		case r == 94: // ['^','^']
-			return 184
+			return 189
 		case r == 95: // ['_','_']
-			return 184
+			return 189
 		case r == 96: // ['`','`']
-			return 184
+			return 189
 		case 97 <= r && r <= 122: // ['a','z']
-			return 184
+			return 189
 		case r == 124: // ['|','|']
-			return 184
+			return 189
 		case r == 125: // ['}','}']
-			return 254
+			return 265
 		case r == 126: // ['~','~']
-			return 184
+			return 189
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 184
+			return 189
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 184
+			return 189
+		}
+		return NoState
+	},
+	// S267
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 101
+		case 65 <= r && r <= 77: // ['A','M']
+			return 102
+		case r == 78: // ['N','N']
+			return 307
+		case 79 <= r && r <= 90: // ['O','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S268
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 101
+		case 65 <= r && r <= 82: // ['A','R']
+			return 102
+		case r == 83: // ['S','S']
+			return 308
+		case 84 <= r && r <= 90: // ['T','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S269
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
 		}
 		return NoState
 	},
-	// S256
+	// S270
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 77: // ['A','M']
-			return 101
-		case r == 78: // ['N','N']
-			return 291
-		case 79 <= r && r <= 90: // ['O','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 109: // ['a','m']
+			return 102
+		case r == 110: // ['n','n']
+			return 309
+		case 111 <= r && r <= 122: // ['o','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S257
+	// S271
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 82: // ['A','R']
-			return 101
-		case r == 83: // ['S','S']
-			return 292
-		case 84 <= r && r <= 90: // ['T','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S258
+	// S272
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 106: // ['a','j']
+			return 102
+		case r == 107: // ['k','k']
+			return 310
+		case 108 <= r && r <= 122: // ['l','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S259
+	// S273
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
 			return 102
-		case 97 <= r && r <= 109: // ['a','m']
-			return 101
-		case r == 110: // ['n','n']
-			return 293
-		case 111 <= r && r <= 122: // ['o','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S260
+	// S274
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S261
+	// S275
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 103: // ['a','g']
+			return 102
+		case r == 104: // ['h','h']
+			return 311
+		case 105 <= r && r <= 122: // ['i','z']
 			return 102
-		case 97 <= r && r <= 106: // ['a','j']
-			return 101
-		case r == 107: // ['k','k']
-			return 294
-		case 108 <= r && r <= 122: // ['l','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S262
+	// S276
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 104: // ['a','h']
+			return 102
+		case r == 105: // ['i','i']
+			return 312
+		case 106 <= r && r <= 122: // ['j','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S263
+	// S277
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 116: // ['a','t']
+			return 102
+		case r == 117: // ['u','u']
+			return 313
+		case 118 <= r && r <= 122: // ['v','z']
 			return 102
-		case 97 <= r && r <= 104: // ['a','h']
-			return 101
-		case r == 105: // ['i','i']
-			return 295
-		case 106 <= r && r <= 122: // ['j','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S264
+	// S278
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S265
+	// S279
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 111: // ['a','o']
-			return 101
+			return 102
 		case r == 112: // ['p','p']
-			return 296
+			return 314
 		case 113 <= r && r <= 122: // ['q','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S266
+	// S280
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S267
+	// S281
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S268
+	// S282
 	func(r rune) int {
 		switch {
 		case r == 48: // ['0','0']
-			return 100
+			return 101
 		case r == 49: // ['1','1']
-			return 297
+			return 315
 		case 50 <= r && r <= 57: // ['2','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 101: // ['a','e']
-			return 101
+			return 102
 		case r == 102: // ['f','f']
-			return 298
+			return 316
 		case 103 <= r && r <= 111: // ['g','o']
-			return 101
+			return 102
 		case r == 112: // ['p','p']
-			return 299
+			return 317
 		case 113 <= r && r <= 122: // ['q','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S269
+	// S283
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 109: // ['a','m']
-			return 101
+			return 102
 		case r == 110: // ['n','n']
-			return 300
+			return 318
 		case 111 <= r && r <= 122: // ['o','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S270
+	// S284
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 100: // ['a','d']
-			return 101
+			return 102
 		case r == 101: // ['e','e']
-			return 301
+			return 319
 		case 102 <= r && r <= 122: // ['f','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S271
+	// S285
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 100: // ['a','d']
-			return 101
+			return 102
 		case r == 101: // ['e','e']
-			return 302
+			return 320
 		case 102 <= r && r <= 122: // ['f','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S272
+	// S286
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 115: // ['a','s']
-			return 101
+			return 102
 		case r == 116: // ['t','t']
-			return 303
+			return 321
 		case 117 <= r && r <= 122: // ['u','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S273
+	// S287
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 115: // ['a','s']
-			return 101
+			return 102
 		case r == 116: // ['t','t']
-			return 304
+			return 322
 		case 117 <= r && r <= 122: // ['u','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S274
+	// S288
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 113: // ['a','q']
+			return 102
+		case r == 114: // ['r','r']
+			return 323
+		case 115 <= r && r <= 122: // ['s','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S275
+	// S289
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
 			return 102
-		case 97 <= r && r <= 115: // ['a','s']
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S290
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 115: // ['a','s']
+			return 102
 		case r == 116: // ['t','t']
-			return 305
+			return 324
 		case 117 <= r && r <= 122: // ['u','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S276
+	// S291
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 113: // ['a','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 306
+			return 325
 		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S277
+	// S292
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 113: // ['a','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 307
+			return 326
 		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S278
+	// S293
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 116: // ['a','t']
-			return 101
+			return 102
 		case r == 117: // ['u','u']
-			return 308
+			return 327
 		case 118 <= r && r <= 122: // ['v','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S279
+	// S294
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S280
+	// S295
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 98: // ['a','b']
+			return 102
+		case r == 99: // ['c','c']
+			return 328
+		case 100 <= r && r <= 122: // ['d','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S281
+	// S296
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
 			return 102
-		case 97 <= r && r <= 115: // ['a','s']
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S297
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 115: // ['a','s']
+			return 102
 		case r == 116: // ['t','t']
-			return 309
+			return 329
 		case 117 <= r && r <= 122: // ['u','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S282
+	// S298
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 100: // ['a','d']
-			return 101
+			return 102
 		case r == 101: // ['e','e']
-			return 310
+			return 330
 		case 102 <= r && r <= 122: // ['f','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S283
+	// S299
 	func(r rune) int {
 		switch {
 		case r == 110: // ['n','n']
-			return 311
+			return 331
 		}
 		return NoState
 	},
-	// S284
+	// S300
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 284
+			return 300
 		}
 		return NoState
 	},
-	// S285
+	// S301
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 285
+			return 301
 		}
 		return NoState
 	},
-	// S286
+	// S302
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 312
+			return 332
 		}
 		return NoState
 	},
-	// S287
+	// S303
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 313
+			return 333
 		}
 		return NoState
 	},
-	// S288
+	// S304
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 288
+			return 304
 		}
 		return NoState
 	},
-	// S289
+	// S305
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 289
+			return 305
 		}
 		return NoState
 	},
-	// S290
+	// S306
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 290
+			return 306
 		}
 		return NoState
 	},
-	// S291
+	// S307
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
+			return 101
 		case r == 65: // ['A','A']
-			return 314
+			return 334
 		case 66 <= r && r <= 84: // ['B','T']
-			return 101
+			return 102
 		case r == 85: // ['U','U']
-			return 315
+			return 335
 		case 86 <= r && r <= 90: // ['V','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S292
+	// S308
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 68: // ['A','D']
 			return 101
+		case 65 <= r && r <= 68: // ['A','D']
+			return 102
 		case r == 69: // ['E','E']
-			return 316
+			return 336
 		case 70 <= r && r <= 90: // ['F','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S293
+	// S309
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S294
+	// S310
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S295
+	// S311
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
 			return 102
-		case 97 <= r && r <= 109: // ['a','m']
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S312
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 109: // ['a','m']
+			return 102
 		case r == 110: // ['n','n']
-			return 317
+			return 337
 		case 111 <= r && r <= 122: // ['o','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S296
+	// S313
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 107: // ['a','k']
+			return 102
+		case r == 108: // ['l','l']
+			return 338
+		case 109 <= r && r <= 122: // ['m','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S297
+	// S314
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S298
+	// S315
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S299
+	// S316
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S300
+	// S317
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
 			return 102
-		case 97 <= r && r <= 115: // ['a','s']
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S318
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 115: // ['a','s']
+			return 102
 		case r == 116: // ['t','t']
-			return 318
+			return 339
 		case 117 <= r && r <= 122: // ['u','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S301
+	// S319
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S320
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 113: // ['a','q']
+			return 102
+		case r == 114: // ['r','r']
+			return 340
+		case 115 <= r && r <= 122: // ['s','z']
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S302
+	// S321
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
 			return 102
-		case 97 <= r && r <= 113: // ['a','q']
-			return 101
-		case r == 114: // ['r','r']
-			return 319
-		case 115 <= r && r <= 122: // ['s','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S303
+	// S322
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S304
+	// S323
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 115: // ['a','s']
+			return 102
+		case r == 116: // ['t','t']
+			return 341
+		case 117 <= r && r <= 122: // ['u','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S305
+	// S324
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 109: // ['a','m']
-			return 101
+			return 102
 		case r == 110: // ['n','n']
-			return 320
+			return 342
 		case 111 <= r && r <= 122: // ['o','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S306
+	// S325
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 109: // ['a','m']
-			return 101
+			return 102
 		case r == 110: // ['n','n']
-			return 321
+			return 343
 		case 111 <= r && r <= 122: // ['o','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S307
+	// S326
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 113: // ['a','q']
-			return 101
+			return 102
 		case r == 114: // ['r','r']
-			return 322
+			return 344
 		case 115 <= r && r <= 122: // ['s','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S308
+	// S327
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 115: // ['a','s']
-			return 101
+			return 102
 		case r == 116: // ['t','t']
-			return 323
+			return 345
 		case 117 <= r && r <= 122: // ['u','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S309
+	// S328
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 103: // ['a','g']
+			return 102
+		case r == 104: // ['h','h']
+			return 346
+		case 105 <= r && r <= 122: // ['i','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S310
+	// S329
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S330
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
 		}
 		return NoState
 	},
-	// S311
+	// S331
 	func(r rune) int {
 		switch {
 		case r == 105: // ['i','i']
-			return 324
+			return 347
 		}
 		return NoState
 	},
-	// S312
+	// S332
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 312
+			return 332
 		}
 		return NoState
 	},
-	// S313
+	// S333
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 313
+			return 333
 		}
 		return NoState
 	},
-	// S314
+	// S334
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 76: // ['A','L']
 			return 101
+		case 65 <= r && r <= 76: // ['A','L']
+			return 102
 		case r == 77: // ['M','M']
-			return 325
+			return 348
 		case 78 <= r && r <= 90: // ['N','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S315
+	// S335
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 76: // ['A','L']
 			return 101
+		case 65 <= r && r <= 76: // ['A','L']
+			return 102
 		case r == 77: // ['M','M']
-			return 326
+			return 349
 		case 78 <= r && r <= 90: // ['N','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S336
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 101
+		case 65 <= r && r <= 79: // ['A','O']
+			return 102
+		case r == 80: // ['P','P']
+			return 350
+		case 81 <= r && r <= 90: // ['Q','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S337
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 116: // ['a','t']
+			return 102
+		case r == 117: // ['u','u']
+			return 351
+		case 118 <= r && r <= 122: // ['v','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S338
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 115: // ['a','s']
+			return 102
+		case r == 116: // ['t','t']
+			return 352
+		case 117 <= r && r <= 122: // ['u','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S316
+	// S339
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 79: // ['A','O']
-			return 101
-		case r == 80: // ['P','P']
-			return 327
-		case 81 <= r && r <= 90: // ['Q','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 109: // ['a','m']
+			return 102
+		case r == 110: // ['n','n']
+			return 353
+		case 111 <= r && r <= 122: // ['o','z']
 			return 102
-		case 97 <= r && r <= 122: // ['a','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S317
+	// S340
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
 			return 102
-		case 97 <= r && r <= 116: // ['a','t']
-			return 101
-		case r == 117: // ['u','u']
-			return 328
-		case 118 <= r && r <= 122: // ['v','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S318
+	// S341
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
 		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
 			return 102
-		case 97 <= r && r <= 109: // ['a','m']
-			return 101
-		case r == 110: // ['n','n']
-			return 329
-		case 111 <= r && r <= 122: // ['o','z']
-			return 101
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S319
+	// S342
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S320
+	// S343
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S321
+	// S344
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S322
+	// S345
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S323
+	// S346
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S324
+	// S347
 	func(r rune) int {
 		switch {
 		case r == 99: // ['c','c']
-			return 330
+			return 354
 		}
 		return NoState
 	},
-	// S325
+	// S348
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 68: // ['A','D']
 			return 101
+		case 65 <= r && r <= 68: // ['A','D']
+			return 102
 		case r == 69: // ['E','E']
-			return 331
+			return 355
 		case 70 <= r && r <= 90: // ['F','Z']
-			return 101
-		case r == 95: // ['_','_']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S326
+	// S349
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S327
+	// S350
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S328
+	// S351
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 100: // ['a','d']
-			return 101
+			return 102
 		case r == 101: // ['e','e']
-			return 332
+			return 356
 		case 102 <= r && r <= 122: // ['f','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S329
+	// S352
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
+		}
+		return NoState
+	},
+	// S353
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
 			return 101
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 102
+		case r == 95: // ['_','_']
+			return 103
+		case 97 <= r && r <= 122: // ['a','z']
+			return 102
+		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
+			return 102
+		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
+			return 102
 		}
 		return NoState
 	},
-	// S330
+	// S354
 	func(r rune) int {
 		switch {
 		case r == 37: // ['%','%']
-			return 333
+			return 357
 		}
 		return NoState
 	},
-	// S331
+	// S355
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S332
+	// S356
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 100
-		case 65 <= r && r <= 90: // ['A','Z']
 			return 101
-		case r == 95: // ['_','_']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 102
+		case r == 95: // ['_','_']
+			return 103
 		case 97 <= r && r <= 122: // ['a','z']
-			return 101
+			return 102
 		case 160 <= r && r <= 255: // [\u00a0,\u00ff]
-			return 101
+			return 102
 		case 256 <= r && r <= 1114111: // [\u0100,\U0010ffff]
-			return 101
+			return 102
 		}
 		return NoState
 	},
-	// S333
+	// S357
 	func(r rune) int {
 		switch {
 		case r == 37: // ['%','%']
-			return 334
+			return 358
 		}
 		return NoState
 	},
-	// S334
+	// S358
 	func(r rune) int {
 		switch {
 		case r == 37: // ['%','%']
-			return 335
+			return 359
 		}
 		return NoState
 	},
-	// S335
+	// S359
 	func(r rune) int {
 		switch {
 		}
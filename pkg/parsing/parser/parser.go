@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 338
-	numStates      = 4675
-	numSymbols     = 256
+	numProductions = 361
+	numStates      = 5212
+	numSymbols     = 273
 )
 
 // Stack
@@ -359,11 +359,21 @@ var productionsTable = ProdTab{
 			return X[0], nil
 		},
 	},
+	ProdTabEntry{
+		String:     `BracelessStatement : ImportStatement	<<  >>`,
+		Id:         "BracelessStatement",
+		NTType:     5,
+		Index:      34,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
 	ProdTabEntry{
 		String:     `Assignment : Lvalue "=" Rvalue	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeAssignment) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      34,
+		Index:      35,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeAssignment)
@@ -379,7 +389,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "Unset",
 		NTType:     7,
-		Index:      35,
+		Index:      36,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AdoptChildren(
@@ -395,7 +405,7 @@ var productionsTable = ProdTab{
 		String:     `Lvalue : Rvalue	<<  >>`,
 		Id:         "Lvalue",
 		NTType:     8,
-		Index:      36,
+		Index:      37,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -405,7 +415,7 @@ var productionsTable = ProdTab{
 		String:     `Lvalue : Typedecl LocalVariable	<< dsl.AppendChild(X[1], X[0]) >>`,
 		Id:         "Lvalue",
 		NTType:     8,
-		Index:      37,
+		Index:      38,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AppendChild(X[1], X[0])
@@ -415,7 +425,7 @@ var productionsTable = ProdTab{
 		String:     `BareBoolean : Rvalue	<< dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeBareBoolean) >>`,
 		Id:         "BareBoolean",
 		NTType:     9,
-		Index:      38,
+		Index:      39,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeBareBoolean)
@@ -425,7 +435,7 @@ var productionsTable = ProdTab{
 		String:     `FilterStatement : filter Rvalue	<< dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeFilterStatement) >>`,
 		Id:         "FilterStatement",
 		NTType:     10,
-		Index:      39,
+		Index:      40,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeFilterStatement)
@@ -435,7 +445,7 @@ var productionsTable = ProdTab{
 		String:     `Redirector : ">" RedirectTarget	<< dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeRedirectWrite) >>`,
 		Id:         "Redirector",
 		NTType:     11,
-		Index:      40,
+		Index:      41,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeRedirectWrite)
@@ -445,7 +455,7 @@ var productionsTable = ProdTab{
 		String:     `Redirector : ">>" RedirectTarget	<< dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeRedirectAppend) >>`,
 		Id:         "Redirector",
 		NTType:     11,
-		Index:      41,
+		Index:      42,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeRedirectAppend)
@@ -455,7 +465,7 @@ var productionsTable = ProdTab{
 		String:     `Redirector : "|" RedirectTarget	<< dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeRedirectPipe) >>`,
 		Id:         "Redirector",
 		NTType:     11,
-		Index:      42,
+		Index:      43,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeRedirectPipe)
@@ -465,7 +475,7 @@ var productionsTable = ProdTab{
 		String:     `RedirectTarget : stdout	<< dsl.NewASTNodeZary(X[0], dsl.NodeTypeRedirectTargetStdout) >>`,
 		Id:         "RedirectTarget",
 		NTType:     12,
-		Index:      43,
+		Index:      44,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(X[0], dsl.NodeTypeRedirectTargetStdout)
@@ -475,7 +485,7 @@ var productionsTable = ProdTab{
 		String:     `RedirectTarget : stderr	<< dsl.NewASTNodeZary(X[0], dsl.NodeTypeRedirectTargetStderr) >>`,
 		Id:         "RedirectTarget",
 		NTType:     12,
-		Index:      44,
+		Index:      45,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(X[0], dsl.NodeTypeRedirectTargetStderr)
@@ -485,7 +495,7 @@ var productionsTable = ProdTab{
 		String:     `RedirectTarget : Rvalue	<<  >>`,
 		Id:         "RedirectTarget",
 		NTType:     12,
-		Index:      45,
+		Index:      46,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -500,7 +510,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "PrintStatement",
 		NTType:     13,
-		Index:      46,
+		Index:      47,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -520,7 +530,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "PrintStatement",
 		NTType:     13,
-		Index:      47,
+		Index:      48,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -540,7 +550,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "PrintStatement",
 		NTType:     13,
-		Index:      48,
+		Index:      49,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -560,7 +570,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "PrintStatement",
 		NTType:     13,
-		Index:      49,
+		Index:      50,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -580,7 +590,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "PrintnStatement",
 		NTType:     14,
-		Index:      50,
+		Index:      51,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -600,7 +610,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "PrintnStatement",
 		NTType:     14,
-		Index:      51,
+		Index:      52,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -620,7 +630,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "PrintnStatement",
 		NTType:     14,
-		Index:      52,
+		Index:      53,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -640,7 +650,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "PrintnStatement",
 		NTType:     14,
-		Index:      53,
+		Index:      54,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -660,7 +670,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EprintStatement",
 		NTType:     15,
-		Index:      54,
+		Index:      55,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -680,7 +690,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EprintStatement",
 		NTType:     15,
-		Index:      55,
+		Index:      56,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -700,7 +710,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EprintnStatement",
 		NTType:     16,
-		Index:      56,
+		Index:      57,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -720,7 +730,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EprintnStatement",
 		NTType:     16,
-		Index:      57,
+		Index:      58,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -740,7 +750,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "DumpStatement",
 		NTType:     17,
-		Index:      58,
+		Index:      59,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -760,7 +770,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "DumpStatement",
 		NTType:     17,
-		Index:      59,
+		Index:      60,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -780,7 +790,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "DumpStatement",
 		NTType:     17,
-		Index:      60,
+		Index:      61,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -800,7 +810,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "DumpStatement",
 		NTType:     17,
-		Index:      61,
+		Index:      62,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -820,7 +830,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EdumpStatement",
 		NTType:     18,
-		Index:      62,
+		Index:      63,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -840,7 +850,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EdumpStatement",
 		NTType:     18,
-		Index:      63,
+		Index:      64,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -855,7 +865,7 @@ var productionsTable = ProdTab{
 		String:     `TeeStatement : tee Redirector "," FullSrec	<< dsl.NewASTNodeBinary(X[0], X[3], X[1], dsl.NodeTypeTeeStatement) >>`,
 		Id:         "TeeStatement",
 		NTType:     19,
-		Index:      64,
+		Index:      65,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[0], X[3], X[1], dsl.NodeTypeTeeStatement)
@@ -870,7 +880,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitFStatement",
 		NTType:     20,
-		Index:      65,
+		Index:      66,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -890,7 +900,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitFStatement",
 		NTType:     20,
-		Index:      66,
+		Index:      67,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -909,7 +919,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "Emit1Statement",
 		NTType:     21,
-		Index:      67,
+		Index:      68,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -929,7 +939,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitStatement",
 		NTType:     22,
-		Index:      68,
+		Index:      69,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -951,7 +961,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "EmitStatement",
 		NTType:     22,
-		Index:      69,
+		Index:      70,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -973,7 +983,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitStatement",
 		NTType:     22,
-		Index:      70,
+		Index:      71,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -995,7 +1005,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitStatement",
 		NTType:     22,
-		Index:      71,
+		Index:      72,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1017,7 +1027,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitStatement",
 		NTType:     22,
-		Index:      72,
+		Index:      73,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1039,7 +1049,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitStatement",
 		NTType:     22,
-		Index:      73,
+		Index:      74,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1061,7 +1071,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitStatement",
 		NTType:     22,
-		Index:      74,
+		Index:      75,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1083,7 +1093,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitStatement",
 		NTType:     22,
-		Index:      75,
+		Index:      76,
 		NumSymbols: 8,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1105,7 +1115,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitPStatement",
 		NTType:     23,
-		Index:      76,
+		Index:      77,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1127,7 +1137,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "EmitPStatement",
 		NTType:     23,
-		Index:      77,
+		Index:      78,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1149,7 +1159,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitPStatement",
 		NTType:     23,
-		Index:      78,
+		Index:      79,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1171,7 +1181,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitPStatement",
 		NTType:     23,
-		Index:      79,
+		Index:      80,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1193,7 +1203,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitPStatement",
 		NTType:     23,
-		Index:      80,
+		Index:      81,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1215,7 +1225,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitPStatement",
 		NTType:     23,
-		Index:      81,
+		Index:      82,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1237,7 +1247,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitPStatement",
 		NTType:     23,
-		Index:      82,
+		Index:      83,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1259,7 +1269,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmitPStatement",
 		NTType:     23,
-		Index:      83,
+		Index:      84,
 		NumSymbols: 8,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -1279,7 +1289,7 @@ var productionsTable = ProdTab{
       ) >>`,
 		Id:         "EmittableList",
 		NTType:     24,
-		Index:      84,
+		Index:      85,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -1296,7 +1306,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "EmittableList",
 		NTType:     24,
-		Index:      85,
+		Index:      86,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.PrependChild(
@@ -1313,7 +1323,7 @@ var productionsTable = ProdTab{
   ) >>`,
 		Id:         "EmittableAsList",
 		NTType:     25,
-		Index:      86,
+		Index:      87,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -1327,7 +1337,7 @@ var productionsTable = ProdTab{
 		String:     `Emittable : LocalVariable	<<  >>`,
 		Id:         "Emittable",
 		NTType:     26,
-		Index:      87,
+		Index:      88,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1337,7 +1347,7 @@ var productionsTable = ProdTab{
 		String:     `Emittable : DirectOosvarValue	<<  >>`,
 		Id:         "Emittable",
 		NTType:     26,
-		Index:      88,
+		Index:      89,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1347,7 +1357,7 @@ var productionsTable = ProdTab{
 		String:     `Emittable : BracedOosvarValue	<<  >>`,
 		Id:         "Emittable",
 		NTType:     26,
-		Index:      89,
+		Index:      90,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1357,7 +1367,7 @@ var productionsTable = ProdTab{
 		String:     `Emittable : IndirectOosvarValue	<<  >>`,
 		Id:         "Emittable",
 		NTType:     26,
-		Index:      90,
+		Index:      91,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1367,7 +1377,7 @@ var productionsTable = ProdTab{
 		String:     `Emittable : DirectFieldValue	<<  >>`,
 		Id:         "Emittable",
 		NTType:     26,
-		Index:      91,
+		Index:      92,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1377,7 +1387,7 @@ var productionsTable = ProdTab{
 		String:     `Emittable : BracedFieldValue	<<  >>`,
 		Id:         "Emittable",
 		NTType:     26,
-		Index:      92,
+		Index:      93,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1387,7 +1397,7 @@ var productionsTable = ProdTab{
 		String:     `Emittable : IndirectFieldValue	<<  >>`,
 		Id:         "Emittable",
 		NTType:     26,
-		Index:      93,
+		Index:      94,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1397,7 +1407,7 @@ var productionsTable = ProdTab{
 		String:     `Emittable : FullSrec	<<  >>`,
 		Id:         "Emittable",
 		NTType:     26,
-		Index:      94,
+		Index:      95,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1407,7 +1417,7 @@ var productionsTable = ProdTab{
 		String:     `Emittable : FullOosvar	<<  >>`,
 		Id:         "Emittable",
 		NTType:     26,
-		Index:      95,
+		Index:      96,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1417,7 +1427,7 @@ var productionsTable = ProdTab{
 		String:     `Emittable : MapLiteral	<<  >>`,
 		Id:         "Emittable",
 		NTType:     26,
-		Index:      96,
+		Index:      97,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1431,7 +1441,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "EmitKeys",
 		NTType:     27,
-		Index:      97,
+		Index:      98,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -1448,7 +1458,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "EmitKeys",
 		NTType:     27,
-		Index:      98,
+		Index:      99,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.PrependChild(
@@ -1461,7 +1471,7 @@ var productionsTable = ProdTab{
 		String:     `FieldValue : DirectFieldValue	<<  >>`,
 		Id:         "FieldValue",
 		NTType:     28,
-		Index:      99,
+		Index:      100,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1471,7 +1481,7 @@ var productionsTable = ProdTab{
 		String:     `FieldValue : IndirectFieldValue	<<  >>`,
 		Id:         "FieldValue",
 		NTType:     28,
-		Index:      100,
+		Index:      101,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1481,7 +1491,7 @@ var productionsTable = ProdTab{
 		String:     `FieldValue : BracedFieldValue	<<  >>`,
 		Id:         "FieldValue",
 		NTType:     28,
-		Index:      101,
+		Index:      102,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1491,7 +1501,7 @@ var productionsTable = ProdTab{
 		String:     `FieldValue : PositionalFieldName	<<  >>`,
 		Id:         "FieldValue",
 		NTType:     28,
-		Index:      102,
+		Index:      103,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1501,7 +1511,7 @@ var productionsTable = ProdTab{
 		String:     `FieldValue : PositionalFieldValue	<<  >>`,
 		Id:         "FieldValue",
 		NTType:     28,
-		Index:      103,
+		Index:      104,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1511,7 +1521,7 @@ var productionsTable = ProdTab{
 		String:     `DirectFieldValue : field_name	<< dsl.NewASTNodeStripDollarOrAtSign(X[0], dsl.NodeTypeDirectFieldValue) >>`,
 		Id:         "DirectFieldValue",
 		NTType:     29,
-		Index:      104,
+		Index:      105,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeStripDollarOrAtSign(X[0], dsl.NodeTypeDirectFieldValue)
@@ -1521,7 +1531,7 @@ var productionsTable = ProdTab{
 		String:     `IndirectFieldValue : "$[" Rvalue "]"	<< dsl.NewASTNodeUnary(dsl.NewASTToken("$[]", X[0]), X[1], dsl.NodeTypeIndirectFieldValue) >>`,
 		Id:         "IndirectFieldValue",
 		NTType:     30,
-		Index:      105,
+		Index:      106,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(dsl.NewASTToken("$[]", X[0]), X[1], dsl.NodeTypeIndirectFieldValue)
@@ -1531,7 +1541,7 @@ var productionsTable = ProdTab{
 		String:     `BracedFieldValue : braced_field_name	<< dsl.NewASTNodeStripDollarOrAtSignAndCurlyBraces(X[0], dsl.NodeTypeDirectFieldValue) >>`,
 		Id:         "BracedFieldValue",
 		NTType:     31,
-		Index:      106,
+		Index:      107,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeStripDollarOrAtSignAndCurlyBraces(X[0], dsl.NodeTypeDirectFieldValue)
@@ -1541,7 +1551,7 @@ var productionsTable = ProdTab{
 		String:     `PositionalFieldName : "$[[" Rvalue "]" "]"	<< dsl.NewASTNodeUnary(dsl.NewASTToken("$[]", X[0]), X[1], dsl.NodeTypePositionalFieldName) >>`,
 		Id:         "PositionalFieldName",
 		NTType:     32,
-		Index:      107,
+		Index:      108,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(dsl.NewASTToken("$[]", X[0]), X[1], dsl.NodeTypePositionalFieldName)
@@ -1551,7 +1561,7 @@ var productionsTable = ProdTab{
 		String:     `PositionalFieldValue : "$[[[" Rvalue "]" "]" "]"	<< dsl.NewASTNodeUnary(dsl.NewASTToken("$[]", X[0]), X[1], dsl.NodeTypePositionalFieldValue) >>`,
 		Id:         "PositionalFieldValue",
 		NTType:     33,
-		Index:      108,
+		Index:      109,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(dsl.NewASTToken("$[]", X[0]), X[1], dsl.NodeTypePositionalFieldValue)
@@ -1561,7 +1571,7 @@ var productionsTable = ProdTab{
 		String:     `FullSrec : full_srec	<< dsl.NewASTNode(X[0], dsl.NodeTypeFullSrec) >>`,
 		Id:         "FullSrec",
 		NTType:     34,
-		Index:      109,
+		Index:      110,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeFullSrec)
@@ -1571,7 +1581,7 @@ var productionsTable = ProdTab{
 		String:     `OosvarValue : DirectOosvarValue	<<  >>`,
 		Id:         "OosvarValue",
 		NTType:     35,
-		Index:      110,
+		Index:      111,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1581,7 +1591,7 @@ var productionsTable = ProdTab{
 		String:     `OosvarValue : IndirectOosvarValue	<<  >>`,
 		Id:         "OosvarValue",
 		NTType:     35,
-		Index:      111,
+		Index:      112,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1591,7 +1601,7 @@ var productionsTable = ProdTab{
 		String:     `OosvarValue : BracedOosvarValue	<<  >>`,
 		Id:         "OosvarValue",
 		NTType:     35,
-		Index:      112,
+		Index:      113,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1601,7 +1611,7 @@ var productionsTable = ProdTab{
 		String:     `DirectOosvarValue : oosvar_name	<< dsl.NewASTNodeStripDollarOrAtSign(X[0], dsl.NodeTypeDirectOosvarValue) >>`,
 		Id:         "DirectOosvarValue",
 		NTType:     36,
-		Index:      113,
+		Index:      114,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeStripDollarOrAtSign(X[0], dsl.NodeTypeDirectOosvarValue)
@@ -1611,7 +1621,7 @@ var productionsTable = ProdTab{
 		String:     `IndirectOosvarValue : "@[" Rvalue "]"	<< dsl.NewASTNodeUnary(dsl.NewASTToken("@[]", X[0]), X[1], dsl.NodeTypeIndirectOosvarValue) >>`,
 		Id:         "IndirectOosvarValue",
 		NTType:     37,
-		Index:      114,
+		Index:      115,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(dsl.NewASTToken("@[]", X[0]), X[1], dsl.NodeTypeIndirectOosvarValue)
@@ -1621,7 +1631,7 @@ var productionsTable = ProdTab{
 		String:     `BracedOosvarValue : braced_oosvar_name	<< dsl.NewASTNodeStripDollarOrAtSignAndCurlyBraces(X[0], dsl.NodeTypeDirectOosvarValue) >>`,
 		Id:         "BracedOosvarValue",
 		NTType:     38,
-		Index:      115,
+		Index:      116,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeStripDollarOrAtSignAndCurlyBraces(X[0], dsl.NodeTypeDirectOosvarValue)
@@ -1631,7 +1641,7 @@ var productionsTable = ProdTab{
 		String:     `FullOosvar : full_oosvar	<< dsl.NewASTNode(X[0], dsl.NodeTypeFullOosvar) >>`,
 		Id:         "FullOosvar",
 		NTType:     39,
-		Index:      116,
+		Index:      117,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeFullOosvar)
@@ -1641,27 +1651,77 @@ var productionsTable = ProdTab{
 		String:     `FullOosvar : all	<< dsl.NewASTNode(X[0], dsl.NodeTypeFullOosvar) >>`,
 		Id:         "FullOosvar",
 		NTType:     39,
-		Index:      117,
+		Index:      118,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeFullOosvar)
 		},
 	},
 	ProdTabEntry{
-		String:     `LocalVariable : non_sigil_name	<< dsl.NewASTNode(X[0], dsl.NodeTypeLocalVariable) >>`,
+		String:     `LocalVariable : NonSigilName	<< dsl.NewASTNode(X[0], dsl.NodeTypeLocalVariable) >>`,
 		Id:         "LocalVariable",
 		NTType:     40,
-		Index:      118,
+		Index:      119,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeLocalVariable)
 		},
 	},
+	ProdTabEntry{
+		String:     `NonSigilName : non_sigil_name	<<  >>`,
+		Id:         "NonSigilName",
+		NTType:     41,
+		Index:      120,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `NonSigilName : case	<<  >>`,
+		Id:         "NonSigilName",
+		NTType:     41,
+		Index:      121,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `NonSigilName : default	<<  >>`,
+		Id:         "NonSigilName",
+		NTType:     41,
+		Index:      122,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `NonSigilName : catch	<<  >>`,
+		Id:         "NonSigilName",
+		NTType:     41,
+		Index:      123,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `NonSigilName : as	<<  >>`,
+		Id:         "NonSigilName",
+		NTType:     41,
+		Index:      124,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
 	ProdTabEntry{
 		String:     `Typedecl : arr	<< dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl) >>`,
 		Id:         "Typedecl",
-		NTType:     41,
-		Index:      119,
+		NTType:     42,
+		Index:      125,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl)
@@ -1670,8 +1730,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `Typedecl : bool	<< dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl) >>`,
 		Id:         "Typedecl",
-		NTType:     41,
-		Index:      120,
+		NTType:     42,
+		Index:      126,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl)
@@ -1680,8 +1740,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `Typedecl : float	<< dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl) >>`,
 		Id:         "Typedecl",
-		NTType:     41,
-		Index:      121,
+		NTType:     42,
+		Index:      127,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl)
@@ -1690,8 +1750,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `Typedecl : int	<< dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl) >>`,
 		Id:         "Typedecl",
-		NTType:     41,
-		Index:      122,
+		NTType:     42,
+		Index:      128,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl)
@@ -1700,8 +1760,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `Typedecl : map	<< dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl) >>`,
 		Id:         "Typedecl",
-		NTType:     41,
-		Index:      123,
+		NTType:     42,
+		Index:      129,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl)
@@ -1710,8 +1770,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `Typedecl : num	<< dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl) >>`,
 		Id:         "Typedecl",
-		NTType:     41,
-		Index:      124,
+		NTType:     42,
+		Index:      130,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl)
@@ -1720,8 +1780,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `Typedecl : str	<< dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl) >>`,
 		Id:         "Typedecl",
-		NTType:     41,
-		Index:      125,
+		NTType:     42,
+		Index:      131,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl)
@@ -1730,8 +1790,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `Typedecl : var	<< dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl) >>`,
 		Id:         "Typedecl",
-		NTType:     41,
-		Index:      126,
+		NTType:     42,
+		Index:      132,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl)
@@ -1740,8 +1800,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `Typedecl : funct	<< dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl) >>`,
 		Id:         "Typedecl",
-		NTType:     41,
-		Index:      127,
+		NTType:     42,
+		Index:      133,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeTypedecl)
@@ -1756,7 +1816,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      128,
+		Index:      134,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -1776,7 +1836,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      129,
+		Index:      135,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -1796,7 +1856,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      130,
+		Index:      136,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -1816,7 +1876,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      131,
+		Index:      137,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -1836,7 +1896,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      132,
+		Index:      138,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -1856,7 +1916,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      133,
+		Index:      139,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -1876,7 +1936,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      134,
+		Index:      140,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -1896,7 +1956,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      135,
+		Index:      141,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -1916,7 +1976,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      136,
+		Index:      142,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -1936,7 +1996,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      137,
+		Index:      143,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -1956,7 +2016,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      138,
+		Index:      144,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -1976,7 +2036,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      139,
+		Index:      145,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -1996,7 +2056,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      140,
+		Index:      146,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -2016,7 +2076,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      141,
+		Index:      147,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -2036,7 +2096,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      142,
+		Index:      148,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -2056,7 +2116,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      143,
+		Index:      149,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -2076,7 +2136,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      144,
+		Index:      150,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -2096,7 +2156,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      145,
+		Index:      151,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -2116,7 +2176,7 @@ var productionsTable = ProdTab{
     ) >>`,
 		Id:         "Assignment",
 		NTType:     6,
-		Index:      146,
+		Index:      152,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -2130,8 +2190,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `Rvalue : PrecedenceChainStart	<<  >>`,
 		Id:         "Rvalue",
-		NTType:     42,
-		Index:      147,
+		NTType:     43,
+		Index:      153,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2140,8 +2200,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `PrecedenceChainStart : TernaryTerm	<<  >>`,
 		Id:         "PrecedenceChainStart",
-		NTType:     43,
-		Index:      148,
+		NTType:     44,
+		Index:      154,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2150,8 +2210,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TernaryTerm : LogicalOrTerm "?" TernaryTerm ":" TernaryTerm	<< dsl.NewASTNodeTernary(dsl.NewASTToken("?:", X[1]), X[0], X[2], X[4], dsl.NodeTypeOperator) >>`,
 		Id:         "TernaryTerm",
-		NTType:     44,
-		Index:      149,
+		NTType:     45,
+		Index:      155,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(dsl.NewASTToken("?:", X[1]), X[0], X[2], X[4], dsl.NodeTypeOperator)
@@ -2160,8 +2220,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TernaryTerm : LogicalOrTerm	<<  >>`,
 		Id:         "TernaryTerm",
-		NTType:     44,
-		Index:      150,
+		NTType:     45,
+		Index:      156,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2170,8 +2230,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `LogicalOrTerm : LogicalOrTerm "||" LogicalXORTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "LogicalOrTerm",
-		NTType:     45,
-		Index:      151,
+		NTType:     46,
+		Index:      157,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2180,8 +2240,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `LogicalOrTerm : LogicalXORTerm	<<  >>`,
 		Id:         "LogicalOrTerm",
-		NTType:     45,
-		Index:      152,
+		NTType:     46,
+		Index:      158,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2190,8 +2250,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `LogicalXORTerm : LogicalXORTerm "^^" LogicalAndTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "LogicalXORTerm",
-		NTType:     46,
-		Index:      153,
+		NTType:     47,
+		Index:      159,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2200,8 +2260,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `LogicalXORTerm : LogicalAndTerm	<<  >>`,
 		Id:         "LogicalXORTerm",
-		NTType:     46,
-		Index:      154,
+		NTType:     47,
+		Index:      160,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2210,8 +2270,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `LogicalAndTerm : LogicalAndTerm "&&" EqneTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "LogicalAndTerm",
-		NTType:     47,
-		Index:      155,
+		NTType:     48,
+		Index:      161,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2220,8 +2280,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `LogicalAndTerm : EqneTerm	<<  >>`,
 		Id:         "LogicalAndTerm",
-		NTType:     47,
-		Index:      156,
+		NTType:     48,
+		Index:      162,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2230,8 +2290,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `EqneTerm : EqneTerm "=~" CmpTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "EqneTerm",
-		NTType:     48,
-		Index:      157,
+		NTType:     49,
+		Index:      163,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2240,8 +2300,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `EqneTerm : EqneTerm "!=~" CmpTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "EqneTerm",
-		NTType:     48,
-		Index:      158,
+		NTType:     49,
+		Index:      164,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2250,8 +2310,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `EqneTerm : EqneTerm "==" CmpTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "EqneTerm",
-		NTType:     48,
-		Index:      159,
+		NTType:     49,
+		Index:      165,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2260,8 +2320,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `EqneTerm : EqneTerm "!=" CmpTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "EqneTerm",
-		NTType:     48,
-		Index:      160,
+		NTType:     49,
+		Index:      166,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2270,8 +2330,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `EqneTerm : EqneTerm "<=>" CmpTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "EqneTerm",
-		NTType:     48,
-		Index:      161,
+		NTType:     49,
+		Index:      167,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2280,8 +2340,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `EqneTerm : CmpTerm	<<  >>`,
 		Id:         "EqneTerm",
-		NTType:     48,
-		Index:      162,
+		NTType:     49,
+		Index:      168,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2290,8 +2350,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `CmpTerm : CmpTerm ">" BitwiseORTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "CmpTerm",
-		NTType:     49,
-		Index:      163,
+		NTType:     50,
+		Index:      169,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2300,8 +2360,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `CmpTerm : CmpTerm ">=" BitwiseORTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "CmpTerm",
-		NTType:     49,
-		Index:      164,
+		NTType:     50,
+		Index:      170,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2310,8 +2370,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `CmpTerm : CmpTerm "<" BitwiseORTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "CmpTerm",
-		NTType:     49,
-		Index:      165,
+		NTType:     50,
+		Index:      171,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2320,8 +2380,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `CmpTerm : CmpTerm "<=" BitwiseORTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "CmpTerm",
-		NTType:     49,
-		Index:      166,
+		NTType:     50,
+		Index:      172,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2330,8 +2390,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `CmpTerm : BitwiseORTerm	<<  >>`,
 		Id:         "CmpTerm",
-		NTType:     49,
-		Index:      167,
+		NTType:     50,
+		Index:      173,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2340,8 +2400,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BitwiseORTerm : BitwiseORTerm "|" BitwiseXORTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "BitwiseORTerm",
-		NTType:     50,
-		Index:      168,
+		NTType:     51,
+		Index:      174,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2350,8 +2410,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BitwiseORTerm : BitwiseXORTerm	<<  >>`,
 		Id:         "BitwiseORTerm",
-		NTType:     50,
-		Index:      169,
+		NTType:     51,
+		Index:      175,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2360,8 +2420,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BitwiseXORTerm : BitwiseXORTerm "^" BitwiseANDTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "BitwiseXORTerm",
-		NTType:     51,
-		Index:      170,
+		NTType:     52,
+		Index:      176,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2370,8 +2430,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BitwiseXORTerm : BitwiseANDTerm	<<  >>`,
 		Id:         "BitwiseXORTerm",
-		NTType:     51,
-		Index:      171,
+		NTType:     52,
+		Index:      177,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2380,8 +2440,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BitwiseANDTerm : BitwiseANDTerm "&" BitwiseShiftTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "BitwiseANDTerm",
-		NTType:     52,
-		Index:      172,
+		NTType:     53,
+		Index:      178,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2390,8 +2450,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BitwiseANDTerm : BitwiseShiftTerm	<<  >>`,
 		Id:         "BitwiseANDTerm",
-		NTType:     52,
-		Index:      173,
+		NTType:     53,
+		Index:      179,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2400,8 +2460,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BitwiseShiftTerm : BitwiseShiftTerm "<<" AddsubdotTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "BitwiseShiftTerm",
-		NTType:     53,
-		Index:      174,
+		NTType:     54,
+		Index:      180,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2410,8 +2470,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BitwiseShiftTerm : BitwiseShiftTerm ">>" AddsubdotTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "BitwiseShiftTerm",
-		NTType:     53,
-		Index:      175,
+		NTType:     54,
+		Index:      181,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2420,8 +2480,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BitwiseShiftTerm : BitwiseShiftTerm ">>>" AddsubdotTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "BitwiseShiftTerm",
-		NTType:     53,
-		Index:      176,
+		NTType:     54,
+		Index:      182,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2430,8 +2490,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BitwiseShiftTerm : AddsubdotTerm	<<  >>`,
 		Id:         "BitwiseShiftTerm",
-		NTType:     53,
-		Index:      177,
+		NTType:     54,
+		Index:      183,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2440,8 +2500,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `AddsubdotTerm : AddsubdotTerm "+" MuldivTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "AddsubdotTerm",
-		NTType:     54,
-		Index:      178,
+		NTType:     55,
+		Index:      184,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2450,8 +2510,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `AddsubdotTerm : AddsubdotTerm "-" MuldivTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "AddsubdotTerm",
-		NTType:     54,
-		Index:      179,
+		NTType:     55,
+		Index:      185,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2460,8 +2520,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `AddsubdotTerm : AddsubdotTerm ".+" MuldivTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "AddsubdotTerm",
-		NTType:     54,
-		Index:      180,
+		NTType:     55,
+		Index:      186,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2470,8 +2530,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `AddsubdotTerm : AddsubdotTerm ".-" MuldivTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "AddsubdotTerm",
-		NTType:     54,
-		Index:      181,
+		NTType:     55,
+		Index:      187,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2480,8 +2540,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `AddsubdotTerm : MuldivTerm	<<  >>`,
 		Id:         "AddsubdotTerm",
-		NTType:     54,
-		Index:      182,
+		NTType:     55,
+		Index:      188,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2490,8 +2550,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MuldivTerm : MuldivTerm "*" DotTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "MuldivTerm",
-		NTType:     55,
-		Index:      183,
+		NTType:     56,
+		Index:      189,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2500,8 +2560,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MuldivTerm : MuldivTerm "/" DotTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "MuldivTerm",
-		NTType:     55,
-		Index:      184,
+		NTType:     56,
+		Index:      190,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2510,8 +2570,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MuldivTerm : MuldivTerm "//" DotTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "MuldivTerm",
-		NTType:     55,
-		Index:      185,
+		NTType:     56,
+		Index:      191,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2520,8 +2580,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MuldivTerm : MuldivTerm "%" DotTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "MuldivTerm",
-		NTType:     55,
-		Index:      186,
+		NTType:     56,
+		Index:      192,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2530,8 +2590,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MuldivTerm : MuldivTerm ".*" DotTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "MuldivTerm",
-		NTType:     55,
-		Index:      187,
+		NTType:     56,
+		Index:      193,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2540,8 +2600,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MuldivTerm : MuldivTerm "./" DotTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "MuldivTerm",
-		NTType:     55,
-		Index:      188,
+		NTType:     56,
+		Index:      194,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2550,8 +2610,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MuldivTerm : MuldivTerm ".//" DotTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "MuldivTerm",
-		NTType:     55,
-		Index:      189,
+		NTType:     56,
+		Index:      195,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2560,8 +2620,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MuldivTerm : DotTerm	<<  >>`,
 		Id:         "MuldivTerm",
-		NTType:     55,
-		Index:      190,
+		NTType:     56,
+		Index:      196,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2570,8 +2630,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `DotTerm : DotTerm "." UnaryOpTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeDotOperator) >>`,
 		Id:         "DotTerm",
-		NTType:     56,
-		Index:      191,
+		NTType:     57,
+		Index:      197,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeDotOperator)
@@ -2580,8 +2640,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `DotTerm : UnaryOpTerm	<<  >>`,
 		Id:         "DotTerm",
-		NTType:     56,
-		Index:      192,
+		NTType:     57,
+		Index:      198,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2590,8 +2650,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `UnaryOpTerm : "+" UnaryOpTerm	<< dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeOperator) >>`,
 		Id:         "UnaryOpTerm",
-		NTType:     57,
-		Index:      193,
+		NTType:     58,
+		Index:      199,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeOperator)
@@ -2600,8 +2660,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `UnaryOpTerm : "-" UnaryOpTerm	<< dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeOperator) >>`,
 		Id:         "UnaryOpTerm",
-		NTType:     57,
-		Index:      194,
+		NTType:     58,
+		Index:      200,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeOperator)
@@ -2610,8 +2670,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `UnaryOpTerm : ".+" UnaryOpTerm	<< dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeOperator) >>`,
 		Id:         "UnaryOpTerm",
-		NTType:     57,
-		Index:      195,
+		NTType:     58,
+		Index:      201,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeOperator)
@@ -2620,8 +2680,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `UnaryOpTerm : ".-" UnaryOpTerm	<< dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeOperator) >>`,
 		Id:         "UnaryOpTerm",
-		NTType:     57,
-		Index:      196,
+		NTType:     58,
+		Index:      202,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeOperator)
@@ -2630,8 +2690,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `UnaryOpTerm : "!" UnaryOpTerm	<< dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeOperator) >>`,
 		Id:         "UnaryOpTerm",
-		NTType:     57,
-		Index:      197,
+		NTType:     58,
+		Index:      203,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeOperator)
@@ -2640,8 +2700,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `UnaryOpTerm : "~" UnaryOpTerm	<< dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeOperator) >>`,
 		Id:         "UnaryOpTerm",
-		NTType:     57,
-		Index:      198,
+		NTType:     58,
+		Index:      204,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeOperator)
@@ -2650,8 +2710,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `UnaryOpTerm : AbsentCoalesceTerm	<<  >>`,
 		Id:         "UnaryOpTerm",
-		NTType:     57,
-		Index:      199,
+		NTType:     58,
+		Index:      205,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2660,8 +2720,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `AbsentCoalesceTerm : AbsentCoalesceTerm "??" EmptyCoalesceTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "AbsentCoalesceTerm",
-		NTType:     58,
-		Index:      200,
+		NTType:     59,
+		Index:      206,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2670,9 +2730,9 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `AbsentCoalesceTerm : EmptyCoalesceTerm	<<  >>`,
 		Id:         "AbsentCoalesceTerm",
-		NTType:     58,
-		Index:      201,
-		NumSymbols: 1,
+		NTType:     59,
+		Index:      207,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
@@ -2680,8 +2740,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `EmptyCoalesceTerm : EmptyCoalesceTerm "???" PowTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "EmptyCoalesceTerm",
-		NTType:     59,
-		Index:      202,
+		NTType:     60,
+		Index:      208,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2690,8 +2750,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `EmptyCoalesceTerm : PowTerm	<<  >>`,
 		Id:         "EmptyCoalesceTerm",
-		NTType:     59,
-		Index:      203,
+		NTType:     60,
+		Index:      209,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2700,8 +2760,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `PowTerm : PrecedenceChainEnd "**" PowTerm	<< dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator) >>`,
 		Id:         "PowTerm",
-		NTType:     60,
-		Index:      204,
+		NTType:     61,
+		Index:      210,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0], X[2], dsl.NodeTypeOperator)
@@ -2711,8 +2771,8 @@ var productionsTable = ProdTab{
 		String: `PowTerm : PrecedenceChainEnd "**" "-" PowTerm	<< dsl.NewASTNodeBinary( X[1], X[0],
   dsl.NewASTNodeUnaryNestable( X[2], X[3], dsl.NodeTypeOperator,), dsl.NodeTypeOperator,) >>`,
 		Id:         "PowTerm",
-		NTType:     60,
-		Index:      205,
+		NTType:     61,
+		Index:      211,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[1], X[0],
@@ -2731,8 +2791,8 @@ var productionsTable = ProdTab{
         dsl.NodeTypeOperator,
       ) >>`,
 		Id:         "PowTerm",
-		NTType:     60,
-		Index:      206,
+		NTType:     61,
+		Index:      212,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -2750,8 +2810,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `PowTerm : PrecedenceChainEnd	<<  >>`,
 		Id:         "PowTerm",
-		NTType:     60,
-		Index:      207,
+		NTType:     61,
+		Index:      213,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2760,8 +2820,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `PrecedenceChainEnd : "(" Rvalue ")"	<< dsl.Nestable(X[1]) >>`,
 		Id:         "PrecedenceChainEnd",
-		NTType:     61,
-		Index:      208,
+		NTType:     62,
+		Index:      214,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.Nestable(X[1])
@@ -2770,8 +2830,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `PrecedenceChainEnd : MlrvalOrFunction	<<  >>`,
 		Id:         "PrecedenceChainEnd",
-		NTType:     61,
-		Index:      209,
+		NTType:     62,
+		Index:      215,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2780,8 +2840,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : FieldValue	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      210,
+		NTType:     63,
+		Index:      216,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2790,8 +2850,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : FullSrec	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      211,
+		NTType:     63,
+		Index:      217,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2800,8 +2860,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : OosvarValue	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      212,
+		NTType:     63,
+		Index:      218,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2810,8 +2870,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : FullOosvar	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      213,
+		NTType:     63,
+		Index:      219,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2820,8 +2880,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : LocalVariable	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      214,
+		NTType:     63,
+		Index:      220,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2830,8 +2890,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : UnnamedFunctionDefinition	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      215,
+		NTType:     63,
+		Index:      221,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2840,8 +2900,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : string_literal	<< dsl.NewASTNodeStripDoubleQuotePair(X[0], dsl.NodeTypeStringLiteral) >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      216,
+		NTType:     63,
+		Index:      222,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeStripDoubleQuotePair(X[0], dsl.NodeTypeStringLiteral)
@@ -2850,8 +2910,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : regex_case_insensitive	<< dsl.NewASTNode(X[0], dsl.NodeTypeRegexCaseInsensitive) >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      217,
+		NTType:     63,
+		Index:      223,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeRegexCaseInsensitive)
@@ -2860,8 +2920,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : int_literal	<< dsl.NewASTNode(X[0], dsl.NodeTypeIntLiteral) >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      218,
+		NTType:     63,
+		Index:      224,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeIntLiteral)
@@ -2870,8 +2930,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : float_literal	<< dsl.NewASTNode(X[0], dsl.NodeTypeFloatLiteral) >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      219,
+		NTType:     63,
+		Index:      225,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeFloatLiteral)
@@ -2880,8 +2940,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : boolean_literal	<< dsl.NewASTNode(X[0], dsl.NodeTypeBoolLiteral) >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      220,
+		NTType:     63,
+		Index:      226,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeBoolLiteral)
@@ -2890,8 +2950,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : null_literal	<< dsl.NewASTNode(X[0], dsl.NodeTypeNullLiteral) >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      221,
+		NTType:     63,
+		Index:      227,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeNullLiteral)
@@ -2900,8 +2960,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : inf_literal	<< dsl.NewASTNode(X[0], dsl.NodeTypeFloatLiteral) >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      222,
+		NTType:     63,
+		Index:      228,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeFloatLiteral)
@@ -2910,8 +2970,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : nan_literal	<< dsl.NewASTNode(X[0], dsl.NodeTypeFloatLiteral) >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      223,
+		NTType:     63,
+		Index:      229,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeFloatLiteral)
@@ -2923,8 +2983,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeConstant,
     ) >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      224,
+		NTType:     63,
+		Index:      230,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(
@@ -2939,8 +2999,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeConstant,
     ) >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      225,
+		NTType:     63,
+		Index:      231,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(
@@ -2952,8 +3012,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : panic	<< dsl.NewASTNode(X[0], dsl.NodeTypePanic) >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      226,
+		NTType:     63,
+		Index:      232,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypePanic)
@@ -2962,8 +3022,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : ArrayLiteral	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      227,
+		NTType:     63,
+		Index:      233,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -2975,8 +3035,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeArrayLiteral,
     ) >>`,
 		Id:         "ArrayLiteral",
-		NTType:     63,
-		Index:      228,
+		NTType:     64,
+		Index:      234,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(
@@ -2994,8 +3054,8 @@ var productionsTable = ProdTab{
         X[1],
       ) >>`,
 		Id:         "ArrayLiteral",
-		NTType:     63,
-		Index:      229,
+		NTType:     64,
+		Index:      235,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AdoptChildren(
@@ -3014,8 +3074,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeArrayLiteral,
     ) >>`,
 		Id:         "ArrayLiteralElements",
-		NTType:     64,
-		Index:      230,
+		NTType:     65,
+		Index:      236,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -3032,8 +3092,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeArrayLiteral,
     ) >>`,
 		Id:         "ArrayLiteralElements",
-		NTType:     64,
-		Index:      231,
+		NTType:     65,
+		Index:      237,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -3049,8 +3109,8 @@ var productionsTable = ProdTab{
       X[0],
     ) >>`,
 		Id:         "ArrayLiteralElements",
-		NTType:     64,
-		Index:      232,
+		NTType:     65,
+		Index:      238,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.PrependChild(
@@ -3062,8 +3122,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : MapLiteral	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      233,
+		NTType:     63,
+		Index:      239,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3075,8 +3135,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeMapLiteral,
     ) >>`,
 		Id:         "MapLiteral",
-		NTType:     65,
-		Index:      234,
+		NTType:     66,
+		Index:      240,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(
@@ -3094,8 +3154,8 @@ var productionsTable = ProdTab{
         X[1],
       ) >>`,
 		Id:         "MapLiteral",
-		NTType:     65,
-		Index:      235,
+		NTType:     66,
+		Index:      241,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AdoptChildren(
@@ -3114,8 +3174,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeMapLiteral,
     ) >>`,
 		Id:         "MapLiteralKeyValuePairs",
-		NTType:     66,
-		Index:      236,
+		NTType:     67,
+		Index:      242,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -3132,8 +3192,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeMapLiteral,
     ) >>`,
 		Id:         "MapLiteralKeyValuePairs",
-		NTType:     66,
-		Index:      237,
+		NTType:     67,
+		Index:      243,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -3149,8 +3209,8 @@ var productionsTable = ProdTab{
       X[0],
     ) >>`,
 		Id:         "MapLiteralKeyValuePairs",
-		NTType:     66,
-		Index:      238,
+		NTType:     67,
+		Index:      244,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.PrependChild(
@@ -3167,8 +3227,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeMapLiteralKeyValuePair,
     ) >>`,
 		Id:         "MapLiteralKeyValuePair",
-		NTType:     67,
-		Index:      239,
+		NTType:     68,
+		Index:      245,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -3182,8 +3242,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : ContextVariable	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      240,
+		NTType:     63,
+		Index:      246,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3192,8 +3252,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContextVariable : ctx_IPS	<< dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable) >>`,
 		Id:         "ContextVariable",
-		NTType:     68,
-		Index:      241,
+		NTType:     69,
+		Index:      247,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable)
@@ -3202,8 +3262,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContextVariable : ctx_IFS	<< dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable) >>`,
 		Id:         "ContextVariable",
-		NTType:     68,
-		Index:      242,
+		NTType:     69,
+		Index:      248,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable)
@@ -3212,8 +3272,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContextVariable : ctx_IRS	<< dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable) >>`,
 		Id:         "ContextVariable",
-		NTType:     68,
-		Index:      243,
+		NTType:     69,
+		Index:      249,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable)
@@ -3222,8 +3282,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContextVariable : ctx_OPS	<< dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable) >>`,
 		Id:         "ContextVariable",
-		NTType:     68,
-		Index:      244,
+		NTType:     69,
+		Index:      250,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable)
@@ -3232,8 +3292,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContextVariable : ctx_OFS	<< dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable) >>`,
 		Id:         "ContextVariable",
-		NTType:     68,
-		Index:      245,
+		NTType:     69,
+		Index:      251,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable)
@@ -3242,8 +3302,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContextVariable : ctx_ORS	<< dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable) >>`,
 		Id:         "ContextVariable",
-		NTType:     68,
-		Index:      246,
+		NTType:     69,
+		Index:      252,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable)
@@ -3252,8 +3312,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContextVariable : ctx_FLATSEP	<< dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable) >>`,
 		Id:         "ContextVariable",
-		NTType:     68,
-		Index:      247,
+		NTType:     69,
+		Index:      253,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable)
@@ -3262,8 +3322,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContextVariable : ctx_NF	<< dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable) >>`,
 		Id:         "ContextVariable",
-		NTType:     68,
-		Index:      248,
+		NTType:     69,
+		Index:      254,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable)
@@ -3272,8 +3332,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContextVariable : ctx_NR	<< dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable) >>`,
 		Id:         "ContextVariable",
-		NTType:     68,
-		Index:      249,
+		NTType:     69,
+		Index:      255,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable)
@@ -3282,8 +3342,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContextVariable : ctx_FNR	<< dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable) >>`,
 		Id:         "ContextVariable",
-		NTType:     68,
-		Index:      250,
+		NTType:     69,
+		Index:      256,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable)
@@ -3292,8 +3352,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContextVariable : ctx_FILENAME	<< dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable) >>`,
 		Id:         "ContextVariable",
-		NTType:     68,
-		Index:      251,
+		NTType:     69,
+		Index:      257,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable)
@@ -3302,8 +3362,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContextVariable : ctx_FILENUM	<< dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable) >>`,
 		Id:         "ContextVariable",
-		NTType:     68,
-		Index:      252,
+		NTType:     69,
+		Index:      258,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeContextVariable)
@@ -3312,8 +3372,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : ENV	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      253,
+		NTType:     63,
+		Index:      259,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3326,8 +3386,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeEnvironmentVariable,
     ) >>`,
 		Id:         "ENV",
-		NTType:     69,
-		Index:      254,
+		NTType:     70,
+		Index:      260,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -3338,14 +3398,14 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `ENV : env "." non_sigil_name	<< dsl.NewASTNodeUnary(
+		String: `ENV : env "." NonSigilName	<< dsl.NewASTNodeUnary(
       X[0],
       dsl.NewASTNodeNestable(X[2], dsl.NodeTypeStringLiteral),
       dsl.NodeTypeEnvironmentVariable,
     ) >>`,
 		Id:         "ENV",
-		NTType:     69,
-		Index:      255,
+		NTType:     70,
+		Index:      261,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -3358,8 +3418,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : ArrayOrMapIndexAccess	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      256,
+		NTType:     63,
+		Index:      262,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3368,8 +3428,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : ArrayOrMapPositionalNameAccess	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      257,
+		NTType:     63,
+		Index:      263,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3378,8 +3438,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : ArrayOrMapPositionalValueAccess	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      258,
+		NTType:     63,
+		Index:      264,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3388,8 +3448,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : ArraySliceAccess	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      259,
+		NTType:     63,
+		Index:      265,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3403,8 +3463,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeArrayOrMapIndexAccess,
     ) >>`,
 		Id:         "ArrayOrMapIndexAccess",
-		NTType:     70,
-		Index:      260,
+		NTType:     71,
+		Index:      266,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -3423,8 +3483,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeArrayOrMapPositionalNameAccess,
     ) >>`,
 		Id:         "ArrayOrMapPositionalNameAccess",
-		NTType:     71,
-		Index:      261,
+		NTType:     72,
+		Index:      267,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -3443,8 +3503,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeArrayOrMapPositionalValueAccess,
     ) >>`,
 		Id:         "ArrayOrMapPositionalValueAccess",
-		NTType:     72,
-		Index:      262,
+		NTType:     73,
+		Index:      268,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -3464,8 +3524,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeArraySliceAccess,
     ) >>`,
 		Id:         "ArraySliceAccess",
-		NTType:     73,
-		Index:      263,
+		NTType:     74,
+		Index:      269,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -3489,8 +3549,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeArraySliceAccess,
     ) >>`,
 		Id:         "ArraySliceAccess",
-		NTType:     73,
-		Index:      264,
+		NTType:     74,
+		Index:      270,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -3517,8 +3577,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeArraySliceAccess,
     ) >>`,
 		Id:         "ArraySliceAccess",
-		NTType:     73,
-		Index:      265,
+		NTType:     74,
+		Index:      271,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -3548,8 +3608,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeArraySliceAccess,
     ) >>`,
 		Id:         "ArraySliceAccess",
-		NTType:     73,
-		Index:      266,
+		NTType:     74,
+		Index:      272,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -3570,8 +3630,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `MlrvalOrFunction : FunctionCallsite	<<  >>`,
 		Id:         "MlrvalOrFunction",
-		NTType:     62,
-		Index:      267,
+		NTType:     63,
+		Index:      273,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3583,8 +3643,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeFunctionCallsite,
     ) >>`,
 		Id:         "FunctionCallsite",
-		NTType:     74,
-		Index:      268,
+		NTType:     75,
+		Index:      274,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(
@@ -3602,8 +3662,8 @@ var productionsTable = ProdTab{
         X[2],
       ) >>`,
 		Id:         "FunctionCallsite",
-		NTType:     74,
-		Index:      269,
+		NTType:     75,
+		Index:      275,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AdoptChildren(
@@ -3616,10 +3676,20 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String:     `FunctionName : non_sigil_name	<<  >>`,
+		String:     `FunctionName : NonSigilName	<<  >>`,
 		Id:         "FunctionName",
-		NTType:     75,
-		Index:      270,
+		NTType:     76,
+		Index:      276,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `FunctionName : NamespacedName	<<  >>`,
+		Id:         "FunctionName",
+		NTType:     76,
+		Index:      277,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3628,8 +3698,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `FunctionName : int	<<  >>`,
 		Id:         "FunctionName",
-		NTType:     75,
-		Index:      271,
+		NTType:     76,
+		Index:      278,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3638,8 +3708,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `FunctionName : float	<<  >>`,
 		Id:         "FunctionName",
-		NTType:     75,
-		Index:      272,
+		NTType:     76,
+		Index:      279,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3652,8 +3722,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeFunctionCallsite,
     ) >>`,
 		Id:         "FcnArgs",
-		NTType:     76,
-		Index:      273,
+		NTType:     77,
+		Index:      280,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -3670,8 +3740,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeFunctionCallsite,
     ) >>`,
 		Id:         "FcnArgs",
-		NTType:     76,
-		Index:      274,
+		NTType:     77,
+		Index:      281,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -3687,8 +3757,8 @@ var productionsTable = ProdTab{
       X[0],
     ) >>`,
 		Id:         "FcnArgs",
-		NTType:     76,
-		Index:      275,
+		NTType:     77,
+		Index:      282,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.PrependChild(
@@ -3703,8 +3773,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeSubroutineCallsite,
     ) >>`,
 		Id:         "SubroutineCallsite",
-		NTType:     77,
-		Index:      276,
+		NTType:     78,
+		Index:      283,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(
@@ -3722,8 +3792,8 @@ var productionsTable = ProdTab{
         X[3],
       ) >>`,
 		Id:         "SubroutineCallsite",
-		NTType:     77,
-		Index:      277,
+		NTType:     78,
+		Index:      284,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AdoptChildren(
@@ -3736,10 +3806,20 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String:     `SubroutineName : non_sigil_name	<<  >>`,
+		String:     `SubroutineName : NonSigilName	<<  >>`,
 		Id:         "SubroutineName",
-		NTType:     78,
-		Index:      278,
+		NTType:     79,
+		Index:      285,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `SubroutineName : NamespacedName	<<  >>`,
+		Id:         "SubroutineName",
+		NTType:     79,
+		Index:      286,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3748,8 +3828,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BracefulStatement : BeginBlock	<<  >>`,
 		Id:         "BracefulStatement",
-		NTType:     79,
-		Index:      279,
+		NTType:     80,
+		Index:      287,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3758,8 +3838,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BracefulStatement : EndBlock	<<  >>`,
 		Id:         "BracefulStatement",
-		NTType:     79,
-		Index:      280,
+		NTType:     80,
+		Index:      288,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3768,8 +3848,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BracefulStatement : CondBlock	<<  >>`,
 		Id:         "BracefulStatement",
-		NTType:     79,
-		Index:      281,
+		NTType:     80,
+		Index:      289,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3778,8 +3858,28 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BracefulStatement : IfChain	<<  >>`,
 		Id:         "BracefulStatement",
-		NTType:     79,
-		Index:      282,
+		NTType:     80,
+		Index:      290,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `BracefulStatement : SwitchStatement	<<  >>`,
+		Id:         "BracefulStatement",
+		NTType:     80,
+		Index:      291,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String:     `BracefulStatement : TryCatchStatement	<<  >>`,
+		Id:         "BracefulStatement",
+		NTType:     80,
+		Index:      292,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3788,8 +3888,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BracefulStatement : WhileLoop	<<  >>`,
 		Id:         "BracefulStatement",
-		NTType:     79,
-		Index:      283,
+		NTType:     80,
+		Index:      293,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3798,8 +3898,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BracefulStatement : ForLoop	<<  >>`,
 		Id:         "BracefulStatement",
-		NTType:     79,
-		Index:      284,
+		NTType:     80,
+		Index:      294,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3808,8 +3908,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BracefulStatement : NamedFunctionDefinition	<<  >>`,
 		Id:         "BracefulStatement",
-		NTType:     79,
-		Index:      285,
+		NTType:     80,
+		Index:      295,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3818,8 +3918,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BracefulStatement : SubroutineDefinition	<<  >>`,
 		Id:         "BracefulStatement",
-		NTType:     79,
-		Index:      286,
+		NTType:     80,
+		Index:      296,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3828,8 +3928,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BeginBlock : begin StatementBlockInBraces	<< dsl.NewASTNodeUnary(nil, X[1], dsl.NodeTypeBeginBlock) >>`,
 		Id:         "BeginBlock",
-		NTType:     80,
-		Index:      287,
+		NTType:     81,
+		Index:      297,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(nil, X[1], dsl.NodeTypeBeginBlock)
@@ -3838,8 +3938,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `EndBlock : end StatementBlockInBraces	<< dsl.NewASTNodeUnary(nil, X[1], dsl.NodeTypeEndBlock) >>`,
 		Id:         "EndBlock",
-		NTType:     81,
-		Index:      288,
+		NTType:     82,
+		Index:      298,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(nil, X[1], dsl.NodeTypeEndBlock)
@@ -3848,8 +3948,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `CondBlock : Rvalue StatementBlockInBraces	<< dsl.NewASTNodeBinary(nil, X[0], X[1], dsl.NodeTypeCondBlock) >>`,
 		Id:         "CondBlock",
-		NTType:     82,
-		Index:      289,
+		NTType:     83,
+		Index:      299,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(nil, X[0], X[1], dsl.NodeTypeCondBlock)
@@ -3858,8 +3958,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `IfChain : IfElifStar	<<  >>`,
 		Id:         "IfChain",
-		NTType:     83,
-		Index:      290,
+		NTType:     84,
+		Index:      300,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3868,8 +3968,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `IfChain : IfElifStar ElseBlock	<< dsl.AppendChild(X[0], X[1]) >>`,
 		Id:         "IfChain",
-		NTType:     83,
-		Index:      291,
+		NTType:     84,
+		Index:      301,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AppendChild(X[0], X[1])
@@ -3878,8 +3978,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `IfElifStar : IfBlock	<< dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeIfChain) >>`,
 		Id:         "IfElifStar",
-		NTType:     84,
-		Index:      292,
+		NTType:     85,
+		Index:      302,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeIfChain)
@@ -3888,8 +3988,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `IfElifStar : IfElifStar ElifBlock	<< dsl.AppendChild(X[0], X[1]) >>`,
 		Id:         "IfElifStar",
-		NTType:     84,
-		Index:      293,
+		NTType:     85,
+		Index:      303,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AppendChild(X[0], X[1])
@@ -3898,8 +3998,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `IfBlock : if "(" Rvalue ")" StatementBlockInBraces	<< dsl.NewASTNodeBinary(X[0], X[2], X[4], dsl.NodeTypeIfItem) >>`,
 		Id:         "IfBlock",
-		NTType:     85,
-		Index:      294,
+		NTType:     86,
+		Index:      304,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[0], X[2], X[4], dsl.NodeTypeIfItem)
@@ -3908,8 +4008,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ElifBlock : elif "(" Rvalue ")" StatementBlockInBraces	<< dsl.NewASTNodeBinary(X[0], X[2], X[4], dsl.NodeTypeIfItem) >>`,
 		Id:         "ElifBlock",
-		NTType:     86,
-		Index:      295,
+		NTType:     87,
+		Index:      305,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[0], X[2], X[4], dsl.NodeTypeIfItem)
@@ -3918,18 +4018,140 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ElseBlock : else StatementBlockInBraces	<< dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeIfItem) >>`,
 		Id:         "ElseBlock",
-		NTType:     87,
-		Index:      296,
+		NTType:     88,
+		Index:      306,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeIfItem)
 		},
 	},
+	ProdTabEntry{
+		String: `SwitchStatement : switch "(" Rvalue ")" "{" "}"	<< dsl.NewASTNodeBinary(
+        X[0], // switch
+        X[2],
+        dsl.NewASTNodeNestable(nil, dsl.NodeTypeSwitchCases), // no cases
+        dsl.NodeTypeSwitchStatement,
+      ) >>`,
+		Id:         "SwitchStatement",
+		NTType:     89,
+		Index:      307,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.NewASTNodeBinary(
+				X[0], // switch
+				X[2],
+				dsl.NewASTNodeNestable(nil, dsl.NodeTypeSwitchCases), // no cases
+				dsl.NodeTypeSwitchStatement,
+			)
+		},
+	},
+	ProdTabEntry{
+		String:     `SwitchStatement : switch "(" Rvalue ")" "{" SwitchCases "}"	<< dsl.NewASTNodeBinary(X[0], X[2], X[5], dsl.NodeTypeSwitchStatement) >>`,
+		Id:         "SwitchStatement",
+		NTType:     89,
+		Index:      308,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.NewASTNodeBinary(X[0], X[2], X[5], dsl.NodeTypeSwitchStatement)
+		},
+	},
+	ProdTabEntry{
+		String:     `SwitchCases : SwitchCase	<< dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeSwitchCases) >>`,
+		Id:         "SwitchCases",
+		NTType:     90,
+		Index:      309,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeSwitchCases)
+		},
+	},
+	ProdTabEntry{
+		String:     `SwitchCases : SwitchCases SwitchCase	<< dsl.AppendChild(X[0], X[1]) >>`,
+		Id:         "SwitchCases",
+		NTType:     90,
+		Index:      310,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.AppendChild(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String:     `SwitchCase : case SwitchCaseValues ":" StatementBlockInBraces	<< dsl.NewASTNodeBinary(X[0], X[1], X[3], dsl.NodeTypeSwitchCase) >>`,
+		Id:         "SwitchCase",
+		NTType:     91,
+		Index:      311,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.NewASTNodeBinary(X[0], X[1], X[3], dsl.NodeTypeSwitchCase)
+		},
+	},
+	ProdTabEntry{
+		String:     `SwitchCase : case "=~" SwitchCaseValues ":" StatementBlockInBraces	<< dsl.NewASTNodeBinary(X[1], X[2], X[4], dsl.NodeTypeSwitchCase) >>`,
+		Id:         "SwitchCase",
+		NTType:     91,
+		Index:      312,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.NewASTNodeBinary(X[1], X[2], X[4], dsl.NodeTypeSwitchCase)
+		},
+	},
+	ProdTabEntry{
+		String:     `SwitchCase : default ":" StatementBlockInBraces	<< dsl.NewASTNodeUnary(X[0], X[2], dsl.NodeTypeSwitchCase) >>`,
+		Id:         "SwitchCase",
+		NTType:     91,
+		Index:      313,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.NewASTNodeUnary(X[0], X[2], dsl.NodeTypeSwitchCase)
+		},
+	},
+	ProdTabEntry{
+		String:     `SwitchCaseValues : Rvalue	<< dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeSwitchCaseValues) >>`,
+		Id:         "SwitchCaseValues",
+		NTType:     92,
+		Index:      314,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeSwitchCaseValues)
+		},
+	},
+	ProdTabEntry{
+		String:     `SwitchCaseValues : SwitchCaseValues "," Rvalue	<< dsl.AppendChild(X[0], X[2]) >>`,
+		Id:         "SwitchCaseValues",
+		NTType:     92,
+		Index:      315,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.AppendChild(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `TryCatchStatement : try StatementBlockInBraces catch "(" LocalVariable ")" StatementBlockInBraces	<< dsl.NewASTNodeTernary(
+        X[0], // try
+        X[1], // try-block
+        X[4], // catch variable
+        X[6], // catch-block
+        dsl.NodeTypeTryCatchStatement,
+      ) >>`,
+		Id:         "TryCatchStatement",
+		NTType:     93,
+		Index:      316,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.NewASTNodeTernary(
+				X[0], // try
+				X[1], // try-block
+				X[4], // catch variable
+				X[6], // catch-block
+				dsl.NodeTypeTryCatchStatement,
+			)
+		},
+	},
 	ProdTabEntry{
 		String:     `WhileLoop : while "(" Rvalue ")" StatementBlockInBraces	<< dsl.NewASTNodeBinary(X[0], X[2], X[4], dsl.NodeTypeWhileLoop) >>`,
 		Id:         "WhileLoop",
-		NTType:     88,
-		Index:      297,
+		NTType:     94,
+		Index:      317,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[0], X[2], X[4], dsl.NodeTypeWhileLoop)
@@ -3938,8 +4160,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `DoWhileLoop : do StatementBlockInBraces while "(" Rvalue ")"	<< dsl.NewASTNodeBinary(X[0], X[1], X[4], dsl.NodeTypeDoWhileLoop) >>`,
 		Id:         "DoWhileLoop",
-		NTType:     89,
-		Index:      298,
+		NTType:     95,
+		Index:      318,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(X[0], X[1], X[4], dsl.NodeTypeDoWhileLoop)
@@ -3948,8 +4170,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ForLoop : ForLoopOneVariable	<<  >>`,
 		Id:         "ForLoop",
-		NTType:     90,
-		Index:      299,
+		NTType:     96,
+		Index:      319,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3958,8 +4180,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ForLoop : ForLoopTwoVariable	<<  >>`,
 		Id:         "ForLoop",
-		NTType:     90,
-		Index:      300,
+		NTType:     96,
+		Index:      320,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3968,8 +4190,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ForLoop : ForLoopMultivariable	<<  >>`,
 		Id:         "ForLoop",
-		NTType:     90,
-		Index:      301,
+		NTType:     96,
+		Index:      321,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3978,8 +4200,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ForLoop : TripleForLoop	<<  >>`,
 		Id:         "ForLoop",
-		NTType:     90,
-		Index:      302,
+		NTType:     96,
+		Index:      322,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -3994,8 +4216,8 @@ var productionsTable = ProdTab{
      dsl.NodeTypeForLoopOneVariable,
    ); >>`,
 		Id:         "ForLoopOneVariable",
-		NTType:     91,
-		Index:      303,
+		NTType:     97,
+		Index:      323,
 		NumSymbols: 7,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -4017,8 +4239,8 @@ var productionsTable = ProdTab{
      dsl.NodeTypeForLoopTwoVariable,
    ); >>`,
 		Id:         "ForLoopTwoVariable",
-		NTType:     92,
-		Index:      304,
+		NTType:     98,
+		Index:      324,
 		NumSymbols: 9,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeQuaternary(
@@ -4041,8 +4263,8 @@ var productionsTable = ProdTab{
      dsl.NodeTypeForLoopMultivariable,
    ); >>`,
 		Id:         "ForLoopMultivariable",
-		NTType:     93,
-		Index:      305,
+		NTType:     99,
+		Index:      325,
 		NumSymbols: 11,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeQuaternary(
@@ -4063,8 +4285,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeParameterList,
     ) >>`,
 		Id:         "MultiIndex",
-		NTType:     94,
-		Index:      306,
+		NTType:     100,
+		Index:      326,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -4081,8 +4303,8 @@ var productionsTable = ProdTab{
       X[2],
     ) >>`,
 		Id:         "MultiIndex",
-		NTType:     94,
-		Index:      307,
+		NTType:     100,
+		Index:      327,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AppendChild(
@@ -4101,8 +4323,8 @@ var productionsTable = ProdTab{
      dsl.NodeTypeTripleForLoop,
    ); >>`,
 		Id:         "TripleForLoop",
-		NTType:     95,
-		Index:      308,
+		NTType:     101,
+		Index:      328,
 		NumSymbols: 9,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeQuaternary(
@@ -4118,8 +4340,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TripleForStart : empty	<< dsl.NewASTNodeZary(nil, dsl.NodeTypeStatementBlock) >>`,
 		Id:         "TripleForStart",
-		NTType:     96,
-		Index:      309,
+		NTType:     102,
+		Index:      329,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(nil, dsl.NodeTypeStatementBlock)
@@ -4128,8 +4350,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TripleForStart : Assignment	<< dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeStatementBlock) >>`,
 		Id:         "TripleForStart",
-		NTType:     96,
-		Index:      310,
+		NTType:     102,
+		Index:      330,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeStatementBlock)
@@ -4138,8 +4360,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TripleForStart : TripleForStart "," Assignment	<< dsl.AppendChild(X[0], X[2]) >>`,
 		Id:         "TripleForStart",
-		NTType:     96,
-		Index:      311,
+		NTType:     102,
+		Index:      331,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AppendChild(X[0], X[2])
@@ -4148,8 +4370,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TripleForContinuation : empty	<< dsl.NewASTNodeZary(nil, dsl.NodeTypeStatementBlock) >>`,
 		Id:         "TripleForContinuation",
-		NTType:     97,
-		Index:      312,
+		NTType:     103,
+		Index:      332,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(nil, dsl.NodeTypeStatementBlock)
@@ -4158,8 +4380,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TripleForContinuation : TripleForContinuationItem	<< dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeStatementBlock) >>`,
 		Id:         "TripleForContinuation",
-		NTType:     97,
-		Index:      313,
+		NTType:     103,
+		Index:      333,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeStatementBlock)
@@ -4168,8 +4390,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TripleForContinuation : TripleForContinuation "," TripleForContinuationItem	<< dsl.AppendChild(X[0], X[2]) >>`,
 		Id:         "TripleForContinuation",
-		NTType:     97,
-		Index:      314,
+		NTType:     103,
+		Index:      334,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AppendChild(X[0], X[2])
@@ -4178,8 +4400,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TripleForContinuationItem : Assignment	<<  >>`,
 		Id:         "TripleForContinuationItem",
-		NTType:     98,
-		Index:      315,
+		NTType:     104,
+		Index:      335,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -4188,8 +4410,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TripleForContinuationItem : BareBoolean	<<  >>`,
 		Id:         "TripleForContinuationItem",
-		NTType:     98,
-		Index:      316,
+		NTType:     104,
+		Index:      336,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -4198,8 +4420,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TripleForUpdate : empty	<< dsl.NewASTNodeZary(nil, dsl.NodeTypeStatementBlock) >>`,
 		Id:         "TripleForUpdate",
-		NTType:     99,
-		Index:      317,
+		NTType:     105,
+		Index:      337,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(nil, dsl.NodeTypeStatementBlock)
@@ -4208,8 +4430,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TripleForUpdate : Assignment	<< dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeStatementBlock) >>`,
 		Id:         "TripleForUpdate",
-		NTType:     99,
-		Index:      318,
+		NTType:     105,
+		Index:      338,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeStatementBlock)
@@ -4218,8 +4440,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TripleForUpdate : TripleForUpdate "," Assignment	<< dsl.AppendChild(X[0], X[2]) >>`,
 		Id:         "TripleForUpdate",
-		NTType:     99,
-		Index:      319,
+		NTType:     105,
+		Index:      339,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AppendChild(X[0], X[2])
@@ -4228,8 +4450,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `BreakStatement : break	<< dsl.NewASTNodeZary(X[0], dsl.NodeTypeBreak) >>`,
 		Id:         "BreakStatement",
-		NTType:     100,
-		Index:      320,
+		NTType:     106,
+		Index:      340,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(X[0], dsl.NodeTypeBreak)
@@ -4238,23 +4460,23 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ContinueStatement : continue	<< dsl.NewASTNodeZary(X[0], dsl.NodeTypeContinue) >>`,
 		Id:         "ContinueStatement",
-		NTType:     101,
-		Index:      321,
+		NTType:     107,
+		Index:      341,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(X[0], dsl.NodeTypeContinue)
 		},
 	},
 	ProdTabEntry{
-		String: `NamedFunctionDefinition : func non_sigil_name "(" FuncOrSubrParameterList ")" StatementBlockInBraces	<< dsl.NewASTNodeBinary(
+		String: `NamedFunctionDefinition : func NonSigilName "(" FuncOrSubrParameterList ")" StatementBlockInBraces	<< dsl.NewASTNodeBinary(
       X[1],
       X[3], // parameter list
       X[5], // { ... }
       dsl.NodeTypeNamedFunctionDefinition,
     ); >>`,
 		Id:         "NamedFunctionDefinition",
-		NTType:     102,
-		Index:      322,
+		NTType:     108,
+		Index:      342,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -4266,7 +4488,7 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `NamedFunctionDefinition : func non_sigil_name "(" FuncOrSubrParameterList ")" ":" Typedecl StatementBlockInBraces	<< dsl.NewASTNodeTernary(
+		String: `NamedFunctionDefinition : func NonSigilName "(" FuncOrSubrParameterList ")" ":" Typedecl StatementBlockInBraces	<< dsl.NewASTNodeTernary(
       X[1],
       X[3], // parameter list
       X[7], // {...}
@@ -4274,8 +4496,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeNamedFunctionDefinition,
     ); >>`,
 		Id:         "NamedFunctionDefinition",
-		NTType:     102,
-		Index:      323,
+		NTType:     108,
+		Index:      343,
 		NumSymbols: 8,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -4295,8 +4517,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeUnnamedFunctionDefinition,
     ); >>`,
 		Id:         "UnnamedFunctionDefinition",
-		NTType:     103,
-		Index:      324,
+		NTType:     109,
+		Index:      344,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -4316,8 +4538,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeUnnamedFunctionDefinition,
     ); >>`,
 		Id:         "UnnamedFunctionDefinition",
-		NTType:     103,
-		Index:      325,
+		NTType:     109,
+		Index:      345,
 		NumSymbols: 7,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeTernary(
@@ -4330,15 +4552,15 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `SubroutineDefinition : subr non_sigil_name "(" FuncOrSubrParameterList ")" StatementBlockInBraces	<< dsl.NewASTNodeBinary(
+		String: `SubroutineDefinition : subr NonSigilName "(" FuncOrSubrParameterList ")" StatementBlockInBraces	<< dsl.NewASTNodeBinary(
       X[1],
       X[3], // parameter list
       X[5], // { ... }
       dsl.NodeTypeSubroutineDefinition,
     ); >>`,
 		Id:         "SubroutineDefinition",
-		NTType:     104,
-		Index:      326,
+		NTType:     110,
+		Index:      346,
 		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeBinary(
@@ -4352,8 +4574,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `FuncOrSubrParameterList : empty	<< dsl.NewASTNodeZary(nil, dsl.NodeTypeParameterList) >>`,
 		Id:         "FuncOrSubrParameterList",
-		NTType:     105,
-		Index:      327,
+		NTType:     111,
+		Index:      347,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(nil, dsl.NodeTypeParameterList)
@@ -4362,8 +4584,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `FuncOrSubrParameterList : FuncOrSubrNonEmptyParameterList	<< dsl.Wrap(X[0]) >>`,
 		Id:         "FuncOrSubrParameterList",
-		NTType:     105,
-		Index:      328,
+		NTType:     111,
+		Index:      348,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.Wrap(X[0])
@@ -4372,8 +4594,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `FuncOrSubrNonEmptyParameterList : FuncOrSubrParameter	<< dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeParameterList) >>`,
 		Id:         "FuncOrSubrNonEmptyParameterList",
-		NTType:     106,
-		Index:      329,
+		NTType:     112,
+		Index:      349,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeParameterList)
@@ -4382,8 +4604,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `FuncOrSubrNonEmptyParameterList : FuncOrSubrParameter ","	<< dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeParameterList) >>`,
 		Id:         "FuncOrSubrNonEmptyParameterList",
-		NTType:     106,
-		Index:      330,
+		NTType:     112,
+		Index:      350,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(nil, X[0], dsl.NodeTypeParameterList)
@@ -4392,8 +4614,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `FuncOrSubrNonEmptyParameterList : FuncOrSubrParameter "," FuncOrSubrNonEmptyParameterList	<< dsl.PrependChild(X[2], X[0]) >>`,
 		Id:         "FuncOrSubrNonEmptyParameterList",
-		NTType:     106,
-		Index:      331,
+		NTType:     112,
+		Index:      351,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.PrependChild(X[2], X[0])
@@ -4406,8 +4628,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeParameter,
     ) >>`,
 		Id:         "FuncOrSubrParameter",
-		NTType:     107,
-		Index:      332,
+		NTType:     113,
+		Index:      352,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -4424,8 +4646,8 @@ var productionsTable = ProdTab{
       dsl.NodeTypeParameter,
     ) >>`,
 		Id:         "FuncOrSubrParameter",
-		NTType:     107,
-		Index:      333,
+		NTType:     113,
+		Index:      353,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(
@@ -4436,10 +4658,10 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String:     `UntypedFuncOrSubrParameterName : non_sigil_name	<< dsl.NewASTNode(X[0], dsl.NodeTypeParameterName) >>`,
+		String:     `UntypedFuncOrSubrParameterName : NonSigilName	<< dsl.NewASTNode(X[0], dsl.NodeTypeParameterName) >>`,
 		Id:         "UntypedFuncOrSubrParameterName",
-		NTType:     108,
-		Index:      334,
+		NTType:     114,
+		Index:      354,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNode(X[0], dsl.NodeTypeParameterName)
@@ -4448,8 +4670,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `TypedFuncOrSubrParameterName : Typedecl UntypedFuncOrSubrParameterName	<< dsl.AppendChild(X[1], X[0]) >>`,
 		Id:         "TypedFuncOrSubrParameterName",
-		NTType:     109,
-		Index:      335,
+		NTType:     115,
+		Index:      355,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.AppendChild(X[1], X[0])
@@ -4458,8 +4680,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ReturnStatement : return Rvalue	<< dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeReturn) >>`,
 		Id:         "ReturnStatement",
-		NTType:     110,
-		Index:      336,
+		NTType:     116,
+		Index:      356,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeUnary(X[0], X[1], dsl.NodeTypeReturn)
@@ -4468,11 +4690,41 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String:     `ReturnStatement : return	<< dsl.NewASTNodeZary(X[0], dsl.NodeTypeReturn) >>`,
 		Id:         "ReturnStatement",
-		NTType:     110,
-		Index:      337,
+		NTType:     116,
+		Index:      357,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return dsl.NewASTNodeZary(X[0], dsl.NodeTypeReturn)
 		},
 	},
+	ProdTabEntry{
+		String:     `ImportStatement : kw_import ImportPath as LocalVariable	<< dsl.NewASTNodeBinary(X[0], X[1], X[3], dsl.NodeTypeImportStatement) >>`,
+		Id:         "ImportStatement",
+		NTType:     117,
+		Index:      358,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.NewASTNodeBinary(X[0], X[1], X[3], dsl.NodeTypeImportStatement)
+		},
+	},
+	ProdTabEntry{
+		String:     `ImportPath : string_literal	<< dsl.NewASTNodeStripDoubleQuotePair(X[0], dsl.NodeTypeStringLiteral) >>`,
+		Id:         "ImportPath",
+		NTType:     118,
+		Index:      359,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.NewASTNodeStripDoubleQuotePair(X[0], dsl.NodeTypeStringLiteral)
+		},
+	},
+	ProdTabEntry{
+		String:     `NamespacedName : NonSigilName "::" NonSigilName	<< dsl.NewNamespacedNameToken(X[0], X[2]) >>`,
+		Id:         "NamespacedName",
+		NTType:     119,
+		Index:      360,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return dsl.NewNamespacedNameToken(X[0], X[2])
+		},
+	},
 }
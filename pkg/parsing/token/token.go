@@ -177,6 +177,10 @@ var TokMap = TokenMap{
 		"full_oosvar",
 		"all",
 		"non_sigil_name",
+		"case",
+		"default",
+		"catch",
+		"as",
 		"arr",
 		"bool",
 		"float",
@@ -272,6 +276,8 @@ var TokMap = TokenMap{
 		"if",
 		"elif",
 		"else",
+		"switch",
+		"try",
 		"while",
 		"do",
 		"for",
@@ -281,6 +287,8 @@ var TokMap = TokenMap{
 		"func",
 		"subr",
 		"return",
+		"kw_import",
+		"::",
 	},
 
 	idMap: map[string]Type{
@@ -325,109 +333,117 @@ var TokMap = TokenMap{
 		"full_oosvar":            38,
 		"all":                    39,
 		"non_sigil_name":         40,
-		"arr":                    41,
-		"bool":                   42,
-		"float":                  43,
-		"int":                    44,
-		"map":                    45,
-		"num":                    46,
-		"str":                    47,
-		"var":                    48,
-		"funct":                  49,
-		"||=":                    50,
-		"^^=":                    51,
-		"&&=":                    52,
-		"??=":                    53,
-		"???=":                   54,
-		"|=":                     55,
-		"&=":                     56,
-		"^=":                     57,
-		"<<=":                    58,
-		">>=":                    59,
-		">>>=":                   60,
-		"+=":                     61,
-		".=":                     62,
-		"-=":                     63,
-		"*=":                     64,
-		"/=":                     65,
-		"//=":                    66,
-		"%=":                     67,
-		"**=":                    68,
-		"?":                      69,
-		":":                      70,
-		"||":                     71,
-		"^^":                     72,
-		"&&":                     73,
-		"=~":                     74,
-		"!=~":                    75,
-		"==":                     76,
-		"!=":                     77,
-		"<=>":                    78,
-		">=":                     79,
-		"<":                      80,
-		"<=":                     81,
-		"^":                      82,
-		"&":                      83,
-		"<<":                     84,
-		">>>":                    85,
-		"+":                      86,
-		"-":                      87,
-		".+":                     88,
-		".-":                     89,
-		"*":                      90,
-		"/":                      91,
-		"//":                     92,
-		"%":                      93,
-		".*":                     94,
-		"./":                     95,
-		".//":                    96,
-		".":                      97,
-		"!":                      98,
-		"~":                      99,
-		"??":                     100,
-		"???":                    101,
-		"**":                     102,
-		"string_literal":         103,
-		"regex_case_insensitive": 104,
-		"int_literal":            105,
-		"float_literal":          106,
-		"boolean_literal":        107,
-		"null_literal":           108,
-		"inf_literal":            109,
-		"nan_literal":            110,
-		"const_M_PI":             111,
-		"const_M_E":              112,
-		"panic":                  113,
-		"[":                      114,
-		"ctx_IPS":                115,
-		"ctx_IFS":                116,
-		"ctx_IRS":                117,
-		"ctx_OPS":                118,
-		"ctx_OFS":                119,
-		"ctx_ORS":                120,
-		"ctx_FLATSEP":            121,
-		"ctx_NF":                 122,
-		"ctx_NR":                 123,
-		"ctx_FNR":                124,
-		"ctx_FILENAME":           125,
-		"ctx_FILENUM":            126,
-		"env":                    127,
-		"[[":                     128,
-		"[[[":                    129,
-		"call":                   130,
-		"begin":                  131,
-		"end":                    132,
-		"if":                     133,
-		"elif":                   134,
-		"else":                   135,
-		"while":                  136,
-		"do":                     137,
-		"for":                    138,
-		"in":                     139,
-		"break":                  140,
-		"continue":               141,
-		"func":                   142,
-		"subr":                   143,
-		"return":                 144,
+		"case":                   41,
+		"default":                42,
+		"catch":                  43,
+		"as":                     44,
+		"arr":                    45,
+		"bool":                   46,
+		"float":                  47,
+		"int":                    48,
+		"map":                    49,
+		"num":                    50,
+		"str":                    51,
+		"var":                    52,
+		"funct":                  53,
+		"||=":                    54,
+		"^^=":                    55,
+		"&&=":                    56,
+		"??=":                    57,
+		"???=":                   58,
+		"|=":                     59,
+		"&=":                     60,
+		"^=":                     61,
+		"<<=":                    62,
+		">>=":                    63,
+		">>>=":                   64,
+		"+=":                     65,
+		".=":                     66,
+		"-=":                     67,
+		"*=":                     68,
+		"/=":                     69,
+		"//=":                    70,
+		"%=":                     71,
+		"**=":                    72,
+		"?":                      73,
+		":":                      74,
+		"||":                     75,
+		"^^":                     76,
+		"&&":                     77,
+		"=~":                     78,
+		"!=~":                    79,
+		"==":                     80,
+		"!=":                     81,
+		"<=>":                    82,
+		">=":                     83,
+		"<":                      84,
+		"<=":                     85,
+		"^":                      86,
+		"&":                      87,
+		"<<":                     88,
+		">>>":                    89,
+		"+":                      90,
+		"-":                      91,
+		".+":                     92,
+		".-":                     93,
+		"*":                      94,
+		"/":                      95,
+		"//":                     96,
+		"%":                      97,
+		".*":                     98,
+		"./":                     99,
+		".//":                    100,
+		".":                      101,
+		"!":                      102,
+		"~":                      103,
+		"??":                     104,
+		"???":                    105,
+		"**":                     106,
+		"string_literal":         107,
+		"regex_case_insensitive": 108,
+		"int_literal":            109,
+		"float_literal":          110,
+		"boolean_literal":        111,
+		"null_literal":           112,
+		"inf_literal":            113,
+		"nan_literal":            114,
+		"const_M_PI":             115,
+		"const_M_E":              116,
+		"panic":                  117,
+		"[":                      118,
+		"ctx_IPS":                119,
+		"ctx_IFS":                120,
+		"ctx_IRS":                121,
+		"ctx_OPS":                122,
+		"ctx_OFS":                123,
+		"ctx_ORS":                124,
+		"ctx_FLATSEP":            125,
+		"ctx_NF":                 126,
+		"ctx_NR":                 127,
+		"ctx_FNR":                128,
+		"ctx_FILENAME":           129,
+		"ctx_FILENUM":            130,
+		"env":                    131,
+		"[[":                     132,
+		"[[[":                    133,
+		"call":                   134,
+		"begin":                  135,
+		"end":                    136,
+		"if":                     137,
+		"elif":                   138,
+		"else":                   139,
+		"switch":                 140,
+		"try":                    141,
+		"while":                  142,
+		"do":                     143,
+		"for":                    144,
+		"in":                     145,
+		"break":                  146,
+		"continue":               147,
+		"func":                   148,
+		"subr":                   149,
+		"return":                 150,
+		"kw_import":              151,
+		"::":                     152,
 	},
 }
@@ -98,6 +98,12 @@ func (stack *Stack) PopStackFrameSet() {
 	stack.head = stack.stackFrameSets.Front().Value.(*StackFrameSet)
 }
 
+// The number of user-defined functions/subroutines being executed, plus one.
+// This is for the REPL's step debugger.
+func (stack *Stack) FrameSetCount() int {
+	return stack.stackFrameSets.Len()
+}
+
 // ----------------------------------------------------------------
 // All of these are simply delegations to the head frameset
 
@@ -12,6 +12,7 @@ import (
 	"github.com/johnkerl/miller/v6/pkg/cli"
 	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/parsing/token"
 	"github.com/johnkerl/miller/v6/pkg/types"
 )
 
@@ -41,8 +42,20 @@ type State struct {
 
 	// StrictMode allows for runtime handling of absent-reads and untyped assignments.
 	StrictMode bool
+
+	// For try/catch: the number of try-blocks being executed, and the first
+	// error raised within the innermost of them, until it's caught.
+	TryDepth    int
+	RaisedError error
+
+	// If non-nil, this is called before each DSL statement is executed, with
+	// the statement's first token for its location. This is for the REPL's
+	// step debugger.
+	StatementObserver StatementObserver
 }
 
+type StatementObserver func(statementToken *token.Token, state *State)
+
 func NewEmptyState(options *cli.TOptions, strictMode bool) *State {
 
 	// See lib.MakeEmptyCaptures for context.
@@ -81,7 +81,11 @@ func Stream(
 
 	// Start the reader, transformer, and writer. Let them run until fatal input
 	// error or end-of-processing happens.
-	bufferedOutputStream := bufio.NewWriter(outputStream)
+	encodingOutputStream, err := output.NewCharsetEncodingWriter(outputStream, &options.WriterOptions)
+	if err != nil {
+		return err
+	}
+	bufferedOutputStream := bufio.NewWriter(encodingOutputStream)
 
 	go recordReader.Read(fileNames, *initialContext, readerChannel, inputErrorChannel, readerDownstreamDoneChannel)
 	go transformers.ChainTransformer(readerChannel, readerDownstreamDoneChannel, recordTransformers,
@@ -106,6 +110,7 @@ func Stream(
 	}
 
 	bufferedOutputStream.Flush()
+	encodingOutputStream.Close()
 
 	return retval
 }
@@ -401,6 +401,18 @@ JSON Lines (sequence of one-line objects):
   Record 1: "apple":"1", "bat":"2", "cog":"3"
   Record 2: "dish:egg":"7", "dish:flint":"8", "garlic":""
 
+YAML (one record per document, or per element of a top-level sequence):
++---------------------+
+| apple: 1            | Record 1: "apple":"1", "bat":"2", "cog":"3"
+| bat: 2              |
+| cog: 3              |
+| ---                 |
+| dish:               | Record 2: "dish.egg":"7",
+|   egg: 7            | "dish.flint":"8", "garlic":""
+|   flint: 8          |
+| garlic: ""          |
++---------------------+
+
 PPRINT: pretty-printed tabular
 +---------------------+
 | apple bat cog       |
@@ -437,6 +449,37 @@ NIDX: implicitly numerically indexed (Unix-toolkit style)
 | the quick brown     | Record 1: "1":"the", "2":"quick", "3":"brown"
 | fox jumped          | Record 2: "1":"fox", "2":"jumped"
 +---------------------+
+
+Fixed-width: each field in a given range of columns, as specified by
+--fixed-spec or --fixed-spec-file; see mlr help fixed-width-only-flags
++---------------------+
+| 0001Alice     12.50 | Record 1: "id":"0001", "name":"Alice", "amount":"12.50"
+| 0002Bob        7.00 | Record 2: "id":"0002", "name":"Bob", "amount":"7.00"
++---------------------+
+  with --fixed-spec id:1-4,name:5-14,amount:15-20:right
+
+Arrow: Apache Arrow IPC binary columnar format, streaming or file (Feather
+V2). Conversion is as for Parquet, below. On output, a new Arrow stream is
+started whenever a record doesn't fit the schema of the current one; with
+mlr -x, that is an error instead.
+
+Parquet: binary columnar format. Nested Parquet columns (lists, structs,
+maps) are read as arrays and maps, as with JSON. On output, records are
+flattened and column types are inferred from the first records written.
+
+SQLite: rows of a table in a SQLite database file; use --table to say which
+table. On output, the table is created if need be, with column types inferred
+from the first records written, and columns are added as new field names
+appear. Output is a new database on standard output, or an append to the table
+in the --sqlite-output-file database. With tee/emit redirects, "> file"
+replaces the table in that database and ">> file" appends to it.
+
+XLSX: Excel workbook. Input is the first sheet, or the one given by --sheet,
+with the first non-empty row, or the one given by --header-row, as the header.
+Numbers, booleans, and the cached values of formulas are read as such; dates
+are read as times, or see --xlsx-dates. On output, a new sheet is started
+whenever the field names change; with split --sheets, each group gets its own
+sheet. See mlr help xlsx-only-flags.
 `)
 }
 
@@ -91,6 +91,7 @@ var envVarsToUnset = []string{
 	"MLR_REPL_PS2",
 	"MLR_REPL_PS1_COLOR",
 	"MLR_REPL_PS2_COLOR",
+	"MLR_PATH",
 }
 
 type stringPair struct {
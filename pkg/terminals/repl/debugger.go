@@ -0,0 +1,373 @@
+// ================================================================
+// Step debugger for DSL statements loaded into the REPL, e.g. with ':load' or
+// multi-line '<<' ... '>>' input.
+//
+// Breakpoints are set by line number within the loaded DSL text, optionally
+// with a condition, or on a condition alone. When one is hit -- or when
+// single-stepping -- execution of the begin, main, or end block pauses before
+// the statement, and the REPL reads commands until ':step', ':next', or
+// ':continue'. While paused, DSL statements can be entered as usual, with
+// access to the local variables in scope at the paused statement.
+//
+// This works by setting the runtime state's StatementObserver, which the CST
+// calls before each statement is executed. It's nil when there are no
+// breakpoints and no stepping, so there's no cost otherwise.
+// ================================================================
+
+package repl
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/dsl/cst"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/parsing/token"
+	"github.com/johnkerl/miller/v6/pkg/runtime"
+)
+
+// ----------------------------------------------------------------
+type tStepMode int
+
+const (
+	stepModeNone tStepMode = iota
+	// Pause at the next statement
+	stepModeStep
+	// Pause at the next statement not within a function or subroutine called
+	// from the current one
+	stepModeNext
+)
+
+type tBreakpoint struct {
+	line            int // 0 for any line
+	conditionString string
+	condition       cst.IEvaluable // nil for unconditional
+}
+
+type tDebugger struct {
+	breakpoints []*tBreakpoint
+	stepMode    tStepMode
+	// For stepModeNext: the stack depth, in frame sets, of the statement
+	// stepped from.
+	nextFrameSetCount int
+
+	paused bool
+	// Set by :step, :next, and :continue while paused.
+	resume bool
+}
+
+func newDebugger() *tDebugger {
+	return &tDebugger{
+		breakpoints: make([]*tBreakpoint, 0),
+		stepMode:    stepModeNone,
+	}
+}
+
+// updateStatementObserver turns statement-observation on or off depending on
+// whether there's anything to observe.
+func (repl *Repl) updateStatementObserver() {
+	debugger := repl.debugger
+	if debugger.paused || (len(debugger.breakpoints) == 0 && debugger.stepMode == stepModeNone) {
+		repl.runtimeState.StatementObserver = nil
+	} else {
+		repl.runtimeState.StatementObserver = repl.observeStatement
+	}
+}
+
+// endRun is called when a run of begin, main, or end blocks has finished, so
+// that stepping from the last statement of one run doesn't pause at the first
+// statement of the next.
+func (repl *Repl) endRun() {
+	debugger := repl.debugger
+	debugger.stepMode = stepModeNone
+	debugger.paused = false
+	debugger.resume = false
+	repl.updateStatementObserver()
+}
+
+// observeStatement is called before each statement is executed, while there
+// are breakpoints or stepping.
+func (repl *Repl) observeStatement(statementToken *token.Token, state *runtime.State) {
+	debugger := repl.debugger
+
+	shouldPause := false
+	if debugger.stepMode == stepModeStep {
+		shouldPause = true
+	} else if debugger.stepMode == stepModeNext {
+		shouldPause = state.Stack.FrameSetCount() <= debugger.nextFrameSetCount
+	}
+	if !shouldPause {
+		shouldPause = repl.hitsBreakpoint(statementToken, state)
+	}
+
+	if shouldPause {
+		repl.pauseAt(statementToken, state)
+	}
+}
+
+func (repl *Repl) hitsBreakpoint(statementToken *token.Token, state *runtime.State) bool {
+	// Don't observe the statements of any functions the conditions call.
+	state.StatementObserver = nil
+	defer func() { state.StatementObserver = repl.observeStatement }()
+
+	for _, breakpoint := range repl.debugger.breakpoints {
+		if breakpoint.line != 0 && breakpoint.line != statementToken.Pos.Line {
+			continue
+		}
+		if breakpoint.condition == nil {
+			return true
+		}
+		conditionBool, isBool := breakpoint.condition.Evaluate(state).GetBoolValue()
+		if isBool && conditionBool {
+			return true
+		}
+	}
+	return false
+}
+
+// pauseAt reads and handles REPL input until :step, :next, or :continue, or
+// end of input.
+func (repl *Repl) pauseAt(statementToken *token.Token, state *runtime.State) {
+	debugger := repl.debugger
+	debugger.paused = true
+	debugger.resume = false
+	repl.updateStatementObserver()
+
+	fmt.Printf(
+		"Paused at DSL expression line %d column %d.\n",
+		statementToken.Pos.Line, statementToken.Pos.Column,
+	)
+
+	for !debugger.resume {
+		repl.printPrompt1()
+
+		line, err := repl.lineReader.ReadString('\n')
+		if err == io.EOF {
+			// Nothing more to be typed, so run to completion.
+			debugger.breakpoints = make([]*tBreakpoint, 0)
+			debugger.stepMode = stepModeNone
+			break
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		trimmedLine := strings.TrimSpace(line)
+		if trimmedLine == ":quit" || trimmedLine == ":q" {
+			os.Exit(0)
+		} else if strings.HasPrefix(trimmedLine, ":") {
+			verbName := strings.Fields(trimmedLine)[0]
+			if !isAvailableWhilePaused(verbName) {
+				fmt.Printf("REPL verb %s is not available while paused at a statement.\n", verbName)
+				continue
+			}
+			repl.handleNonDSLLine(trimmedLine)
+		} else if repl.handleNonDSLLine(trimmedLine) {
+			// Help requests like '?strlen'
+		} else {
+			err = repl.handleDSLStringImmediate(line, repl.doWarnings)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+		}
+	}
+
+	debugger.paused = false
+	debugger.resume = false
+	repl.updateStatementObserver()
+}
+
+// While paused, REPL verbs which would read records, or run begin/main/end
+// blocks, aren't available.
+var verbNamesAvailableWhilePaused = map[string]bool{
+	":break":    true,
+	":unbreak":  true,
+	":step":     true,
+	":next":     true,
+	":continue": true,
+	":locals":   true,
+	":c":        true,
+	":context":  true,
+	":w":        true,
+	":write":    true,
+	":astprint": true,
+	":blocks":   true,
+	":h":        true,
+	":help":     true,
+}
+
+func isAvailableWhilePaused(verbName string) bool {
+	return verbNamesAvailableWhilePaused[verbName]
+}
+
+// ----------------------------------------------------------------
+func usageBreak(repl *Repl) {
+	fmt.Println(":break {line number} sets a breakpoint before the statement(s) starting on that line.")
+	fmt.Println(":break {line number} if {condition} sets a breakpoint which is hit only when the")
+	fmt.Println("condition is true, e.g. ':break 12 if $x > 3'.")
+	fmt.Println(":break if {condition} sets a breakpoint on every statement, hit only when the")
+	fmt.Println("condition is true.")
+	fmt.Println(":break with no arguments lists the breakpoints.")
+	fmt.Println("Line numbers are within the DSL text as loaded by :load or by multi-line input.")
+	fmt.Println("Statements typed in at the prompt are not paused at.")
+	fmt.Println("See also :unbreak, :step, :next, :continue, and :locals.")
+}
+
+func handleBreak(repl *Repl, args []string) bool {
+	args = args[1:] // strip off verb
+	if len(args) == 0 {
+		for i, breakpoint := range repl.debugger.breakpoints {
+			fmt.Printf("%d: %s\n", i+1, breakpoint.describe())
+		}
+		return true
+	}
+
+	breakpoint := &tBreakpoint{}
+	if args[0] != "if" {
+		line, err := strconv.Atoi(args[0])
+		if err != nil || line < 1 {
+			fmt.Printf("Could not parse \"%s\" as line number.\n", args[0])
+			return true
+		}
+		breakpoint.line = line
+		args = args[1:]
+	}
+
+	if len(args) > 0 {
+		if args[0] != "if" || len(args) == 1 {
+			return false
+		}
+		breakpoint.conditionString = strings.Join(args[1:], " ")
+		condition, err := repl.cstRootNode.BuildREPLCondition(breakpoint.conditionString)
+		if err != nil {
+			fmt.Println(err)
+			return true
+		}
+		breakpoint.condition = condition
+	}
+
+	repl.debugger.breakpoints = append(repl.debugger.breakpoints, breakpoint)
+	fmt.Printf("Breakpoint %d: %s\n", len(repl.debugger.breakpoints), breakpoint.describe())
+	repl.updateStatementObserver()
+	return true
+}
+
+func (breakpoint *tBreakpoint) describe() string {
+	where := "any line"
+	if breakpoint.line != 0 {
+		where = "line " + strconv.Itoa(breakpoint.line)
+	}
+	if breakpoint.condition == nil {
+		return where
+	}
+	return where + " if " + breakpoint.conditionString
+}
+
+// ----------------------------------------------------------------
+func usageUnbreak(repl *Repl) {
+	fmt.Println(":unbreak {breakpoint number} removes the breakpoint with that number, as listed")
+	fmt.Println("by :break with no arguments.")
+	fmt.Println(":unbreak with no arguments removes all breakpoints.")
+}
+
+func handleUnbreak(repl *Repl, args []string) bool {
+	args = args[1:] // strip off verb
+	debugger := repl.debugger
+	if len(args) == 0 {
+		debugger.breakpoints = make([]*tBreakpoint, 0)
+	} else if len(args) == 1 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 || n > len(debugger.breakpoints) {
+			fmt.Printf("No breakpoint \"%s\".\n", args[0])
+			return true
+		}
+		debugger.breakpoints = append(debugger.breakpoints[:n-1], debugger.breakpoints[n:]...)
+	} else {
+		return false
+	}
+	repl.updateStatementObserver()
+	return true
+}
+
+// ----------------------------------------------------------------
+func usageStep(repl *Repl) {
+	fmt.Println(":step with no arguments.")
+	fmt.Println("While paused, runs the current statement and pauses at the next one, including")
+	fmt.Println("within any function or subroutine it calls.")
+	fmt.Println("Otherwise, pauses at the first statement run by the next :main, :process, etc.")
+}
+
+func handleStep(repl *Repl, args []string) bool {
+	if len(args) != 1 {
+		return false
+	}
+	repl.debugger.stepMode = stepModeStep
+	if repl.debugger.paused {
+		repl.debugger.resume = true
+	} else {
+		repl.updateStatementObserver()
+	}
+	return true
+}
+
+// ----------------------------------------------------------------
+func usageNext(repl *Repl) {
+	fmt.Println(":next with no arguments.")
+	fmt.Println("While paused, runs the current statement and pauses at the next one, not")
+	fmt.Println("pausing within any function or subroutine it calls unless a breakpoint is hit.")
+}
+
+func handleNext(repl *Repl, args []string) bool {
+	if len(args) != 1 {
+		return false
+	}
+	if !repl.debugger.paused {
+		fmt.Println("Not paused at a statement.")
+		return true
+	}
+	repl.debugger.stepMode = stepModeNext
+	repl.debugger.nextFrameSetCount = repl.runtimeState.Stack.FrameSetCount()
+	repl.debugger.resume = true
+	return true
+}
+
+// ----------------------------------------------------------------
+func usageContinue(repl *Repl) {
+	fmt.Println(":continue with no arguments.")
+	fmt.Println("While paused, runs until the next breakpoint is hit.")
+}
+
+func handleContinue(repl *Repl, args []string) bool {
+	if len(args) != 1 {
+		return false
+	}
+	if !repl.debugger.paused {
+		fmt.Println("Not paused at a statement.")
+		return true
+	}
+	repl.debugger.stepMode = stepModeNone
+	repl.debugger.resume = true
+	return true
+}
+
+// ----------------------------------------------------------------
+func usageLocals(repl *Repl) {
+	fmt.Println(":locals with no arguments.")
+	fmt.Println("Shows the local-variable stack -- with a frame set for each function or")
+	fmt.Println("subroutine being run, and a frame for each curly-braced block -- then the")
+	fmt.Println("out-of-stream variables.")
+}
+
+func handleLocals(repl *Repl, args []string) bool {
+	if len(args) != 1 {
+		return false
+	}
+	repl.runtimeState.Stack.Dump()
+	fmt.Println("OOSVARS:")
+	fmt.Println(mlrval.FromMap(repl.runtimeState.Oosvars).String())
+	return true
+}
@@ -51,6 +51,7 @@ func (repl *Repl) handleDSLStringAux(
 	// which the REPL doesn't use.
 	_, err := repl.cstRootNode.Build(
 		[]string{dslString},
+		nil,
 		cst.DSLInstanceTypeREPL,
 		isReplImmediate,
 		doWarnings,
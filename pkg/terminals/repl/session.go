@@ -88,6 +88,7 @@ func NewRepl(
 	// If there was a --load/--mload on the command line, load those DSL strings here (e.g.
 	// someone's local function library).
 	dslStrings := make([]string, 0)
+	importDirectories := make([]string, 0)
 	for _, filename := range options.DSLPreloadFileNames {
 		theseDSLStrings, err := lib.LoadStringsFromFileOrDir(filename, ".mlr")
 		if err != nil {
@@ -96,7 +97,10 @@ func NewRepl(
 			return nil, err
 		}
 		dslStrings = append(dslStrings, theseDSLStrings...)
+		importDirectories = append(importDirectories, cst.ImportDirectoryOf(filename))
 	}
+	importDirectories = append(importDirectories, ".")
+	cstRootNode.WithImportDirectories(importDirectories)
 
 	repl := &Repl{
 		exeName:  exeName,
@@ -119,6 +123,7 @@ func NewRepl(
 		recordWriter:  recordWriter,
 
 		runtimeState:                 runtimeState,
+		debugger:                     newDebugger(),
 		sysToSignalHandlerChannel:    sysToSignalHandlerChannel,
 		appSignalNotificationChannel: appSignalNotificationChannel,
 	}
@@ -154,6 +159,7 @@ func (repl *Repl) handleSession(istream *os.File) error {
 	}
 
 	lineReader := bufio.NewReader(istream)
+	repl.lineReader = lineReader
 
 	for {
 		repl.printPrompt1()
@@ -63,6 +63,10 @@ type Repl struct {
 
 	runtimeState *runtime.State
 
+	// For reading REPL input, including while paused in the step debugger.
+	lineReader *bufio.Reader
+	debugger   *tDebugger
+
 	// For control-C handling
 	sysToSignalHandlerChannel    chan os.Signal // Our signal handler reads system notification here
 	appSignalNotificationChannel chan bool      // Our signal handler writes this for our app to poll
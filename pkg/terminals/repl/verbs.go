@@ -55,6 +55,12 @@ func init() {
 		{verbNames: []string{":astprint"}, handlerFunc: handleASTPrint, usageFunc: usageASTPrint},
 		{verbNames: []string{":blocks"}, handlerFunc: handleBlocks, usageFunc: usageBlocks},
 		{verbNames: []string{":rb", ":resetblocks"}, handlerFunc: handleResetBlocks, usageFunc: usageResetBlocks},
+		{verbNames: []string{":break"}, handlerFunc: handleBreak, usageFunc: usageBreak},
+		{verbNames: []string{":unbreak"}, handlerFunc: handleUnbreak, usageFunc: usageUnbreak},
+		{verbNames: []string{":step"}, handlerFunc: handleStep, usageFunc: usageStep},
+		{verbNames: []string{":next"}, handlerFunc: handleNext, usageFunc: usageNext},
+		{verbNames: []string{":continue"}, handlerFunc: handleContinue, usageFunc: usageContinue},
+		{verbNames: []string{":locals"}, handlerFunc: handleLocals, usageFunc: usageLocals},
 		{verbNames: []string{":q", ":quit"}, handlerFunc: nil, usageFunc: usageQuit},
 		{verbNames: []string{":h", ":help"}, handlerFunc: handleHelp, usageFunc: usageHelp},
 	}
@@ -480,6 +486,7 @@ func handleSkipOrProcessUntil(repl *Repl, dslString string, processingNotSkippin
 	// which the REPL doesn't use.
 	_, err := repl.cstRootNode.Build(
 		[]string{dslString},
+		nil,
 		cst.DSLInstanceTypeREPL,
 		true, // isReplImmediate
 		repl.doWarnings,
@@ -585,6 +592,7 @@ func skipOrProcessRecord(
 	// Non-nil record to be printed
 	if processingNotSkipping {
 		outrec, err := repl.cstRootNode.ExecuteMainBlock(repl.runtimeState)
+		repl.endRun()
 		if err != nil {
 			fmt.Println(err)
 			return true
@@ -738,6 +746,7 @@ func handleBegin(repl *Repl, args []string) bool {
 		return false
 	}
 	err := repl.cstRootNode.ExecuteBeginBlocks(repl.runtimeState)
+	repl.endRun()
 	if err != nil {
 		fmt.Println(err)
 	}
@@ -756,6 +765,7 @@ func handleMain(repl *Repl, args []string) bool {
 		return false
 	}
 	_, err := repl.cstRootNode.ExecuteMainBlock(repl.runtimeState)
+	repl.endRun()
 	if err != nil {
 		fmt.Println(err)
 	}
@@ -773,6 +783,7 @@ func handleEnd(repl *Repl, args []string) bool {
 		return false
 	}
 	err := repl.cstRootNode.ExecuteEndBlocks(repl.runtimeState)
+	repl.endRun()
 	if err != nil {
 		fmt.Println(err)
 	}
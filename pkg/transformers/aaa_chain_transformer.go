@@ -184,15 +184,28 @@ func ChainTransformer(
 			orchan = intermediateRecordChannels[i]
 		}
 
-		go runSingleTransformer(
-			recordTransformer,
-			i == 0,
-			irchan,
-			orchan,
-			idchan,
-			odchan,
-			options,
-		)
+		if parallelTransformer, ok := recordTransformer.(*ParallelTransformer); ok {
+			// For mlr --workers: see aaa_parallel_transformer.go.
+			go runParallelTransformer(
+				parallelTransformer,
+				i == 0,
+				irchan,
+				orchan,
+				idchan,
+				odchan,
+				options,
+			)
+		} else {
+			go runSingleTransformer(
+				recordTransformer,
+				i == 0,
+				irchan,
+				orchan,
+				idchan,
+				odchan,
+				options,
+			)
+		}
 	}
 }
 
@@ -288,6 +301,18 @@ func runSingleTransformerBatch(
 		}
 	}
 
+	// Transformers such as sort with spill-to-disk may have more end-of-stream
+	// output than should be held in memory at once. They send it along a batch
+	// at a time.
+	if done {
+		if batcher, ok := recordTransformer.(IEndOfStreamBatcher); ok {
+			for !batcher.EndOfStreamBatch(outputRecordsAndContexts) {
+				outputRecordChannel <- outputRecordsAndContexts
+				outputRecordsAndContexts = list.New()
+			}
+		}
+	}
+
 	outputRecordChannel <- outputRecordsAndContexts
 
 	return done
@@ -0,0 +1,127 @@
+package transformers
+
+import (
+	"container/list"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// ================================================================
+// ParallelTransformer is for mlr --workers. It holds several instances of a
+// verb which keeps no state from one record to the next. Within the
+// transformer chain, each instance runs in its own goroutine, taking batches
+// of records from the upstream channel as they arrive; the output batches are
+// sent downstream in the order their input batches arrived, so record order
+// is the same as without --workers.
+//
+//      irchan
+//        |
+//    dispatcher ---------> pending
+//    |    |    |              |
+//    v    v    v              |
+//   [0]  [1]  [2] instances   |
+//    |    |    |              |
+//    v    v    v              v
+//   per-batch result channels, read in order by the collector
+//        |
+//      orchan
+//
+// The batch with the end-of-stream marker goes to just one of the instances,
+// as any other batch does. The others never see it, which is fine for verbs
+// without cross-record state.
+// ================================================================
+
+type ParallelTransformer struct {
+	instances []IRecordTransformer
+}
+
+func NewParallelTransformer(instances []IRecordTransformer) *ParallelTransformer {
+	return &ParallelTransformer{
+		instances: instances,
+	}
+}
+
+// Transform is for use outside of the transformer chain, where there is no
+// parallelism.
+func (tr *ParallelTransformer) Transform(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	tr.instances[0].Transform(
+		inrecAndContext,
+		outputRecordsAndContexts,
+		inputDownstreamDoneChannel,
+		outputDownstreamDoneChannel,
+	)
+}
+
+// A batch of records for one of the instances, and where to send its output.
+type tParallelTransformerJob struct {
+	inputRecordsAndContexts *list.List // list of *types.RecordAndContext
+	resultChannel           chan *list.List
+}
+
+func runParallelTransformer(
+	parallelTransformer *ParallelTransformer,
+	isFirstInChain bool,
+	inputRecordChannel <-chan *list.List, // list of *types.RecordAndContext
+	outputRecordChannel chan<- *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+	options *cli.TOptions,
+) {
+	numInstances := len(parallelTransformer.instances)
+	jobChannel := make(chan *tParallelTransformerJob, numInstances)
+	// In order of arrival. This also limits how many batches are in flight.
+	pendingResultChannels := make(chan chan *list.List, 2*numInstances)
+
+	for _, instance := range parallelTransformer.instances {
+		go func(instance IRecordTransformer) {
+			for job := range jobChannel {
+				runSingleTransformerBatch(
+					job.inputRecordsAndContexts,
+					instance,
+					isFirstInChain,
+					job.resultChannel,
+					inputDownstreamDoneChannel,
+					outputDownstreamDoneChannel,
+					options,
+				)
+			}
+		}(instance)
+	}
+
+	go func() {
+		for resultChannel := range pendingResultChannels {
+			outputRecordChannel <- <-resultChannel
+		}
+	}()
+
+	done := false
+	for !done {
+		recordsAndContexts := <-inputRecordChannel
+		done = batchHasEndOfStream(recordsAndContexts)
+
+		// Stateless verbs send exactly one output batch per input batch.
+		resultChannel := make(chan *list.List, 1)
+		pendingResultChannels <- resultChannel
+		jobChannel <- &tParallelTransformerJob{
+			inputRecordsAndContexts: recordsAndContexts,
+			resultChannel:           resultChannel,
+		}
+	}
+	close(jobChannel)
+	close(pendingResultChannels)
+}
+
+func batchHasEndOfStream(recordsAndContexts *list.List) bool {
+	for e := recordsAndContexts.Back(); e != nil; e = e.Prev() {
+		if e.Value.(*types.RecordAndContext).EndOfStream {
+			return true
+		}
+	}
+	return false
+}
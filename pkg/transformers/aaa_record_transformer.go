@@ -20,6 +20,26 @@ type IRecordTransformer interface {
 	)
 }
 
+// IEndOfStreamBatcher is optionally satisfied by transformers whose output at
+// end of stream may be too large to hold in memory all at once. After the
+// transformer has been passed the end-of-stream marker, the chain transformer
+// calls EndOfStreamBatch repeatedly, sending each batch downstream, until it
+// returns true. Such transformers must emit the end-of-stream marker in their
+// last batch.
+type IEndOfStreamBatcher interface {
+	EndOfStreamBatch(
+		outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	) (done bool)
+}
+
+// IParallelizableTransformer is optionally satisfied by transformers whose
+// TransformerSetup has Parallelizable set, but for which that depends on the
+// verb's options: e.g. put is parallelizable only if its DSL expression keeps
+// no state from one record to the next.
+type IParallelizableTransformer interface {
+	IsParallelizable() bool
+}
+
 type RecordTransformerFunc func(
 	inrecAndContext *types.RecordAndContext,
 	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
@@ -55,6 +75,13 @@ type TransformerSetup struct {
 	// own. (The seqgen verb probably should have been designed as a zero-file
 	// record "reader" object, rather than a verb, alas.)
 	IgnoresInput bool
+
+	// For verbs which keep no state from one record to the next, such as cut
+	// and rename. With mlr --workers, such verbs are run as several
+	// instances, each constructed from the verb's command-line flags, and each
+	// transforming its own batches of records. See also
+	// IParallelizableTransformer.
+	Parallelizable bool
 }
 
 // HandleDefaultDownstreamDone is a utility function for most verbs other than
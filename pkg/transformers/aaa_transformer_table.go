@@ -14,7 +14,9 @@ var TRANSFORMER_LOOKUP_TABLE = []TransformerSetup{
 	AltkvSetup,
 	BarSetup,
 	BootstrapSetup,
+	BranchSetup,
 	CaseSetup,
+	CastSetup,
 	CatSetup,
 	CheckSetup,
 	CleanWhitespaceSetup,
@@ -37,12 +39,14 @@ var TRANSFORMER_LOOKUP_TABLE = []TransformerSetup{
 	HavingFieldsSetup,
 	HeadSetup,
 	HistogramSetup,
+	InferSchemaSetup,
 	JSONParseSetup,
 	JSONStringifySetup,
 	JoinSetup,
 	LabelSetup,
 	Latin1ToUTF8Setup,
 	LeastFrequentSetup,
+	MergeSetup,
 	MergeFieldsSetup,
 	MostFrequentSetup,
 	NestSetup,
@@ -74,12 +78,15 @@ var TRANSFORMER_LOOKUP_TABLE = []TransformerSetup{
 	TailSetup,
 	TeeSetup,
 	TemplateSetup,
+	TimeBucketSetup,
 	TopSetup,
 	UTF8ToLatin1Setup,
 	UnflattenSetup,
 	UniqSetup,
 	UnspaceSetup,
 	UnsparsifySetup,
+	ValidateSetup,
+	WindowSetup,
 }
 
 func ShowHelpForTransformer(verb string) bool {
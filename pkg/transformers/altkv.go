@@ -16,10 +16,11 @@ import (
 const verbNameAltkv = "altkv"
 
 var AltkvSetup = TransformerSetup{
-	Verb:         verbNameAltkv,
-	UsageFunc:    transformerAltkvUsage,
-	ParseCLIFunc: transformerAltkvParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameAltkv,
+	UsageFunc:      transformerAltkvUsage,
+	ParseCLIFunc:   transformerAltkvParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerAltkvUsage(
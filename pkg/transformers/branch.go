@@ -0,0 +1,517 @@
+// ================================================================
+// The branch verb is for branching pipelines. Each record is passed through
+// unmodified, and a copy of it is sent through the branch's own then-chain of
+// verbs, written within curly braces. For example, for a detail output and a
+// summary output in one pass over the input:
+//
+//   mlr --icsv --opprint \
+//     put '$z = $x * $y' \
+//     then branch --ojson -o summary.json { stats1 -a mean -f z -g a then sort -f a } \
+//     then cut -f a,z
+//
+// The branch's output goes either to a file (or pipe), with its own output
+// format if desired, or to a named stream which the merge verb brings back
+// into the main record stream, or into another branch. Branches may contain
+// branches.
+//
+// The branch's then-chain is run in the same goroutine as the branch verb, one
+// record at a time. So by the time the branch verb passes along the
+// end-of-stream marker, its then-chain has produced all its output; this is
+// what lets merge, downstream, emit the named stream at end of stream.
+//
+// Merge verbs are connected to the named streams of branch verbs once the
+// whole then-chain has been constructed: see LinkBranchNamedStreams.
+// ================================================================
+
+package transformers
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/output"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// ----------------------------------------------------------------
+const verbNameBranch = "branch"
+
+var BranchSetup = TransformerSetup{
+	Verb:         verbNameBranch,
+	UsageFunc:    transformerBranchUsage,
+	ParseCLIFunc: transformerBranchParseCLI,
+	IgnoresInput: false,
+}
+
+// This is set in init() rather than referring to LookUp directly, since the
+// transformer lookup table refers to BranchSetup, which would be an
+// initialization cycle.
+var lookUpBranchVerb func(verb string) *TransformerSetup
+
+func init() {
+	lookUpBranchVerb = LookUp
+}
+
+func transformerBranchUsage(
+	o *os.File,
+) {
+	fmt.Fprintf(o, "Usage: %s %s [options] { {verb} [then {verb} ...] }\n", "mlr", verbNameBranch)
+	fmt.Fprintf(o,
+		`Passes each record through unmodified, and sends a copy of it through the
+then-chain of verbs within the curly braces. The curly braces must be separate
+command-line arguments. The output of that then-chain goes to a file, or to a
+named stream for the merge verb.
+Options:
+-o {filename} Write the branch's output to the file.
+-a            Append to existing file, if any, rather than overwriting.
+-p            Treat filename as a pipe-to command.
+-n {name}     Send the branch's output to the named stream, for the merge verb.
+              This is held in memory until end of stream.
+Exactly one of -o and -n is required.
+Any of the output-format command-line flags (see mlr -h). Example: using
+  mlr --icsv --opprint put '...' then branch --ojson -o ./summary.json { stats1 ... }
+the input is CSV, the main output is pretty-print tabular, but the branch's
+output is written in JSON format.
+
+-h|--help Show this message.
+
+Examples:
+  mlr --icsv --opprint branch -o counts.csv { count-distinct -f shape } then sort -f color example.csv
+  mlr --icsv --ojson branch -n low { filter '$quantity < 50' then put '$level = "low"' } \
+    then branch -n high { filter '$quantity >= 50' then put '$level = "high"' } \
+    then nothing then merge -n low,high example.csv
+`)
+}
+
+func transformerBranchParseCLI(
+	pargi *int,
+	argc int,
+	args []string,
+	mainOptions *cli.TOptions,
+	doConstruct bool, // false for first pass of CLI-parse, true for second pass
+) IRecordTransformer {
+
+	// Skip the verb name from the current spot in the mlr command line
+	argi := *pargi
+	verb := args[argi]
+	argi++
+
+	filenameOrCommand := ""
+	streamName := ""
+	appending := false
+	piping := false
+	var localOptions *cli.TOptions = nil
+	if mainOptions != nil {
+		copyThereof := *mainOptions // struct copy
+		localOptions = &copyThereof
+	}
+
+	// Parse local flags.
+
+	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
+		opt := args[argi]
+		if !strings.HasPrefix(opt, "-") {
+			break // No more flag options to process
+		}
+		if args[argi] == "--" {
+			break // All transformers must do this so main-flags can follow verb-flags
+		}
+		argi++
+
+		if opt == "-h" || opt == "--help" {
+			transformerBranchUsage(os.Stdout)
+			os.Exit(0)
+
+		} else if opt == "-o" {
+			filenameOrCommand = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+
+		} else if opt == "-n" {
+			streamName = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+
+		} else if opt == "-a" {
+			appending = true
+			piping = false
+
+		} else if opt == "-p" {
+			appending = false
+			piping = true
+
+		} else {
+			// As in the tee verb: ParseWriterOptions expects argi unadvanced.
+			largi := argi - 1
+			if cli.FLAG_TABLE.Parse(args, argc, &largi, localOptions) {
+				// This lets mlr main and mlr branch have different output formats.
+				argi = largi
+			} else {
+				transformerBranchUsage(os.Stderr)
+				os.Exit(1)
+			}
+		}
+	}
+
+	if (filenameOrCommand == "") == (streamName == "") {
+		fmt.Fprintf(os.Stderr, "mlr %s: exactly one of -o and -n is required.\n", verb)
+		os.Exit(1)
+	}
+
+	cli.FinalizeWriterOptions(&localOptions.WriterOptions)
+
+	subChainTransformers, subChainVerbSequences := parseBranchSubChain(
+		verb, &argi, argc, args, localOptions, doConstruct,
+	)
+
+	*pargi = argi
+	if !doConstruct { // All transformers must do this for main command-line parsing
+		return nil
+	}
+
+	// As for the main then-chain: see parseCommandLinePassTwo.
+	if filenameOrCommand != "" {
+		if cli.DecideFinalFlatten(&localOptions.WriterOptions) {
+			transformer, _ := NewTransformerFlatten(localOptions.WriterOptions.FLATSEP, localOptions, nil)
+			subChainTransformers = append(subChainTransformers, transformer)
+		}
+		if cli.DecideFinalUnflatten(localOptions, subChainVerbSequences) {
+			transformer, _ := NewTransformerUnflatten(localOptions.WriterOptions.FLATSEP, localOptions, nil)
+			subChainTransformers = append(subChainTransformers, transformer)
+		}
+	}
+
+	transformer, err := NewTransformerBranch(
+		subChainTransformers,
+		appending,
+		piping,
+		filenameOrCommand,
+		streamName,
+		&localOptions.WriterOptions,
+	)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	return transformer
+}
+
+// parseBranchSubChain parses '{ verb ... then verb ... }', returning the
+// transformers -- on the second CLI-parse pass -- and the verbs' command-line
+// arguments.
+func parseBranchSubChain(
+	verb string,
+	pargi *int,
+	argc int,
+	args []string,
+	options *cli.TOptions,
+	doConstruct bool,
+) ([]IRecordTransformer, [][]string) {
+	argi := *pargi
+	if argi >= argc || args[argi] != "{" {
+		fmt.Fprintf(os.Stderr, "mlr %s: expected \"{\" after options.\n", verb)
+		os.Exit(1)
+	}
+	argi++
+
+	subChainTransformers := make([]IRecordTransformer, 0)
+	subChainVerbSequences := make([][]string, 0)
+
+	for {
+		if argi >= argc {
+			fmt.Fprintf(os.Stderr, "mlr %s: expected a verb after \"{\" or \"then\".\n", verb)
+			os.Exit(1)
+		}
+		subVerb := args[argi]
+		transformerSetup := lookUpBranchVerb(subVerb)
+		if transformerSetup == nil {
+			fmt.Fprintf(os.Stderr,
+				"mlr %s: verb \"%s\" not found. Please use \"mlr --help\" for a list.\n",
+				verb, subVerb)
+			os.Exit(1)
+		}
+		if transformerSetup.IgnoresInput {
+			fmt.Fprintf(os.Stderr, "mlr %s: verb \"%s\" cannot be used within a branch.\n", verb, subVerb)
+			os.Exit(1)
+		}
+
+		oargi := argi
+		transformer := transformerSetup.ParseCLIFunc(&argi, argc, args, options, doConstruct)
+		subChainVerbSequences = append(subChainVerbSequences, args[oargi:argi])
+		if doConstruct {
+			subChainTransformers = append(subChainTransformers, transformer)
+		}
+
+		if argi >= argc {
+			fmt.Fprintf(os.Stderr, "mlr %s: missing \"}\".\n", verb)
+			os.Exit(1)
+		}
+		if args[argi] == "}" {
+			argi++
+			break
+		}
+		if args[argi] != "then" && args[argi] != "+" {
+			fmt.Fprintf(os.Stderr,
+				"mlr %s: expected \"then\" or \"}\" after verb \"%s\"; got \"%s\".\n",
+				verb, subVerb, args[argi])
+			os.Exit(1)
+		}
+		argi++
+	}
+
+	*pargi = argi
+	return subChainTransformers, subChainVerbSequences
+}
+
+// ----------------------------------------------------------------
+type TransformerBranch struct {
+	subChain *tBranchSubChain
+
+	// Exactly one of these is non-nil
+	fileOutputHandler           *output.FileOutputHandler
+	filenameOrCommandForDisplay string
+	namedStream                 *tBranchNamedStream
+}
+
+func NewTransformerBranch(
+	subChainTransformers []IRecordTransformer,
+	appending bool,
+	piping bool,
+	filenameOrCommand string,
+	streamName string,
+	recordWriterOptions *cli.TWriterOptions,
+) (*TransformerBranch, error) {
+
+	tr := &TransformerBranch{
+		subChain: newBranchSubChain(subChainTransformers),
+	}
+
+	if streamName != "" {
+		tr.namedStream = newBranchNamedStream(streamName)
+		return tr, nil
+	}
+
+	var err error = nil
+	if piping {
+		tr.fileOutputHandler, err = output.NewPipeWriteOutputHandler(filenameOrCommand, recordWriterOptions)
+		tr.filenameOrCommandForDisplay = "| " + filenameOrCommand
+	} else if appending {
+		tr.fileOutputHandler, err = output.NewFileAppendOutputHandler(filenameOrCommand, recordWriterOptions)
+		tr.filenameOrCommandForDisplay = ">> " + filenameOrCommand
+	} else {
+		tr.fileOutputHandler, err = output.NewFileWriteOutputHandler(filenameOrCommand, recordWriterOptions)
+		tr.filenameOrCommandForDisplay = "> " + filenameOrCommand
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tr, nil
+}
+
+func (tr *TransformerBranch) Transform(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	// As with the tee verb, downstream-done flags aren't passed upstream:
+	// 'mlr branch -o foo.csv { cat } then head -n 10' should still write
+	// all the records to foo.csv.
+	select {
+	case _ = <-inputDownstreamDoneChannel:
+		break
+	default:
+		break
+	}
+
+	branchInput := inrecAndContext
+	if !inrecAndContext.EndOfStream {
+		branchInput = inrecAndContext.Copy()
+	}
+	tr.subChain.process(branchInput, tr.writeBranchOutput)
+
+	if inrecAndContext.EndOfStream && tr.fileOutputHandler != nil {
+		err := tr.fileOutputHandler.Close()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "mlr: error closing branch \"%s\":\n", tr.filenameOrCommandForDisplay)
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	outputRecordsAndContexts.PushBack(inrecAndContext)
+}
+
+// writeBranchOutput sends a record from the end of the branch's then-chain to
+// the named stream or the file.
+func (tr *TransformerBranch) writeBranchOutput(branchOutput *types.RecordAndContext) {
+	if branchOutput.EndOfStream {
+		return
+	}
+	if tr.namedStream != nil {
+		tr.namedStream.recordsAndContexts.PushBack(branchOutput)
+	} else {
+		err := tr.fileOutputHandler.WriteRecordAndContext(branchOutput)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "mlr: error writing to branch \"%s\":\n", tr.filenameOrCommandForDisplay)
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// ================================================================
+// tBranchSubChain runs a then-chain of transformers synchronously, one input
+// record at a time, rather than each in its own goroutine as
+// ChainTransformer does.
+
+type tBranchSubChain struct {
+	transformers []IRecordTransformer
+	// Transformer i reads downstream-done flags from downstreamDoneChannels[i]
+	// and writes them to downstreamDoneChannels[i-1], or, for the first, to
+	// upstreamDoneChannel which is drained and ignored.
+	downstreamDoneChannels []chan bool
+	upstreamDoneChannel    chan bool
+}
+
+func newBranchSubChain(transformers []IRecordTransformer) *tBranchSubChain {
+	downstreamDoneChannels := make([]chan bool, len(transformers))
+	for i := range downstreamDoneChannels {
+		downstreamDoneChannels[i] = make(chan bool, 1)
+	}
+	return &tBranchSubChain{
+		transformers:           transformers,
+		downstreamDoneChannels: downstreamDoneChannels,
+		upstreamDoneChannel:    make(chan bool, 1),
+	}
+}
+
+// process runs a record through the then-chain, passing each record which
+// comes out of the end of it to the sink.
+func (subChain *tBranchSubChain) process(
+	inrecAndContext *types.RecordAndContext,
+	sink func(*types.RecordAndContext),
+) {
+	inputRecordsAndContexts := list.New()
+	inputRecordsAndContexts.PushBack(inrecAndContext)
+	subChain.processFrom(0, inputRecordsAndContexts, sink)
+
+	select {
+	case _ = <-subChain.upstreamDoneChannel:
+		break
+	default:
+		break
+	}
+}
+
+// processFrom runs records through the then-chain starting at transformer i.
+// As in runSingleTransformerBatch, transformers such as sort with
+// spill-to-disk send their end-of-stream output a batch at a time, and each
+// batch is sent on through the rest of the then-chain before the next.
+func (subChain *tBranchSubChain) processFrom(
+	i int,
+	inputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	sink func(*types.RecordAndContext),
+) {
+	if i == len(subChain.transformers) {
+		for e := inputRecordsAndContexts.Front(); e != nil; e = e.Next() {
+			sink(e.Value.(*types.RecordAndContext))
+		}
+		return
+	}
+
+	transformer := subChain.transformers[i]
+	outputDownstreamDoneChannel := subChain.upstreamDoneChannel
+	if i > 0 {
+		outputDownstreamDoneChannel = subChain.downstreamDoneChannels[i-1]
+	}
+
+	outputRecordsAndContexts := list.New()
+	for e := inputRecordsAndContexts.Front(); e != nil; e = e.Next() {
+		inputRecordAndContext := e.Value.(*types.RecordAndContext)
+		// As in runSingleTransformerBatch, strings from print statements
+		// and the like are passed along as-is.
+		if !inputRecordAndContext.EndOfStream && inputRecordAndContext.Record == nil {
+			outputRecordsAndContexts.PushBack(inputRecordAndContext)
+			continue
+		}
+		transformer.Transform(
+			inputRecordAndContext,
+			outputRecordsAndContexts,
+			subChain.downstreamDoneChannels[i],
+			outputDownstreamDoneChannel,
+		)
+		if inputRecordAndContext.EndOfStream {
+			if batcher, ok := transformer.(IEndOfStreamBatcher); ok {
+				for !batcher.EndOfStreamBatch(outputRecordsAndContexts) {
+					subChain.processFrom(i+1, outputRecordsAndContexts, sink)
+					outputRecordsAndContexts = list.New()
+				}
+			}
+		}
+	}
+	subChain.processFrom(i+1, outputRecordsAndContexts, sink)
+}
+
+// ================================================================
+// Named streams are written by branch verbs and read by merge verbs. See the
+// comments at the top of this file for why no locking is needed.
+
+type tBranchNamedStream struct {
+	name               string
+	recordsAndContexts *list.List // list of *types.RecordAndContext
+}
+
+func newBranchNamedStream(streamName string) *tBranchNamedStream {
+	return &tBranchNamedStream{
+		name:               streamName,
+		recordsAndContexts: list.New(),
+	}
+}
+
+// LinkBranchNamedStreams connects each merge verb in a then-chain, including
+// those within branches, to the named streams of the branch verbs before it.
+// This is called once the then-chain has been constructed. The stream names
+// are scoped to the then-chain, so separately constructed then-chains don't
+// see each other's.
+func LinkBranchNamedStreams(recordTransformers []IRecordTransformer) error {
+	return linkBranchNamedStreams(recordTransformers, make(map[string]*tBranchNamedStream))
+}
+
+func linkBranchNamedStreams(
+	recordTransformers []IRecordTransformer,
+	namedStreams map[string]*tBranchNamedStream, // keyed by stream name
+) error {
+	for _, recordTransformer := range recordTransformers {
+		switch tr := recordTransformer.(type) {
+
+		case *TransformerBranch:
+			err := linkBranchNamedStreams(tr.subChain.transformers, namedStreams)
+			if err != nil {
+				return err
+			}
+			if tr.namedStream != nil {
+				if namedStreams[tr.namedStream.name] != nil {
+					return fmt.Errorf(
+						"mlr %s: stream name \"%s\" is used more than once.",
+						verbNameBranch, tr.namedStream.name,
+					)
+				}
+				namedStreams[tr.namedStream.name] = tr.namedStream
+			}
+
+		case *TransformerMerge:
+			for i, streamName := range tr.streamNames {
+				namedStream := namedStreams[streamName]
+				if namedStream == nil {
+					return fmt.Errorf(
+						"mlr %s: stream name \"%s\" not found; it must be written by a branch verb earlier in the then-chain.",
+						verbNameMerge, streamName,
+					)
+				}
+				tr.namedStreams[i] = namedStream
+			}
+		}
+	}
+	return nil
+}
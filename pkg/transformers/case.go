@@ -19,10 +19,11 @@ import (
 const verbNameCase = "case"
 
 var CaseSetup = TransformerSetup{
-	Verb:         verbNameCase,
-	UsageFunc:    transformerCaseUsage,
-	ParseCLIFunc: transformerCaseParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameCase,
+	UsageFunc:      transformerCaseUsage,
+	ParseCLIFunc:   transformerCaseParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 const (
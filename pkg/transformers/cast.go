@@ -0,0 +1,229 @@
+package transformers
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/transformers/utils"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// ----------------------------------------------------------------
+const verbNameCast = "cast"
+
+type tCastMode int
+
+const (
+	castModeFail tCastMode = iota
+	castModeWarn
+	castModeVoid
+)
+
+var CastSetup = TransformerSetup{
+	Verb:           verbNameCast,
+	UsageFunc:      transformerCastUsage,
+	ParseCLIFunc:   transformerCastParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
+}
+
+func transformerCastUsage(
+	o *os.File,
+) {
+	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameCast)
+	fmt.Fprintf(o,
+		`Sets the types of the given fields from their values as they appear in the
+data file, regardless of type inference. For example, zip codes like 01234 can
+be kept as strings, while other fields are still inferred as usual.
+Options:
+-f {a:type,b:type,...} Field names and their types. May be given more than once.
+-t {filename}  Field names and types from a JSON file, as for the --types-file
+               main flag. May be given more than once.
+--fail         Stop with an error at the first value which doesn't convert.
+               This is the default.
+--warn         Print a message to standard error for each value which doesn't
+               convert, leaving the value as it was.
+--void         Set values which don't convert to empty, without a message.
+-h|--help      Show this message.
+Types are string, int, float, decimal (exact, as with mlr --decimal), number
+(int or float), boolean (true or false), and time(format), which is a time as
+from the time function with that format: it prints as it was, and int of it
+gives seconds since the epoch. Empty values are left as they are.
+The JSON file is either a map from field name to type, such as
+  { "zip": "string", "amount": "float", "ts": "time(%%Y-%%m-%%d)" }
+or a schema as for the validate verb, such as infer-schema writes. For the
+latter, fields with a single type of string, int, float, number, or boolean are
+cast.
+Examples:
+  mlr --icsv --ojson cast -f zip:string,amount:float myfile.csv
+  mlr --icsv --ojson cast -f 'id:string,ts:time(%%Y-%%m-%%d %%H:%%M:%%S)' myfile.csv
+  mlr --icsv --ocsv cast --warn -t types.json myfile.csv
+`)
+}
+
+func transformerCastParseCLI(
+	pargi *int,
+	argc int,
+	args []string,
+	_ *cli.TOptions,
+	doConstruct bool, // false for first pass of CLI-parse, true for second pass
+) IRecordTransformer {
+
+	// Skip the verb name from the current spot in the mlr command line
+	argi := *pargi
+	verb := args[argi]
+	argi++
+
+	fieldCasts := make([]*utils.FieldCast, 0)
+	mode := castModeFail
+
+	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
+		opt := args[argi]
+		if !strings.HasPrefix(opt, "-") {
+			break // No more flag options to process
+		}
+		if args[argi] == "--" {
+			break // All transformers must do this so main-flags can follow verb-flags
+		}
+		argi++
+
+		if opt == "-h" || opt == "--help" {
+			transformerCastUsage(os.Stdout)
+			os.Exit(0)
+
+		} else if opt == "-f" {
+			spec := cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+			moreFieldCasts, err := utils.ParseFieldCasts(spec)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "mlr %s: %v.\n", verb, err)
+				os.Exit(1)
+			}
+			fieldCasts = append(fieldCasts, moreFieldCasts...)
+
+		} else if opt == "-t" {
+			filename := cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+			moreFieldCasts, err := utils.LoadFieldCastsFromFile(filename)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "mlr %s: %v.\n", verb, err)
+				os.Exit(1)
+			}
+			fieldCasts = append(fieldCasts, moreFieldCasts...)
+
+		} else if opt == "--fail" {
+			mode = castModeFail
+
+		} else if opt == "--warn" {
+			mode = castModeWarn
+
+		} else if opt == "--void" {
+			mode = castModeVoid
+
+		} else {
+			transformerCastUsage(os.Stderr)
+			os.Exit(1)
+		}
+	}
+
+	if len(fieldCasts) == 0 {
+		transformerCastUsage(os.Stderr)
+		os.Exit(1)
+	}
+
+	*pargi = argi
+	if !doConstruct { // All transformers must do this for main command-line parsing
+		return nil
+	}
+
+	transformer, err := NewTransformerCast(
+		fieldCasts,
+		mode,
+	)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	return transformer
+}
+
+// ----------------------------------------------------------------
+type TransformerCast struct {
+	fieldCasts []*utils.FieldCast
+	mode       tCastMode
+	// For error messages: the verb, or the --types-file flag
+	name string
+}
+
+func NewTransformerCast(
+	fieldCasts []*utils.FieldCast,
+	mode tCastMode,
+) (*TransformerCast, error) {
+	return &TransformerCast{
+		fieldCasts: fieldCasts,
+		mode:       mode,
+		name:       verbNameCast,
+	}, nil
+}
+
+// NewTransformerCastFromTypesFile is for the --types-file main flag, which
+// puts this at the start of the then-chain.
+func NewTransformerCastFromTypesFile(
+	filename string,
+) (*TransformerCast, error) {
+	fieldCasts, err := utils.LoadFieldCastsFromFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return &TransformerCast{
+		fieldCasts: fieldCasts,
+		mode:       castModeFail,
+		name:       "--types-file",
+	}, nil
+}
+
+// ----------------------------------------------------------------
+
+func (tr *TransformerCast) Transform(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	HandleDefaultDownstreamDone(inputDownstreamDoneChannel, outputDownstreamDoneChannel)
+	if !inrecAndContext.EndOfStream {
+		inrec := inrecAndContext.Record
+		for _, fieldCast := range tr.fieldCasts {
+			value := inrec.Get(fieldCast.FieldName)
+			if value == nil {
+				continue
+			}
+			newValue, err := fieldCast.Cast(value)
+			if err == nil {
+				inrec.PutReference(fieldCast.FieldName, newValue)
+				continue
+			}
+
+			if tr.mode == castModeVoid {
+				inrec.PutReference(fieldCast.FieldName, mlrval.VOID)
+				continue
+			}
+			fmt.Fprintf(
+				os.Stderr,
+				"mlr %s: record %d of %s: field %s: %v.\n",
+				tr.name,
+				inrecAndContext.Context.FNR,
+				inrecAndContext.Context.FILENAME,
+				fieldCast.FieldName,
+				err,
+			)
+			if tr.mode == castModeFail {
+				os.Exit(1)
+			}
+		}
+	}
+	outputRecordsAndContexts.PushBack(inrecAndContext) // including end-of-stream marker
+}
@@ -16,10 +16,11 @@ import (
 const verbNameCleanWhitespace = "clean-whitespace"
 
 var CleanWhitespaceSetup = TransformerSetup{
-	Verb:         verbNameCleanWhitespace,
-	UsageFunc:    transformerCleanWhitespaceUsage,
-	ParseCLIFunc: transformerCleanWhitespaceParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameCleanWhitespace,
+	UsageFunc:      transformerCleanWhitespaceUsage,
+	ParseCLIFunc:   transformerCleanWhitespaceParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerCleanWhitespaceUsage(
@@ -17,10 +17,11 @@ import (
 const verbNameCut = "cut"
 
 var CutSetup = TransformerSetup{
-	Verb:         verbNameCut,
-	UsageFunc:    transformerCutUsage,
-	ParseCLIFunc: transformerCutParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameCut,
+	UsageFunc:      transformerCutUsage,
+	ParseCLIFunc:   transformerCutParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerCutUsage(
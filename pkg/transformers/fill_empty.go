@@ -16,10 +16,11 @@ const verbNameFillEmpty = "fill-empty"
 const defaultFillEmptyString = "N/A"
 
 var FillEmptySetup = TransformerSetup{
-	Verb:         verbNameFillEmpty,
-	UsageFunc:    transformerFillEmptyUsage,
-	ParseCLIFunc: transformerFillEmptyParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameFillEmpty,
+	UsageFunc:      transformerFillEmptyUsage,
+	ParseCLIFunc:   transformerFillEmptyParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerFillEmptyUsage(
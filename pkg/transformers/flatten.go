@@ -15,10 +15,11 @@ import (
 const verbNameFlatten = "flatten"
 
 var FlattenSetup = TransformerSetup{
-	Verb:         verbNameFlatten,
-	UsageFunc:    transformerFlattenUsage,
-	ParseCLIFunc: transformerFlattenParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameFlatten,
+	UsageFunc:      transformerFlattenUsage,
+	ParseCLIFunc:   transformerFlattenParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerFlattenUsage(
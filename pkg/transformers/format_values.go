@@ -19,10 +19,11 @@ const defaultFormatValuesIntFormat = "%d"
 const defaultFormatValuesFloatFormat = "%f"
 
 var FormatValuesSetup = TransformerSetup{
-	Verb:         verbNameFormatValues,
-	UsageFunc:    transformerFormatValuesUsage,
-	ParseCLIFunc: transformerFormatValuesParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameFormatValues,
+	UsageFunc:      transformerFormatValuesUsage,
+	ParseCLIFunc:   transformerFormatValuesParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 // ----------------------------------------------------------------
@@ -189,6 +190,7 @@ func (tr *TransformerFormatValues) Transform(
 		} else {
 			_, isInt := pe.Value.GetIntValue()
 			_, isFloat := pe.Value.GetFloatValue()
+			isFloat = isFloat || pe.Value.IsDecimal()
 			if isInt {
 				pe.Value = tr.intFormatter.Format(pe.Value)
 			} else if isFloat {
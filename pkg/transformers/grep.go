@@ -15,10 +15,11 @@ import (
 const verbNameGrep = "grep"
 
 var GrepSetup = TransformerSetup{
-	Verb:         verbNameGrep,
-	UsageFunc:    transformerGrepUsage,
-	ParseCLIFunc: transformerGrepParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameGrep,
+	UsageFunc:      transformerGrepUsage,
+	ParseCLIFunc:   transformerGrepParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerGrepUsage(
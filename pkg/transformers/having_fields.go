@@ -32,7 +32,8 @@ var HavingFieldsSetup = TransformerSetup{
 	UsageFunc:    transformerHavingFieldsUsage,
 	ParseCLIFunc: transformerHavingFieldsParseCLI,
 
-	IgnoresInput: false,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerHavingFieldsUsage(
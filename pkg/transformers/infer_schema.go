@@ -0,0 +1,139 @@
+package transformers
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/transformers/utils"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// ----------------------------------------------------------------
+const verbNameInferSchema = "infer-schema"
+const inferSchemaDefaultMaxEnumCount = 10
+
+var InferSchemaSetup = TransformerSetup{
+	Verb:         verbNameInferSchema,
+	UsageFunc:    transformerInferSchemaUsage,
+	ParseCLIFunc: transformerInferSchemaParseCLI,
+	IgnoresInput: false,
+}
+
+func transformerInferSchemaUsage(
+	o *os.File,
+) {
+	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameInferSchema)
+	fmt.Fprint(o,
+		`Scans all records and, at end of stream, outputs a single record which is a
+schema for them, in the form taken by the validate verb. For each field this
+has the widest type seen, whether the field is required (present and non-empty
+in all records), whether it's nullable (ever empty), min and max for numeric
+fields, and the distinct values as an enum when there are few of them. Map- and
+array-valued fields, from JSON input, have nested "fields" and "items".
+Options:
+--max-enum {n} Report values as an enum only for fields with at most this many
+               distinct values. Default 10; 0 for no enums.
+-h|--help      Show this message.
+Notes:
+* Types widen as follows: int and float to float, and any other mixture of
+  int, float, boolean, and string to string. Fields having both collection and
+  non-collection values get an array of types.
+* Enums are only reported for int and string fields, and only when some value
+  repeats -- so, for example, not for ID fields.
+* Use JSON output to get a schema file which validate can read.
+Examples:
+  mlr --icsv --ojson infer-schema example.csv > schema.json
+  mlr --icsv --ojson validate -s schema.json --annotate other.csv
+`)
+}
+
+func transformerInferSchemaParseCLI(
+	pargi *int,
+	argc int,
+	args []string,
+	_ *cli.TOptions,
+	doConstruct bool, // false for first pass of CLI-parse, true for second pass
+) IRecordTransformer {
+
+	// Skip the verb name from the current spot in the mlr command line
+	argi := *pargi
+	verb := args[argi]
+	argi++
+
+	maxEnumCount := int64(inferSchemaDefaultMaxEnumCount)
+
+	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
+		opt := args[argi]
+		if !strings.HasPrefix(opt, "-") {
+			break // No more flag options to process
+		}
+		if args[argi] == "--" {
+			break // All transformers must do this so main-flags can follow verb-flags
+		}
+		argi++
+
+		if opt == "-h" || opt == "--help" {
+			transformerInferSchemaUsage(os.Stdout)
+			os.Exit(0)
+
+		} else if opt == "--max-enum" {
+			maxEnumCount = cli.VerbGetIntArgOrDie(verb, opt, args, &argi, argc)
+
+		} else {
+			transformerInferSchemaUsage(os.Stderr)
+			os.Exit(1)
+		}
+	}
+
+	*pargi = argi
+	if !doConstruct { // All transformers must do this for main command-line parsing
+		return nil
+	}
+
+	transformer, err := NewTransformerInferSchema(
+		maxEnumCount,
+	)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	return transformer
+}
+
+// ----------------------------------------------------------------
+type TransformerInferSchema struct {
+	inferrer *utils.SchemaInferrer
+}
+
+func NewTransformerInferSchema(
+	maxEnumCount int64,
+) (*TransformerInferSchema, error) {
+	if maxEnumCount < 0 {
+		return nil, fmt.Errorf("mlr %s: --max-enum must be non-negative; got %d", verbNameInferSchema, maxEnumCount)
+	}
+	return &TransformerInferSchema{
+		inferrer: utils.NewSchemaInferrer(int(maxEnumCount)),
+	}, nil
+}
+
+// ----------------------------------------------------------------
+
+func (tr *TransformerInferSchema) Transform(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	HandleDefaultDownstreamDone(inputDownstreamDoneChannel, outputDownstreamDoneChannel)
+	if !inrecAndContext.EndOfStream {
+		tr.inferrer.Ingest(inrecAndContext.Record)
+	} else {
+		outrec := tr.inferrer.ToMlrmap()
+		outputRecordsAndContexts.PushBack(types.NewRecordAndContext(outrec, &inrecAndContext.Context))
+		outputRecordsAndContexts.PushBack(inrecAndContext) // end-of-stream marker
+	}
+}
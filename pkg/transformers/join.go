@@ -48,6 +48,9 @@ type tJoinOptions struct {
 	prepipe      string
 	prepipeIsRaw bool
 
+	// For as-of and range joins; nil for equality-only joins
+	orderedSpec *utils.JoinOrderedSpec
+
 	// These allow the joiner to have its own different format/delimiter for the left-file:
 	joinFlagOptions cli.TOptions
 }
@@ -70,6 +73,8 @@ func newJoinOptions() *tJoinOptions {
 		leftFileName: "",
 		prepipe:      "",
 		prepipeIsRaw: false,
+
+		orderedSpec: nil,
 	}
 }
 
@@ -98,6 +103,18 @@ func transformerJoinUsage(
 	fmt.Fprintf(o, "               the left file\n")
 	fmt.Fprintf(o, "  --rp {text}  Additional prefix for non-join output field names from\n")
 	fmt.Fprintf(o, "               the right file(s)\n")
+	fmt.Fprintf(o, "  --asof {a}|{a,b} As-of join: pair each right record with the one left record\n")
+	fmt.Fprintf(o, "               having the latest value of field a at or before the right record's\n")
+	fmt.Fprintf(o, "               value of field b. The field b defaults to a.\n")
+	fmt.Fprintf(o, "  --range {s,e,t} Range join: pair each right record with all left records\n")
+	fmt.Fprintf(o, "               having s <= t < e, for left-record fields s and e and right-record\n")
+	fmt.Fprintf(o, "               field t.\n")
+	fmt.Fprintf(o, "               With --asof and --range, -j is optional: if given, only left and\n")
+	fmt.Fprintf(o, "               right records with equal join-field values are paired. Values are\n")
+	fmt.Fprintf(o, "               compared numerically if numeric, else lexically (as for ISO8601\n")
+	fmt.Fprintf(o, "               timestamps). With -s, input must be sorted lexically by the join\n")
+	fmt.Fprintf(o, "               fields and then ascending by the as-of or range fields, e.g. with\n")
+	fmt.Fprintf(o, "               %s sort -f id -nf t.\n", "mlr")
 	fmt.Fprintf(o, "  --np         Do not emit paired records\n")
 	fmt.Fprintf(o, "  --ul         Emit unpaired records from the left file\n")
 	fmt.Fprintf(o, "  --ur         Emit unpaired records from the right file(s)\n")
@@ -199,6 +216,41 @@ func transformerJoinParseCLI(
 		} else if opt == "--rp" {
 			opts.rightPrefix = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
 
+		} else if opt == "--asof" {
+			if opts.orderedSpec != nil {
+				fmt.Fprintf(os.Stderr, "%s %s: only one of --asof or --range may be given.\n", "mlr", verb)
+				os.Exit(1)
+			}
+			names := cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
+			if len(names) != 1 && len(names) != 2 {
+				fmt.Fprintf(os.Stderr, "%s %s: %s needs one or two field names; got %d.\n",
+					"mlr", verb, opt, len(names))
+				os.Exit(1)
+			}
+			opts.orderedSpec = &utils.JoinOrderedSpec{
+				Mode:           utils.JOIN_ORDERED_ASOF,
+				LeftFieldName:  names[0],
+				RightFieldName: names[len(names)-1],
+			}
+
+		} else if opt == "--range" {
+			if opts.orderedSpec != nil {
+				fmt.Fprintf(os.Stderr, "%s %s: only one of --asof or --range may be given.\n", "mlr", verb)
+				os.Exit(1)
+			}
+			names := cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
+			if len(names) != 3 {
+				fmt.Fprintf(os.Stderr, "%s %s: %s needs three field names; got %d.\n",
+					"mlr", verb, opt, len(names))
+				os.Exit(1)
+			}
+			opts.orderedSpec = &utils.JoinOrderedSpec{
+				Mode:             utils.JOIN_ORDERED_RANGE,
+				LeftFieldName:    names[0],
+				LeftEndFieldName: names[1],
+				RightFieldName:   names[2],
+			}
+
 		} else if opt == "--np" {
 			opts.emitPairables = false
 
@@ -247,6 +299,13 @@ func transformerJoinParseCLI(
 		return nil
 	}
 
+	if opts.outputJoinFieldNames == nil && opts.orderedSpec != nil {
+		// As-of and range joins don't need equality join fields.
+		if opts.leftJoinFieldNames == nil && opts.rightJoinFieldNames == nil {
+			opts.outputJoinFieldNames = []string{}
+		}
+	}
+
 	if opts.outputJoinFieldNames == nil {
 		fmt.Fprintf(os.Stderr, "%s %s: need output field names\n", "mlr", verb)
 		transformerJoinUsage(os.Stderr)
@@ -301,6 +360,9 @@ type TransformerJoin struct {
 	// For sorted/doubly-streaming input
 	joinBucketKeeper *utils.JoinBucketKeeper
 
+	// For sorted/doubly-streaming input with as-of or range joins
+	joinOrderedKeeper *utils.JoinOrderedKeeper
+
 	recordTransformerFunc RecordTransformerFunc
 }
 
@@ -328,6 +390,13 @@ func NewTransformerJoin(
 		for _, name := range opts.leftJoinFieldNames {
 			tr.leftKeepFieldNameSet[name] = true
 		}
+		// Likewise the as-of or range fields, which are needed for pairing.
+		if opts.orderedSpec != nil {
+			tr.leftKeepFieldNameSet[opts.orderedSpec.LeftFieldName] = true
+			if opts.orderedSpec.Mode == utils.JOIN_ORDERED_RANGE {
+				tr.leftKeepFieldNameSet[opts.orderedSpec.LeftEndFieldName] = true
+			}
+		}
 	}
 
 	if opts.allowUnsortedInput {
@@ -335,7 +404,22 @@ func NewTransformerJoin(
 
 		tr.leftUnpairableRecordsAndContexts = list.New()
 		tr.leftBucketsByJoinFieldValues = lib.NewOrderedMap()
-		tr.recordTransformerFunc = tr.transformHalfStreaming
+		if opts.orderedSpec == nil {
+			tr.recordTransformerFunc = tr.transformHalfStreaming
+		} else {
+			tr.recordTransformerFunc = tr.transformHalfStreamingOrdered
+		}
+
+	} else if opts.orderedSpec != nil {
+		tr.joinOrderedKeeper = utils.NewJoinOrderedKeeper(
+			opts.leftFileName,
+			&opts.joinFlagOptions.ReaderOptions,
+			opts.leftJoinFieldNames,
+			tr.leftKeepFieldNameSet,
+			opts.orderedSpec,
+		)
+
+		tr.recordTransformerFunc = tr.transformDoublyStreamingOrdered
 
 	} else {
 		// Doubly-streaming (non-default) case: step left/right files forward.
@@ -470,6 +554,103 @@ func (tr *TransformerJoin) transformDoublyStreaming(
 	}
 }
 
+// ----------------------------------------------------------------
+// This is for the half-streaming case with as-of or range joins. As with
+// equality-only joins, the entire left file is ingested into buckets by
+// join-field values; then each right record is matched against the left
+// records in its bucket by as-of or range value.
+func (tr *TransformerJoin) transformHalfStreamingOrdered(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	if !tr.ingested { // First call
+		tr.ingestLeftFile()
+		tr.ingested = true
+	}
+
+	if !inrecAndContext.EndOfStream {
+		inrec := inrecAndContext.Record
+		isPaired := false
+
+		groupingKey, hasAllJoinKeys := inrec.GetSelectedValuesJoined(
+			tr.opts.rightJoinFieldNames,
+		)
+		rightValue := tr.opts.orderedSpec.GetRightValue(inrec)
+		if hasAllJoinKeys && rightValue != nil {
+			iLeftBucket := tr.leftBucketsByJoinFieldValues.Get(groupingKey)
+			if iLeftBucket != nil {
+				lefts := iLeftBucket.(*utils.JoinOrderedBucket).FindPairs(rightValue)
+				isPaired = lefts.Len() > 0
+				if isPaired && tr.opts.emitPairables {
+					tr.formAndEmitPairs(lefts, inrecAndContext, outputRecordsAndContexts)
+				}
+			}
+		}
+
+		if !isPaired && tr.opts.emitRightUnpairables {
+			outputRecordsAndContexts.PushBack(inrecAndContext)
+		}
+
+	} else { // end of record stream
+		if tr.opts.emitLeftUnpairables {
+			for pe := tr.leftBucketsByJoinFieldValues.Head; pe != nil; pe = pe.Next {
+				pe.Value.(*utils.JoinOrderedBucket).OutputUnpaireds(outputRecordsAndContexts)
+			}
+			tr.emitLeftUnpairables(outputRecordsAndContexts)
+		}
+		outputRecordsAndContexts.PushBack(inrecAndContext) // emit end-of-stream marker
+	}
+}
+
+// ----------------------------------------------------------------
+func (tr *TransformerJoin) transformDoublyStreamingOrdered(
+	rightRecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	keeper := tr.joinOrderedKeeper // keystroke-saver
+
+	if !rightRecAndContext.EndOfStream {
+		rightRec := rightRecAndContext.Record
+		isPaired := false
+
+		rightFieldValues, hasAllJoinKeys := rightRec.ReferenceSelectedValues(
+			tr.opts.rightJoinFieldNames,
+		)
+		rightValue := tr.opts.orderedSpec.GetRightValue(rightRec)
+		var lefts *list.List
+		if hasAllJoinKeys && rightValue != nil {
+			lefts = keeper.FindPairs(rightFieldValues, rightValue)
+			isPaired = lefts.Len() > 0
+		}
+		if tr.opts.emitLeftUnpairables {
+			keeper.OutputAndReleaseLeftUnpaireds(outputRecordsAndContexts)
+		} else {
+			keeper.ReleaseLeftUnpaireds()
+		}
+
+		if !isPaired && tr.opts.emitRightUnpairables {
+			outputRecordsAndContexts.PushBack(rightRecAndContext)
+		}
+
+		if isPaired && tr.opts.emitPairables {
+			tr.formAndEmitPairs(lefts, rightRecAndContext, outputRecordsAndContexts)
+		}
+
+	} else { // end of record stream
+		keeper.MarkRemainingsAsUnpaired()
+
+		if tr.opts.emitLeftUnpairables {
+			keeper.OutputAndReleaseLeftUnpaireds(outputRecordsAndContexts)
+		}
+
+		outputRecordsAndContexts.PushBack(rightRecAndContext) // emit end-of-stream marker
+	}
+}
+
 // ----------------------------------------------------------------
 // This is for the half-streaming case. We ingest the entire left file,
 // matching each right record against those.
@@ -536,7 +717,17 @@ func (tr *TransformerJoin) ingestLeftFile() {
 			groupingKey, leftFieldValues, ok := leftrec.GetSelectedValuesAndJoined(
 				tr.opts.leftJoinFieldNames,
 			)
-			if ok {
+			if ok && tr.opts.orderedSpec != nil {
+				iBucket := tr.leftBucketsByJoinFieldValues.Get(groupingKey)
+				if iBucket == nil {
+					iBucket = utils.NewJoinOrderedBucket(tr.opts.orderedSpec)
+					tr.leftBucketsByJoinFieldValues.Put(groupingKey, iBucket)
+				}
+				if !iBucket.(*utils.JoinOrderedBucket).Add(leftrecAndContext) {
+					// Lacking the as-of or range fields
+					tr.leftUnpairableRecordsAndContexts.PushBack(leftrecAndContext)
+				}
+			} else if ok {
 				iBucket := tr.leftBucketsByJoinFieldValues.Get(groupingKey)
 				if iBucket == nil { // New key-field-value: new bucket and hash-map entry
 					bucket := utils.NewJoinBucket(leftFieldValues)
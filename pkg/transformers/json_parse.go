@@ -15,10 +15,11 @@ import (
 const verbNameJSONParse = "json-parse"
 
 var JSONParseSetup = TransformerSetup{
-	Verb:         verbNameJSONParse,
-	UsageFunc:    transformerJSONParseUsage,
-	ParseCLIFunc: transformerJSONParseParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameJSONParse,
+	UsageFunc:      transformerJSONParseUsage,
+	ParseCLIFunc:   transformerJSONParseParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerJSONParseUsage(
@@ -16,10 +16,11 @@ import (
 const verbNameJSONStringify = "json-stringify"
 
 var JSONStringifySetup = TransformerSetup{
-	Verb:         verbNameJSONStringify,
-	UsageFunc:    transformerJSONStringifyUsage,
-	ParseCLIFunc: transformerJSONStringifyParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameJSONStringify,
+	UsageFunc:      transformerJSONStringifyUsage,
+	ParseCLIFunc:   transformerJSONStringifyParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerJSONStringifyUsage(
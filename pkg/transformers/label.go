@@ -15,10 +15,11 @@ import (
 const verbNameLabel = "label"
 
 var LabelSetup = TransformerSetup{
-	Verb:         verbNameLabel,
-	UsageFunc:    transformerLabelUsage,
-	ParseCLIFunc: transformerLabelParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameLabel,
+	UsageFunc:      transformerLabelUsage,
+	ParseCLIFunc:   transformerLabelParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerLabelUsage(
@@ -16,10 +16,11 @@ import (
 const verbNameLatin1ToUTF8 = "latin1-to-utf8"
 
 var Latin1ToUTF8Setup = TransformerSetup{
-	Verb:         verbNameLatin1ToUTF8,
-	UsageFunc:    transformerLatin1ToUTF8Usage,
-	ParseCLIFunc: transformerLatin1ToUTF8ParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameLatin1ToUTF8,
+	UsageFunc:      transformerLatin1ToUTF8Usage,
+	ParseCLIFunc:   transformerLatin1ToUTF8ParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerLatin1ToUTF8Usage(
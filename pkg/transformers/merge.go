@@ -0,0 +1,138 @@
+package transformers
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// ----------------------------------------------------------------
+const verbNameMerge = "merge"
+
+var MergeSetup = TransformerSetup{
+	Verb:         verbNameMerge,
+	UsageFunc:    transformerMergeUsage,
+	ParseCLIFunc: transformerMergeParseCLI,
+	IgnoresInput: false,
+}
+
+func transformerMergeUsage(
+	o *os.File,
+) {
+	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameMerge)
+	fmt.Fprintf(o,
+		`Passes records through unmodified, then at end of stream emits the records of
+the named streams written by earlier branch verbs (see mlr branch --help), in
+the order the stream names are given.
+Options:
+-n {a,b,c} Names of the streams to merge in. Required.
+-h|--help Show this message.
+Example:
+  mlr --icsv --opprint branch -n totals { stats1 -a sum -f quantity -g shape } \
+    then put '$kind = "detail"' then merge -n totals example.csv
+`)
+}
+
+func transformerMergeParseCLI(
+	pargi *int,
+	argc int,
+	args []string,
+	_ *cli.TOptions,
+	doConstruct bool, // false for first pass of CLI-parse, true for second pass
+) IRecordTransformer {
+
+	// Skip the verb name from the current spot in the mlr command line
+	argi := *pargi
+	verb := args[argi]
+	argi++
+
+	var streamNames []string = nil
+
+	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
+		opt := args[argi]
+		if !strings.HasPrefix(opt, "-") {
+			break // No more flag options to process
+		}
+		if args[argi] == "--" {
+			break // All transformers must do this so main-flags can follow verb-flags
+		}
+		argi++
+
+		if opt == "-h" || opt == "--help" {
+			transformerMergeUsage(os.Stdout)
+			os.Exit(0)
+
+		} else if opt == "-n" {
+			streamNames = cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
+
+		} else {
+			transformerMergeUsage(os.Stderr)
+			os.Exit(1)
+		}
+	}
+
+	if streamNames == nil {
+		transformerMergeUsage(os.Stderr)
+		os.Exit(1)
+	}
+
+	*pargi = argi
+	if !doConstruct { // All transformers must do this for main command-line parsing
+		return nil
+	}
+
+	transformer, err := NewTransformerMerge(streamNames)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	return transformer
+}
+
+// ----------------------------------------------------------------
+type TransformerMerge struct {
+	streamNames []string
+	// These are filled in by LinkBranchNamedStreams.
+	namedStreams []*tBranchNamedStream
+}
+
+func NewTransformerMerge(
+	streamNames []string,
+) (*TransformerMerge, error) {
+	return &TransformerMerge{
+		streamNames:  streamNames,
+		namedStreams: make([]*tBranchNamedStream, len(streamNames)),
+	}, nil
+}
+
+func (tr *TransformerMerge) Transform(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	HandleDefaultDownstreamDone(inputDownstreamDoneChannel, outputDownstreamDoneChannel)
+	if !inrecAndContext.EndOfStream {
+		outputRecordsAndContexts.PushBack(inrecAndContext)
+		return
+	}
+
+	for _, namedStream := range tr.namedStreams {
+		for e := namedStream.recordsAndContexts.Front(); e != nil; e = e.Next() {
+			recordAndContext := e.Value.(*types.RecordAndContext)
+			lib.InternalCodingErrorIf(recordAndContext.EndOfStream)
+			if recordAndContext.Record != nil {
+				// The same named stream may be merged in more than once.
+				recordAndContext = recordAndContext.Copy()
+			}
+			outputRecordsAndContexts.PushBack(recordAndContext)
+		}
+	}
+	outputRecordsAndContexts.PushBack(inrecAndContext) // end-of-stream marker
+}
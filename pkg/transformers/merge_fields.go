@@ -54,6 +54,7 @@ func transformerMergeFieldsUsage(
 	fmt.Fprintf(o, "-o {name}   Output field basename for -f/-r.\n")
 	fmt.Fprintf(o, "-k          Keep the input fields which contributed to the output statistics;\n")
 	fmt.Fprintf(o, "            the default is to omit them.\n")
+	utils.ListStats1SketchAccuracyFlags(o, "            ")
 	fmt.Fprintf(o, "\n")
 	fmt.Fprintf(o, "String-valued data make sense unless arithmetic on them is required,\n")
 	fmt.Fprintf(o, "e.g. for sum, mean, interpolated percentiles, etc. In case of mixed data,\n")
@@ -90,6 +91,8 @@ func transformerMergeFieldsParseCLI(
 	doWhich := e_MERGE_UNSPECIFIED
 	keepInputFields := false
 	doInterpolatedPercentiles := false
+	tdigestCompression := lib.DefaultTDigestCompression
+	hllPrecision := lib.DefaultHyperLogLogPrecision
 
 	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
 		opt := args[argi]
@@ -129,6 +132,11 @@ func transformerMergeFieldsParseCLI(
 		} else if opt == "-i" {
 			doInterpolatedPercentiles = true
 
+		} else if utils.ParseStats1SketchAccuracyFlag(
+			verb, opt, args, &argi, argc, &tdigestCompression, &hllPrecision,
+		) {
+			// Handled
+
 		} else if opt == "-S" {
 			// No-op pass-through for backward compatibility with Miller 5
 
@@ -171,6 +179,8 @@ func transformerMergeFieldsParseCLI(
 		doWhich,
 		doInterpolatedPercentiles,
 		keepInputFields,
+		tdigestCompression,
+		hllPrecision,
 	)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -249,6 +259,8 @@ func NewTransformerMergeFields(
 	doWhich mergeByType,
 	doInterpolatedPercentiles bool,
 	keepInputFields bool,
+	tdigestCompression float64,
+	hllPrecision int,
 ) (*TransformerMergeFields, error) {
 
 	for _, accumulatorName := range accumulatorNameList {
@@ -266,7 +278,7 @@ func NewTransformerMergeFields(
 		outputFieldBasename:       outputFieldBasename,
 		doInterpolatedPercentiles: doInterpolatedPercentiles,
 		keepInputFields:           keepInputFields,
-		accumulatorFactory:        utils.NewStats1AccumulatorFactory(),
+		accumulatorFactory:        utils.NewStats1AccumulatorFactory().WithSketchAccuracy(tdigestCompression, hllPrecision),
 		namedAccumulators:         lib.NewOrderedMap(),
 	}
 
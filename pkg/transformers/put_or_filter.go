@@ -19,19 +19,21 @@ import (
 const verbNamePut = "put"
 
 var PutSetup = TransformerSetup{
-	Verb:         verbNamePut,
-	UsageFunc:    transformerPutUsage,
-	ParseCLIFunc: transformerPutOrFilterParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNamePut,
+	UsageFunc:      transformerPutUsage,
+	ParseCLIFunc:   transformerPutOrFilterParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true, // depending on the DSL expression: see IsParallelizable
 }
 
 const verbNameFilter = "filter"
 
 var FilterSetup = TransformerSetup{
-	Verb:         verbNameFilter,
-	UsageFunc:    transformerFilterUsage,
-	ParseCLIFunc: transformerPutOrFilterParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameFilter,
+	UsageFunc:      transformerFilterUsage,
+	ParseCLIFunc:   transformerPutOrFilterParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true, // depending on the DSL expression: see IsParallelizable
 }
 
 // ----------------------------------------------------------------
@@ -196,6 +198,10 @@ func transformerPutOrFilterParseCLI(
 	argi++
 
 	var dslStrings []string = make([]string, 0)
+	// Where to look for modules imported by relative path: first, for each DSL
+	// string, the directory of the file it came from; then all of those.
+	dslImportDirectories := make([]string, 0)
+	importDirectories := make([]string, 0)
 	haveDSLStringsHere := false
 	echoDSLString := false
 	printASTAsTree := false
@@ -227,6 +233,11 @@ func transformerPutOrFilterParseCLI(
 			os.Exit(1)
 		}
 		dslStrings = append(dslStrings, theseDSLStrings...)
+		importDirectory := cst.ImportDirectoryOf(filename)
+		for range theseDSLStrings {
+			dslImportDirectories = append(dslImportDirectories, importDirectory)
+		}
+		importDirectories = append(importDirectories, importDirectory)
 	}
 
 	// Parse local flags.
@@ -268,12 +279,18 @@ func transformerPutOrFilterParseCLI(
 					os.Exit(1)
 				}
 				dslStrings = append(dslStrings, theseDSLStrings...)
+				importDirectory := cst.ImportDirectoryOf(filename)
+				for range theseDSLStrings {
+					dslImportDirectories = append(dslImportDirectories, importDirectory)
+				}
+				importDirectories = append(importDirectories, importDirectory)
 			}
 			haveDSLStringsHere = true
 
 		} else if opt == "-e" {
 			dslString := cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
 			dslStrings = append(dslStrings, dslString)
+			dslImportDirectories = append(dslImportDirectories, ".")
 			haveDSLStringsHere = true
 
 		} else if opt == "-s" {
@@ -351,6 +368,7 @@ func transformerPutOrFilterParseCLI(
 		}
 		dslString := args[argi]
 		dslStrings = append(dslStrings, dslString)
+		dslImportDirectories = append(dslImportDirectories, ".")
 		argi++
 	}
 
@@ -364,8 +382,13 @@ func transformerPutOrFilterParseCLI(
 		dslInstanceType = cst.DSLInstanceTypeFilter
 	}
 
+	// Imports in -e expressions are relative to the current directory.
+	importDirectories = append(importDirectories, ".")
+
 	transformer, err := NewTransformerPut(
 		dslStrings,
+		dslImportDirectories,
+		importDirectories,
 		dslInstanceType,
 		presets,
 		echoDSLString,
@@ -396,10 +419,13 @@ type TransformerPut struct {
 	invertFilter         bool
 	suppressOutputRecord bool
 	executedBeginBlocks  bool
+	parallelizable       bool
 }
 
 func NewTransformerPut(
 	dslStrings []string,
+	dslImportDirectories []string,
+	importDirectories []string,
 	dslInstanceType cst.DSLInstanceType,
 	presets []string,
 	echoDSLString bool,
@@ -415,10 +441,13 @@ func NewTransformerPut(
 	options *cli.TOptions,
 ) (*TransformerPut, error) {
 
-	cstRootNode := cst.NewEmptyRoot(&options.WriterOptions, dslInstanceType).WithStrictMode(strictMode)
+	cstRootNode := cst.NewEmptyRoot(&options.WriterOptions, dslInstanceType).
+		WithStrictMode(strictMode).
+		WithImportDirectories(importDirectories)
 
 	hadWarnings, err := cstRootNode.Build(
 		dslStrings,
+		dslImportDirectories,
 		dslInstanceType,
 		false, // isReplImmediate
 		doWarnings,
@@ -489,9 +518,18 @@ func NewTransformerPut(
 		invertFilter:         invertFilter,
 		suppressOutputRecord: suppressOutputRecord,
 		executedBeginBlocks:  false,
+		// With -v etc., constructing more instances for --workers would print
+		// the AST again for each.
+		parallelizable: !cstRootNode.HasCrossRecordState() &&
+			!echoDSLString && !printASTAsTree && !printASTMultiLine && !printASTSingleLine,
 	}, nil
 }
 
+// IsParallelizable is for mlr --workers.
+func (tr *TransformerPut) IsParallelizable() bool {
+	return tr.parallelizable
+}
+
 func (tr *TransformerPut) Transform(
 	inrecAndContext *types.RecordAndContext,
 	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
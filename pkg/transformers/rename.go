@@ -16,10 +16,11 @@ import (
 const verbNameRename = "rename"
 
 var RenameSetup = TransformerSetup{
-	Verb:         verbNameRename,
-	UsageFunc:    transformerRenameUsage,
-	ParseCLIFunc: transformerRenameParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameRename,
+	UsageFunc:      transformerRenameUsage,
+	ParseCLIFunc:   transformerRenameParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerRenameUsage(
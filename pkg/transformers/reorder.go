@@ -17,10 +17,11 @@ import (
 const verbNameReorder = "reorder"
 
 var ReorderSetup = TransformerSetup{
-	Verb:         verbNameReorder,
-	UsageFunc:    transformerReorderUsage,
-	ParseCLIFunc: transformerReorderParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameReorder,
+	UsageFunc:      transformerReorderUsage,
+	ParseCLIFunc:   transformerReorderParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerReorderUsage(
@@ -15,10 +15,11 @@ import (
 const verbNameSec2GMT = "sec2gmt"
 
 var Sec2GMTSetup = TransformerSetup{
-	Verb:         verbNameSec2GMT,
-	UsageFunc:    transformerSec2GMTUsage,
-	ParseCLIFunc: transformerSec2GMTParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameSec2GMT,
+	UsageFunc:      transformerSec2GMTUsage,
+	ParseCLIFunc:   transformerSec2GMTParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerSec2GMTUsage(
@@ -15,10 +15,11 @@ import (
 const verbNameSec2GMTDate = "sec2gmtdate"
 
 var Sec2GMTDateSetup = TransformerSetup{
-	Verb:         verbNameSec2GMTDate,
-	UsageFunc:    transformerSec2GMTDateUsage,
-	ParseCLIFunc: transformerSec2GMTDateParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameSec2GMTDate,
+	UsageFunc:      transformerSec2GMTDateUsage,
+	ParseCLIFunc:   transformerSec2GMTDateParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerSec2GMTDateUsage(
@@ -37,11 +37,19 @@
 // * Note in particular that string keys ["a":"red","x":"1"] and
 //   ["a":"red","x":"1.0"] map to different groups, but will sort equally.
 //
+// * With --max-records-in-memory, whenever that many records have been
+//   ingested, the groups are sorted as above and written out to a temp file
+//   as a sorted run, and the hash map is cleared. At end of stream the sorted
+//   runs are k-way merged, using the same comparators, and the output is sent
+//   downstream a batch at a time. Ties go to the earlier run, so the sort
+//   remains stable.
+//
 // ================================================================
 
 package transformers
 
 import (
+	"container/heap"
 	"container/list"
 	"fmt"
 	"os"
@@ -51,6 +59,7 @@ import (
 	"github.com/johnkerl/miller/v6/pkg/cli"
 	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/transformers/utils"
 	"github.com/johnkerl/miller/v6/pkg/types"
 )
 
@@ -84,6 +93,10 @@ func transformerSortUsage(
 	fmt.Fprintf(o, "-nr {comma-separated field names}  Numerical descending; nulls sort first\n")
 	fmt.Fprintf(o, "-t  {comma-separated field names}  Natural ascending\n")
 	fmt.Fprintf(o, "-tr|-rt {comma-separated field names}  Natural descending\n")
+	fmt.Fprintf(o, "--max-records-in-memory {n} Hold at most this many records in memory, spilling\n")
+	fmt.Fprintf(o, "   sorted runs of records to temp files and merging them at end of stream. This\n")
+	fmt.Fprintf(o, "   is for inputs too large to sort in memory. Temp files are written to $TMPDIR\n")
+	fmt.Fprintf(o, "   if set, else the system default such as /tmp.\n")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 	fmt.Fprintf(o, "\n")
 	fmt.Fprintf(o, "Example:\n")
@@ -107,6 +120,7 @@ func transformerSortParseCLI(
 
 	groupByFieldNames := make([]string, 0)
 	comparatorFuncs := make([]mlrval.CmpFuncInt, 0)
+	maxRecordsInMemory := int64(0)
 
 	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
 		opt := args[argi]
@@ -255,6 +269,13 @@ func transformerSortParseCLI(
 				comparatorFuncs = append(comparatorFuncs, mlrval.NumericDescendingComparator)
 			}
 
+		} else if opt == "--max-records-in-memory" {
+			maxRecordsInMemory = cli.VerbGetIntArgOrDie(verb, opt, args, &argi, argc)
+			if maxRecordsInMemory <= 0 {
+				fmt.Fprintf(os.Stderr, "mlr %s: %s argument must be positive; got %d.\n", verb, opt, maxRecordsInMemory)
+				os.Exit(1)
+			}
+
 		} else {
 			transformerSortUsage(os.Stderr)
 			os.Exit(1)
@@ -274,6 +295,7 @@ func transformerSortParseCLI(
 	transformer, err := NewTransformerSort(
 		groupByFieldNames,
 		comparatorFuncs,
+		maxRecordsInMemory,
 	)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -311,11 +333,29 @@ type TransformerSort struct {
 	// Map from string to []*lib.Mlrval:
 	groupHeads *lib.OrderedMap
 	spillGroup *list.List // e.g. sort by field "a" -- this is for records lacking a field named "a"
+
+	// -- External-memory state, for --max-records-in-memory
+	maxRecordsInMemory int64 // 0 means no limit
+	numRecordsInMemory int64
+	sortedRuns         []*utils.SortRun
+	mergedRuns         []*utils.SortRun       // output of the current merge pass
+	runWriter          *utils.SortRunWriter   // the run being written, if any
+	mergeReaders       []*utils.SortRunReader // the runs being merged
+	spillGroupRun      *utils.SortRunWriter   // spillGroup records, once any have been written out
+	spillGroupReader   *utils.SortRunReader
+	mergeHeap          *sortRunMergeHeap
+	endOfStreamMarker  *types.RecordAndContext
 }
 
+// sortMergeFanIn is how many sorted runs are merged at once. With more runs
+// than this, they're merged in passes, so that only this many temp files are
+// open at a time.
+const sortMergeFanIn = 64
+
 func NewTransformerSort(
 	groupByFieldNames []string,
 	comparatorFuncs []mlrval.CmpFuncInt,
+	maxRecordsInMemory int64,
 ) (*TransformerSort, error) {
 
 	tr := &TransformerSort{
@@ -325,6 +365,14 @@ func NewTransformerSort(
 		recordListsByGroup: lib.NewOrderedMap(),
 		groupHeads:         lib.NewOrderedMap(),
 		spillGroup:         list.New(),
+
+		maxRecordsInMemory: maxRecordsInMemory,
+		numRecordsInMemory: 0,
+		sortedRuns:         make([]*utils.SortRun, 0),
+		spillGroupRun:      nil,
+		spillGroupReader:   nil,
+		mergeHeap:          nil,
+		endOfStreamMarker:  nil,
 	}
 
 	return tr, nil
@@ -351,17 +399,28 @@ func (tr *TransformerSort) Transform(
 		)
 		if !ok {
 			tr.spillGroup.PushBack(inrecAndContext)
-			return
+		} else {
+			recordListForGroup := tr.recordListsByGroup.Get(groupingKey)
+			if recordListForGroup == nil {
+				recordListForGroup = list.New()
+				tr.recordListsByGroup.Put(groupingKey, recordListForGroup)
+				tr.groupHeads.Put(groupingKey, selectedValues)
+			}
+
+			recordListForGroup.(*list.List).PushBack(inrecAndContext)
 		}
 
-		recordListForGroup := tr.recordListsByGroup.Get(groupingKey)
-		if recordListForGroup == nil {
-			recordListForGroup = list.New()
-			tr.recordListsByGroup.Put(groupingKey, recordListForGroup)
-			tr.groupHeads.Put(groupingKey, selectedValues)
+		tr.numRecordsInMemory++
+		if tr.maxRecordsInMemory > 0 && tr.numRecordsInMemory >= tr.maxRecordsInMemory {
+			tr.writeSortedRun()
 		}
 
-		recordListForGroup.(*list.List).PushBack(inrecAndContext)
+	} else if len(tr.sortedRuns) > 0 || tr.spillGroupRun != nil { // End of record stream, with runs on disk
+		// Write out what's left as the last run, then merge the runs a batch
+		// at a time in EndOfStreamBatch.
+		tr.writeSortedRun()
+		tr.startMerge()
+		tr.endOfStreamMarker = inrecAndContext
 
 	} else { // End of record stream
 
@@ -379,33 +438,10 @@ func (tr *TransformerSort) Transform(
 		//   [ "eks,2", ["eks', 2]
 		// ]
 
-		groupingKeysAndMlrvals := groupHeadsToArray(tr.groupHeads)
-
-		// Go sort API: for ascending sort, return true if element i < element j.
-		sort.Slice(groupingKeysAndMlrvals, func(i, j int) bool {
-			for k, comparator := range tr.comparatorFuncs {
-				result := comparator(
-					groupingKeysAndMlrvals[i].mlrvals[k],
-					groupingKeysAndMlrvals[j].mlrvals[k],
-				)
-				if result < 0 {
-					return true
-				} else if result > 0 {
-					return false
-				}
-			}
-			return false
+		tr.forEachSortedRecord(func(recordAndContext *types.RecordAndContext) {
+			outputRecordsAndContexts.PushBack(recordAndContext)
 		})
 
-		// Now output the groups
-		for _, groupingKeyAndMlrvals := range groupingKeysAndMlrvals {
-			iRecordsInGroup := tr.recordListsByGroup.Get(groupingKeyAndMlrvals.groupingKey)
-			recordsInGroup := iRecordsInGroup.(*list.List)
-			for iRecord := recordsInGroup.Front(); iRecord != nil; iRecord = iRecord.Next() {
-				outputRecordsAndContexts.PushBack(iRecord.Value.(*types.RecordAndContext))
-			}
-		}
-
 		for iRecord := tr.spillGroup.Front(); iRecord != nil; iRecord = iRecord.Next() {
 			outputRecordsAndContexts.PushBack(iRecord.Value.(*types.RecordAndContext))
 		}
@@ -414,6 +450,330 @@ func (tr *TransformerSort) Transform(
 	}
 }
 
+// forEachSortedRecord sorts the groups currently in memory, then visits their
+// records in sorted order.
+func (tr *TransformerSort) forEachSortedRecord(
+	visitor func(recordAndContext *types.RecordAndContext),
+) {
+	groupingKeysAndMlrvals := groupHeadsToArray(tr.groupHeads)
+
+	// Go sort API: for ascending sort, return true if element i < element j.
+	sort.Slice(groupingKeysAndMlrvals, func(i, j int) bool {
+		return tr.compareSelectedValues(
+			groupingKeysAndMlrvals[i].mlrvals,
+			groupingKeysAndMlrvals[j].mlrvals,
+		) < 0
+	})
+
+	// Now output the groups
+	for _, groupingKeyAndMlrvals := range groupingKeysAndMlrvals {
+		iRecordsInGroup := tr.recordListsByGroup.Get(groupingKeyAndMlrvals.groupingKey)
+		recordsInGroup := iRecordsInGroup.(*list.List)
+		for iRecord := recordsInGroup.Front(); iRecord != nil; iRecord = iRecord.Next() {
+			visitor(iRecord.Value.(*types.RecordAndContext))
+		}
+	}
+}
+
+// compareSelectedValues walks through the sort-key values one slot at a
+// time, returning the first difference.
+func (tr *TransformerSort) compareSelectedValues(a, b []*mlrval.Mlrval) int {
+	for k, comparator := range tr.comparatorFuncs {
+		result := comparator(a[k], b[k])
+		if result != 0 {
+			return result
+		}
+	}
+	return 0
+}
+
+// ----------------------------------------------------------------
+// External-memory sort
+
+// writeSortedRun sorts the records in memory and writes them to a temp file,
+// freeing up their memory. Records lacking sort keys are appended, in arrival
+// order, to a temp file of their own.
+func (tr *TransformerSort) writeSortedRun() {
+	if tr.recordListsByGroup.FieldCount > 0 {
+		tr.newRunWriter()
+		tr.forEachSortedRecord(func(recordAndContext *types.RecordAndContext) {
+			tr.writeToRun(recordAndContext)
+		})
+		tr.sortedRuns = append(tr.sortedRuns, tr.finishRunWriter())
+	}
+
+	if tr.spillGroup.Len() > 0 {
+		if tr.spillGroupRun == nil {
+			runWriter, err := utils.NewSortRunWriter()
+			if err != nil {
+				tr.exitOnError(err)
+			}
+			tr.spillGroupRun = runWriter
+		}
+		for iRecord := tr.spillGroup.Front(); iRecord != nil; iRecord = iRecord.Next() {
+			err := tr.spillGroupRun.Write(iRecord.Value.(*types.RecordAndContext))
+			if err != nil {
+				tr.exitOnError(err)
+			}
+		}
+	}
+
+	tr.recordListsByGroup = lib.NewOrderedMap()
+	tr.groupHeads = lib.NewOrderedMap()
+	tr.spillGroup = list.New()
+	tr.numRecordsInMemory = 0
+}
+
+func (tr *TransformerSort) newRunWriter() {
+	runWriter, err := utils.NewSortRunWriter()
+	if err != nil {
+		tr.exitOnError(err)
+	}
+	tr.runWriter = runWriter
+}
+
+func (tr *TransformerSort) writeToRun(recordAndContext *types.RecordAndContext) {
+	err := tr.runWriter.Write(recordAndContext)
+	if err != nil {
+		tr.exitOnError(err)
+	}
+}
+
+func (tr *TransformerSort) finishRunWriter() *utils.SortRun {
+	run, err := tr.runWriter.Finish()
+	tr.runWriter = nil
+	if err != nil {
+		tr.exitOnError(err)
+	}
+	return run
+}
+
+// startMerge merges the sorted runs, sortMergeFanIn at a time, until there are
+// few enough of them to be open at once. Then it primes the merge heap with
+// the first record of each.
+func (tr *TransformerSort) startMerge() {
+	for len(tr.sortedRuns) > sortMergeFanIn {
+		// Runs are merged in consecutive groups, and the merged runs are kept
+		// in the same order, since ties are broken by run order.
+		tr.mergedRuns = make([]*utils.SortRun, 0, len(tr.sortedRuns)/sortMergeFanIn+1)
+		for len(tr.sortedRuns) > 0 {
+			n := len(tr.sortedRuns)
+			if n > sortMergeFanIn {
+				n = sortMergeFanIn
+			}
+			runs := tr.sortedRuns[:n]
+			tr.sortedRuns = tr.sortedRuns[n:]
+			tr.mergedRuns = append(tr.mergedRuns, tr.mergeRuns(runs))
+		}
+		tr.sortedRuns = tr.mergedRuns
+		tr.mergedRuns = nil
+	}
+
+	runs := tr.sortedRuns
+	tr.sortedRuns = nil
+	tr.openMerge(runs)
+}
+
+// mergeRuns merges runs into a single run.
+func (tr *TransformerSort) mergeRuns(runs []*utils.SortRun) *utils.SortRun {
+	tr.newRunWriter()
+	tr.openMerge(runs)
+	for tr.mergeHeap.Len() > 0 {
+		tr.writeToRun(tr.nextMergedRecord())
+	}
+	tr.closeMerge()
+	return tr.finishRunWriter()
+}
+
+// openMerge opens the runs for reading, and primes the merge heap with the
+// first record of each. The readers remove the runs' temp files when closed.
+func (tr *TransformerSort) openMerge(runs []*utils.SortRun) {
+	tr.mergeReaders = make([]*utils.SortRunReader, 0, len(runs))
+	for i, run := range runs {
+		runReader, err := run.Open()
+		if err != nil {
+			for _, unopenedRun := range runs[i:] {
+				unopenedRun.Remove()
+			}
+			tr.exitOnError(err)
+		}
+		tr.mergeReaders = append(tr.mergeReaders, runReader)
+	}
+
+	tr.mergeHeap = &sortRunMergeHeap{
+		entries: make([]*sortRunMergeEntry, 0, len(tr.mergeReaders)),
+		tr:      tr,
+	}
+	for runIndex := range tr.mergeReaders {
+		entry := tr.readMergeEntry(runIndex)
+		if entry != nil {
+			tr.mergeHeap.entries = append(tr.mergeHeap.entries, entry)
+		}
+	}
+	heap.Init(tr.mergeHeap)
+}
+
+func (tr *TransformerSort) closeMerge() {
+	for _, runReader := range tr.mergeReaders {
+		runReader.Close()
+	}
+	tr.mergeReaders = nil
+	tr.mergeHeap = nil
+}
+
+// nextMergedRecord takes the least record off the merge heap, replacing it
+// with the next one from the same run.
+func (tr *TransformerSort) nextMergedRecord() *types.RecordAndContext {
+	entry := tr.mergeHeap.entries[0]
+	next := tr.readMergeEntry(entry.runIndex)
+	if next != nil {
+		tr.mergeHeap.entries[0] = next
+		heap.Fix(tr.mergeHeap, 0)
+	} else {
+		heap.Pop(tr.mergeHeap)
+	}
+	return entry.recordAndContext
+}
+
+// EndOfStreamBatch is for the IEndOfStreamBatcher interface. It sends along
+// the next batch of merged records; after those come the records lacking sort
+// keys, then the end-of-stream marker.
+func (tr *TransformerSort) EndOfStreamBatch(
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+) bool {
+	if tr.mergeHeap == nil {
+		// Everything fit in memory and was output by Transform.
+		return true
+	}
+
+	for tr.mergeHeap.Len() > 0 {
+		if outputRecordsAndContexts.Len() >= cli.DEFAULT_RECORDS_PER_BATCH {
+			return false
+		}
+		outputRecordsAndContexts.PushBack(tr.nextMergedRecord())
+	}
+
+	if tr.spillGroupRun != nil {
+		run, err := tr.spillGroupRun.Finish()
+		tr.spillGroupRun = nil
+		if err != nil {
+			tr.exitOnError(err)
+		}
+		tr.spillGroupReader, err = run.Open()
+		if err != nil {
+			run.Remove()
+			tr.exitOnError(err)
+		}
+	}
+
+	if tr.spillGroupReader != nil {
+		for outputRecordsAndContexts.Len() < cli.DEFAULT_RECORDS_PER_BATCH {
+			recordAndContext, err := tr.spillGroupReader.Read()
+			if err != nil {
+				tr.exitOnError(err)
+			}
+			if recordAndContext == nil {
+				tr.spillGroupReader.Close()
+				tr.spillGroupReader = nil
+				break
+			}
+			outputRecordsAndContexts.PushBack(recordAndContext)
+		}
+		if tr.spillGroupReader != nil {
+			return false
+		}
+	}
+
+	tr.closeMerge()
+
+	outputRecordsAndContexts.PushBack(tr.endOfStreamMarker)
+	return true
+}
+
+// readMergeEntry gets the next record from the given run, or nil at end of run.
+func (tr *TransformerSort) readMergeEntry(runIndex int) *sortRunMergeEntry {
+	recordAndContext, err := tr.mergeReaders[runIndex].Read()
+	if err != nil {
+		tr.exitOnError(err)
+	}
+	if recordAndContext == nil {
+		return nil
+	}
+	// Every record in a sorted run has all the sort keys.
+	_, selectedValues, _ := recordAndContext.Record.GetSelectedValuesAndJoined(tr.groupByFieldNames)
+	return &sortRunMergeEntry{
+		recordAndContext: recordAndContext,
+		selectedValues:   selectedValues,
+		runIndex:         runIndex,
+	}
+}
+
+// exitOnError removes all the temp files before exiting.
+func (tr *TransformerSort) exitOnError(err error) {
+	if tr.runWriter != nil {
+		tr.runWriter.Remove()
+	}
+	if tr.spillGroupRun != nil {
+		tr.spillGroupRun.Remove()
+	}
+	if tr.spillGroupReader != nil {
+		tr.spillGroupReader.Close()
+	}
+	for _, runReader := range tr.mergeReaders {
+		runReader.Close()
+	}
+	for _, run := range tr.sortedRuns {
+		run.Remove()
+	}
+	for _, run := range tr.mergedRuns {
+		run.Remove()
+	}
+
+	fmt.Fprintf(os.Stderr, "mlr %s: %v\n", verbNameSort, err)
+	os.Exit(1)
+}
+
+// sortRunMergeHeap is a min-heap, for container/heap, of the current head
+// record of each sorted run.
+type sortRunMergeEntry struct {
+	recordAndContext *types.RecordAndContext
+	selectedValues   []*mlrval.Mlrval
+	runIndex         int
+}
+
+type sortRunMergeHeap struct {
+	entries []*sortRunMergeEntry
+	tr      *TransformerSort
+}
+
+func (h *sortRunMergeHeap) Len() int {
+	return len(h.entries)
+}
+
+func (h *sortRunMergeHeap) Less(i, j int) bool {
+	result := h.tr.compareSelectedValues(h.entries[i].selectedValues, h.entries[j].selectedValues)
+	if result != 0 {
+		return result < 0
+	}
+	// Earlier runs hold earlier records
+	return h.entries[i].runIndex < h.entries[j].runIndex
+}
+
+func (h *sortRunMergeHeap) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+}
+
+func (h *sortRunMergeHeap) Push(x any) {
+	h.entries = append(h.entries, x.(*sortRunMergeEntry))
+}
+
+func (h *sortRunMergeHeap) Pop() any {
+	n := len(h.entries)
+	entry := h.entries[n-1]
+	h.entries = h.entries[:n-1]
+	return entry
+}
+
 func groupHeadsToArray(groupHeads *lib.OrderedMap) []GroupingKeysAndMlrvals {
 	retval := make([]GroupingKeysAndMlrvals, groupHeads.FieldCount)
 
@@ -14,10 +14,11 @@ import (
 const verbNameSortWithinRecords = "sort-within-records"
 
 var SortWithinRecordsSetup = TransformerSetup{
-	Verb:         verbNameSortWithinRecords,
-	UsageFunc:    transformerSortWithinRecordsUsage,
-	ParseCLIFunc: transformerSortWithinRecordsParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameSortWithinRecords,
+	UsageFunc:      transformerSortWithinRecordsUsage,
+	ParseCLIFunc:   transformerSortWithinRecordsParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerSortWithinRecordsUsage(
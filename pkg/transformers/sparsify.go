@@ -16,10 +16,11 @@ import (
 const verbNameSparsify = "sparsify"
 
 var SparsifySetup = TransformerSetup{
-	Verb:         verbNameSparsify,
-	UsageFunc:    transformerSparsifyUsage,
-	ParseCLIFunc: transformerSparsifyParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameSparsify,
+	UsageFunc:      transformerSparsifyUsage,
+	ParseCLIFunc:   transformerSparsifyParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerSparsifyUsage(
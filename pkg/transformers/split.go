@@ -1,10 +1,12 @@
 package transformers
 
 import (
+	"bufio"
 	"container/list"
 	"fmt"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/johnkerl/miller/v6/pkg/cli"
@@ -41,6 +43,8 @@ Exactly one  of -m, -n, or -g must be supplied.
 -v           Send records along to downstream verbs as well as splitting to files.
 -e           Do NOT URL-escape names of output files.
 -j {J}       Use string J to join filename parts; default "`+splitDefaultFileNamePartJoiner+`".
+--sheets     With --oxlsx: write one workbook, {prefix}.{suffix}, with a sheet per
+             file which would otherwise be written, named without the prefix.
 -h|--help    Show this message.
 Any of the output-format command-line flags (see mlr -h). For example, using
   mlr --icsv --from myfile.csv split --ojson -n 1000
@@ -68,6 +72,10 @@ If the color field has values yellow and green, and the shape field has values t
 then there will be split_yellow_triangle.csv, split_yellow_square.csv, etc.
   mlr --csv --from myfile.csv split -g color,shape
 
+If the shape field has values triangle and square, then there will be split.xlsx with
+sheets named triangle and square.
+  mlr --icsv --from myfile.csv split --oxlsx --sheets -g shape
+
 See also the "tee" DSL function which lets you do more ad-hoc customization.
 `)
 }
@@ -93,6 +101,7 @@ func transformerSplitParseCLI(
 	var escapeFileNameCharacters bool = true
 	var fileNamePartJoiner string = splitDefaultFileNamePartJoiner
 	var doAppend bool = false
+	var doSheets bool = false
 	var outputFileNamePrefix string = splitDefaultOutputFileNamePrefix
 	var outputFileNameSuffix string = "uninit"
 	haveOutputFileNameSuffix := false
@@ -139,6 +148,9 @@ func transformerSplitParseCLI(
 		} else if opt == "-a" {
 			doAppend = true
 
+		} else if opt == "--sheets" {
+			doSheets = true
+
 		} else if opt == "-v" {
 			emitDownstream = true
 
@@ -175,6 +187,16 @@ func transformerSplitParseCLI(
 	}
 
 	cli.FinalizeWriterOptions(&localOptions.WriterOptions)
+	if doSheets {
+		if localOptions.WriterOptions.OutputFileFormat != "xlsx" {
+			fmt.Fprintf(os.Stderr, "mlr %s: --sheets is only for XLSX output.\n", verb)
+			os.Exit(1)
+		}
+		if doAppend {
+			fmt.Fprintf(os.Stderr, "mlr %s: --sheets and -a are mutually exclusive.\n", verb)
+			os.Exit(1)
+		}
+	}
 	if !haveOutputFileNameSuffix {
 		outputFileNameSuffix = localOptions.WriterOptions.OutputFileFormat
 	}
@@ -193,6 +215,7 @@ func transformerSplitParseCLI(
 		escapeFileNameCharacters,
 		fileNamePartJoiner,
 		doAppend,
+		doSheets,
 		outputFileNamePrefix,
 		outputFileNameSuffix,
 		&localOptions.WriterOptions,
@@ -225,6 +248,10 @@ type TransformerSplit struct {
 	// For all other cases: multiple files open at a time
 	outputHandlerManager output.OutputHandlerManager
 
+	// For --sheets: one workbook, with sheets in place of files
+	sheetsWriter  *output.RecordWriterXLSX
+	sheetNameFunc func(inrec *mlrval.Mlrmap) string
+
 	recordTransformerFunc RecordTransformerFunc
 }
 
@@ -237,6 +264,7 @@ func NewTransformerSplit(
 	escapeFileNameCharacters bool,
 	fileNamePartJoiner string,
 	doAppend bool,
+	doSheets bool,
 	outputFileNamePrefix string,
 	outputFileNameSuffix string,
 	recordWriterOptions *cli.TWriterOptions,
@@ -260,7 +288,21 @@ func NewTransformerSplit(
 
 	tr.outputHandlerManager = output.NewFileOutputHandlerManager(recordWriterOptions, doAppend)
 
-	if groupByFieldNames != nil {
+	if doSheets {
+		sheetsWriter, err := output.NewRecordWriterXLSX(recordWriterOptions)
+		if err != nil {
+			return nil, err
+		}
+		tr.sheetsWriter = sheetsWriter
+		if groupByFieldNames != nil {
+			tr.sheetNameFunc = tr.makeGroupedSheetName
+		} else if doMod {
+			tr.sheetNameFunc = tr.makeModSheetName
+		} else {
+			tr.sheetNameFunc = tr.makeSizeSheetName
+		}
+		tr.recordTransformerFunc = tr.splitToSheets
+	} else if groupByFieldNames != nil {
 		tr.recordTransformerFunc = tr.splitGrouped
 	} else if doMod {
 		tr.recordTransformerFunc = tr.splitModUngrouped
@@ -411,6 +453,85 @@ func (tr *TransformerSplit) splitGrouped(
 	}
 }
 
+// splitToSheets is for --sheets, where the records all go into one workbook,
+// which is written at end of stream.
+func (tr *TransformerSplit) splitToSheets(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	if !inrecAndContext.EndOfStream {
+		tr.sheetsWriter.WriteToSheet(inrecAndContext.Record, tr.sheetNameFunc(inrecAndContext.Record))
+
+		if tr.emitDownstream {
+			outputRecordsAndContexts.PushBack(inrecAndContext)
+		}
+
+		tr.ungroupedCounter++
+
+	} else {
+		outputRecordsAndContexts.PushBack(inrecAndContext) // emit end-of-stream marker
+
+		// As without --sheets, there's no output file if there were no records.
+		if tr.ungroupedCounter == 0 {
+			return
+		}
+		filename := tr.outputFileNamePrefix + "." + tr.outputFileNameSuffix
+		err := tr.writeWorkbook(filename)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "mlr: file-write error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+}
+
+func (tr *TransformerSplit) writeWorkbook(filename string) error {
+	handle, err := os.OpenFile(
+		filename,
+		os.O_CREATE|os.O_WRONLY|os.O_TRUNC,
+		0644,
+	)
+	if err != nil {
+		return err
+	}
+	bufferedOutputStream := bufio.NewWriter(handle)
+	err = tr.sheetsWriter.WriteWorkbook(bufferedOutputStream)
+	if err == nil {
+		err = bufferedOutputStream.Flush()
+	}
+	if err != nil {
+		handle.Close()
+		return err
+	}
+	return handle.Close()
+}
+
+// makeGroupedSheetName example: "orange". Sheet names aren't URL-escaped, as
+// file names are; the XLSX writer replaces characters Excel disallows.
+func (tr *TransformerSplit) makeGroupedSheetName(inrec *mlrval.Mlrmap) string {
+	groupByFieldValues, ok := inrec.GetSelectedValues(tr.groupByFieldNames)
+	if !ok {
+		return "ungrouped"
+	}
+	var sheetNameParts []string
+	for _, groupByFieldValue := range groupByFieldValues {
+		sheetNameParts = append(sheetNameParts, groupByFieldValue.String())
+	}
+	return strings.Join(sheetNameParts, tr.fileNamePartJoiner)
+}
+
+// makeModSheetName example: "3", for the third of the sheets which -m
+// round-robins among.
+func (tr *TransformerSplit) makeModSheetName(inrec *mlrval.Mlrmap) string {
+	return strconv.FormatInt(1+(tr.ungroupedCounter%tr.n), 10)
+}
+
+// makeSizeSheetName example: "3", for the third sheet of -n records.
+func (tr *TransformerSplit) makeSizeSheetName(inrec *mlrval.Mlrmap) string {
+	return strconv.FormatInt(1+(tr.ungroupedCounter/tr.n), 10)
+}
+
 // makeUngroupedOutputFileName example: "split_53.csv"
 func (tr *TransformerSplit) makeUngroupedOutputFileName(k int64) string {
 	return fmt.Sprintf("%s_%d.%s", tr.outputFileNamePrefix, k, tr.outputFileNameSuffix)
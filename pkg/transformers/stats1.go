@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/johnkerl/miller/v6/pkg/bifs"
 	"github.com/johnkerl/miller/v6/pkg/cli"
 	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
@@ -55,7 +56,17 @@ Options:
 
 -i             Use interpolated percentiles, like R's type=7; default like type=1.
                Not sensical for string-valued fields.\n");
--s             Print iterative stats. Useful in tail -f contexts, in which
+`)
+	utils.ListStats1SketchAccuracyFlags(o, "               ")
+	fmt.Fprint(o, `--emit-state   Output each accumulator's mergeable state, rather than its value,
+               for later use with --merge. E.g. for x_mean this is the count and
+               sum of x rather than their quotient.
+--merge        Input field names are as output by --emit-state, e.g. x_mean for
+               -a mean -f x, and their states are merged. With --emit-state as
+               well, the merged states are output for further merging; else,
+               final values are output.
+`)
+	fmt.Fprint(o, `-s             Print iterative stats. Useful in tail -f contexts, in which
                case please avoid pprint-format output since end of input
 `)
 	fmt.Fprintln(o, "               stream will never be seen. Likewise, if input is coming from `tail -f`")
@@ -73,18 +84,29 @@ Options:
 	fmt.Fprintln(o,
 		`        This computes count and mode statistics on all field names beginning
          with a through h, grouped by all field names starting with k.`)
+	fmt.Fprintln(o,
+		"Example: mlr stats1 --emit-state -a mean,approx_p90 -f x -g a hourly-*.csv > states.csv")
+	fmt.Fprintln(o,
+		"         mlr stats1 --merge -a mean,approx_p90 -f x -g a states.csv")
 	fmt.Println()
 	fmt.Fprint(o,
 		`Notes:
 * p50 and median are synonymous.
 * min and max output the same results as p0 and p100, respectively, but use
   less memory.
+* approx_p50 etc. use a t-digest, and approx_distinct_count uses HyperLogLog,
+  whose memory use doesn't grow with the number of records. They are for when
+  p50 or distinct_count would run out of memory on large input.
 * String-valued data make sense unless arithmetic on them is required,
   e.g. for sum, mean, interpolated percentiles, etc. In case of mixed data,
   numbers are less than strings.
 * count and mode allow text input; the rest require numeric input.
   In particular, 1 and 1.0 are distinct text for count and mode.
 * When there are mode ties, the first-encountered datum wins.
+* --emit-state and --merge are for map-reduce-style rollups: e.g. compute
+  states per hourly file, then merge those into daily statistics. The median,
+  percentiles, and mad are not mergeable: please use approx_median,
+  approx_p25, etc. for those.
 `)
 }
 
@@ -112,6 +134,10 @@ func transformerStats1ParseCLI(
 
 	doInterpolatedPercentiles := false
 	doIterativeStats := false
+	tdigestCompression := lib.DefaultTDigestCompression
+	hllPrecision := lib.DefaultHyperLogLogPrecision
+	doEmitStates := false
+	doMergeStates := false
 
 	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
 		opt := args[argi]
@@ -165,6 +191,17 @@ func transformerStats1ParseCLI(
 		} else if opt == "-s" {
 			doIterativeStats = true
 
+		} else if opt == "--emit-state" {
+			doEmitStates = true
+
+		} else if opt == "--merge" {
+			doMergeStates = true
+
+		} else if utils.ParseStats1SketchAccuracyFlag(
+			verb, opt, args, &argi, argc, &tdigestCompression, &hllPrecision,
+		) {
+			// Handled
+
 		} else if opt == "-S" {
 			// No-op pass-through for backward compatibility with Miller 5
 
@@ -206,6 +243,10 @@ func transformerStats1ParseCLI(
 
 		doInterpolatedPercentiles,
 		doIterativeStats,
+		tdigestCompression,
+		hllPrecision,
+		doEmitStates,
+		doMergeStates,
 	)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -239,6 +280,7 @@ type TransformerStats1 struct {
 
 	doInterpolatedPercentiles bool
 	doIterativeStats          bool
+	doMergeStates             bool
 
 	// State:
 	accumulatorFactory *utils.Stats1AccumulatorFactory
@@ -309,13 +351,30 @@ func NewTransformerStats1(
 
 	doInterpolatedPercentiles bool,
 	doIterativeStats bool,
+	tdigestCompression float64,
+	hllPrecision int,
+	doEmitStates bool,
+	doMergeStates bool,
 ) (*TransformerStats1, error) {
 	for _, name := range accumulatorNameList {
 		if !utils.ValidateStats1AccumulatorName(name) {
 			return nil, fmt.Errorf("mlr stats1: accumulator \"%s\" not found.", name)
 		}
+		if (doEmitStates || doMergeStates) && !bifs.IsMergeableStatsAccumulatorName(name) {
+			return nil, fmt.Errorf(
+				"mlr stats1: accumulator \"%s\" does not have mergeable state; please see mlr stats1 --help.",
+				name,
+			)
+		}
+	}
+	if doMergeStates && doRegexValueFieldNames {
+		return nil, fmt.Errorf("mlr stats1: --merge is not supported with --fr, --fx, or --grfx.")
 	}
 
+	accumulatorFactory := utils.NewStats1AccumulatorFactory().
+		WithSketchAccuracy(tdigestCompression, hllPrecision).
+		WithStates(doEmitStates, doMergeStates)
+
 	tr := &TransformerStats1{
 		accumulatorNameList:        accumulatorNameList,
 		valueFieldNameList:         valueFieldNameList,
@@ -329,7 +388,8 @@ func NewTransformerStats1(
 
 		doInterpolatedPercentiles:        doInterpolatedPercentiles,
 		doIterativeStats:                 doIterativeStats,
-		accumulatorFactory:               utils.NewStats1AccumulatorFactory(),
+		doMergeStates:                    doMergeStates,
+		accumulatorFactory:               accumulatorFactory,
 		namedAccumulators:                lib.NewOrderedMap(),
 		groupingKeysToGroupByFieldValues: make(map[string]*lib.OrderedMap),
 	}
@@ -395,7 +455,9 @@ func (tr *TransformerStats1) handleInputRecord(
 		tr.groupingKeysToGroupByFieldValues[groupingKey] = groupByFieldValues
 	}
 
-	if tr.doRegexValueFieldNames {
+	if tr.doMergeStates {
+		tr.ingestStates(inrec, groupingKey, level2.(*lib.OrderedMap))
+	} else if tr.doRegexValueFieldNames {
 		tr.ingestWithValueFieldRegexes(inrec, groupingKey, level2.(*lib.OrderedMap))
 	} else {
 		tr.ingestWithoutValueFieldRegexes(inrec, groupingKey, level2.(*lib.OrderedMap))
@@ -553,6 +615,43 @@ func (tr *TransformerStats1) ingestWithValueFieldRegexes(
 	}
 }
 
+// ingestStates is for --merge, where the input has states as output by
+// --emit-state: e.g. for -a mean,p50 -f x, field x_mean has the state for
+// the mean of x, and field x_p50 has the state for the median of x.
+func (tr *TransformerStats1) ingestStates(
+	inrec *mlrval.Mlrmap,
+	groupingKey string,
+	level2 *lib.OrderedMap,
+) {
+	for _, valueFieldName := range tr.valueFieldNameList {
+		for _, accumulatorName := range tr.accumulatorNameList {
+			state := inrec.Get(valueFieldName + "_" + accumulatorName)
+			if state == nil {
+				continue
+			}
+			level3 := level2.Get(valueFieldName)
+			if level3 == nil {
+				level3 = lib.NewOrderedMap()
+				level2.Put(valueFieldName, level3)
+			}
+			namedAccumulator := level3.(*lib.OrderedMap).Get(accumulatorName)
+			if namedAccumulator == nil {
+				namedAccumulator = tr.accumulatorFactory.MakeNamedAccumulator(
+					accumulatorName,
+					groupingKey,
+					valueFieldName,
+					tr.doInterpolatedPercentiles,
+				)
+				level3.(*lib.OrderedMap).Put(accumulatorName, namedAccumulator)
+			}
+			if state.IsVoid() {
+				continue
+			}
+			namedAccumulator.(*utils.Stats1NamedAccumulator).Ingest(state)
+		}
+	}
+}
+
 func (tr *TransformerStats1) matchGroupByFieldName(
 	groupByFieldName string,
 ) bool {
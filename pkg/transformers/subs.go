@@ -20,24 +20,27 @@ const verbNameGsub = "gsub"
 const verbNameSsub = "ssub"
 
 var SubSetup = TransformerSetup{
-	Verb:         verbNameSub,
-	UsageFunc:    transformerSubUsage,
-	ParseCLIFunc: transformerSubParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameSub,
+	UsageFunc:      transformerSubUsage,
+	ParseCLIFunc:   transformerSubParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 var GsubSetup = TransformerSetup{
-	Verb:         verbNameGsub,
-	UsageFunc:    transformerGsubUsage,
-	ParseCLIFunc: transformerGsubParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameGsub,
+	UsageFunc:      transformerGsubUsage,
+	ParseCLIFunc:   transformerGsubParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 var SsubSetup = TransformerSetup{
-	Verb:         verbNameSsub,
-	UsageFunc:    transformerSsubUsage,
-	ParseCLIFunc: transformerSsubParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameSsub,
+	UsageFunc:      transformerSsubUsage,
+	ParseCLIFunc:   transformerSsubParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerSubUsage(
@@ -22,6 +22,7 @@ const (
 	stFieldType = iota
 	stAccumulator
 	stPercentile
+	stApproxPercentile
 )
 
 type tSummarizerInfo struct {
@@ -36,6 +37,7 @@ var allSummarizerInfos = []tSummarizerInfo{
 	{"count", "+1 for every instance of the field across all records in the input record stream", stAccumulator},
 	{"null_count", "count of field values either empty string or JSON null", stAccumulator},
 	{"distinct_count", "count of distinct values for the field", stAccumulator},
+	{"approx_distinct_count", "estimated count of distinct values for the field, in bounded memory", stAccumulator},
 	{"mode", "most-frequently-occurring value for the field", stAccumulator},
 
 	{"sum", "sum of field values", stAccumulator},
@@ -57,6 +59,9 @@ var allSummarizerInfos = []tSummarizerInfo{
 	{"lif", "lower inner fence: p25 - 1.5 * iqr", stPercentile},
 	{"uif", "upper inner fence: p75 + 1.5 * iqr", stPercentile},
 	{"uof", "upper outer fence: p75 + 3.0 * iqr", stPercentile},
+	{"approx_p25", "estimated first-quartile numeric value, in bounded memory", stApproxPercentile},
+	{"approx_median", "estimated median numeric value, in bounded memory", stApproxPercentile},
+	{"approx_p75", "estimated third-quartile numeric value, in bounded memory", stApproxPercentile},
 }
 
 var summaryDefaultSummarizerNames = []string{
@@ -85,7 +90,7 @@ func transformerSummaryUsage(
 	fmt.Fprintf(o, "\n")
 	fmt.Fprintf(o, "All summarizers:\n")
 	for _, info := range allSummarizerInfos {
-		fmt.Fprintf(o, "  %-14s  %s\n", info.name, info.help)
+		fmt.Fprintf(o, "  %-21s  %s\n", info.name, info.help)
 	}
 
 	fmt.Fprintf(o, "\n")
@@ -107,6 +112,7 @@ func transformerSummaryUsage(
 	fmt.Fprintf(o, "-x {mean,sum,etc.} Use all summarizers, except the specified ones.\n")
 	fmt.Fprintf(o, "--all              Use all available summarizers.\n")
 	fmt.Fprintf(o, "--transpose        Show output with field names as column names..\n")
+	utils.ListStats1SketchAccuracyFlags(o, "                   ")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 }
 
@@ -136,6 +142,8 @@ func transformerSummaryParseCLI(
 	}
 
 	transposeOutput := false
+	tdigestCompression := lib.DefaultTDigestCompression
+	hllPrecision := lib.DefaultHyperLogLogPrecision
 
 	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
 		opt := args[argi]
@@ -191,6 +199,11 @@ func transformerSummaryParseCLI(
 		} else if opt == "--transpose" {
 			transposeOutput = true
 
+		} else if utils.ParseStats1SketchAccuracyFlag(
+			verb, opt, args, &argi, argc, &tdigestCompression, &hllPrecision,
+		) {
+			// Handled
+
 		} else {
 			transformerSummaryUsage(os.Stderr)
 			os.Exit(1)
@@ -202,7 +215,12 @@ func transformerSummaryParseCLI(
 		return nil
 	}
 
-	transformer, err := NewTransformerSummary(summarizerNames, transposeOutput)
+	transformer, err := NewTransformerSummary(
+		summarizerNames,
+		transposeOutput,
+		tdigestCompression,
+		hllPrecision,
+	)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -220,9 +238,14 @@ type tFieldSummary struct {
 	accumulators map[string]utils.IStats1Accumulator
 
 	percentileKeeper *utils.PercentileKeeper
+
+	tdigest *lib.TDigest
 }
 
-func newFieldSummary() *tFieldSummary {
+func newFieldSummary(
+	tdigestCompression float64,
+	hllPrecision int,
+) *tFieldSummary {
 	fieldSummary := &tFieldSummary{
 		fieldTypesMap: lib.NewOrderedMap(),
 
@@ -230,11 +253,14 @@ func newFieldSummary() *tFieldSummary {
 
 		// Interpolated percentiles don't play well with string-valued input data
 		percentileKeeper: utils.NewPercentileKeeper(false),
+
+		tdigest: lib.NewTDigest(tdigestCompression),
 	}
 
 	fieldSummary.accumulators["count"] = utils.NewStats1CountAccumulator()
 	fieldSummary.accumulators["null_count"] = utils.NewStats1NullCountAccumulator()
 	fieldSummary.accumulators["distinct_count"] = utils.NewStats1DistinctCountAccumulator()
+	fieldSummary.accumulators["approx_distinct_count"] = utils.NewStats1ApproxDistinctCountAccumulator(hllPrecision)
 	fieldSummary.accumulators["mode"] = utils.NewStats1ModeAccumulator()
 
 	fieldSummary.accumulators["min"] = utils.NewStats1MinAccumulator()
@@ -252,22 +278,50 @@ func newFieldSummary() *tFieldSummary {
 	return fieldSummary
 }
 
+// emitApproxPercentile is for "approx_median" etc.
+func (fieldSummary *tFieldSummary) emitApproxPercentile(summarizerName string) *mlrval.Mlrval {
+	var q float64
+	switch summarizerName {
+	case "approx_p25":
+		q = 0.25
+	case "approx_median":
+		q = 0.5
+	case "approx_p75":
+		q = 0.75
+	default:
+		lib.InternalCodingErrorIf(true)
+	}
+	value, ok := fieldSummary.tdigest.Quantile(q)
+	if !ok {
+		return mlrval.VOID
+	}
+	return mlrval.FromFloat(value)
+}
+
 type TransformerSummary struct {
-	fieldSummaries    *lib.OrderedMap
-	summarizerNames   map[string]bool
-	hasAnyPercentiles bool
-	transposeOutput   bool
+	fieldSummaries          *lib.OrderedMap
+	summarizerNames         map[string]bool
+	hasAnyPercentiles       bool
+	hasAnyApproxPercentiles bool
+	transposeOutput         bool
+
+	tdigestCompression float64
+	hllPrecision       int
 }
 
 func NewTransformerSummary(
 	summarizerNames []string,
 	transposeOutput bool,
+	tdigestCompression float64,
+	hllPrecision int,
 ) (*TransformerSummary, error) {
 
 	tr := &TransformerSummary{
-		fieldSummaries:  lib.NewOrderedMap(),
-		summarizerNames: make(map[string]bool),
-		transposeOutput: transposeOutput,
+		fieldSummaries:     lib.NewOrderedMap(),
+		summarizerNames:    make(map[string]bool),
+		transposeOutput:    transposeOutput,
+		tdigestCompression: tdigestCompression,
+		hllPrecision:       hllPrecision,
 	}
 
 	for _, summarizerName := range summarizerNames {
@@ -284,6 +338,13 @@ func NewTransformerSummary(
 			break
 		}
 	}
+	tr.hasAnyApproxPercentiles = false
+	for _, info := range allSummarizerInfos {
+		if info.stype == stApproxPercentile && tr.summarizerNames[info.name] {
+			tr.hasAnyApproxPercentiles = true
+			break
+		}
+	}
 
 	return tr, nil
 }
@@ -319,7 +380,7 @@ func (tr *TransformerSummary) ingest(
 		iFieldSummary := tr.fieldSummaries.Get(fieldName)
 		var fieldSummary *tFieldSummary
 		if iFieldSummary == nil {
-			fieldSummary = newFieldSummary()
+			fieldSummary = newFieldSummary(tr.tdigestCompression, tr.hllPrecision)
 			tr.fieldSummaries.Put(fieldName, fieldSummary)
 		} else {
 			fieldSummary = iFieldSummary.(*tFieldSummary)
@@ -346,6 +407,13 @@ func (tr *TransformerSummary) ingest(
 		if tr.hasAnyPercentiles {
 			fieldSummary.percentileKeeper.Ingest(pe.Value)
 		}
+
+		if tr.hasAnyApproxPercentiles {
+			floatValue, ok := pe.Value.GetNumericToFloatValue()
+			if ok {
+				fieldSummary.tdigest.Add(floatValue)
+			}
+		}
 	}
 }
 
@@ -382,6 +450,10 @@ func (tr *TransformerSummary) emit(
 				if tr.summarizerNames[info.name] {
 					newrec.PutCopy(info.name, fieldSummary.percentileKeeper.EmitNamed(info.name))
 				}
+			} else if info.stype == stApproxPercentile {
+				if tr.summarizerNames[info.name] {
+					newrec.PutCopy(info.name, fieldSummary.emitApproxPercentile(info.name))
+				}
 			}
 		}
 
@@ -420,6 +492,8 @@ func (tr *TransformerSummary) emitTransposed(
 			tr.maybeEmitAccumulatorTransposed(oracs, octx, info.name)
 		} else if info.stype == stPercentile {
 			tr.maybeEmitPercentileNameTransposed(oracs, octx, info.name)
+		} else if info.stype == stApproxPercentile {
+			tr.maybeEmitApproxPercentileNameTransposed(oracs, octx, info.name)
 		}
 	}
 
@@ -463,3 +537,21 @@ func (tr *TransformerSummary) maybeEmitPercentileNameTransposed(
 		oracs.PushBack(types.NewRecordAndContext(newrec, octx))
 	}
 }
+
+// maybeEmitApproxPercentileNameTransposed is a helper method for
+// emitTransposed, for "approx_median" etc.
+func (tr *TransformerSummary) maybeEmitApproxPercentileNameTransposed(
+	oracs *list.List, // list of *types.RecordAndContext
+	octx *types.Context,
+	summarizerName string,
+) {
+	if tr.summarizerNames[summarizerName] {
+		newrec := mlrval.NewMlrmapAsRecord()
+		newrec.PutCopy("field_name", mlrval.FromString(summarizerName))
+		for pe := tr.fieldSummaries.Head; pe != nil; pe = pe.Next {
+			fieldSummary := pe.Value.(*tFieldSummary)
+			newrec.PutCopy(pe.Key, fieldSummary.emitApproxPercentile(summarizerName))
+		}
+		oracs.PushBack(types.NewRecordAndContext(newrec, octx))
+	}
+}
@@ -16,10 +16,11 @@ import (
 const verbNameTemplate = "template"
 
 var TemplateSetup = TransformerSetup{
-	Verb:         verbNameTemplate,
-	UsageFunc:    transformerTemplateUsage,
-	ParseCLIFunc: transformerTemplateParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameTemplate,
+	UsageFunc:      transformerTemplateUsage,
+	ParseCLIFunc:   transformerTemplateParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerTemplateUsage(
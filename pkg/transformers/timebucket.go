@@ -0,0 +1,366 @@
+package transformers
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/johnkerl/miller/v6/pkg/bifs"
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	strptime "github.com/johnkerl/miller/v6/pkg/pbnjay-strptime"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// ----------------------------------------------------------------
+const verbNameTimeBucket = "timebucket"
+
+var TimeBucketSetup = TransformerSetup{
+	Verb:           verbNameTimeBucket,
+	UsageFunc:      transformerTimeBucketUsage,
+	ParseCLIFunc:   transformerTimeBucketParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
+}
+
+func transformerTimeBucketUsage(
+	o *os.File,
+) {
+	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameTimeBucket)
+	fmt.Fprintf(o,
+		`Rounds a time field down to the start of its calendar bucket, such as the hour,
+ISO week, or quarter containing it. Times may be seconds since the epoch, RFC 3339
+timestamps such as 2023-01-02T03:04:05Z, or, with --ifmt, timestamps in a
+strptime format; the bucket is written in the same form unless --ofmt is given.
+Records without the field, or whose field isn't a time, are passed along as-is.
+Options:
+-f {name}    Time field name. Required.
+-u {unit}    Bucket unit: second, minute, hour, day, week (ISO, starting on
+             Monday), month, quarter, or year, optionally with a multiple such
+             as 15minute or 6hour. Required.
+-o {name}    Put the bucket in this field rather than replacing the time field.
+--tz {name}  Time zone for the buckets, such as America/New_York. Days start at
+             local midnight, and across daylight-saving changes the hours are
+             those of the wall clock. Default: seconds since the epoch are
+             bucketed in UTC, and timestamps in their own offset.
+--ceil       Round up to the start of the next bucket, rather than down, unless
+             the time is already at the start of one.
+--ifmt {fmt} strptime format for input times, such as '%%Y-%%m-%%d %%H:%%M:%%S'.
+--ofmt {fmt} strftime format for output buckets.
+--fill       Also emit a record for each empty bucket between one record and the
+             next. Input should be sorted by time within each group. Fill records
+             have only the -g fields and the bucket field.
+-g {a,b,c}   Group-by field names for --fill.
+-h|--help    Show this message.
+This is a keystroke-saver for the timefloor and timeceil functions, plus gap
+filling: without --fill,
+  mlr timebucket -f t -u hour -o hour
+is the same as
+  mlr put '$hour = timefloor($t, "hour")'
+Examples:
+  mlr --icsv --opprint timebucket -f t -u 15minute then count -g t myfile.csv
+  mlr --icsv --opprint timebucket -f t -u day --tz Asia/Tokyo --ifmt '%%Y-%%m-%%d %%H:%%M:%%S' myfile.csv
+  mlr --icsv --opprint timebucket -f t -u hour --fill -g host then count-similar -g host,t myfile.csv
+`)
+}
+
+func transformerTimeBucketParseCLI(
+	pargi *int,
+	argc int,
+	args []string,
+	_ *cli.TOptions,
+	doConstruct bool, // false for first pass of CLI-parse, true for second pass
+) IRecordTransformer {
+
+	// Skip the verb name from the current spot in the mlr command line
+	argi := *pargi
+	verb := args[argi]
+	argi++
+
+	fieldName := ""
+	unitString := ""
+	outputFieldName := ""
+	locationName := ""
+	doCeil := false
+	inputFormat := ""
+	outputFormat := ""
+	doFill := false
+	var groupByFieldNames []string = nil
+
+	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
+		opt := args[argi]
+		if !strings.HasPrefix(opt, "-") {
+			break // No more flag options to process
+		}
+		if args[argi] == "--" {
+			break // All transformers must do this so main-flags can follow verb-flags
+		}
+		argi++
+
+		if opt == "-h" || opt == "--help" {
+			transformerTimeBucketUsage(os.Stdout)
+			os.Exit(0)
+
+		} else if opt == "-f" {
+			fieldName = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+
+		} else if opt == "-u" {
+			unitString = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+
+		} else if opt == "-o" {
+			outputFieldName = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+
+		} else if opt == "--tz" {
+			locationName = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+
+		} else if opt == "--ceil" {
+			doCeil = true
+
+		} else if opt == "--ifmt" {
+			inputFormat = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+
+		} else if opt == "--ofmt" {
+			outputFormat = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+
+		} else if opt == "--fill" {
+			doFill = true
+
+		} else if opt == "-g" {
+			groupByFieldNames = cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
+
+		} else {
+			transformerTimeBucketUsage(os.Stderr)
+			os.Exit(1)
+		}
+	}
+
+	if fieldName == "" || unitString == "" {
+		transformerTimeBucketUsage(os.Stderr)
+		os.Exit(1)
+	}
+
+	*pargi = argi
+	if !doConstruct { // All transformers must do this for main command-line parsing
+		return nil
+	}
+
+	transformer, err := NewTransformerTimeBucket(
+		fieldName,
+		unitString,
+		outputFieldName,
+		locationName,
+		doCeil,
+		inputFormat,
+		outputFormat,
+		doFill,
+		groupByFieldNames,
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "mlr %s: %v.\n", verb, err)
+		os.Exit(1)
+	}
+
+	return transformer
+}
+
+// ----------------------------------------------------------------
+type TransformerTimeBucket struct {
+	fieldName         string
+	unit              *lib.TimeBucketUnit
+	outputFieldName   string
+	location          *time.Location
+	doCeil            bool
+	inputFormat       string
+	outputFormat      string
+	doFill            bool
+	groupByFieldNames []string
+
+	// For --fill: grouping key to *tTimeBucketGroup
+	groups *lib.OrderedMap
+}
+
+// tTimeBucketGroup is the most recent bucket for a group, along with what's
+// needed to make fill records for the buckets after it.
+type tTimeBucketGroup struct {
+	lastBucket         time.Time
+	lastInput          *mlrval.Mlrval
+	groupByFieldValues []*mlrval.Mlrval
+}
+
+func NewTransformerTimeBucket(
+	fieldName string,
+	unitString string,
+	outputFieldName string,
+	locationName string,
+	doCeil bool,
+	inputFormat string,
+	outputFormat string,
+	doFill bool,
+	groupByFieldNames []string,
+) (*TransformerTimeBucket, error) {
+	unit, err := lib.ParseTimeBucketUnit(unitString)
+	if err != nil {
+		return nil, err
+	}
+
+	var location *time.Location = nil
+	if locationName != "" {
+		location, err = time.LoadLocation(locationName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if outputFieldName == "" {
+		outputFieldName = fieldName
+	}
+	if groupByFieldNames == nil {
+		groupByFieldNames = []string{}
+	}
+
+	return &TransformerTimeBucket{
+		fieldName:         fieldName,
+		unit:              unit,
+		outputFieldName:   outputFieldName,
+		location:          location,
+		doCeil:            doCeil,
+		inputFormat:       inputFormat,
+		outputFormat:      outputFormat,
+		doFill:            doFill,
+		groupByFieldNames: groupByFieldNames,
+		groups:            lib.NewOrderedMap(),
+	}, nil
+}
+
+// IsParallelizable is for mlr --workers. Gap-filling needs to see the records
+// of each group in order.
+func (tr *TransformerTimeBucket) IsParallelizable() bool {
+	return !tr.doFill
+}
+
+// ----------------------------------------------------------------
+
+func (tr *TransformerTimeBucket) Transform(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	HandleDefaultDownstreamDone(inputDownstreamDoneChannel, outputDownstreamDoneChannel)
+	if inrecAndContext.EndOfStream {
+		outputRecordsAndContexts.PushBack(inrecAndContext) // end-of-stream marker
+		return
+	}
+
+	inrec := inrecAndContext.Record
+	value := inrec.Get(tr.fieldName)
+	if value == nil {
+		outputRecordsAndContexts.PushBack(inrecAndContext)
+		return
+	}
+	t, ok := tr.parseTime(value)
+	if !ok {
+		outputRecordsAndContexts.PushBack(inrecAndContext)
+		return
+	}
+	bucket := tr.toBucket(t)
+
+	if tr.doFill {
+		groupByFieldValues, hasAll := inrec.ReferenceSelectedValues(tr.groupByFieldNames)
+		if hasAll {
+			groupingKey, _ := inrec.GetSelectedValuesJoined(tr.groupByFieldNames)
+			tr.fill(groupingKey, groupByFieldValues, bucket, value, &inrecAndContext.Context, outputRecordsAndContexts)
+		}
+	}
+
+	inrec.PutReference(tr.outputFieldName, tr.formatBucket(bucket, value))
+	outputRecordsAndContexts.PushBack(inrecAndContext)
+}
+
+// fill emits records for any empty buckets between the group's previous
+// bucket and this one, then remembers this one.
+func (tr *TransformerTimeBucket) fill(
+	groupingKey string,
+	groupByFieldValues []*mlrval.Mlrval,
+	bucket time.Time,
+	input *mlrval.Mlrval,
+	context *types.Context,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+) {
+	iGroup := tr.groups.Get(groupingKey)
+	if iGroup == nil {
+		copies := make([]*mlrval.Mlrval, len(groupByFieldValues))
+		for i, groupByFieldValue := range groupByFieldValues {
+			copies[i] = groupByFieldValue.Copy()
+		}
+		tr.groups.Put(groupingKey, &tTimeBucketGroup{
+			lastBucket:         bucket,
+			lastInput:          input.Copy(),
+			groupByFieldValues: copies,
+		})
+		return
+	}
+
+	group := iGroup.(*tTimeBucketGroup)
+	if !bucket.After(group.lastBucket) {
+		return
+	}
+	for next := tr.unit.Next(group.lastBucket); next.Before(bucket); next = tr.unit.Next(next) {
+		fillrec := mlrval.NewMlrmapAsRecord()
+		for i, groupByFieldName := range tr.groupByFieldNames {
+			fillrec.PutCopy(groupByFieldName, group.groupByFieldValues[i])
+		}
+		fillrec.PutReference(tr.outputFieldName, tr.formatBucket(next, group.lastInput))
+		outputRecordsAndContexts.PushBack(types.NewRecordAndContext(fillrec, context))
+	}
+	group.lastBucket = bucket
+	group.lastInput = input.Copy()
+}
+
+func (tr *TransformerTimeBucket) parseTime(value *mlrval.Mlrval) (time.Time, bool) {
+	if tr.inputFormat == "" {
+		return bifs.TimeFromMlrval(value)
+	}
+	if value.IsVoid() {
+		return time.Time{}, false
+	}
+	var t time.Time
+	var err error
+	if tr.location != nil {
+		t, err = strptime.ParseLocation(value.OriginalString(), tr.inputFormat, tr.location)
+	} else {
+		t, err = strptime.Parse(value.OriginalString(), tr.inputFormat)
+	}
+	return t, err == nil
+}
+
+func (tr *TransformerTimeBucket) toBucket(t time.Time) time.Time {
+	if tr.location != nil {
+		t = t.In(tr.location)
+	}
+	if tr.doCeil {
+		return tr.unit.Ceil(t)
+	} else {
+		return tr.unit.Floor(t)
+	}
+}
+
+// formatBucket writes the bucket in the same form as the input, unless --ofmt
+// was given.
+func (tr *TransformerTimeBucket) formatBucket(bucket time.Time, input *mlrval.Mlrval) *mlrval.Mlrval {
+	format := tr.outputFormat
+	if format == "" {
+		format = tr.inputFormat
+	}
+	if format == "" {
+		return bifs.TimeToMlrvalLike(bucket, input)
+	}
+	output, err := lib.Strftime(bucket, format)
+	if err != nil {
+		return mlrval.FromError(err)
+	}
+	return mlrval.FromInferredType(output)
+}
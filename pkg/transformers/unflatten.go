@@ -15,10 +15,11 @@ import (
 const verbNameUnflatten = "unflatten"
 
 var UnflattenSetup = TransformerSetup{
-	Verb:         verbNameUnflatten,
-	UsageFunc:    transformerUnflattenUsage,
-	ParseCLIFunc: transformerUnflattenParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameUnflatten,
+	UsageFunc:      transformerUnflattenUsage,
+	ParseCLIFunc:   transformerUnflattenParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerUnflattenUsage(
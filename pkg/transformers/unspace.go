@@ -15,10 +15,11 @@ import (
 const verbNameUnspace = "unspace"
 
 var UnspaceSetup = TransformerSetup{
-	Verb:         verbNameUnspace,
-	UsageFunc:    transformerUnspaceUsage,
-	ParseCLIFunc: transformerUnspaceParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameUnspace,
+	UsageFunc:      transformerUnspaceUsage,
+	ParseCLIFunc:   transformerUnspaceParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerUnspaceUsage(
@@ -16,10 +16,11 @@ import (
 const verbNameUTF8ToLatin1 = "utf8-to-latin1"
 
 var UTF8ToLatin1Setup = TransformerSetup{
-	Verb:         verbNameUTF8ToLatin1,
-	UsageFunc:    transformerUTF8ToLatin1Usage,
-	ParseCLIFunc: transformerUTF8ToLatin1ParseCLI,
-	IgnoresInput: false,
+	Verb:           verbNameUTF8ToLatin1,
+	UsageFunc:      transformerUTF8ToLatin1Usage,
+	ParseCLIFunc:   transformerUTF8ToLatin1ParseCLI,
+	IgnoresInput:   false,
+	Parallelizable: true,
 }
 
 func transformerUTF8ToLatin1Usage(
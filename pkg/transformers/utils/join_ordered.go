@@ -0,0 +1,436 @@
+// ================================================================
+// Helper data structures for the join verb's as-of and range joins.
+//
+// In an as-of join, each right record is paired with the left record having
+// the latest as-of value at or before the right record's value: e.g. each trade
+// is paired with the latest quote at or before the trade's time. In a range
+// join, each right record is paired with all the left records whose [start,
+// end) interval contains the right record's value: e.g. each event is paired
+// with the sessions it happened in.
+//
+// In both cases the equality join fields (-j/-l/-r), if any, still apply: the
+// ordered matching is done within each set of left records having the same
+// join-field values as the right record.
+//
+// JoinOrderedBucket is for the unsorted (half-streaming) case: all the left
+// records with given join-field values are held in memory. JoinOrderedKeeper is
+// for the sorted (doubly-streaming) case: both files must be sorted lexically
+// by their join fields and then ascending by their as-of/range fields. Then
+// only the left records which might yet pair with a right record are held in
+// memory.
+//
+// As-of and range values are compared using Miller's sort collation: numbers
+// compare numerically, and strings (e.g. ISO8601 timestamps) lexically.
+// ================================================================
+
+package utils
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/input"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// ----------------------------------------------------------------
+type TJoinOrderedMode int
+
+const (
+	JOIN_ORDERED_ASOF TJoinOrderedMode = iota
+	JOIN_ORDERED_RANGE
+)
+
+// JoinOrderedSpec says which fields an as-of or range join compares.
+type JoinOrderedSpec struct {
+	Mode TJoinOrderedMode
+	// As-of field, or range start field, in the left file
+	LeftFieldName string
+	// Range end field in the left file; unused for as-of joins
+	LeftEndFieldName string
+	// As-of or range field in the right file
+	RightFieldName string
+}
+
+// GetLeftValues returns the left record's as-of value, or range start and end
+// values. The last return value is false if any of these is absent or empty,
+// in which case the record is unpairable.
+func (spec *JoinOrderedSpec) GetLeftValues(
+	leftrec *mlrval.Mlrmap,
+) (*mlrval.Mlrval, *mlrval.Mlrval, bool) {
+	leftValue := leftrec.Get(spec.LeftFieldName)
+	if leftValue == nil || leftValue.IsVoid() {
+		return nil, nil, false
+	}
+	if spec.Mode != JOIN_ORDERED_RANGE {
+		return leftValue, nil, true
+	}
+	leftEndValue := leftrec.Get(spec.LeftEndFieldName)
+	if leftEndValue == nil || leftEndValue.IsVoid() {
+		return nil, nil, false
+	}
+	return leftValue, leftEndValue, true
+}
+
+// GetRightValue returns the right record's as-of or range value, or nil if it
+// is absent or empty.
+func (spec *JoinOrderedSpec) GetRightValue(rightrec *mlrval.Mlrmap) *mlrval.Mlrval {
+	rightValue := rightrec.Get(spec.RightFieldName)
+	if rightValue == nil || rightValue.IsVoid() {
+		return nil
+	}
+	return rightValue
+}
+
+// ----------------------------------------------------------------
+type joinOrderedEntry struct {
+	recordAndContext *types.RecordAndContext
+	leftValue        *mlrval.Mlrval
+	leftEndValue     *mlrval.Mlrval
+	wasPaired        bool
+}
+
+func compareJoinOrderedValues(a, b *mlrval.Mlrval) int {
+	return mlrval.NumericAscendingComparator(a, b)
+}
+
+// findPairs appends to pairs the entries, from those given, which pair with
+// the right value. The entries must be sorted by left value.
+func (spec *JoinOrderedSpec) findPairs(
+	entries []*joinOrderedEntry,
+	rightValue *mlrval.Mlrval,
+	pairs *list.List, // list of *types.RecordAndContext
+) {
+	// Index of the first entry with left value > right value
+	n := sort.Search(len(entries), func(i int) bool {
+		return compareJoinOrderedValues(entries[i].leftValue, rightValue) > 0
+	})
+
+	if spec.Mode == JOIN_ORDERED_ASOF {
+		if n > 0 {
+			entries[n-1].wasPaired = true
+			pairs.PushBack(entries[n-1].recordAndContext)
+		}
+	} else {
+		for _, entry := range entries[:n] {
+			if compareJoinOrderedValues(rightValue, entry.leftEndValue) < 0 {
+				entry.wasPaired = true
+				pairs.PushBack(entry.recordAndContext)
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------
+// JoinOrderedBucket holds all the left records having given join-field values,
+// for the unsorted (half-streaming) join.
+type JoinOrderedBucket struct {
+	spec     *JoinOrderedSpec
+	entries  []*joinOrderedEntry // in left-file order
+	sorted   []*joinOrderedEntry // by left value, stably
+	isSorted bool
+}
+
+func NewJoinOrderedBucket(spec *JoinOrderedSpec) *JoinOrderedBucket {
+	return &JoinOrderedBucket{
+		spec:     spec,
+		entries:  make([]*joinOrderedEntry, 0),
+		sorted:   nil,
+		isSorted: false,
+	}
+}
+
+// Add returns false if the left record lacks the as-of or range fields.
+func (bucket *JoinOrderedBucket) Add(leftrecAndContext *types.RecordAndContext) bool {
+	leftValue, leftEndValue, ok := bucket.spec.GetLeftValues(leftrecAndContext.Record)
+	if !ok {
+		return false
+	}
+	bucket.entries = append(bucket.entries, &joinOrderedEntry{
+		recordAndContext: leftrecAndContext,
+		leftValue:        leftValue,
+		leftEndValue:     leftEndValue,
+		wasPaired:        false,
+	})
+	bucket.isSorted = false
+	return true
+}
+
+// FindPairs returns the left records which pair with the right value, marking
+// them as paired.
+func (bucket *JoinOrderedBucket) FindPairs(
+	rightValue *mlrval.Mlrval,
+) *list.List { // list of *types.RecordAndContext
+	if !bucket.isSorted {
+		bucket.sorted = make([]*joinOrderedEntry, len(bucket.entries))
+		copy(bucket.sorted, bucket.entries)
+		sort.SliceStable(bucket.sorted, func(i, j int) bool {
+			return compareJoinOrderedValues(bucket.sorted[i].leftValue, bucket.sorted[j].leftValue) < 0
+		})
+		bucket.isSorted = true
+	}
+	pairs := list.New()
+	bucket.spec.findPairs(bucket.sorted, rightValue, pairs)
+	return pairs
+}
+
+// OutputUnpaireds emits the left records which were never paired, in
+// left-file order.
+func (bucket *JoinOrderedBucket) OutputUnpaireds(
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+) {
+	for _, entry := range bucket.entries {
+		if !entry.wasPaired {
+			outputRecordsAndContexts.PushBack(entry.recordAndContext)
+		}
+	}
+}
+
+// ----------------------------------------------------------------
+// JoinOrderedKeeper steps through the left file for the sorted
+// (doubly-streaming) join. It keeps a window of left records having the
+// current join-field values and as-of/range values at or before the current
+// right value. Once the right record's join-field values move on, or the right
+// value moves past a left record's as-of value (superseded by a later one) or
+// range end, that left record can never be paired again. It's released from
+// the window then, and placed on the left-unpaired list if it was never paired.
+type JoinOrderedKeeper struct {
+	// For streaming through the left-side file
+	readerChannel    <-chan *list.List // list of *types.RecordAndContext
+	errorChannel     chan error
+	recordReaderDone bool
+
+	spec                 *JoinOrderedSpec
+	leftJoinFieldNames   []string
+	leftKeepFieldNameSet map[string]bool
+
+	peek                *joinOrderedEntry
+	peekJoinFieldValues []*mlrval.Mlrval
+
+	window                []*joinOrderedEntry
+	windowJoinFieldValues []*mlrval.Mlrval
+
+	leftUnpaireds *list.List
+}
+
+func NewJoinOrderedKeeper(
+	leftFileName string,
+	joinReaderOptions *cli.TReaderOptions,
+	leftJoinFieldNames []string,
+	leftKeepFieldNameSet map[string]bool,
+	spec *JoinOrderedSpec,
+) *JoinOrderedKeeper {
+
+	// Instantiate the record-reader
+	recordReader, err := input.Create(joinReaderOptions, 1) // TODO: maybe increase records per batch
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "mlr join: %v", err)
+		os.Exit(1)
+	}
+
+	// Set the initial context for the left-file.  Since Go is concurrent, the
+	// context struct needs to be duplicated and passed through the channels
+	// along with each record.
+	initialContext := types.NewNilContext()
+	initialContext.UpdateForStartOfFile(leftFileName)
+
+	// Set up channels for the record-reader
+	readerChannel := make(chan *list.List, 2) // list of *types.RecordAndContext
+	errorChannel := make(chan error, 1)
+	downstreamDoneChannel := make(chan bool, 1)
+
+	// Start the record-reader in its own goroutine.
+	leftFileNameArray := [1]string{leftFileName}
+	go recordReader.Read(leftFileNameArray[:], *initialContext, readerChannel, errorChannel, downstreamDoneChannel)
+
+	return &JoinOrderedKeeper{
+		readerChannel:    readerChannel,
+		errorChannel:     errorChannel,
+		recordReaderDone: false,
+
+		spec:                 spec,
+		leftJoinFieldNames:   leftJoinFieldNames,
+		leftKeepFieldNameSet: leftKeepFieldNameSet,
+
+		peek:                nil,
+		peekJoinFieldValues: nil,
+
+		window:                make([]*joinOrderedEntry, 0),
+		windowJoinFieldValues: nil,
+
+		leftUnpaireds: list.New(),
+	}
+}
+
+// FindPairs returns the left records which pair with the right record having
+// the given join-field values and as-of/range value. Left records found along
+// the way which can't be paired with this or any later right record are moved
+// to the left-unpaired list.
+func (keeper *JoinOrderedKeeper) FindPairs(
+	rightJoinFieldValues []*mlrval.Mlrval,
+	rightValue *mlrval.Mlrval,
+) *list.List { // list of *types.RecordAndContext
+	pairs := list.New()
+
+	if keeper.windowJoinFieldValues != nil {
+		cmp := compareLexically(keeper.windowJoinFieldValues, rightJoinFieldValues)
+		if cmp > 0 {
+			// Right input is out of order; nothing to pair with.
+			return pairs
+		}
+		if cmp < 0 {
+			keeper.releaseWindow()
+		}
+	}
+
+	// Move left records into the window, up to the first one past the
+	// right record.
+	for {
+		if keeper.peek == nil {
+			keeper.readPeek()
+			if keeper.peek == nil { // left EOF
+				break
+			}
+		}
+		cmp := compareLexically(keeper.peekJoinFieldValues, rightJoinFieldValues)
+		if cmp > 0 {
+			break
+		}
+		if cmp < 0 {
+			// Right input has moved past these join-field values
+			keeper.leftUnpaireds.PushBack(keeper.peek.recordAndContext)
+			keeper.peek = nil
+			continue
+		}
+		if compareJoinOrderedValues(keeper.peek.leftValue, rightValue) > 0 {
+			break
+		}
+		if keeper.windowJoinFieldValues == nil {
+			keeper.windowJoinFieldValues = keeper.peekJoinFieldValues
+		}
+		keeper.window = append(keeper.window, keeper.peek)
+		keeper.peek = nil
+	}
+
+	if keeper.windowJoinFieldValues == nil {
+		return pairs
+	}
+
+	// Release left records which have been superseded (as-of) or ended
+	// (range) as of the right value.
+	retained := keeper.window[:0]
+	for i, entry := range keeper.window {
+		var isDone bool
+		if keeper.spec.Mode == JOIN_ORDERED_ASOF {
+			isDone = i < len(keeper.window)-1
+		} else {
+			isDone = compareJoinOrderedValues(rightValue, entry.leftEndValue) >= 0
+		}
+		if isDone {
+			keeper.release(entry)
+		} else {
+			retained = append(retained, entry)
+		}
+	}
+	keeper.window = retained
+
+	keeper.spec.findPairs(keeper.window, rightValue, pairs)
+	return pairs
+}
+
+// MarkRemainingsAsUnpaired is for right-file EOF.
+func (keeper *JoinOrderedKeeper) MarkRemainingsAsUnpaired() {
+	keeper.releaseWindow()
+	if keeper.peek != nil {
+		keeper.leftUnpaireds.PushBack(keeper.peek.recordAndContext)
+		keeper.peek = nil
+	}
+	for {
+		leftrecAndContext := keeper.readRecord()
+		if leftrecAndContext == nil {
+			break
+		}
+		keeper.leftUnpaireds.PushBack(leftrecAndContext)
+	}
+}
+
+func (keeper *JoinOrderedKeeper) OutputAndReleaseLeftUnpaireds(
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+) {
+	moveRecordsAndContexts(outputRecordsAndContexts, keeper.leftUnpaireds)
+}
+
+func (keeper *JoinOrderedKeeper) ReleaseLeftUnpaireds() {
+	keeper.leftUnpaireds.Init()
+}
+
+func (keeper *JoinOrderedKeeper) releaseWindow() {
+	for _, entry := range keeper.window {
+		keeper.release(entry)
+	}
+	keeper.window = keeper.window[:0]
+	keeper.windowJoinFieldValues = nil
+}
+
+func (keeper *JoinOrderedKeeper) release(entry *joinOrderedEntry) {
+	if !entry.wasPaired {
+		keeper.leftUnpaireds.PushBack(entry.recordAndContext)
+	}
+}
+
+// readPeek reads the next left record having the join fields and the
+// as-of/range fields. Records lacking them go straight to the left-unpaired
+// list. The peek is left nil at left EOF.
+func (keeper *JoinOrderedKeeper) readPeek() {
+	for {
+		leftrecAndContext := keeper.readRecord()
+		if leftrecAndContext == nil {
+			return
+		}
+		leftrec := leftrecAndContext.Record
+		joinFieldValues, hasAllJoinKeys := leftrec.ReferenceSelectedValues(keeper.leftJoinFieldNames)
+		leftValue, leftEndValue, hasOrderedFields := keeper.spec.GetLeftValues(leftrec)
+		if hasAllJoinKeys && hasOrderedFields {
+			keeper.peek = &joinOrderedEntry{
+				recordAndContext: leftrecAndContext,
+				leftValue:        leftValue,
+				leftEndValue:     leftEndValue,
+				wasPaired:        false,
+			}
+			keeper.peekJoinFieldValues = mlrval.CopyMlrvalArray(joinFieldValues)
+			return
+		}
+		keeper.leftUnpaireds.PushBack(leftrecAndContext)
+	}
+}
+
+// readRecord gets the next left-file record from the record-reader goroutine.
+// Returns nil at EOF.
+func (keeper *JoinOrderedKeeper) readRecord() *types.RecordAndContext {
+	for !keeper.recordReaderDone {
+		select {
+		case err := <-keeper.errorChannel:
+			fmt.Fprintln(os.Stderr, "mlr", ": ", err)
+			os.Exit(1)
+		case leftrecsAndContexts := <-keeper.readerChannel:
+			lib.InternalCodingErrorIf(leftrecsAndContexts.Len() != 1)
+			leftrecAndContext := leftrecsAndContexts.Front().Value.(*types.RecordAndContext)
+			if leftrecAndContext.EndOfStream {
+				keeper.recordReaderDone = true
+				return nil
+			}
+			if leftrecAndContext.Record == nil {
+				// E.g. the only payload is OutputString
+				continue
+			}
+			leftrecAndContext.Record = KeepLeftFieldNames(leftrecAndContext.Record, keeper.leftKeepFieldNameSet)
+			return leftrecAndContext
+		}
+	}
+	return nil
+}
@@ -0,0 +1,587 @@
+// ================================================================
+// Record schemas, for the validate verb. A schema file is JSON, in either of
+// two forms.
+//
+// Miller-native:
+//
+//   {
+//     "fields": {
+//       "id":    { "type": "int", "required": true, "min": 1 },
+//       "color": { "type": "string", "enum": ["red", "blue"] },
+//       "code":  { "pattern": "^[A-Z]{3}$" },
+//       "tags":  { "type": "array", "items": { "type": "string" } },
+//       "point": { "type": "map", "fields": { "x": { "type": "float" } } }
+//     },
+//     "additional_fields": false
+//   }
+//
+// This is also what the infer-schema verb outputs; as a record, it's wrapped in
+// a JSON array, which is accepted here as well.
+//
+// A subset of JSON Schema, recognized by its "properties" key:
+//
+//   {
+//     "type": "object",
+//     "properties": {
+//       "id":    { "type": "integer", "minimum": 1 },
+//       "color": { "type": "string", "enum": ["red", "blue"] },
+//       "code":  { "pattern": "^[A-Z]{3}$" }
+//     },
+//     "required": ["id"],
+//     "additionalProperties": false
+//   }
+// ================================================================
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+)
+
+// Schema type names are those of mlrval.MVType, plus "number" for int or
+// float. Types are checked leniently: float includes int, and string includes
+// all non-collection values.
+var schemaTypeNames = []string{"int", "float", "number", "boolean", "string", "map", "array"}
+
+// Violation kinds, for the summary at end of stream
+const (
+	SchemaViolationMissing    = "missing"
+	SchemaViolationEmpty      = "empty"
+	SchemaViolationType       = "type"
+	SchemaViolationPattern    = "pattern"
+	SchemaViolationEnum       = "enum"
+	SchemaViolationRange      = "range"
+	SchemaViolationUnexpected = "unexpected"
+)
+
+type Schema struct {
+	Fields                []*SchemaField
+	fieldsByName          map[string]*SchemaField
+	AllowAdditionalFields bool
+}
+
+type SchemaField struct {
+	Name     string
+	Required bool     // present and non-empty
+	Nullable bool     // may be empty, if present
+	Types    []string // any type if empty
+	Pattern  *regexp.Regexp
+	Enum     []*mlrval.Mlrval
+	Min      *mlrval.Mlrval // inclusive; nil if unspecified
+	Max      *mlrval.Mlrval // inclusive; nil if unspecified
+	Fields   *Schema        // for map values; nil if unspecified
+	Items    *SchemaField   // for array elements; nil if unspecified
+}
+
+func NewSchemaField(name string) *SchemaField {
+	return &SchemaField{
+		Name:     name,
+		Nullable: true,
+	}
+}
+
+type SchemaViolation struct {
+	FieldName string
+	Kind      string
+	Message   string
+}
+
+func NewSchema() *Schema {
+	return &Schema{
+		Fields:                make([]*SchemaField, 0),
+		fieldsByName:          make(map[string]*SchemaField),
+		AllowAdditionalFields: true,
+	}
+}
+
+func (schema *Schema) AddField(field *SchemaField) {
+	schema.Fields = append(schema.Fields, field)
+	schema.fieldsByName[field.Name] = field
+}
+
+func (schema *Schema) GetField(name string) *SchemaField {
+	return schema.fieldsByName[name]
+}
+
+// ----------------------------------------------------------------
+// LOADING
+
+func LoadSchemaFromFile(filename string) (*Schema, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := ParseSchema(data)
+	if err != nil {
+		return nil, fmt.Errorf("schema file \"%s\": %v", filename, err)
+	}
+	return schema, nil
+}
+
+func ParseSchema(data []byte) (*Schema, error) {
+	mv, err := mlrval.TryUnmarshalJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	if mv.IsArray() && len(mv.GetArray()) == 1 {
+		mv = mv.GetArray()[0]
+	}
+	top := mv.GetMap()
+	if top == nil {
+		return nil, fmt.Errorf("expected a JSON object; got %s", mv.GetTypeName())
+	}
+	if top.Has("properties") {
+		return parseJSONSchema(top)
+	} else {
+		return parseNativeSchema(top)
+	}
+}
+
+func parseNativeSchema(top *mlrval.Mlrmap) (*Schema, error) {
+	schema := NewSchema()
+	for pe := top.Head; pe != nil; pe = pe.Next {
+		switch pe.Key {
+
+		case "fields":
+			err := parseNativeSchemaFields(schema, pe.Value)
+			if err != nil {
+				return nil, err
+			}
+
+		case "additional_fields":
+			allow, ok := pe.Value.GetBoolValue()
+			if !ok {
+				return nil, fmt.Errorf("\"additional_fields\" should be a boolean")
+			}
+			schema.AllowAdditionalFields = allow
+
+		default:
+			return nil, fmt.Errorf("unrecognized key \"%s\"", pe.Key)
+		}
+	}
+	return schema, nil
+}
+
+func parseNativeSchemaFields(schema *Schema, spec *mlrval.Mlrval) error {
+	fields := spec.GetMap()
+	if fields == nil {
+		return fmt.Errorf("\"fields\" should be a map; got %s", spec.GetTypeName())
+	}
+	for pe := fields.Head; pe != nil; pe = pe.Next {
+		field, err := parseNativeSchemaField(pe.Key, pe.Value)
+		if err != nil {
+			return err
+		}
+		schema.AddField(field)
+	}
+	return nil
+}
+
+func parseNativeSchemaField(name string, spec *mlrval.Mlrval) (*SchemaField, error) {
+	m := spec.GetMap()
+	if m == nil {
+		return nil, fmt.Errorf("field \"%s\": expected a map; got %s", name, spec.GetTypeName())
+	}
+	field := NewSchemaField(name)
+	for pe := m.Head; pe != nil; pe = pe.Next {
+		var err error
+		switch pe.Key {
+		case "type":
+			field.Types, err = parseSchemaTypes(pe.Value, nil)
+		case "required":
+			var ok bool
+			field.Required, ok = pe.Value.GetBoolValue()
+			if !ok {
+				err = fmt.Errorf("\"required\" should be a boolean")
+			}
+		case "nullable":
+			var ok bool
+			field.Nullable, ok = pe.Value.GetBoolValue()
+			if !ok {
+				err = fmt.Errorf("\"nullable\" should be a boolean")
+			}
+		case "pattern":
+			field.Pattern, err = parseSchemaPattern(pe.Value)
+		case "enum":
+			field.Enum, err = parseSchemaEnum(pe.Value)
+		case "min":
+			field.Min, err = parseSchemaBound(pe.Key, pe.Value)
+		case "max":
+			field.Max, err = parseSchemaBound(pe.Key, pe.Value)
+		case "fields":
+			if field.Fields == nil {
+				field.Fields = NewSchema()
+			}
+			err = parseNativeSchemaFields(field.Fields, pe.Value)
+		case "additional_fields":
+			if field.Fields == nil {
+				field.Fields = NewSchema()
+			}
+			var ok bool
+			field.Fields.AllowAdditionalFields, ok = pe.Value.GetBoolValue()
+			if !ok {
+				err = fmt.Errorf("\"additional_fields\" should be a boolean")
+			}
+		case "items":
+			field.Items, err = parseNativeSchemaField(name+"[]", pe.Value)
+		default:
+			err = fmt.Errorf("unrecognized key \"%s\"", pe.Key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("field \"%s\": %v", name, err)
+		}
+	}
+	return field, nil
+}
+
+// JSON Schema keywords which don't affect validation
+var jsonSchemaAnnotationKeywords = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"$comment":    true,
+	"title":       true,
+	"description": true,
+	"default":     true,
+	"examples":    true,
+}
+
+var jsonSchemaTypeNames = map[string]string{
+	"integer": "int",
+	"number":  "number",
+	"boolean": "boolean",
+	"string":  "string",
+	"object":  "map",
+	"array":   "array",
+}
+
+func parseJSONSchema(top *mlrval.Mlrmap) (*Schema, error) {
+	schema := NewSchema()
+	var required *mlrval.Mlrval = nil
+	for pe := top.Head; pe != nil; pe = pe.Next {
+		switch pe.Key {
+
+		case "type":
+			if pe.Value.String() != "object" {
+				return nil, fmt.Errorf("top-level \"type\" should be \"object\"; got %s", pe.Value.String())
+			}
+
+		case "properties":
+			properties := pe.Value.GetMap()
+			if properties == nil {
+				return nil, fmt.Errorf("\"properties\" should be an object")
+			}
+			for pf := properties.Head; pf != nil; pf = pf.Next {
+				field, err := parseJSONSchemaProperty(pf.Key, pf.Value)
+				if err != nil {
+					return nil, err
+				}
+				schema.AddField(field)
+			}
+
+		case "required":
+			required = pe.Value
+
+		case "additionalProperties":
+			allow, ok := pe.Value.GetBoolValue()
+			if !ok {
+				return nil, fmt.Errorf("\"additionalProperties\" is supported only as a boolean")
+			}
+			schema.AllowAdditionalFields = allow
+
+		default:
+			if !jsonSchemaAnnotationKeywords[pe.Key] {
+				return nil, fmt.Errorf("unsupported JSON Schema keyword \"%s\"", pe.Key)
+			}
+		}
+	}
+
+	if required != nil {
+		names := required.GetArray()
+		if names == nil {
+			return nil, fmt.Errorf("\"required\" should be an array")
+		}
+		for _, name := range names {
+			field := schema.GetField(name.String())
+			if field == nil {
+				field = NewSchemaField(name.String())
+				schema.AddField(field)
+			}
+			field.Required = true
+		}
+	}
+
+	return schema, nil
+}
+
+func parseJSONSchemaProperty(name string, spec *mlrval.Mlrval) (*SchemaField, error) {
+	m := spec.GetMap()
+	if m == nil {
+		return nil, fmt.Errorf("property \"%s\": expected an object; got %s", name, spec.GetTypeName())
+	}
+	field := NewSchemaField(name)
+
+	// Nested objects
+	if m.Has("properties") {
+		object := mlrval.NewMlrmap()
+		for _, key := range []string{"properties", "required", "additionalProperties"} {
+			if m.Has(key) {
+				object.PutReference(key, m.Get(key))
+			}
+		}
+		var err error
+		field.Fields, err = parseJSONSchema(object)
+		if err != nil {
+			return nil, fmt.Errorf("property \"%s\": %v", name, err)
+		}
+	}
+
+	for pe := m.Head; pe != nil; pe = pe.Next {
+		var err error
+		switch pe.Key {
+		case "properties", "required", "additionalProperties":
+			// Handled above
+		case "items":
+			field.Items, err = parseJSONSchemaProperty(name+"[]", pe.Value)
+		case "type":
+			field.Types, err = parseSchemaTypes(pe.Value, jsonSchemaTypeNames)
+		case "pattern":
+			field.Pattern, err = parseSchemaPattern(pe.Value)
+		case "enum":
+			field.Enum, err = parseSchemaEnum(pe.Value)
+		case "minimum":
+			field.Min, err = parseSchemaBound(pe.Key, pe.Value)
+		case "maximum":
+			field.Max, err = parseSchemaBound(pe.Key, pe.Value)
+		default:
+			if !jsonSchemaAnnotationKeywords[pe.Key] {
+				err = fmt.Errorf("unsupported JSON Schema keyword \"%s\"", pe.Key)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("property \"%s\": %v", name, err)
+		}
+	}
+	return field, nil
+}
+
+// parseSchemaTypes takes a type name or array of type names. If typeNameMap is
+// non-nil, it maps from the schema's type names to Miller's.
+func parseSchemaTypes(spec *mlrval.Mlrval, typeNameMap map[string]string) ([]string, error) {
+	var specs []*mlrval.Mlrval
+	if spec.IsArray() {
+		specs = spec.GetArray()
+	} else {
+		specs = []*mlrval.Mlrval{spec}
+	}
+
+	types := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		typeName := spec.String()
+		if typeNameMap != nil {
+			if typeName == "null" {
+				continue // Empty values are checked as missing, not by type
+			}
+			mapped, ok := typeNameMap[typeName]
+			if !ok {
+				return nil, fmt.Errorf("unsupported type \"%s\"", typeName)
+			}
+			typeName = mapped
+		} else if typeName == "bool" {
+			typeName = "boolean"
+		}
+		if !isSchemaTypeName(typeName) {
+			return nil, fmt.Errorf("unsupported type \"%s\"", typeName)
+		}
+		types = append(types, typeName)
+	}
+	return types, nil
+}
+
+func isSchemaTypeName(typeName string) bool {
+	for _, schemaTypeName := range schemaTypeNames {
+		if typeName == schemaTypeName {
+			return true
+		}
+	}
+	return false
+}
+
+func parseSchemaPattern(spec *mlrval.Mlrval) (*regexp.Regexp, error) {
+	if !spec.IsStringOrVoid() {
+		return nil, fmt.Errorf("\"pattern\" should be a string")
+	}
+	return lib.CompileMillerRegex(spec.String())
+}
+
+func parseSchemaEnum(spec *mlrval.Mlrval) ([]*mlrval.Mlrval, error) {
+	values := spec.GetArray()
+	if values == nil {
+		return nil, fmt.Errorf("\"enum\" should be an array")
+	}
+	return values, nil
+}
+
+func parseSchemaBound(key string, spec *mlrval.Mlrval) (*mlrval.Mlrval, error) {
+	if !spec.IsNumeric() {
+		return nil, fmt.Errorf("\"%s\" should be a number", key)
+	}
+	return spec, nil
+}
+
+// ----------------------------------------------------------------
+// VALIDATION
+
+// Validate returns the ways in which the record doesn't conform to the
+// schema, in the order of the schema's fields and then the record's. Empty
+// values count as missing for required fields. Violations within maps and
+// arrays have flattened field names, such as "req.method" or "tags.2".
+func (schema *Schema) Validate(record *mlrval.Mlrmap) []SchemaViolation {
+	return schema.validateWithPrefix(record, "", nil)
+}
+
+func (schema *Schema) validateWithPrefix(
+	record *mlrval.Mlrmap,
+	prefix string,
+	violations []SchemaViolation,
+) []SchemaViolation {
+	for _, field := range schema.Fields {
+		violations = field.validate(prefix+field.Name, record.Get(field.Name), violations)
+	}
+
+	if !schema.AllowAdditionalFields {
+		for pe := record.Head; pe != nil; pe = pe.Next {
+			if schema.fieldsByName[pe.Key] == nil {
+				violations = append(violations, SchemaViolation{
+					prefix + pe.Key, SchemaViolationUnexpected, "unexpected field",
+				})
+			}
+		}
+	}
+
+	return violations
+}
+
+// The value is nil if the field is absent.
+func (field *SchemaField) validate(
+	name string,
+	value *mlrval.Mlrval,
+	violations []SchemaViolation,
+) []SchemaViolation {
+	if value == nil || value.IsVoid() || value.IsNull() {
+		if field.Required {
+			violations = append(violations, SchemaViolation{
+				name, SchemaViolationMissing, "missing",
+			})
+		} else if value != nil && !field.Nullable {
+			violations = append(violations, SchemaViolation{
+				name, SchemaViolationEmpty, "empty",
+			})
+		}
+		return violations
+	}
+
+	if len(field.Types) > 0 && !field.hasType(value) {
+		return append(violations, SchemaViolation{
+			name, SchemaViolationType,
+			fmt.Sprintf("expected %s; got %s", strings.Join(field.Types, " or "), value.StringMaybeQuoted()),
+		})
+	}
+
+	if field.Pattern != nil && !field.Pattern.MatchString(value.OriginalString()) {
+		violations = append(violations, SchemaViolation{
+			name, SchemaViolationPattern,
+			fmt.Sprintf("%s does not match %s", value.StringMaybeQuoted(), field.Pattern.String()),
+		})
+	}
+
+	if field.Enum != nil && !field.inEnum(value) {
+		violations = append(violations, SchemaViolation{
+			name, SchemaViolationEnum,
+			fmt.Sprintf("%s is not one of the allowed values", value.StringMaybeQuoted()),
+		})
+	}
+
+	if field.Min != nil || field.Max != nil {
+		if !value.IsNumeric() {
+			violations = append(violations, SchemaViolation{
+				name, SchemaViolationRange,
+				fmt.Sprintf("%s is not a number", value.StringMaybeQuoted()),
+			})
+		} else if (field.Min != nil && mlrval.LessThan(value, field.Min)) ||
+			(field.Max != nil && mlrval.GreaterThan(value, field.Max)) {
+			violations = append(violations, SchemaViolation{
+				name, SchemaViolationRange,
+				fmt.Sprintf("%s is out of range", value.OriginalString()),
+			})
+		}
+	}
+
+	if field.Fields != nil && value.IsMap() {
+		violations = field.Fields.validateWithPrefix(value.GetMap(), name+".", violations)
+	}
+
+	if field.Items != nil && value.IsArray() {
+		for i, element := range value.GetArray() {
+			violations = field.Items.validate(fmt.Sprintf("%s.%d", name, i+1), element, violations)
+		}
+	}
+
+	return violations
+}
+
+func (field *SchemaField) hasType(value *mlrval.Mlrval) bool {
+	for _, typeName := range field.Types {
+		switch typeName {
+		case "int":
+			if value.IsInt() {
+				return true
+			}
+		case "float":
+			if value.IsNumeric() {
+				return true
+			}
+		case "number":
+			if value.IsNumeric() {
+				return true
+			}
+		case "boolean":
+			if value.IsTrue() || value.IsFalse() {
+				return true
+			}
+			if _, ok := lib.TryBoolFromBoolString(value.OriginalString()); ok {
+				return true
+			}
+		case "string":
+			// Data from files are all strings, with numbers inferred from
+			// them, so anything which isn't a collection qualifies.
+			if !value.IsArrayOrMap() {
+				return true
+			}
+		case "map":
+			if value.IsMap() {
+				return true
+			}
+		case "array":
+			if value.IsArray() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (field *SchemaField) inEnum(value *mlrval.Mlrval) bool {
+	valueString := value.OriginalString()
+	for _, allowed := range field.Enum {
+		if allowed.OriginalString() == valueString {
+			return true
+		}
+	}
+	return false
+}
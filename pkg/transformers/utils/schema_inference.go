@@ -0,0 +1,236 @@
+// ================================================================
+// Schema inference, for the infer-schema verb. The output is the
+// Miller-native schema form described in schema.go, so it can be used as-is
+// by the validate verb.
+// ================================================================
+
+package utils
+
+import (
+	"github.com/johnkerl/miller/v6/pkg/bifs"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+)
+
+type SchemaInferrer struct {
+	maxEnumCount int
+	recordCount  int64
+	fields       *lib.OrderedMap // field name to *schemaFieldInferrer
+}
+
+type schemaFieldInferrer struct {
+	maxEnumCount int
+	presentCount int64
+	emptyCount   int64
+	scalarCount  int64 // non-empty, non-collection values
+
+	sawInt     bool
+	sawFloat   bool
+	sawBoolean bool
+	sawString  bool
+	sawMap     bool
+	sawArray   bool
+
+	min *mlrval.Mlrval // over numeric values
+	max *mlrval.Mlrval
+
+	// From value string to value, in order of first occurrence. This is nil
+	// once there are too many for an enum.
+	distincts *lib.OrderedMap
+
+	fields *SchemaInferrer      // for map values
+	items  *schemaFieldInferrer // for array elements
+}
+
+// NewSchemaInferrer takes the largest number of distinct values for which a
+// field's values are reported as an enum; 0 for none.
+func NewSchemaInferrer(maxEnumCount int) *SchemaInferrer {
+	return &SchemaInferrer{
+		maxEnumCount: maxEnumCount,
+		recordCount:  0,
+		fields:       lib.NewOrderedMap(),
+	}
+}
+
+func newSchemaFieldInferrer(maxEnumCount int) *schemaFieldInferrer {
+	return &schemaFieldInferrer{
+		maxEnumCount: maxEnumCount,
+		min:          mlrval.ABSENT,
+		max:          mlrval.ABSENT,
+		distincts:    lib.NewOrderedMap(),
+	}
+}
+
+func (inferrer *SchemaInferrer) Ingest(record *mlrval.Mlrmap) {
+	inferrer.recordCount++
+	for pe := record.Head; pe != nil; pe = pe.Next {
+		iFieldInferrer := inferrer.fields.Get(pe.Key)
+		if iFieldInferrer == nil {
+			iFieldInferrer = newSchemaFieldInferrer(inferrer.maxEnumCount)
+			inferrer.fields.Put(pe.Key, iFieldInferrer)
+		}
+		iFieldInferrer.(*schemaFieldInferrer).ingest(pe.Value)
+	}
+}
+
+func (inferrer *schemaFieldInferrer) ingest(value *mlrval.Mlrval) {
+	inferrer.presentCount++
+
+	if value.IsVoid() || value.IsNull() {
+		inferrer.emptyCount++
+		return
+	}
+
+	if value.IsMap() {
+		inferrer.sawMap = true
+		if inferrer.fields == nil {
+			inferrer.fields = NewSchemaInferrer(inferrer.maxEnumCount)
+		}
+		inferrer.fields.Ingest(value.GetMap())
+		return
+	}
+
+	if value.IsArray() {
+		inferrer.sawArray = true
+		if inferrer.items == nil {
+			inferrer.items = newSchemaFieldInferrer(inferrer.maxEnumCount)
+		}
+		for _, element := range value.GetArray() {
+			inferrer.items.ingest(element)
+		}
+		return
+	}
+
+	inferrer.scalarCount++
+	if value.IsInt() {
+		inferrer.sawInt = true
+	} else if value.IsFloat() || value.IsDecimal() {
+		inferrer.sawFloat = true
+	} else if value.IsTrue() || value.IsFalse() {
+		inferrer.sawBoolean = true
+	} else if _, ok := lib.TryBoolFromBoolString(value.OriginalString()); ok {
+		inferrer.sawBoolean = true
+	} else {
+		inferrer.sawString = true
+	}
+
+	if value.IsNumeric() {
+		inferrer.min = bifs.BIF_min_binary(inferrer.min, value)
+		inferrer.max = bifs.BIF_max_binary(inferrer.max, value)
+	}
+
+	if inferrer.distincts != nil {
+		key := value.OriginalString()
+		if !inferrer.distincts.Has(key) {
+			if inferrer.distincts.FieldCount >= int64(inferrer.maxEnumCount) {
+				inferrer.distincts = nil
+			} else {
+				inferrer.distincts.Put(key, value.Copy())
+			}
+		}
+	}
+}
+
+// The widest type of the non-collection values: int and float widen to
+// float, and anything else mixed widens to string.
+func (inferrer *schemaFieldInferrer) scalarTypeName() string {
+	if inferrer.sawString || (inferrer.sawBoolean && (inferrer.sawInt || inferrer.sawFloat)) {
+		return "string"
+	} else if inferrer.sawFloat {
+		return "float"
+	} else if inferrer.sawInt {
+		return "int"
+	} else if inferrer.sawBoolean {
+		return "boolean"
+	} else {
+		return ""
+	}
+}
+
+// ----------------------------------------------------------------
+// OUTPUT
+
+// ToMlrmap returns the schema as Miller-native JSON, with
+// "additional_fields": false since the inferred schema lists all fields seen.
+func (inferrer *SchemaInferrer) ToMlrmap() *mlrval.Mlrmap {
+	schema := mlrval.NewMlrmap()
+	schema.PutReference("fields", mlrval.FromMap(inferrer.fieldsToMlrmap()))
+	schema.PutReference("additional_fields", mlrval.FALSE)
+	return schema
+}
+
+func (inferrer *SchemaInferrer) fieldsToMlrmap() *mlrval.Mlrmap {
+	fields := mlrval.NewMlrmap()
+	for pe := inferrer.fields.Head; pe != nil; pe = pe.Next {
+		fieldInferrer := pe.Value.(*schemaFieldInferrer)
+		required := fieldInferrer.presentCount == inferrer.recordCount && fieldInferrer.emptyCount == 0
+		spec := fieldInferrer.toMlrmap(mlrval.FromBool(required))
+		fields.PutReference(pe.Key, mlrval.FromMap(spec))
+	}
+	return fields
+}
+
+// The required flag is nil for array items, which are always present.
+func (inferrer *schemaFieldInferrer) toMlrmap(required *mlrval.Mlrval) *mlrval.Mlrmap {
+	spec := mlrval.NewMlrmap()
+
+	if required != nil {
+		spec.PutReference("required", required)
+	}
+
+	typeNames := make([]*mlrval.Mlrval, 0)
+	scalarTypeName := inferrer.scalarTypeName()
+	if scalarTypeName != "" {
+		typeNames = append(typeNames, mlrval.FromString(scalarTypeName))
+	}
+	if inferrer.sawMap {
+		typeNames = append(typeNames, mlrval.FromString("map"))
+	}
+	if inferrer.sawArray {
+		typeNames = append(typeNames, mlrval.FromString("array"))
+	}
+	if len(typeNames) == 1 {
+		spec.PutReference("type", typeNames[0])
+	} else if len(typeNames) > 1 {
+		spec.PutReference("type", mlrval.FromArray(typeNames))
+	}
+
+	spec.PutReference("nullable", mlrval.FromBool(inferrer.emptyCount > 0))
+
+	// These would be violations for map or array values
+	isScalar := !inferrer.sawMap && !inferrer.sawArray
+
+	if isScalar && (scalarTypeName == "int" || scalarTypeName == "float") && !inferrer.min.IsAbsent() {
+		spec.PutCopy("min", inferrer.min)
+		spec.PutCopy("max", inferrer.max)
+	}
+
+	// Only where there's repetition: an enum of all-distinct values, such as
+	// IDs, isn't a useful contract.
+	if isScalar && (scalarTypeName == "int" || scalarTypeName == "string") &&
+		inferrer.distincts != nil &&
+		inferrer.distincts.FieldCount > 0 &&
+		inferrer.distincts.FieldCount < inferrer.scalarCount {
+		values := make([]*mlrval.Mlrval, 0, inferrer.distincts.FieldCount)
+		for pe := inferrer.distincts.Head; pe != nil; pe = pe.Next {
+			if scalarTypeName == "string" {
+				// E.g. for zip codes, keep leading zeroes by quoting them
+				values = append(values, mlrval.FromString(pe.Key))
+			} else {
+				values = append(values, pe.Value.(*mlrval.Mlrval))
+			}
+		}
+		spec.PutReference("enum", mlrval.FromArray(values))
+	}
+
+	if inferrer.fields != nil {
+		spec.PutReference("fields", mlrval.FromMap(inferrer.fields.fieldsToMlrmap()))
+		spec.PutReference("additional_fields", mlrval.FALSE)
+	}
+
+	if inferrer.items != nil {
+		spec.PutReference("items", mlrval.FromMap(inferrer.items.toMlrmap(nil)))
+	}
+
+	return spec
+}
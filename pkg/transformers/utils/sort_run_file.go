@@ -0,0 +1,336 @@
+// ================================================================
+// Temp-file storage of sorted runs of records, for the external-memory sort
+// in the sort verb. Records are written one after another in a simple
+// length-prefixed binary encoding, along with their contexts (NR, FNR,
+// FILENAME, FILENUM), and read back in the same order. A finished run's temp
+// file is closed until it's opened for reading, since there may be more runs
+// than files which can be open at once.
+//
+// Field values keep their types across the round trip: numbers keep both their
+// values and their original formatting (e.g. 0xff or 1.500), strings which
+// look like numbers stay strings, times keep their location and format, and
+// maps and arrays are stored as JSON.
+// ================================================================
+
+package utils
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"time"
+
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// Type tags for stored field values
+const (
+	sortRunValueString   byte = 's'
+	sortRunValueInt      byte = 'i'
+	sortRunValueFloat    byte = 'f'
+	sortRunValueBool     byte = 'b'
+	sortRunValueDecimal  byte = 'd'
+	sortRunValueTime     byte = 't'
+	sortRunValueDuration byte = 'u'
+	sortRunValueJSON     byte = 'j'
+)
+
+// ----------------------------------------------------------------
+type SortRunWriter struct {
+	handle     *os.File
+	writer     *bufio.Writer
+	numRecords int64
+}
+
+func NewSortRunWriter() (*SortRunWriter, error) {
+	// Use "" as first argument to os.CreateTemp to use default directory.
+	// This is $TMPDIR if set.
+	handle, err := os.CreateTemp("", "mlr-sort-")
+	if err != nil {
+		return nil, fmt.Errorf("could not create sort temp file: %v", err)
+	}
+	return &SortRunWriter{
+		handle:     handle,
+		writer:     bufio.NewWriter(handle),
+		numRecords: 0,
+	}, nil
+}
+
+func (w *SortRunWriter) NumRecords() int64 {
+	return w.numRecords
+}
+
+func (w *SortRunWriter) Write(recordAndContext *types.RecordAndContext) error {
+	context := &recordAndContext.Context
+	w.writeString(context.FILENAME)
+	w.writeInt(context.FILENUM)
+	w.writeInt(context.NR)
+	w.writeInt(context.FNR)
+
+	record := recordAndContext.Record
+	w.writeInt(record.FieldCount)
+	for pe := record.Head; pe != nil; pe = pe.Next {
+		w.writeString(pe.Key)
+		err := w.writeValue(pe.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	w.numRecords++
+	return nil
+}
+
+// Finish flushes the run to disk and closes its temp file.
+func (w *SortRunWriter) Finish() (*SortRun, error) {
+	err := w.writer.Flush()
+	closeErr := w.handle.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(w.handle.Name())
+		return nil, fmt.Errorf("could not write sort temp file %s: %v", w.handle.Name(), err)
+	}
+	return &SortRun{
+		path: w.handle.Name(),
+	}, nil
+}
+
+// Remove closes and removes the temp file of a run which won't be finished,
+// e.g. on error.
+func (w *SortRunWriter) Remove() {
+	w.handle.Close()
+	os.Remove(w.handle.Name())
+}
+
+func (w *SortRunWriter) writeValue(value *mlrval.Mlrval) error {
+	if value.IsArrayOrMap() {
+		jsonString, err := value.MarshalJSON(mlrval.JSON_SINGLE_LINE, false)
+		if err != nil {
+			return err
+		}
+		w.writer.WriteByte(sortRunValueJSON)
+		w.writeString(jsonString)
+	} else if intValue, ok := value.GetIntValue(); ok {
+		w.writer.WriteByte(sortRunValueInt)
+		w.writeString(value.String())
+		w.writeInt(intValue)
+	} else if floatValue, ok := value.GetFloatValue(); ok {
+		w.writer.WriteByte(sortRunValueFloat)
+		w.writeString(value.String())
+		w.writeInt(int64(math.Float64bits(floatValue)))
+	} else if value.IsDecimal() {
+		w.writer.WriteByte(sortRunValueDecimal)
+		w.writeString(value.String())
+	} else if value.IsTime() {
+		t := value.AcquireTimeValue()
+		_, offset := t.Zone()
+		w.writer.WriteByte(sortRunValueTime)
+		w.writeString(value.String())
+		w.writeString(value.AcquireTimeFormat())
+		w.writeString(t.Location().String())
+		w.writeInt(int64(offset))
+		w.writeInt(t.Unix())
+		w.writeInt(int64(t.Nanosecond()))
+	} else if value.IsDuration() {
+		w.writer.WriteByte(sortRunValueDuration)
+		w.writeString(value.String())
+		w.writeInt(int64(value.AcquireDurationValue()))
+	} else if boolValue, ok := value.GetBoolValue(); ok {
+		w.writer.WriteByte(sortRunValueBool)
+		if boolValue {
+			w.writeString("true")
+		} else {
+			w.writeString("false")
+		}
+	} else {
+		w.writer.WriteByte(sortRunValueString)
+		w.writeString(value.String())
+	}
+	return nil
+}
+
+func (w *SortRunWriter) writeString(s string) {
+	w.writeInt(int64(len(s)))
+	w.writer.WriteString(s)
+}
+
+func (w *SortRunWriter) writeInt(n int64) {
+	var buffer [binary.MaxVarintLen64]byte
+	numBytes := binary.PutVarint(buffer[:], n)
+	w.writer.Write(buffer[:numBytes])
+}
+
+// ----------------------------------------------------------------
+// SortRun is a finished run, whose temp file is closed.
+type SortRun struct {
+	path string
+}
+
+// Open returns a reader positioned at the start of the run. Closing the reader
+// removes the run's temp file.
+func (run *SortRun) Open() (*SortRunReader, error) {
+	handle, err := os.Open(run.path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open sort temp file: %v", err)
+	}
+	return &SortRunReader{
+		handle: handle,
+		reader: bufio.NewReader(handle),
+	}, nil
+}
+
+// Remove removes the run's temp file, for runs which won't be read, e.g. on
+// error.
+func (run *SortRun) Remove() {
+	os.Remove(run.path)
+}
+
+// ----------------------------------------------------------------
+type SortRunReader struct {
+	handle *os.File
+	reader *bufio.Reader
+}
+
+// Read returns the next record from the run, or nil at end of run.
+func (r *SortRunReader) Read() (*types.RecordAndContext, error) {
+	filename, err := r.readString()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, r.readError(err)
+	}
+
+	context := types.NewNilContext()
+	context.FILENAME = filename
+	var fieldCount int64
+	for _, p := range []*int64{&context.FILENUM, &context.NR, &context.FNR, &fieldCount} {
+		*p, err = binary.ReadVarint(r.reader)
+		if err != nil {
+			return nil, r.readError(err)
+		}
+	}
+
+	record := mlrval.NewMlrmapAsRecord()
+	for i := int64(0); i < fieldCount; i++ {
+		key, err := r.readString()
+		if err != nil {
+			return nil, r.readError(err)
+		}
+		value, err := r.readValue()
+		if err != nil {
+			return nil, r.readError(err)
+		}
+		record.PutReference(key, value)
+	}
+
+	return types.NewRecordAndContext(record, context), nil
+}
+
+// Close closes and removes the run's temp file.
+func (r *SortRunReader) Close() {
+	r.handle.Close()
+	os.Remove(r.handle.Name())
+}
+
+func (r *SortRunReader) readValue() (*mlrval.Mlrval, error) {
+	tag, err := r.reader.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	s, err := r.readString()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case sortRunValueString:
+		return mlrval.FromString(s), nil
+	case sortRunValueInt:
+		intValue, err := binary.ReadVarint(r.reader)
+		if err != nil {
+			return nil, err
+		}
+		return mlrval.FromPrevalidatedIntString(s, intValue), nil
+	case sortRunValueFloat:
+		floatBits, err := binary.ReadVarint(r.reader)
+		if err != nil {
+			return nil, err
+		}
+		return mlrval.FromPrevalidatedFloatString(s, math.Float64frombits(uint64(floatBits))), nil
+	case sortRunValueDecimal:
+		return mlrval.TryFromDecimalString(s), nil
+	case sortRunValueTime:
+		return r.readTime(s)
+	case sortRunValueDuration:
+		nanoseconds, err := binary.ReadVarint(r.reader)
+		if err != nil {
+			return nil, err
+		}
+		return mlrval.FromDuration(time.Duration(nanoseconds)), nil
+	case sortRunValueBool:
+		return mlrval.FromBool(s == "true"), nil
+	case sortRunValueJSON:
+		return mlrval.TryUnmarshalJSON([]byte(s))
+	default:
+		return nil, fmt.Errorf("unknown value tag %q", tag)
+	}
+}
+
+// readTime reads the rest of a time value, after its string representation.
+func (r *SortRunReader) readTime(s string) (*mlrval.Mlrval, error) {
+	format, err := r.readString()
+	if err != nil {
+		return nil, err
+	}
+	locationName, err := r.readString()
+	if err != nil {
+		return nil, err
+	}
+	var numbers [3]int64 // zone offset, seconds, nanoseconds
+	for i := range numbers {
+		numbers[i], err = binary.ReadVarint(r.reader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Times parsed with a numeric zone offset have a fixed zone, often
+	// unnamed, rather than a location which can be loaded by name.
+	t := time.Unix(numbers[1], numbers[2])
+	location, err := time.LoadLocation(locationName)
+	if locationName == "" || err != nil {
+		location = time.FixedZone(locationName, int(numbers[0]))
+	}
+	t = t.In(location)
+	if _, offset := t.Zone(); offset != int(numbers[0]) {
+		t = t.In(time.FixedZone(locationName, int(numbers[0])))
+	}
+	return mlrval.FromParsedTime(s, t, format), nil
+}
+
+func (r *SortRunReader) readString() (string, error) {
+	length, err := binary.ReadVarint(r.reader)
+	if err != nil {
+		return "", err
+	}
+	buffer := make([]byte, length)
+	_, err = io.ReadFull(r.reader, buffer)
+	if err != nil {
+		return "", err
+	}
+	return string(buffer), nil
+}
+
+func (r *SortRunReader) readError(err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return fmt.Errorf("could not read sort temp file %s: %v", r.handle.Name(), err)
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/johnkerl/miller/v6/pkg/bifs"
+	"github.com/johnkerl/miller/v6/pkg/cli"
 	"github.com/johnkerl/miller/v6/pkg/lib"
 	"github.com/johnkerl/miller/v6/pkg/mlrval"
 )
@@ -169,21 +170,110 @@ func (nacc *Stats1NamedAccumulator) Reset() {
 // stats1 -a median -f x,y -g a,b' there will be an entry keyed primarily by
 // the string "x", and secondarily keyed by the values of a and b for a given
 // record.
+//
+// Likewise, t-digests for approximate percentiles are shared.
 type Stats1AccumulatorFactory struct {
 	percentileKeepers map[string]map[string]*PercentileKeeper
+	tdigests          map[string]map[string]*lib.TDigest
+
+	// Accuracy settings for approx_p50, approx_distinct_count, etc.
+	tdigestCompression float64
+	hllPrecision       int
+
+	// For map-reduce-style rollups: see WithStates.
+	emitStates  bool
+	mergeStates bool
 }
 
 func NewStats1AccumulatorFactory() *Stats1AccumulatorFactory {
 	return &Stats1AccumulatorFactory{
-		percentileKeepers: make(map[string]map[string]*PercentileKeeper),
+		percentileKeepers:  make(map[string]map[string]*PercentileKeeper),
+		tdigests:           make(map[string]map[string]*lib.TDigest),
+		tdigestCompression: lib.DefaultTDigestCompression,
+		hllPrecision:       lib.DefaultHyperLogLogPrecision,
 	}
 }
 
+// WithSketchAccuracy sets the t-digest compression for approximate
+// percentiles, and the HyperLogLog precision for approximate distinct counts.
+// Higher is more accurate, using more memory.
+func (factory *Stats1AccumulatorFactory) WithSketchAccuracy(
+	tdigestCompression float64,
+	hllPrecision int,
+) *Stats1AccumulatorFactory {
+	factory.tdigestCompression = tdigestCompression
+	factory.hllPrecision = hllPrecision
+	return factory
+}
+
+// WithStates is for stats1 --emit-state and --merge. With emitStates, the
+// accumulators emit their mergeable state rather than their final value. With
+// mergeStates, they ingest such states rather than data values. The
+// accumulator names must then be mergeable ones: see
+// bifs.IsMergeableStatsAccumulatorName.
+func (factory *Stats1AccumulatorFactory) WithStates(
+	emitStates bool,
+	mergeStates bool,
+) *Stats1AccumulatorFactory {
+	factory.emitStates = emitStates
+	factory.mergeStates = mergeStates
+	return factory
+}
+
 // ----------------------------------------------------------------
 func ListStats1Accumulators(o *os.File) {
 	for _, info := range stats1AccumulatorInfos {
 		fmt.Fprintf(o, "  %-8s %s\n", info.name, info.description)
 	}
+	fmt.Fprintf(o, "  approx_distinct_count\n")
+	fmt.Fprintf(o, "           Estimate number of distinct values per field, in bounded memory\n")
+	fmt.Fprintf(o, "  approx_median approx_p10 approx_p25.2 approx_p99.9 etc.\n")
+	fmt.Fprintf(o, "           Estimate percentiles of numeric values, in bounded memory\n")
+}
+
+// ListStats1SketchAccuracyFlags is for verbs which take the
+// --tdigest-compression and --hll-precision flags.
+func ListStats1SketchAccuracyFlags(o *os.File, indent string) {
+	fmt.Fprintf(o, "--tdigest-compression {n}\n")
+	fmt.Fprintf(o, "%sAccuracy of the approx_p{N} percentiles: higher is more accurate,\n", indent)
+	fmt.Fprintf(o, "%susing more memory. Default %d.\n", indent, int(lib.DefaultTDigestCompression))
+	fmt.Fprintf(o, "--hll-precision {n}\n")
+	fmt.Fprintf(o, "%sAccuracy of approx_distinct_count, from %d to %d: the relative error is\n",
+		indent, lib.MinHyperLogLogPrecision, lib.MaxHyperLogLogPrecision)
+	fmt.Fprintf(o, "%sabout 1.04/sqrt(2^n), using 2^n bytes. Default %d.\n", indent, lib.DefaultHyperLogLogPrecision)
+}
+
+// ParseStats1SketchAccuracyFlag handles --tdigest-compression and
+// --hll-precision, returning false if the flag is neither of those.
+func ParseStats1SketchAccuracyFlag(
+	verb string,
+	opt string,
+	args []string,
+	pargi *int,
+	argc int,
+	ptdigestCompression *float64,
+	phllPrecision *int,
+) bool {
+	if opt == "--tdigest-compression" {
+		compression := cli.VerbGetFloatArgOrDie(verb, opt, args, pargi, argc)
+		if compression < 1 {
+			fmt.Fprintf(os.Stderr, "mlr %s: %s must be at least 1; got %v.\n", verb, opt, compression)
+			os.Exit(1)
+		}
+		*ptdigestCompression = compression
+		return true
+	}
+	if opt == "--hll-precision" {
+		precision := cli.VerbGetIntArgOrDie(verb, opt, args, pargi, argc)
+		if precision < lib.MinHyperLogLogPrecision || precision > lib.MaxHyperLogLogPrecision {
+			fmt.Fprintf(os.Stderr, "mlr %s: %s must be from %d to %d; got %d.\n",
+				verb, opt, lib.MinHyperLogLogPrecision, lib.MaxHyperLogLogPrecision, precision)
+			os.Exit(1)
+		}
+		*phllPrecision = int(precision)
+		return true
+	}
+	return false
 }
 
 func ValidateStats1AccumulatorName(
@@ -194,6 +284,13 @@ func ValidateStats1AccumulatorName(
 	if ok {
 		return true
 	}
+	_, ok = tryApproxPercentileFromName(accumulatorName)
+	if ok {
+		return true
+	}
+	if accumulatorName == "approx_distinct_count" {
+		return true
+	}
 
 	// Then try the lookup table.
 	for _, info := range stats1AccumulatorInfos {
@@ -228,10 +325,19 @@ func tryPercentileFromName(accumulatorName string) (float64, bool) {
 	return 0.0, false
 }
 
+// Likewise for "approx_p95", "approx_median", etc.
+func tryApproxPercentileFromName(accumulatorName string) (float64, bool) {
+	if !strings.HasPrefix(accumulatorName, "approx_") {
+		return 0.0, false
+	}
+	return tryPercentileFromName(strings.TrimPrefix(accumulatorName, "approx_"))
+}
+
 // ----------------------------------------------------------------
 // For merge-fields wherein percentile-keepers are re-created on each record
 func (factory *Stats1AccumulatorFactory) Reset() {
 	factory.percentileKeepers = make(map[string]map[string]*PercentileKeeper)
+	factory.tdigests = make(map[string]map[string]*lib.TDigest)
 }
 
 func (factory *Stats1AccumulatorFactory) MakeNamedAccumulator(
@@ -269,6 +375,16 @@ func (factory *Stats1AccumulatorFactory) MakeAccumulator(
 	valueFieldName string,
 	doInterpolatedPercentiles bool,
 ) IStats1Accumulator {
+	if factory.emitStates || factory.mergeStates {
+		return NewStats1StateAccumulator(
+			accumulatorName,
+			factory.tdigestCompression,
+			factory.hllPrecision,
+			factory.emitStates,
+			factory.mergeStates,
+		)
+	}
+
 	// First try percentiles, which have parameterized names.
 	percentile, ok := tryPercentileFromName(accumulatorName)
 	if ok {
@@ -295,6 +411,30 @@ func (factory *Stats1AccumulatorFactory) MakeAccumulator(
 		return NewStats1PercentileAccumulator(percentileKeeper, percentile, isPrimary)
 	}
 
+	// Approximate percentiles share t-digests in the same way.
+	percentile, ok = tryApproxPercentileFromName(accumulatorName)
+	if ok {
+		tdigestsForValueFieldName := factory.tdigests[valueFieldName]
+		if tdigestsForValueFieldName == nil {
+			tdigestsForValueFieldName = make(map[string]*lib.TDigest)
+			factory.tdigests[valueFieldName] = tdigestsForValueFieldName
+		}
+
+		tdigest := tdigestsForValueFieldName[groupingKey]
+		isPrimary := false
+		if tdigest == nil {
+			tdigest = lib.NewTDigest(factory.tdigestCompression)
+			tdigestsForValueFieldName[groupingKey] = tdigest
+			isPrimary = true
+		}
+
+		return NewStats1ApproxPercentileAccumulator(tdigest, factory.tdigestCompression, percentile, isPrimary)
+	}
+
+	if accumulatorName == "approx_distinct_count" {
+		return NewStats1ApproxDistinctCountAccumulator(factory.hllPrecision)
+	}
+
 	// Then try the lookup table.
 	for _, info := range stats1AccumulatorInfos {
 		if info.name == accumulatorName {
@@ -850,3 +990,128 @@ func (acc *Stats1PercentileAccumulator) Reset() {
 		acc.percentileKeeper.Reset()
 	}
 }
+
+// ----------------------------------------------------------------
+// Approximate percentiles, using a t-digest. These share t-digests as above.
+type Stats1ApproxPercentileAccumulator struct {
+	tdigest     *lib.TDigest
+	compression float64
+	percentile  float64
+	isPrimary   bool
+}
+
+func NewStats1ApproxPercentileAccumulator(
+	tdigest *lib.TDigest,
+	compression float64,
+	percentile float64,
+	isPrimary bool,
+) IStats1Accumulator {
+	return &Stats1ApproxPercentileAccumulator{
+		tdigest:     tdigest,
+		compression: compression,
+		percentile:  percentile,
+		isPrimary:   isPrimary,
+	}
+}
+
+func (acc *Stats1ApproxPercentileAccumulator) Ingest(value *mlrval.Mlrval) {
+	if acc.isPrimary {
+		floatValue, ok := value.GetNumericToFloatValue()
+		if ok {
+			acc.tdigest.Add(floatValue)
+		}
+	}
+}
+
+func (acc *Stats1ApproxPercentileAccumulator) Emit() *mlrval.Mlrval {
+	quantile, ok := acc.tdigest.Quantile(acc.percentile / 100.0)
+	if !ok {
+		return mlrval.VOID
+	}
+	return mlrval.FromFloat(quantile)
+}
+
+func (acc *Stats1ApproxPercentileAccumulator) Reset() {
+	if acc.isPrimary {
+		*acc.tdigest = *lib.NewTDigest(acc.compression)
+	}
+}
+
+// ----------------------------------------------------------------
+// Stats1ApproxDistinctCountAccumulator is like Stats1DistinctCountAccumulator
+// but uses a HyperLogLog sketch rather than keeping all the distinct values.
+type Stats1ApproxDistinctCountAccumulator struct {
+	hll       *lib.HyperLogLog
+	precision int
+}
+
+func NewStats1ApproxDistinctCountAccumulator(precision int) IStats1Accumulator {
+	return &Stats1ApproxDistinctCountAccumulator{
+		hll:       lib.NewHyperLogLog(precision),
+		precision: precision,
+	}
+}
+func (acc *Stats1ApproxDistinctCountAccumulator) Ingest(value *mlrval.Mlrval) {
+	acc.hll.Add(value.OriginalString())
+}
+func (acc *Stats1ApproxDistinctCountAccumulator) Emit() *mlrval.Mlrval {
+	return mlrval.FromInt(acc.hll.Count())
+}
+func (acc *Stats1ApproxDistinctCountAccumulator) Reset() {
+	acc.hll = lib.NewHyperLogLog(acc.precision)
+}
+
+// ----------------------------------------------------------------
+// Stats1StateAccumulator is for stats1 --emit-state and --merge. It's a nil
+// return, for the caller to handle, if the accumulator name doesn't have
+// mergeable state.
+type Stats1StateAccumulator struct {
+	state              *bifs.StatsState
+	accumulatorName    string
+	tdigestCompression float64
+	hllPrecision       int
+	emitState          bool
+	mergeState         bool
+}
+
+func NewStats1StateAccumulator(
+	accumulatorName string,
+	tdigestCompression float64,
+	hllPrecision int,
+	emitState bool,
+	mergeState bool,
+) IStats1Accumulator {
+	state, err := bifs.NewStatsState(accumulatorName, tdigestCompression, hllPrecision)
+	if err != nil {
+		return nil
+	}
+	return &Stats1StateAccumulator{
+		state:              state,
+		accumulatorName:    accumulatorName,
+		tdigestCompression: tdigestCompression,
+		hllPrecision:       hllPrecision,
+		emitState:          emitState,
+		mergeState:         mergeState,
+	}
+}
+func (acc *Stats1StateAccumulator) Ingest(value *mlrval.Mlrval) {
+	if acc.mergeState {
+		err := acc.state.Merge(value.String())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "mlr: %v.\n", err)
+			os.Exit(1)
+		}
+	} else {
+		acc.state.Ingest(value)
+	}
+}
+func (acc *Stats1StateAccumulator) Emit() *mlrval.Mlrval {
+	if acc.emitState {
+		return mlrval.FromString(acc.state.String())
+	} else {
+		return acc.state.Finalize()
+	}
+}
+func (acc *Stats1StateAccumulator) Reset() {
+	acc.state, _ = bifs.NewStatsState(acc.accumulatorName, acc.tdigestCompression, acc.hllPrecision)
+}
@@ -0,0 +1,245 @@
+// ================================================================
+// Per-field type casts, for the cast verb and the --types-file main flag.
+// These take each value's original string from the data file and convert it
+// to the named type, regardless of type inference: e.g. the zip code 01234
+// stays a string, and 1e5 can be pinned as a string or a float.
+//
+// Type specs are:
+//
+//	string
+//	int
+//	float
+//	number       -- int if the value looks like one, else float
+//	boolean      -- from true or false
+//	time(format) -- a time, as from the time DSL function with this format
+// ================================================================
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	strptime "github.com/johnkerl/miller/v6/pkg/pbnjay-strptime"
+)
+
+type FieldCast struct {
+	FieldName  string
+	TypeName   string // string, int, float, number, boolean, or time
+	TimeFormat string // for time only
+}
+
+// ----------------------------------------------------------------
+// PARSING
+
+// ParseFieldCasts parses a comma-separated list of name:type pairs such as
+// "zip:string,amount:float,ts:time(%Y-%m-%d)". Commas within the parentheses
+// of a time format don't separate pairs.
+func ParseFieldCasts(spec string) ([]*FieldCast, error) {
+	fieldCasts := make([]*FieldCast, 0)
+	for _, pair := range splitFieldCastSpec(spec) {
+		colonIndex := strings.Index(pair, ":")
+		if colonIndex <= 0 {
+			return nil, fmt.Errorf("type spec \"%s\" should be of the form name:type", pair)
+		}
+		fieldCast, err := NewFieldCast(pair[:colonIndex], pair[colonIndex+1:])
+		if err != nil {
+			return nil, err
+		}
+		fieldCasts = append(fieldCasts, fieldCast)
+	}
+	return fieldCasts, nil
+}
+
+func splitFieldCastSpec(spec string) []string {
+	pairs := make([]string, 0)
+	depth := 0
+	start := 0
+	for i, c := range spec {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				pairs = append(pairs, spec[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(pairs, spec[start:])
+}
+
+func NewFieldCast(fieldName string, typeSpec string) (*FieldCast, error) {
+	switch typeSpec {
+	case "string", "int", "float", "decimal", "number", "boolean":
+		return &FieldCast{FieldName: fieldName, TypeName: typeSpec}, nil
+	}
+	if strings.HasPrefix(typeSpec, "time(") && strings.HasSuffix(typeSpec, ")") {
+		timeFormat := typeSpec[len("time(") : len(typeSpec)-1]
+		if timeFormat == "" {
+			return nil, fmt.Errorf("field \"%s\": time format is empty", fieldName)
+		}
+		return &FieldCast{FieldName: fieldName, TypeName: "time", TimeFormat: timeFormat}, nil
+	}
+	return nil, fmt.Errorf(
+		"field \"%s\": unsupported type \"%s\"; expected string, int, float, decimal, number, boolean, or time(format)",
+		fieldName, typeSpec,
+	)
+}
+
+// LoadFieldCastsFromFile reads a JSON types file. This is either a map from
+// field name to type spec, such as
+//
+//	{ "zip": "string", "amount": "float", "ts": "time(%Y-%m-%d)" }
+//
+// or a schema as taken by the validate verb, such as is written by
+// infer-schema. For the latter, fields having a single type of string, int,
+// float, number, or boolean are cast; others are left as they are.
+func LoadFieldCastsFromFile(filename string) ([]*FieldCast, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	fieldCasts, err := parseFieldCastsFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("types file \"%s\": %v", filename, err)
+	}
+	return fieldCasts, nil
+}
+
+func parseFieldCastsFile(data []byte) ([]*FieldCast, error) {
+	mv, err := mlrval.TryUnmarshalJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	if mv.IsArray() && len(mv.GetArray()) == 1 {
+		mv = mv.GetArray()[0]
+	}
+	top := mv.GetMap()
+	if top == nil {
+		return nil, fmt.Errorf("expected a JSON object; got %s", mv.GetTypeName())
+	}
+
+	if isSchemaMap(top) {
+		schema, err := ParseSchema(data)
+		if err != nil {
+			return nil, err
+		}
+		fieldCasts := make([]*FieldCast, 0)
+		for _, field := range schema.Fields {
+			if len(field.Types) != 1 {
+				continue
+			}
+			switch field.Types[0] {
+			case "string", "int", "float", "number", "boolean":
+				fieldCasts = append(fieldCasts, &FieldCast{FieldName: field.Name, TypeName: field.Types[0]})
+			}
+		}
+		return fieldCasts, nil
+	}
+
+	fieldCasts := make([]*FieldCast, 0, top.FieldCount)
+	for pe := top.Head; pe != nil; pe = pe.Next {
+		if !pe.Value.IsStringOrVoid() {
+			return nil, fmt.Errorf("field \"%s\": type should be a string; got %s", pe.Key, pe.Value.GetTypeName())
+		}
+		fieldCast, err := NewFieldCast(pe.Key, pe.Value.String())
+		if err != nil {
+			return nil, err
+		}
+		fieldCasts = append(fieldCasts, fieldCast)
+	}
+	return fieldCasts, nil
+}
+
+// A name-to-type map can't have map values, so this distinguishes the two forms.
+func isSchemaMap(top *mlrval.Mlrmap) bool {
+	for _, key := range []string{"fields", "properties"} {
+		value := top.Get(key)
+		if value != nil && value.IsMap() {
+			return true
+		}
+	}
+	return false
+}
+
+// ----------------------------------------------------------------
+// CASTING
+
+// Cast converts the value, returning an error if it can't be. Empty values
+// are returned as-is.
+func (fieldCast *FieldCast) Cast(value *mlrval.Mlrval) (*mlrval.Mlrval, error) {
+	if value.IsVoid() {
+		return value, nil
+	}
+	if value.IsArrayOrMap() {
+		return nil, fmt.Errorf("cannot convert %s to %s", value.GetTypeName(), fieldCast.typeDescription())
+	}
+	input := value.OriginalString()
+
+	switch fieldCast.TypeName {
+	case "string":
+		return mlrval.FromString(input), nil
+
+	case "int":
+		if intval, ok := tryCastIntFromString(input); ok {
+			return mlrval.FromPrevalidatedIntString(input, intval), nil
+		}
+
+	case "float":
+		if floatval, ok := lib.TryFloatFromString(input); ok {
+			return mlrval.FromPrevalidatedFloatString(input, floatval), nil
+		}
+
+	case "decimal":
+		if decimalval, ok := lib.TryDecimalFromString(input); ok {
+			return mlrval.FromPrevalidatedDecimalString(input, decimalval), nil
+		}
+
+	case "number":
+		if intval, ok := tryCastIntFromString(input); ok {
+			return mlrval.FromPrevalidatedIntString(input, intval), nil
+		}
+		if floatval, ok := lib.TryFloatFromString(input); ok {
+			return mlrval.FromPrevalidatedFloatString(input, floatval), nil
+		}
+
+	case "boolean":
+		if boolval, ok := lib.TryBoolFromBoolString(input); ok {
+			return mlrval.FromBool(boolval), nil
+		}
+
+	case "time":
+		t, err := strptime.Parse(input, fieldCast.TimeFormat)
+		if err == nil {
+			return mlrval.FromParsedTime(input, t, fieldCast.TimeFormat), nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot convert \"%s\" to %s", input, fieldCast.typeDescription())
+}
+
+// tryCastIntFromString is as lib.TryIntFromString, except that decimal digits
+// with leading zeroes, such as the zip code 01234, are decimal rather than
+// octal. Prefixes 0x, 0b, and 0o are still hex, binary, and octal.
+func tryCastIntFromString(input string) (int64, bool) {
+	digits := strings.TrimLeft(input, "-+")
+	if len(digits) > 1 && digits[0] == '0' && strings.Trim(digits, "0123456789") == "" {
+		return lib.TryIntFromStringWithBase(input, 10)
+	}
+	return lib.TryIntFromString(input)
+}
+
+func (fieldCast *FieldCast) typeDescription() string {
+	if fieldCast.TypeName == "time" {
+		return "time(" + fieldCast.TimeFormat + ")"
+	}
+	return fieldCast.TypeName
+}
@@ -0,0 +1,331 @@
+package transformers
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/transformers/utils"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// ----------------------------------------------------------------
+const verbNameValidate = "validate"
+const validateDefaultErrorsFieldName = "_errors"
+
+type tValidateMode int
+
+const (
+	validateModeFail tValidateMode = iota
+	validateModeDrop
+	validateModeAnnotate
+)
+
+var ValidateSetup = TransformerSetup{
+	Verb:         verbNameValidate,
+	UsageFunc:    transformerValidateUsage,
+	ParseCLIFunc: transformerValidateParseCLI,
+	IgnoresInput: false,
+}
+
+func transformerValidateUsage(
+	o *os.File,
+) {
+	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameValidate)
+	fmt.Fprint(o,
+		`Checks records against a schema: field names, whether they're required, types,
+regex patterns, allowed values, and numeric ranges. At end of stream, prints a
+summary of the violations per field to standard error, if there were any.
+Options:
+-s {filename}  Schema file, as described below. Required.
+--fail         Stop with an error at the first invalid record. This is the default.
+--drop         Drop invalid records.
+--annotate     Pass all records through, with an _errors field which describes the
+               violations, or is empty for valid records.
+-e {name}      Field name for --annotate, instead of _errors.
+-q             Don't print the summary.
+-h|--help      Show this message.
+
+The schema file is JSON, in either of two forms. Miller's own:
+
+  {
+    "fields": {
+      "id":    { "type": "int", "required": true, "min": 1 },
+      "color": { "type": "string", "enum": ["red", "blue"] },
+      "code":  { "pattern": "^[A-Z]{3}$" },
+      "tags":  { "type": "array", "items": { "type": "string" } },
+      "point": { "type": "map", "fields": { "x": { "type": "float" } } }
+    },
+    "additional_fields": false
+  }
+
+Field keys are type, required, nullable, pattern, enum, min, and max, all
+optional. Types are int, float, number (int or float), boolean, string, map,
+and array, or an array of these meaning any of them. With "additional_fields":
+false, fields not in the schema are violations. With "nullable": false, empty
+values are violations even for non-required fields. Map-valued fields may have
+"fields" and "additional_fields" of their own, and array-valued fields may have
+"items" which applies to each element. The infer-schema verb writes this form.
+
+Or, a subset of JSON Schema, with the same meaning:
+
+  {
+    "type": "object",
+    "properties": {
+      "id":    { "type": "integer", "minimum": 1 },
+      "color": { "type": "string", "enum": ["red", "blue"] },
+      "code":  { "pattern": "^[A-Z]{3}$" }
+    },
+    "required": ["id"],
+    "additionalProperties": false
+  }
+
+Notes:
+* Empty values count as missing, for required fields, and are otherwise
+  valid.
+* Values from data files are text, so any non-map/non-array value is a string.
+  Likewise, "true" and "false" are booleans, and ints are floats.
+* Violations within maps and arrays are reported with flattened field names,
+  such as point.x or tags.2.
+* Patterns are unanchored, as for the =~ operator; use ^ and $ as needed.
+Examples:
+  mlr --icsv --ojson validate -s schema.json myfile.csv
+  mlr --icsv --ocsv validate -s schema.json --annotate then filter '$_errors != ""' myfile.csv
+`)
+}
+
+func transformerValidateParseCLI(
+	pargi *int,
+	argc int,
+	args []string,
+	_ *cli.TOptions,
+	doConstruct bool, // false for first pass of CLI-parse, true for second pass
+) IRecordTransformer {
+
+	// Skip the verb name from the current spot in the mlr command line
+	argi := *pargi
+	verb := args[argi]
+	argi++
+
+	schemaFileName := ""
+	mode := validateModeFail
+	errorsFieldName := validateDefaultErrorsFieldName
+	doSummary := true
+
+	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
+		opt := args[argi]
+		if !strings.HasPrefix(opt, "-") {
+			break // No more flag options to process
+		}
+		if args[argi] == "--" {
+			break // All transformers must do this so main-flags can follow verb-flags
+		}
+		argi++
+
+		if opt == "-h" || opt == "--help" {
+			transformerValidateUsage(os.Stdout)
+			os.Exit(0)
+
+		} else if opt == "-s" {
+			schemaFileName = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+
+		} else if opt == "--fail" {
+			mode = validateModeFail
+
+		} else if opt == "--drop" {
+			mode = validateModeDrop
+
+		} else if opt == "--annotate" {
+			mode = validateModeAnnotate
+
+		} else if opt == "-e" {
+			errorsFieldName = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+
+		} else if opt == "-q" {
+			doSummary = false
+
+		} else {
+			transformerValidateUsage(os.Stderr)
+			os.Exit(1)
+		}
+	}
+
+	if schemaFileName == "" {
+		fmt.Fprintf(os.Stderr, "mlr %s: -s option is required.\n", verb)
+		fmt.Fprintf(os.Stderr, "Please see 'mlr %s --help' for more information.\n", verb)
+		os.Exit(1)
+	}
+
+	*pargi = argi
+	if !doConstruct { // All transformers must do this for main command-line parsing
+		return nil
+	}
+
+	transformer, err := NewTransformerValidate(
+		schemaFileName,
+		mode,
+		errorsFieldName,
+		doSummary,
+	)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	return transformer
+}
+
+// ----------------------------------------------------------------
+type TransformerValidate struct {
+	schema          *utils.Schema
+	mode            tValidateMode
+	errorsFieldName string
+	doSummary       bool
+
+	recordCount        int64
+	invalidRecordCount int64
+	// Field name -> violation kind -> count, in order of first occurrence
+	violationCounts *lib.OrderedMap
+}
+
+func NewTransformerValidate(
+	schemaFileName string,
+	mode tValidateMode,
+	errorsFieldName string,
+	doSummary bool,
+) (*TransformerValidate, error) {
+	schema, err := utils.LoadSchemaFromFile(schemaFileName)
+	if err != nil {
+		return nil, fmt.Errorf("mlr %s: %v", verbNameValidate, err)
+	}
+
+	return &TransformerValidate{
+		schema:          schema,
+		mode:            mode,
+		errorsFieldName: errorsFieldName,
+		doSummary:       doSummary,
+		violationCounts: lib.NewOrderedMap(),
+	}, nil
+}
+
+// ----------------------------------------------------------------
+
+func (tr *TransformerValidate) Transform(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	HandleDefaultDownstreamDone(inputDownstreamDoneChannel, outputDownstreamDoneChannel)
+	if !inrecAndContext.EndOfStream {
+		tr.handleInputRecord(inrecAndContext, outputRecordsAndContexts)
+	} else {
+		if tr.doSummary {
+			tr.printSummary()
+		}
+		outputRecordsAndContexts.PushBack(inrecAndContext) // end-of-stream marker
+	}
+}
+
+func (tr *TransformerValidate) handleInputRecord(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+) {
+	inrec := inrecAndContext.Record
+	tr.recordCount++
+
+	violations := tr.schema.Validate(inrec)
+	if len(violations) == 0 {
+		if tr.mode == validateModeAnnotate {
+			inrec.PutReference(tr.errorsFieldName, mlrval.VOID)
+		}
+		outputRecordsAndContexts.PushBack(inrecAndContext)
+		return
+	}
+
+	tr.invalidRecordCount++
+	for _, violation := range violations {
+		tr.countViolation(violation)
+	}
+
+	messages := make([]string, len(violations))
+	for i, violation := range violations {
+		messages[i] = violation.FieldName + ": " + violation.Message
+	}
+
+	switch tr.mode {
+	case validateModeFail:
+		fmt.Fprintf(
+			os.Stderr,
+			"mlr %s: invalid record %d of %s: %s\n",
+			verbNameValidate,
+			inrecAndContext.Context.FNR,
+			inrecAndContext.Context.FILENAME,
+			strings.Join(messages, "; "),
+		)
+		os.Exit(1)
+	case validateModeDrop:
+		// Nothing to emit
+	case validateModeAnnotate:
+		inrec.PutReference(tr.errorsFieldName, mlrval.FromString(strings.Join(messages, "; ")))
+		outputRecordsAndContexts.PushBack(inrecAndContext)
+	}
+}
+
+func (tr *TransformerValidate) countViolation(violation utils.SchemaViolation) {
+	iCountsForField := tr.violationCounts.Get(violation.FieldName)
+	if iCountsForField == nil {
+		iCountsForField = lib.NewOrderedMap()
+		tr.violationCounts.Put(violation.FieldName, iCountsForField)
+	}
+	countsForField := iCountsForField.(*lib.OrderedMap)
+	iCount := countsForField.Get(violation.Kind)
+	if iCount == nil {
+		countsForField.Put(violation.Kind, int64(1))
+	} else {
+		countsForField.Put(violation.Kind, iCount.(int64)+1)
+	}
+}
+
+// printSummary writes, e.g.
+//
+//	mlr validate: 2 of 10 records were invalid.
+//	  color: 1 enum
+//	  quantity: 1 type, 1 range
+func (tr *TransformerValidate) printSummary() {
+	if tr.invalidRecordCount == 0 {
+		return
+	}
+	fmt.Fprintf(
+		os.Stderr,
+		"mlr %s: %d of %d records were invalid.\n",
+		verbNameValidate,
+		tr.invalidRecordCount,
+		tr.recordCount,
+	)
+
+	// Schema fields first, in schema order, then unexpected ones
+	for _, field := range tr.schema.Fields {
+		iCountsForField := tr.violationCounts.Get(field.Name)
+		if iCountsForField != nil {
+			printValidateSummaryLine(field.Name, iCountsForField.(*lib.OrderedMap))
+		}
+	}
+	for pe := tr.violationCounts.Head; pe != nil; pe = pe.Next {
+		if tr.schema.GetField(pe.Key) == nil {
+			printValidateSummaryLine(pe.Key, pe.Value.(*lib.OrderedMap))
+		}
+	}
+}
+
+func printValidateSummaryLine(fieldName string, countsForField *lib.OrderedMap) {
+	descriptions := make([]string, 0, countsForField.FieldCount)
+	for pe := countsForField.Head; pe != nil; pe = pe.Next {
+		descriptions = append(descriptions, fmt.Sprintf("%d %s", pe.Value.(int64), pe.Key))
+	}
+	fmt.Fprintf(os.Stderr, "  %s: %s\n", fieldName, strings.Join(descriptions, ", "))
+}
@@ -0,0 +1,847 @@
+// ================================================================
+// The window verb is like SQL window functions: records are partitioned by the
+// -g fields and ordered within each partition by the -s/-r fields. Then for
+// each record, ranking functions are computed from its position in the
+// partition, and value functions -- first_value, last_value, and the stats1
+// accumulators -- are computed over its frame. For example:
+//
+//   mlr window -g shape -s t -a rank,mean -f x --frame 'rows between 2 preceding and current row'
+//
+// The frame is a range of records around the current one, in the ordering:
+//
+// * "rows" frames are by record count: "rows between 3 preceding and 1 following".
+//
+// * "range" frames are by the value of the ordering field: with "range between
+//   10 preceding and current row" and ordering by t, the frame for a record with
+//   t=100 has all the records in its partition with t from 90 through 100.
+//   Offsets may be durations such as 1h or 30m, for ordering fields in seconds.
+//   Here "current row" means the current record along with its peers, i.e. the
+//   records having the same ordering-field values.
+//
+// Since a partition's last record may be anywhere in the record stream, all
+// records are retained until end of stream.
+// ================================================================
+
+package transformers
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/johnkerl/miller/v6/pkg/bifs"
+	"github.com/johnkerl/miller/v6/pkg/cli"
+	"github.com/johnkerl/miller/v6/pkg/lib"
+	"github.com/johnkerl/miller/v6/pkg/mlrval"
+	"github.com/johnkerl/miller/v6/pkg/transformers/utils"
+	"github.com/johnkerl/miller/v6/pkg/types"
+)
+
+// ----------------------------------------------------------------
+const verbNameWindow = "window"
+
+const windowDefaultFrame = "range between unbounded preceding and current row"
+
+var WindowSetup = TransformerSetup{
+	Verb:         verbNameWindow,
+	UsageFunc:    transformerWindowUsage,
+	ParseCLIFunc: transformerWindowParseCLI,
+	IgnoresInput: false,
+}
+
+type tWindowFunctionInfo struct {
+	name string
+	desc string
+}
+
+// Ranking functions, which depend only on the ordering within the partition.
+var WINDOW_RANKING_FUNCTION_TABLE = []tWindowFunctionInfo{
+	{"row_number", "1, 2, 3, ... within the partition"},
+	{"rank", "Like row_number but the same for peers, with gaps after ties: 1, 2, 2, 4, ..."},
+	{"dense_rank", "Like rank but without gaps: 1, 2, 2, 3, ..."},
+	{"ntile_N", "Bucket number from 1 to N, e.g. ntile_4 for quartiles, with bucket sizes\n" +
+		"             differing by at most one"},
+}
+
+// Value functions, which are computed over the -f fields' values in the frame.
+var WINDOW_VALUE_FUNCTION_TABLE = []tWindowFunctionInfo{
+	{"first_value", "Value of the first record in the frame"},
+	{"last_value", "Value of the last record in the frame"},
+}
+
+func transformerWindowUsage(
+	o *os.File,
+) {
+	fmt.Fprintf(o, "Usage: mlr %s [options]\n", verbNameWindow)
+	fmt.Fprintf(o, "Computes SQL-style window functions: for each record, values depending on its\n")
+	fmt.Fprintf(o, "position within its partition, and statistics over a frame of records around it.\n")
+	fmt.Fprintf(o, "Output is by partition, in order of first appearance, and ordered within each\n")
+	fmt.Fprintf(o, "partition by the -s/-r fields. The sort is stable. Records lacking any of the -g,\n")
+	fmt.Fprintf(o, "-s, or -r fields are passed through unmodified, at the end of the output.\n")
+	fmt.Fprintf(o, "Options:\n")
+	fmt.Fprintf(o, "-a {rank,mean,...} Names of window functions: comma-separated, one or more of:\n")
+	for _, info := range WINDOW_RANKING_FUNCTION_TABLE {
+		fmt.Fprintf(o, "  %-10s %s\n", info.name, info.desc)
+	}
+	for _, info := range WINDOW_VALUE_FUNCTION_TABLE {
+		fmt.Fprintf(o, "  %-10s %s\n", info.name, info.desc)
+	}
+	fmt.Fprintf(o, "  as well as the following, computed over the frame:\n")
+	fmt.Fprintf(o, "  median   This is the same as p50\n")
+	fmt.Fprintf(o, "  p10 p25.2 p50 p98 p100 etc.\n")
+	utils.ListStats1Accumulators(o)
+	fmt.Fprintf(o, "-f {a,b,c}   Value-field names for first_value, last_value, and statistics.\n")
+	fmt.Fprintf(o, "             Output fields are named like x_mean for field x and function mean.\n")
+	fmt.Fprintf(o, "             Ranking functions' output fields are named like the function.\n")
+	fmt.Fprintf(o, "-g {d,e,f}   Optional partition (group-by) field names.\n")
+	fmt.Fprintf(o, "-s {d,e,f}   Ordering-field names, ascending, with numbers before strings.\n")
+	fmt.Fprintf(o, "-r {d,e,f}   Ordering-field names, descending. -s and -r may be used together,\n")
+	fmt.Fprintf(o, "             e.g. '-s a -r b', with ordering by a first then by b.\n")
+	fmt.Fprintf(o, "--frame {spec} Frame for value functions: one of\n")
+	fmt.Fprintf(o, "               rows between {start} and {end}\n")
+	fmt.Fprintf(o, "               range between {start} and {end}\n")
+	fmt.Fprintf(o, "             where {start} and {end} are one of\n")
+	fmt.Fprintf(o, "               unbounded preceding\n")
+	fmt.Fprintf(o, "               {n} preceding\n")
+	fmt.Fprintf(o, "               current row\n")
+	fmt.Fprintf(o, "               {n} following\n")
+	fmt.Fprintf(o, "               unbounded following\n")
+	fmt.Fprintf(o, "             \"rows {start}\" and \"range {start}\" are short for ending at current row.\n")
+	fmt.Fprintf(o, "             For rows, {n} is a record count. For range, {n} is a difference in the\n")
+	fmt.Fprintf(o, "             value of the ordering field, of which there must be exactly one; it may\n")
+	fmt.Fprintf(o, "             be a duration like 90s, 30m, 1h, or 2d for ordering fields in seconds.\n")
+	fmt.Fprintf(o, "             For range, current row includes peers: records with the same\n")
+	fmt.Fprintf(o, "             ordering-field values. The default frame is\n")
+	fmt.Fprintf(o, "               %s\n", windowDefaultFrame)
+	fmt.Fprintf(o, "             which is the entire partition if there are no ordering fields.\n")
+	fmt.Fprintf(o, "-i           Use interpolated percentiles, like R's type=7; default like type=1.\n")
+	fmt.Fprintf(o, "-h|--help    Show this message.\n")
+	fmt.Fprintf(o, "\n")
+	fmt.Fprintf(o, "Examples:\n")
+	fmt.Fprintf(o, "  mlr %s -g shape -s quantity -a rank,dense_rank,ntile_4\n", verbNameWindow)
+	fmt.Fprintf(o, "  mlr %s -g shape -s index -a sum -f quantity\n", verbNameWindow)
+	fmt.Fprintf(o, "  mlr %s -s index -a mean,max -f x --frame 'rows between 2 preceding and 2 following'\n",
+		verbNameWindow)
+	fmt.Fprintf(o, "  mlr %s -g host -s t -a count,p95 -f latency --frame 'range between 1h preceding and current row'\n",
+		verbNameWindow)
+}
+
+func transformerWindowParseCLI(
+	pargi *int,
+	argc int,
+	args []string,
+	_ *cli.TOptions,
+	doConstruct bool, // false for first pass of CLI-parse, true for second pass
+) IRecordTransformer {
+
+	// Skip the verb name from the current spot in the mlr command line
+	argi := *pargi
+	verb := args[argi]
+	argi++
+
+	var functionNames []string = nil
+	var valueFieldNames []string = nil
+	var groupByFieldNames []string = nil
+	var orderFieldNames []string = nil
+	var orderDescendings []bool = nil
+	frameSpec := windowDefaultFrame
+	doInterpolatedPercentiles := false
+
+	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
+		opt := args[argi]
+		if !strings.HasPrefix(opt, "-") {
+			break // No more flag options to process
+		}
+		if args[argi] == "--" {
+			break // All transformers must do this so main-flags can follow verb-flags
+		}
+		argi++
+
+		if opt == "-h" || opt == "--help" {
+			transformerWindowUsage(os.Stdout)
+			os.Exit(0)
+
+		} else if opt == "-a" {
+			functionNames = append(functionNames, cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)...)
+
+		} else if opt == "-f" {
+			valueFieldNames = append(valueFieldNames, cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)...)
+
+		} else if opt == "-g" {
+			groupByFieldNames = append(groupByFieldNames, cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)...)
+
+		} else if opt == "-s" {
+			for _, item := range cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc) {
+				orderFieldNames = append(orderFieldNames, item)
+				orderDescendings = append(orderDescendings, false)
+			}
+
+		} else if opt == "-r" {
+			for _, item := range cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc) {
+				orderFieldNames = append(orderFieldNames, item)
+				orderDescendings = append(orderDescendings, true)
+			}
+
+		} else if opt == "--frame" {
+			frameSpec = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+
+		} else if opt == "-i" {
+			doInterpolatedPercentiles = true
+
+		} else {
+			transformerWindowUsage(os.Stderr)
+			os.Exit(1)
+		}
+	}
+
+	*pargi = argi
+	if !doConstruct { // All transformers must do this for main command-line parsing
+		return nil
+	}
+
+	transformer, err := NewTransformerWindow(
+		functionNames,
+		valueFieldNames,
+		groupByFieldNames,
+		orderFieldNames,
+		orderDescendings,
+		frameSpec,
+		doInterpolatedPercentiles,
+	)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	return transformer
+}
+
+// ================================================================
+// FRAMES
+
+type tWindowFrameBoundType int
+
+// In order: a frame's start may not be of a later type than its end.
+const (
+	windowFrameBoundUnboundedPreceding tWindowFrameBoundType = iota
+	windowFrameBoundPreceding
+	windowFrameBoundCurrentRow
+	windowFrameBoundFollowing
+	windowFrameBoundUnboundedFollowing
+)
+
+type tWindowFrameBound struct {
+	boundType tWindowFrameBoundType
+	// For windowFrameBoundPreceding and windowFrameBoundFollowing
+	rowCount   int
+	rangeDelta float64
+}
+
+type tWindowFrame struct {
+	isRange bool
+	start   tWindowFrameBound
+	end     tWindowFrameBound
+}
+
+// parseWindowFrame parses frame specifications like "rows between 3 preceding
+// and current row" or "range between 1h preceding and current row".
+func parseWindowFrame(frameSpec string) (*tWindowFrame, error) {
+	words := strings.Fields(strings.ToLower(frameSpec))
+	if len(words) < 2 || (words[0] != "rows" && words[0] != "range") {
+		return nil, fmt.Errorf(
+			"mlr %s: frame \"%s\" must start with \"rows\" or \"range\".", verbNameWindow, frameSpec,
+		)
+	}
+	frame := &tWindowFrame{isRange: words[0] == "range"}
+	words = words[1:]
+
+	var startWords, endWords []string
+	if words[0] == "between" {
+		andIndex := -1
+		for i, word := range words {
+			if word == "and" {
+				andIndex = i
+				break
+			}
+		}
+		if andIndex < 0 {
+			return nil, fmt.Errorf(
+				"mlr %s: frame \"%s\" has \"between\" without \"and\".", verbNameWindow, frameSpec,
+			)
+		}
+		startWords = words[1:andIndex]
+		endWords = words[andIndex+1:]
+	} else {
+		startWords = words
+		endWords = []string{"current", "row"}
+	}
+
+	var err error
+	frame.start, err = frame.parseBound(startWords, frameSpec)
+	if err != nil {
+		return nil, err
+	}
+	frame.end, err = frame.parseBound(endWords, frameSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	if frame.start.boundType == windowFrameBoundUnboundedFollowing {
+		return nil, fmt.Errorf(
+			"mlr %s: frame \"%s\" may not start at unbounded following.", verbNameWindow, frameSpec,
+		)
+	}
+	if frame.end.boundType == windowFrameBoundUnboundedPreceding {
+		return nil, fmt.Errorf(
+			"mlr %s: frame \"%s\" may not end at unbounded preceding.", verbNameWindow, frameSpec,
+		)
+	}
+	if frame.start.boundType > frame.end.boundType {
+		return nil, fmt.Errorf(
+			"mlr %s: frame \"%s\" starts after it ends.", verbNameWindow, frameSpec,
+		)
+	}
+
+	return frame, nil
+}
+
+func (frame *tWindowFrame) parseBound(words []string, frameSpec string) (tWindowFrameBound, error) {
+	bound := tWindowFrameBound{}
+	if len(words) != 2 {
+		return bound, fmt.Errorf(
+			"mlr %s: could not parse frame \"%s\".", verbNameWindow, frameSpec,
+		)
+	}
+
+	if words[0] == "unbounded" && words[1] == "preceding" {
+		bound.boundType = windowFrameBoundUnboundedPreceding
+		return bound, nil
+	}
+	if words[0] == "unbounded" && words[1] == "following" {
+		bound.boundType = windowFrameBoundUnboundedFollowing
+		return bound, nil
+	}
+	if words[0] == "current" && words[1] == "row" {
+		bound.boundType = windowFrameBoundCurrentRow
+		return bound, nil
+	}
+
+	if words[1] == "preceding" {
+		bound.boundType = windowFrameBoundPreceding
+	} else if words[1] == "following" {
+		bound.boundType = windowFrameBoundFollowing
+	} else {
+		return bound, fmt.Errorf(
+			"mlr %s: could not parse frame \"%s\".", verbNameWindow, frameSpec,
+		)
+	}
+
+	if frame.isRange {
+		delta, ok := parseWindowRangeDelta(words[0])
+		if !ok || delta < 0 {
+			return bound, fmt.Errorf(
+				"mlr %s: frame \"%s\": range offset \"%s\" is not a non-negative number or duration.",
+				verbNameWindow, frameSpec, words[0],
+			)
+		}
+		bound.rangeDelta = delta
+	} else {
+		rowCount, err := strconv.Atoi(words[0])
+		if err != nil || rowCount < 0 {
+			return bound, fmt.Errorf(
+				"mlr %s: frame \"%s\": row count \"%s\" is not a non-negative integer.",
+				verbNameWindow, frameSpec, words[0],
+			)
+		}
+		bound.rowCount = rowCount
+	}
+	return bound, nil
+}
+
+// parseWindowRangeDelta accepts numbers like 10 or 0.5, and durations like
+// 90s, 30m, 1h, 2d, or 1h30m, which are converted to seconds.
+func parseWindowRangeDelta(input string) (float64, bool) {
+	delta, ok := lib.TryFloatFromString(input)
+	if ok {
+		return delta, true
+	}
+	seconds := bifs.BIF_dhms2fsec(mlrval.FromString(input))
+	return seconds.GetNumericToFloatValue()
+}
+
+// hasRangeOffsets is true if the frame needs the numeric values of the ordering
+// field.
+func (frame *tWindowFrame) hasRangeOffsets() bool {
+	if !frame.isRange {
+		return false
+	}
+	for _, bound := range []tWindowFrameBound{frame.start, frame.end} {
+		if bound.boundType == windowFrameBoundPreceding || bound.boundType == windowFrameBoundFollowing {
+			return true
+		}
+	}
+	return false
+}
+
+// ================================================================
+
+type TransformerWindow struct {
+	rankingFunctionNames []string
+	valueFunctionNames   []string
+	// Subset of valueFunctionNames, for stats1 accumulators
+	accumulatorNames          []string
+	valueFieldNames           []string
+	groupByFieldNames         []string
+	orderFieldNames           []string
+	orderDescendings          []bool
+	orderComparatorFuncs      []mlrval.CmpFuncInt
+	frame                     *tWindowFrame
+	doInterpolatedPercentiles bool
+
+	// Map from grouping key to *tWindowPartition
+	partitions *lib.OrderedMap
+	// Records lacking partition or ordering fields
+	spillGroup *list.List
+}
+
+type tWindowPartitionRecord struct {
+	recordAndContext *types.RecordAndContext
+	orderValues      []*mlrval.Mlrval
+}
+
+type tWindowPartition struct {
+	records []*tWindowPartitionRecord
+	// Computed at end of stream, after sorting
+	peerStarts []int
+	peerEnds   []int
+}
+
+func NewTransformerWindow(
+	functionNames []string,
+	valueFieldNames []string,
+	groupByFieldNames []string,
+	orderFieldNames []string,
+	orderDescendings []bool,
+	frameSpec string,
+	doInterpolatedPercentiles bool,
+) (*TransformerWindow, error) {
+
+	if len(functionNames) == 0 {
+		return nil, fmt.Errorf("mlr %s: -a is a required argument.", verbNameWindow)
+	}
+
+	tr := &TransformerWindow{
+		rankingFunctionNames:      make([]string, 0),
+		valueFunctionNames:        make([]string, 0),
+		accumulatorNames:          make([]string, 0),
+		valueFieldNames:           valueFieldNames,
+		groupByFieldNames:         groupByFieldNames,
+		orderFieldNames:           orderFieldNames,
+		orderDescendings:          orderDescendings,
+		orderComparatorFuncs:      make([]mlrval.CmpFuncInt, len(orderDescendings)),
+		doInterpolatedPercentiles: doInterpolatedPercentiles,
+		partitions:                lib.NewOrderedMap(),
+		spillGroup:                list.New(),
+	}
+
+	for i, descending := range orderDescendings {
+		if descending {
+			tr.orderComparatorFuncs[i] = mlrval.NumericDescendingComparator
+		} else {
+			tr.orderComparatorFuncs[i] = mlrval.NumericAscendingComparator
+		}
+	}
+
+	for _, functionName := range functionNames {
+		if isWindowRankingFunctionName(functionName) {
+			tr.rankingFunctionNames = append(tr.rankingFunctionNames, functionName)
+		} else if functionName == "first_value" || functionName == "last_value" {
+			tr.valueFunctionNames = append(tr.valueFunctionNames, functionName)
+		} else if utils.ValidateStats1AccumulatorName(functionName) {
+			tr.valueFunctionNames = append(tr.valueFunctionNames, functionName)
+			tr.accumulatorNames = append(tr.accumulatorNames, functionName)
+		} else {
+			return nil, fmt.Errorf("mlr %s: function \"%s\" not found.", verbNameWindow, functionName)
+		}
+	}
+
+	if len(tr.valueFunctionNames) > 0 && len(valueFieldNames) == 0 {
+		return nil, fmt.Errorf(
+			"mlr %s: -f is required for function \"%s\".", verbNameWindow, tr.valueFunctionNames[0],
+		)
+	}
+
+	frame, err := parseWindowFrame(frameSpec)
+	if err != nil {
+		return nil, err
+	}
+	if frame.hasRangeOffsets() && len(orderFieldNames) != 1 {
+		return nil, fmt.Errorf(
+			"mlr %s: range frames with offsets need exactly one ordering field; got %d.",
+			verbNameWindow, len(orderFieldNames),
+		)
+	}
+	tr.frame = frame
+
+	return tr, nil
+}
+
+func isWindowRankingFunctionName(functionName string) bool {
+	if functionName == "row_number" || functionName == "rank" || functionName == "dense_rank" {
+		return true
+	}
+	_, ok := windowNtileBucketCount(functionName)
+	return ok
+}
+
+// windowNtileBucketCount gets N from names like ntile_N.
+func windowNtileBucketCount(functionName string) (int, bool) {
+	if !strings.HasPrefix(functionName, "ntile_") {
+		return 0, false
+	}
+	n, err := strconv.Atoi(functionName[len("ntile_"):])
+	if err != nil || n < 1 {
+		return 0, false
+	}
+	return n, true
+}
+
+// ----------------------------------------------------------------
+func (tr *TransformerWindow) Transform(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	HandleDefaultDownstreamDone(inputDownstreamDoneChannel, outputDownstreamDoneChannel)
+
+	if !inrecAndContext.EndOfStream {
+		inrec := inrecAndContext.Record
+
+		groupingKey, gok := inrec.GetSelectedValuesJoined(tr.groupByFieldNames)
+		if !gok {
+			tr.spillGroup.PushBack(inrecAndContext)
+			return
+		}
+		orderValues, ook := inrec.ReferenceSelectedValues(tr.orderFieldNames)
+		if !ook {
+			tr.spillGroup.PushBack(inrecAndContext)
+			return
+		}
+
+		partition := tr.partitions.Get(groupingKey)
+		if partition == nil {
+			partition = &tWindowPartition{
+				records: make([]*tWindowPartitionRecord, 0),
+			}
+			tr.partitions.Put(groupingKey, partition)
+		}
+		partition.(*tWindowPartition).records = append(
+			partition.(*tWindowPartition).records,
+			&tWindowPartitionRecord{
+				recordAndContext: inrecAndContext,
+				orderValues:      orderValues,
+			},
+		)
+
+	} else {
+		for pe := tr.partitions.Head; pe != nil; pe = pe.Next {
+			partition := pe.Value.(*tWindowPartition)
+			tr.processPartition(partition)
+			for _, partitionRecord := range partition.records {
+				outputRecordsAndContexts.PushBack(partitionRecord.recordAndContext)
+			}
+		}
+
+		for e := tr.spillGroup.Front(); e != nil; e = e.Next() {
+			outputRecordsAndContexts.PushBack(e.Value.(*types.RecordAndContext))
+		}
+
+		outputRecordsAndContexts.PushBack(inrecAndContext) // end-of-stream marker
+	}
+}
+
+// ----------------------------------------------------------------
+func (tr *TransformerWindow) processPartition(partition *tWindowPartition) {
+	if len(tr.orderFieldNames) > 0 {
+		sort.SliceStable(partition.records, func(i, j int) bool {
+			return tr.compareOrderValues(partition.records[i], partition.records[j]) < 0
+		})
+	}
+	tr.findPeers(partition)
+
+	if len(tr.rankingFunctionNames) > 0 {
+		tr.computeRankingFunctions(partition)
+	}
+	if len(tr.valueFunctionNames) > 0 {
+		frameStarts, frameEnds := tr.computeFrames(partition)
+		for _, valueFieldName := range tr.valueFieldNames {
+			tr.computeValueFunctions(partition, valueFieldName, frameStarts, frameEnds)
+		}
+	}
+}
+
+func (tr *TransformerWindow) compareOrderValues(a, b *tWindowPartitionRecord) int {
+	for i, comparatorFunc := range tr.orderComparatorFuncs {
+		c := comparatorFunc(a.orderValues[i], b.orderValues[i])
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// findPeers finds, for each record, the indices of the first and last records
+// having the same ordering-field values. With no ordering fields, all records
+// in the partition are peers.
+func (tr *TransformerWindow) findPeers(partition *tWindowPartition) {
+	n := len(partition.records)
+	partition.peerStarts = make([]int, n)
+	partition.peerEnds = make([]int, n)
+
+	start := 0
+	for i := 1; i <= n; i++ {
+		if i == n || tr.compareOrderValues(partition.records[start], partition.records[i]) != 0 {
+			for j := start; j < i; j++ {
+				partition.peerStarts[j] = start
+				partition.peerEnds[j] = i - 1
+			}
+			start = i
+		}
+	}
+}
+
+// ----------------------------------------------------------------
+func (tr *TransformerWindow) computeRankingFunctions(partition *tWindowPartition) {
+	n := len(partition.records)
+	denseRank := 0
+	for i, partitionRecord := range partition.records {
+		if partition.peerStarts[i] == i {
+			denseRank++
+		}
+		record := partitionRecord.recordAndContext.Record
+
+		for _, functionName := range tr.rankingFunctionNames {
+			var value int
+			switch functionName {
+			case "row_number":
+				value = i + 1
+			case "rank":
+				value = partition.peerStarts[i] + 1
+			case "dense_rank":
+				value = denseRank
+			default:
+				bucketCount, _ := windowNtileBucketCount(functionName)
+				value = windowNtile(i, n, bucketCount)
+			}
+			record.PutReference(functionName, mlrval.FromInt(int64(value)))
+		}
+	}
+}
+
+// windowNtile returns the 1-up bucket number for the 0-up index i of n, with
+// bucket sizes differing by at most one, and the larger buckets first.
+func windowNtile(i, n, bucketCount int) int {
+	quotient := n / bucketCount
+	remainder := n % bucketCount
+	numInLargerBuckets := remainder * (quotient + 1)
+	if i < numInLargerBuckets {
+		return i/(quotient+1) + 1
+	}
+	return remainder + (i-numInLargerBuckets)/quotient + 1
+}
+
+// ----------------------------------------------------------------
+// computeFrames returns, for each record in the partition, the indices of the
+// first and last records of its frame. The frame is empty if the start is past
+// the end. Both starts and ends are non-decreasing.
+func (tr *TransformerWindow) computeFrames(partition *tWindowPartition) ([]int, []int) {
+	n := len(partition.records)
+	frameStarts := make([]int, n)
+	frameEnds := make([]int, n)
+
+	var orderFloats []float64 = nil
+	if tr.frame.hasRangeOffsets() {
+		orderFloats = make([]float64, n)
+		for i, partitionRecord := range partition.records {
+			orderValue := partitionRecord.orderValues[0]
+			orderFloat, ok := orderValue.GetNumericToFloatValue()
+			if !ok {
+				fmt.Fprintf(
+					os.Stderr,
+					"mlr %s: range frames with offsets need numeric values of field \"%s\"; got \"%s\".\n",
+					verbNameWindow, tr.orderFieldNames[0], orderValue.String(),
+				)
+				os.Exit(1)
+			}
+			orderFloats[i] = orderFloat
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		frameStarts[i] = tr.frameBoundIndex(partition, tr.frame.start, true, i, orderFloats)
+		frameEnds[i] = tr.frameBoundIndex(partition, tr.frame.end, false, i, orderFloats)
+	}
+	return frameStarts, frameEnds
+}
+
+func (tr *TransformerWindow) frameBoundIndex(
+	partition *tWindowPartition,
+	bound tWindowFrameBound,
+	isStart bool,
+	i int,
+	orderFloats []float64,
+) int {
+	n := len(partition.records)
+
+	switch bound.boundType {
+	case windowFrameBoundUnboundedPreceding:
+		return 0
+	case windowFrameBoundUnboundedFollowing:
+		return n - 1
+	case windowFrameBoundCurrentRow:
+		if !tr.frame.isRange {
+			return i
+		} else if isStart {
+			return partition.peerStarts[i]
+		} else {
+			return partition.peerEnds[i]
+		}
+	}
+
+	if !tr.frame.isRange {
+		index := i + bound.rowCount
+		if bound.boundType == windowFrameBoundPreceding {
+			index = i - bound.rowCount
+		}
+		if isStart && index < 0 {
+			return 0
+		}
+		if !isStart && index >= n {
+			return n - 1
+		}
+		return index
+	}
+
+	// Range frames: the frame is the records whose ordering-field values are
+	// within the deltas of the current record's, in the ordering direction.
+	descending := tr.orderDescendings[0]
+	delta := bound.rangeDelta
+	if bound.boundType == windowFrameBoundPreceding {
+		delta = -delta
+	}
+	if descending {
+		delta = -delta
+	}
+	threshold := orderFloats[i] + delta
+
+	if isStart {
+		// First record at or beyond the threshold
+		return sort.Search(n, func(j int) bool {
+			if descending {
+				return orderFloats[j] <= threshold
+			}
+			return orderFloats[j] >= threshold
+		})
+	} else {
+		// Last record at or before the threshold
+		return sort.Search(n, func(j int) bool {
+			if descending {
+				return orderFloats[j] < threshold
+			}
+			return orderFloats[j] > threshold
+		}) - 1
+	}
+}
+
+// ----------------------------------------------------------------
+// computeValueFunctions computes first_value, last_value, and the stats1
+// accumulators over each record's frame. Since frame starts and ends are
+// non-decreasing, the accumulators are re-ingested only when the frame start
+// moves; for frames starting at unbounded preceding, each value is ingested
+// once.
+func (tr *TransformerWindow) computeValueFunctions(
+	partition *tWindowPartition,
+	valueFieldName string,
+	frameStarts []int,
+	frameEnds []int,
+) {
+	n := len(partition.records)
+	values := make([]*mlrval.Mlrval, n)
+	for i, partitionRecord := range partition.records {
+		values[i] = partitionRecord.recordAndContext.Record.Get(valueFieldName)
+	}
+
+	accumulatorFactory := utils.NewStats1AccumulatorFactory()
+	accumulators := make(map[string]utils.IStats1Accumulator)
+	for _, accumulatorName := range tr.accumulatorNames {
+		accumulators[accumulatorName] = accumulatorFactory.MakeAccumulator(
+			accumulatorName,
+			"",
+			valueFieldName,
+			tr.doInterpolatedPercentiles,
+		)
+	}
+	ingestedStart := 0
+	ingestedEnd := -1
+
+	for i, partitionRecord := range partition.records {
+		frameStart := frameStarts[i]
+		frameEnd := frameEnds[i]
+
+		if len(accumulators) > 0 {
+			if frameStart != ingestedStart || frameEnd < ingestedEnd {
+				for _, accumulator := range accumulators {
+					accumulator.Reset()
+				}
+				ingestedStart = frameStart
+				ingestedEnd = frameStart - 1
+			}
+			for j := ingestedEnd + 1; j <= frameEnd; j++ {
+				tr.ingestValue(values[j], accumulators)
+				ingestedEnd = j
+			}
+		}
+
+		record := partitionRecord.recordAndContext.Record
+		for _, functionName := range tr.valueFunctionNames {
+			var value *mlrval.Mlrval = nil
+			switch functionName {
+			case "first_value":
+				if frameStart <= frameEnd {
+					value = values[frameStart]
+				}
+			case "last_value":
+				if frameStart <= frameEnd {
+					value = values[frameEnd]
+				}
+			default:
+				value = accumulators[functionName].Emit()
+			}
+			if value == nil || value.IsAbsent() {
+				value = mlrval.VOID
+			}
+			record.PutCopy(valueFieldName+"_"+functionName, value)
+		}
+	}
+}
+
+// ingestValue follows the stats1 verb: empty values are counted only by
+// null_count, and records lacking the field are skipped.
+func (tr *TransformerWindow) ingestValue(
+	value *mlrval.Mlrval,
+	accumulators map[string]utils.IStats1Accumulator,
+) {
+	if value == nil {
+		return
+	}
+	for accumulatorName, accumulator := range accumulators {
+		if value.IsVoid() && accumulatorName != "null_count" {
+			continue
+		}
+		accumulator.Ingest(value)
+	}
+}
@@ -0,0 +1,6 @@
+# account extract layout
+id:1-6
+name:7-26
+amount:27-38
+# 2-column filler
+date:41-48